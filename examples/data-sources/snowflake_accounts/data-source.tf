@@ -0,0 +1,9 @@
+# requires the ORGADMIN role in the session
+data "snowflake_accounts" "prod" {
+  pattern = "PROD_%"
+}
+
+# the accounts of the organization, e.g. the targets of providers configured per account
+output "prod_account_urls" {
+  value = { for a in data.snowflake_accounts.prod.accounts : a.account_name => a.account_url }
+}
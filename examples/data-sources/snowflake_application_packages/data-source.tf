@@ -0,0 +1,10 @@
+data "snowflake_application_packages" "app" {
+  pattern = "MY_APP_PACKAGE"
+}
+
+# the version and patch released to the consumers by default, e.g. to check it in a CI pipeline
+output "default_release" {
+  value = [
+    for d in data.snowflake_application_packages.app.application_packages[0].release_directives : "${d.version}.${d.patch}" if d.name == "DEFAULT"
+  ]
+}
@@ -0,0 +1,6 @@
+data "snowflake_applications" "all" {}
+
+# the installed version of each application
+output "application_versions" {
+  value = { for a in data.snowflake_applications.all.applications : a.name => "${a.version}.${a.patch}" }
+}
@@ -0,0 +1,6 @@
+data "snowflake_compute_pools" "all" {}
+
+# the compute pools which are not running
+output "suspended_compute_pools" {
+  value = [for p in data.snowflake_compute_pools.all.compute_pools : p.name if p.state == "SUSPENDED"]
+}
@@ -0,0 +1,13 @@
+data "snowflake_connections" "prod" {
+  pattern = "PROD"
+}
+
+# the host name of the connection, e.g. the target of the CNAME record the clients connect to
+output "prod_connection_url" {
+  value = data.snowflake_connections.prod.connections[0].connection_url
+}
+
+# the account of the primary connection, to which the clients are redirected
+output "prod_primary_account" {
+  value = [for c in data.snowflake_connections.prod.connections : "${c.organization_name}.${c.account_name}" if c.is_primary]
+}
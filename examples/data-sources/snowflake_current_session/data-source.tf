@@ -0,0 +1,5 @@
+data "snowflake_current_session" "this" {}
+
+output "connected_as" {
+  value = "${data.snowflake_current_session.this.user} (${data.snowflake_current_session.this.role}) on ${data.snowflake_current_session.this.account}"
+}
@@ -0,0 +1,11 @@
+data "snowflake_event_tables" "logging" {
+  database = "MYDB"
+  schema   = "LOGGING"
+  pattern  = "EVENTS"
+}
+
+# collect the logs and traces of the account in the event table
+resource "snowflake_account_parameter" "event_table" {
+  key   = "EVENT_TABLE"
+  value = data.snowflake_event_tables.logging.event_tables[0].fully_qualified_name
+}
@@ -0,0 +1,10 @@
+# users and roles granted the role directly
+data "snowflake_grants_of_role" "analyst" {
+  role = "ANALYST"
+}
+
+# users and roles granted the role directly or through the role hierarchy
+data "snowflake_grants_of_role" "analyst_recursive" {
+  role      = "ANALYST"
+  recursive = true
+}
@@ -0,0 +1,10 @@
+# roles granted to the user directly
+data "snowflake_grants_to_user" "user" {
+  user = "JOHN"
+}
+
+# roles the user has directly or through the role hierarchy
+data "snowflake_grants_to_user" "user_recursive" {
+  user      = "JOHN"
+  recursive = true
+}
@@ -0,0 +1,17 @@
+# the latest logins of the user
+data "snowflake_login_history" "user" {
+  user_name = "JOHN"
+  limit     = 10
+}
+
+# the failed login attempts in the time range
+data "snowflake_login_history" "failed" {
+  start_time  = "2023-01-01T00:00:00Z"
+  end_time    = "2023-01-02T00:00:00Z"
+  failed_only = true
+}
+
+# the users who logged in with a password only
+output "password_only_users" {
+  value = distinct([for e in data.snowflake_login_history.user.events : e.user_name if e.first_authentication_factor == "PASSWORD" && e.second_authentication_factor == ""])
+}
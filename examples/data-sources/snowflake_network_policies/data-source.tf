@@ -0,0 +1,5 @@
+data "snowflake_network_policies" "current" {}
+
+output "policies_allowing_any_ip" {
+  value = [for policy in data.snowflake_network_policies.current.network_policies : policy.name if contains(policy.allowed_ip_list, "0.0.0.0/0")]
+}
@@ -21,3 +21,9 @@ data "snowflake_parameters" "p3" {
   pattern        = "ROWS_PER_RESULTSET"
   user           = "TEST_USER"
 }
+
+// read the account parameters overridden on the account, e.g. to assert the compliance settings
+data "snowflake_parameters" "p4" {
+  parameter_type = "ACCOUNT"
+  level          = "ACCOUNT"
+}
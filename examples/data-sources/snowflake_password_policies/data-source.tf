@@ -0,0 +1,4 @@
+data "snowflake_password_policies" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
@@ -0,0 +1,8 @@
+data "snowflake_regions" "aws" {
+  pattern = "aws_%"
+}
+
+# the AWS regions available for the new accounts
+output "aws_regions" {
+  value = [for r in data.snowflake_regions.aws.regions : r.snowflake_region]
+}
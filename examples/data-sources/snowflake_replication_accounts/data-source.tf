@@ -0,0 +1,7 @@
+data "snowflake_replication_accounts" "this" {}
+
+resource "snowflake_failover_group" "this" {
+  name             = "FAILOVER_GROUP"
+  object_types     = ["DATABASES"]
+  allowed_accounts = [for account in data.snowflake_replication_accounts.this.replication_accounts : account.account_identifier if account.snowflake_region == "AWS_US_WEST_2"]
+}
@@ -5,3 +5,7 @@ data "snowflake_roles" "this" {
 data "snowflake_roles" "ad" {
   pattern = "SYSADMIN"
 }
+
+data "snowflake_roles" "budget_instance_roles" {
+  in_class = "SNOWFLAKE.CORE.BUDGET"
+}
@@ -0,0 +1,9 @@
+data "snowflake_secrets" "api" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
+
+# the fully qualified names of the secrets, e.g. to be allowed in an external access integration
+output "secret_names" {
+  value = [for s in data.snowflake_secrets.api.secrets : "${s.database}.${s.schema}.${s.name}"]
+}
@@ -0,0 +1,6 @@
+data "snowflake_security_integrations" "all" {}
+
+data "snowflake_security_integrations" "scim" {
+  pattern       = "AAD_PROVISIONING"
+  with_describe = true
+}
@@ -0,0 +1,13 @@
+data "snowflake_services" "app" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
+
+# the public URLs of the services
+output "service_urls" {
+  value = flatten([
+    for s in data.snowflake_services.app.services : [
+      for e in s.endpoints : "https://${e.ingress_url}" if e.is_public && e.ingress_url != ""
+    ]
+  ])
+}
@@ -1,2 +1,21 @@
 data "snowflake_storage_integrations" "current" {
-}
\ No newline at end of file
+}
+
+locals {
+  s3_integration = one([for integration in data.snowflake_storage_integrations.current.storage_integrations : integration if integration.name == "S3_INTEGRATION"])
+}
+
+data "aws_iam_policy_document" "snowflake_trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "AWS"
+      identifiers = [local.s3_integration.storage_aws_iam_user_arn]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [local.s3_integration.storage_aws_external_id]
+    }
+  }
+}
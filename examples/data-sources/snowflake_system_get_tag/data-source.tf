@@ -0,0 +1,5 @@
+data "snowflake_system_get_tag" "cost_center" {
+  tag_id            = "MYDB.MYSCHEMA.COST_CENTER"
+  object_type       = "COLUMN"
+  object_identifier = "MYDB.MYSCHEMA.MYTABLE.MYCOLUMN"
+}
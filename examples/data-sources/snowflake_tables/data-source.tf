@@ -1,4 +1,8 @@
 data "snowflake_tables" "current" {
   database = "MYDB"
   schema   = "MYSCHEMA"
-}
\ No newline at end of file
+}
+
+data "snowflake_tables" "all" {
+  database = "MYDB"
+}
@@ -0,0 +1,4 @@
+data "snowflake_tags" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
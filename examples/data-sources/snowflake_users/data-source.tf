@@ -1,3 +1,8 @@
 data "snowflake_users" "current" {
   pattern = "user1"
-}
\ No newline at end of file
+}
+
+data "snowflake_users" "service_accounts" {
+  starts_with = "SVC_"
+  limit       = 100
+}
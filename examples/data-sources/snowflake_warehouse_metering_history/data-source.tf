@@ -0,0 +1,9 @@
+# credits used by all the warehouses in the last 30 days
+data "snowflake_warehouse_metering_history" "last_month" {
+  days = 30
+}
+
+# warehouses that used no more than a credit per metered hour, candidates for a smaller size
+output "underused_warehouses" {
+  value = [for w in data.snowflake_warehouse_metering_history.last_month.warehouses : w.name if w.credits_used <= w.metered_hours]
+}
@@ -1,2 +1,6 @@
 data "snowflake_warehouses" "current" {
-}
\ No newline at end of file
+}
+
+data "snowflake_warehouses" "etl" {
+  pattern = "ETL_%"
+}
@@ -16,3 +16,7 @@ resource "snowflake_account" "ac1" {
   comment              = "Snowflake Test Account"
   region               = "AWS_US_WEST_2"
 }
+
+output "account_url" {
+  value = snowflake_account.ac1.account_url
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | authentication policy name
+terraform import snowflake_account_authentication_policy_attachment.example 'dbName|schemaName|authenticationPolicyName'
@@ -0,0 +1,3 @@
+resource "snowflake_account_authentication_policy_attachment" "attachment" {
+  authentication_policy = "\"prod\".\"security\".\"default_authentication_policy\""
+}
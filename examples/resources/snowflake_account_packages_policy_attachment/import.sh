@@ -0,0 +1,2 @@
+# format is database name | schema name | packages policy name
+terraform import snowflake_account_packages_policy_attachment.example 'dbName|schemaName|packagesPolicyName'
@@ -0,0 +1,10 @@
+resource "snowflake_packages_policy" "policy" {
+  database  = "prod"
+  schema    = "security"
+  name      = "default_packages_policy"
+  allowlist = ["numpy", "pandas"]
+}
+
+resource "snowflake_account_packages_policy_attachment" "attachment" {
+  packages_policy = snowflake_packages_policy.policy.qualified_name
+}
@@ -7,3 +7,8 @@ resource "snowflake_account_parameter" "p2" {
   key   = "CLIENT_ENCRYPTION_KEY_SIZE"
   value = "256"
 }
+
+resource "snowflake_account_parameter" "p3" {
+  key   = "PREVENT_UNLOAD_TO_INLINE_URL"
+  value = "true"
+}
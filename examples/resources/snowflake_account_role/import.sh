@@ -0,0 +1 @@
+terraform import snowflake_account_role.example roleName
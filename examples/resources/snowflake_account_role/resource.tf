@@ -0,0 +1,4 @@
+resource "snowflake_account_role" "role" {
+  name    = "role1"
+  comment = "A role."
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | session policy name
+terraform import snowflake_account_session_policy_attachment.example 'dbName|schemaName|sessionPolicyName'
@@ -0,0 +1,3 @@
+resource "snowflake_account_session_policy_attachment" "attachment" {
+  session_policy = "\"prod\".\"security\".\"default_session_policy\""
+}
@@ -11,3 +11,19 @@ resource "snowflake_alert" "alert" {
   enabled   = true
   comment   = "my alert"
 }
+
+# serverless alert running on Snowflake-managed compute resources
+resource "snowflake_alert" "serverless_alert" {
+  database = "database"
+  schema   = "schema"
+  name     = "serverless_alert"
+  alert_schedule {
+    cron {
+      expression = "0 * * * *"
+      time_zone  = "UTC"
+    }
+  }
+  condition = "select 1 as c"
+  action    = "select 1 as c"
+  enabled   = true
+}
@@ -0,0 +1 @@
+terraform import snowflake_api_authentication_integration.example name
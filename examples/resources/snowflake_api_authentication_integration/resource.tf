@@ -0,0 +1,22 @@
+# client credentials grant, e.g. for calling an external API from a UDF with an external access integration
+resource "snowflake_api_authentication_integration" "client_credentials" {
+  name                        = "MY_API_CLIENT_CREDENTIALS"
+  oauth_grant                 = "CLIENT_CREDENTIALS"
+  oauth_client_id             = var.client_id
+  oauth_client_secret         = var.client_secret
+  oauth_token_endpoint        = "https://example.com/oauth/token"
+  oauth_access_token_validity = 3600
+  oauth_allowed_scopes        = ["read", "write"]
+  comment                     = "Client credentials for the example API"
+}
+
+# authorization code grant, e.g. for connectors acting on behalf of a user
+resource "snowflake_api_authentication_integration" "authorization_code" {
+  name                         = "MY_API_AUTHORIZATION_CODE"
+  oauth_grant                  = "AUTHORIZATION_CODE"
+  oauth_client_id              = var.client_id
+  oauth_client_secret          = var.client_secret
+  oauth_token_endpoint         = "https://example.com/oauth/token"
+  oauth_authorization_endpoint = "https://example.com/oauth/authorize"
+  oauth_refresh_token_validity = 86400
+}
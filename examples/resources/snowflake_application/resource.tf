@@ -0,0 +1,14 @@
+# installed from an application package, upgraded in place when the version changes
+resource "snowflake_application" "from_package" {
+  name                = "my_app"
+  application_package = "my_app_package"
+  version             = "v1_0"
+  patch               = 0
+  comment             = "Installed by Terraform"
+}
+
+# installed from a listing, upgraded by the provider of the application
+resource "snowflake_application" "from_listing" {
+  name    = "marketplace_app"
+  listing = "GZ1Z2X3Y4"
+}
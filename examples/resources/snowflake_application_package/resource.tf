@@ -0,0 +1,16 @@
+resource "snowflake_application_package" "example" {
+  name         = "my_app_package"
+  comment      = "Application package of my Native App"
+  distribution = "EXTERNAL"
+
+  version {
+    name  = "v1_0"
+    using = "@app_db.app_schema.app_stage/v1_0"
+    label = "First release"
+  }
+
+  default_release_directive {
+    version = "v1_0"
+    patch   = 0
+  }
+}
@@ -0,0 +1,11 @@
+resource "snowflake_authentication_policy" "policy" {
+  database                   = "database"
+  schema                     = "schema"
+  name                       = "authentication_policy"
+  authentication_methods     = ["PASSWORD", "SAML"]
+  mfa_authentication_methods = ["PASSWORD"]
+  mfa_enrollment             = "REQUIRED"
+  client_types               = ["SNOWFLAKE_UI", "DRIVERS"]
+  security_integrations      = ["ALL"]
+  comment                    = "Require MFA for password logins"
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | budget name
+terraform import snowflake_budget.example 'dbName|schemaName|budgetName'
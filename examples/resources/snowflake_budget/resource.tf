@@ -0,0 +1,13 @@
+resource "snowflake_budget" "example" {
+  database = "database"
+  schema   = "schema"
+  name     = "budget"
+
+  spending_limit = 500
+
+  notification_integration = "budgets_email_integration"
+  notification_emails      = ["admin@example.com"]
+
+  warehouses = ["analytics_wh"]
+  databases  = ["analytics"]
+}
@@ -0,0 +1 @@
+terraform import snowflake_connection.example 'conn1'
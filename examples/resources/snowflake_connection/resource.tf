@@ -0,0 +1,26 @@
+resource "snowflake_connection" "primary" {
+  name                        = "CONN1"
+  enable_failover_to_accounts = ["<org_name>.<target_account_name>"]
+  comment                     = "client redirect for the production accounts"
+}
+
+provider "snowflake" {
+  alias = "account2"
+}
+
+resource "snowflake_connection" "secondary" {
+  provider = snowflake.account2
+  name     = snowflake_connection.primary.name
+  as_replica_of {
+    organization_name   = "..."
+    source_account_name = "..."
+    name                = snowflake_connection.primary.name
+  }
+
+  // set to true during a failover to redirect the clients to this account
+  is_primary = false
+}
+
+output "connection_url" {
+  value = snowflake_connection.primary.connection_url
+}
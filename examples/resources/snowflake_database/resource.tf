@@ -4,12 +4,22 @@ resource "snowflake_database" "simple" {
   data_retention_time_in_days = 3
 }
 
+resource "snowflake_database" "with_parameters" {
+  name                            = "testing_parameters"
+  data_retention_time_in_days     = 3
+  max_data_extension_time_in_days = 7
+  default_ddl_collation           = "en-ci"
+  log_level                       = "INFO"
+  trace_level                     = "ON_EVENT"
+}
+
 resource "snowflake_database" "with_replication" {
   name    = "testing_2"
   comment = "test comment 2"
   replication_configuration {
-    accounts             = ["test_account1", "test_account_2"]
+    accounts             = ["myorg.test_account1", "myorg.test_account_2"]
     ignore_edition_check = true
+    enable_failover      = true
   }
 }
 
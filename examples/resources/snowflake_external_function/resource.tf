@@ -15,3 +15,26 @@ resource "snowflake_external_function" "test_ext_func" {
   api_integration           = "api_integration_name"
   url_of_proxy_and_resource = "https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func"
 }
+
+resource "snowflake_external_function" "test_ext_func_with_translators" {
+  name     = "my_translated_function"
+  database = "my_test_db"
+  schema   = "my_test_schema"
+  arg {
+    name = "arg1"
+    type = "varchar"
+  }
+  return_type     = "variant"
+  return_behavior = "IMMUTABLE"
+  api_integration = "api_integration_name"
+  header {
+    name  = "x-custom-header"
+    value = "snowflake"
+  }
+  context_headers           = ["CURRENT_TIMESTAMP"]
+  max_batch_rows            = 500
+  compression               = "GZIP"
+  request_translator        = "my_test_db.my_test_schema.request_translator"
+  response_translator       = "my_test_db.my_test_schema.response_translator"
+  url_of_proxy_and_resource = "https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func"
+}
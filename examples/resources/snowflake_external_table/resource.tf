@@ -15,3 +15,30 @@ resource "snowflake_external_table" "external_table" {
     type = "text"
   }
 }
+
+resource "snowflake_external_table" "delta_lake" {
+  database     = "db"
+  schema       = "schema"
+  name         = "delta_lake"
+  location     = "@db.schema.stage/delta/"
+  file_format  = "TYPE = PARQUET"
+  table_format = "DELTA"
+  partition_by = ["event_date"]
+
+  refresh_on_create = false
+  auto_refresh      = false
+  # changing the value refreshes the external table metadata
+  refresh_trigger = "2024-01-01"
+
+  column {
+    name = "event_date"
+    type = "date"
+    as   = "(value:event_date::date)"
+  }
+
+  column {
+    name = "payload"
+    type = "variant"
+    as   = "(value:payload::variant)"
+  }
+}
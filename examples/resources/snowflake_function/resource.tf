@@ -37,6 +37,47 @@ resource "snowflake_function" "test_funct_java" {
   statement   = "class CoolFunc {public static String test(int n) {return \"hello!\";}}"
 }
 
+// Example for Java language with the handler in a staged jar
+resource "snowflake_function" "test_funct_java_jar" {
+  name     = "my_java_jar_func"
+  database = "MY_DB"
+  schema   = "MY_SCHEMA"
+  arguments {
+    name = "arg1"
+    type = "varchar"
+  }
+  return_type = "varchar"
+  language    = "java"
+  imports     = ["@MY_DB.MY_SCHEMA.MY_STAGE/my_udfs.jar"]
+  handler     = "com.example.MyUdfs.echo"
+}
+
+// Example for Scala table function (UDTF)
+resource "snowflake_function" "test_funct_scala_udtf" {
+  name     = "my_scala_udtf"
+  database = "MY_DB"
+  schema   = "MY_SCHEMA"
+  arguments {
+    name = "sentence"
+    type = "varchar"
+  }
+  return_type     = "table (word varchar)"
+  language        = "scala"
+  runtime_version = "2.12"
+  handler         = "Splitter"
+  statement       = <<EOT
+class OutputRow(val word: String)
+
+class Splitter {
+  def process(sentence: String): Iterator[OutputRow] = sentence.split(" ").iterator.map(new OutputRow(_))
+}
+
+object Splitter {
+  def getOutputClass(): Class[OutputRow] = classOf[OutputRow]
+}
+EOT
+}
+
 // Example for Python language
 resource "snowflake_function" "python_test" {
   name     = "MY_PYTHON_FUNC"
@@ -56,6 +97,38 @@ resource "snowflake_function" "python_test" {
   statement           = "def add_py(i): return i+1"
 }
 
+// Example for Python language with packages, imports and external access
+resource "snowflake_function" "python_external_access" {
+  name     = "MY_PYTHON_FETCH_FUNC"
+  database = "MY_DB"
+  schema   = "MY_SCHEMA"
+  arguments {
+    name = "url"
+    type = "varchar"
+  }
+  comment                      = "Example for Python language with external access"
+  return_type                  = "varchar"
+  language                     = "python"
+  runtime_version              = "3.10"
+  packages                     = ["requests", "snowflake-snowpark-python"]
+  imports                      = ["@MY_DB.MY_SCHEMA.MY_STAGE/helpers.py"]
+  handler                      = "fetch"
+  external_access_integrations = ["MY_EXTERNAL_ACCESS_INTEGRATION"]
+  secrets {
+    secret_variable_name = "cred"
+    secret_id            = "MY_DB.MY_SCHEMA.MY_SECRET"
+  }
+  statement = <<EOT
+import _snowflake
+import requests
+from helpers import build_headers
+
+def fetch(url):
+    token = _snowflake.get_generic_secret_string('cred')
+    return requests.get(url, headers=build_headers(token)).text
+EOT
+}
+
 // Example SQL language
 resource "snowflake_function" "sql_test" {
   name     = "MY_SQL_FUNC"
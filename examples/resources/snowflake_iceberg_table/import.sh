@@ -0,0 +1,2 @@
+# format is database name | schema name | iceberg table name
+terraform import snowflake_iceberg_table.example 'dbName|schemaName|icebergTableName'
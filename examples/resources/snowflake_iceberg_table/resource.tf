@@ -0,0 +1,33 @@
+# Snowflake-managed Iceberg table
+resource "snowflake_iceberg_table" "managed" {
+  database        = "database"
+  schema          = "schema"
+  name            = "iceberg_table"
+  external_volume = "my_external_volume"
+  catalog         = "SNOWFLAKE"
+  base_location   = "iceberg_table/"
+
+  column {
+    name     = "id"
+    type     = "NUMBER(38,0)"
+    nullable = false
+  }
+
+  column {
+    name    = "data"
+    type    = "VARCHAR"
+    comment = "payload"
+  }
+
+  comment = "my iceberg table"
+}
+
+# Iceberg table using an object store catalog integration
+resource "snowflake_iceberg_table" "unmanaged" {
+  database           = "database"
+  schema             = "schema"
+  name               = "external_iceberg_table"
+  external_volume    = "my_external_volume"
+  catalog            = "my_object_store_catalog_integration"
+  metadata_file_path = "path/to/metadata/v1.metadata.json"
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | job service name
+terraform import snowflake_job_service.example 'dbName|schemaName|jobServiceName'
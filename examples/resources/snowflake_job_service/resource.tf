@@ -0,0 +1,31 @@
+resource "snowflake_job_service" "migration" {
+  database     = "database"
+  schema       = "schema"
+  name         = "migration"
+  compute_pool = "compute_pool"
+
+  specification = <<-EOT
+  spec:
+    containers:
+    - name: main
+      image: /database/schema/repository/migrations:latest
+      args: ["migrate", "up"]
+  EOT
+
+  query_warehouse = "warehouse"
+  async           = true
+
+  # changing the triggers executes the job again
+  triggers = {
+    version = "1.4.0"
+  }
+}
+
+resource "snowflake_job_service" "loader" {
+  database           = "database"
+  schema             = "schema"
+  name               = "loader"
+  compute_pool       = "compute_pool"
+  stage              = "@database.schema.specs"
+  specification_file = "loader.yaml"
+}
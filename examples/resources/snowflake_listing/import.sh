@@ -0,0 +1 @@
+terraform import snowflake_listing.example 'listing_name'
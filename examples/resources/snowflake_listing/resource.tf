@@ -0,0 +1,19 @@
+resource "snowflake_share" "share" {
+  name = "share_name"
+}
+
+resource "snowflake_listing" "listing" {
+  name     = "private_listing"
+  share    = snowflake_share.share.name
+  publish  = true
+  comment  = "deployed from CI"
+  manifest = <<-EOT
+    title: "Weather data"
+    subtitle: "Daily weather observations"
+    description: "Weather observations for the whole world."
+    listing_terms:
+      type: "OFFLINE"
+    targets:
+      accounts: ["organizationName.accountName"]
+  EOT
+}
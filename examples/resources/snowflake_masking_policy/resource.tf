@@ -21,3 +21,24 @@ resource "snowflake_masking_policy" "test" {
 
   return_data_type = "VARCHAR"
 }
+
+# Conditional masking policy - the additional columns are used to decide whether the first one should be masked
+resource "snowflake_masking_policy" "conditional" {
+  name     = "EXAMPLE_CONDITIONAL_MASKING_POLICY"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  signature {
+    column {
+      name = "email"
+      type = "VARCHAR"
+    }
+    column {
+      name = "visibility"
+      type = "VARCHAR"
+    }
+  }
+  masking_expression = "case when visibility = 'PUBLIC' then email else '***MASKED***' end"
+
+  return_data_type      = "VARCHAR"
+  exempt_other_policies = true
+}
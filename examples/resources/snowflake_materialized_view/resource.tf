@@ -12,3 +12,16 @@ SQL
   or_replace = false
   is_secure  = false
 }
+
+resource "snowflake_materialized_view" "clustered" {
+  database  = "db"
+  schema    = "schema"
+  name      = "clustered_view"
+  warehouse = "warehouse"
+
+  statement = "select id, created_at from foo"
+  is_secure = true
+
+  cluster_by           = ["id", "to_date(created_at)"]
+  automatic_clustering = false
+}
@@ -5,3 +5,19 @@ resource "snowflake_network_policy" "policy" {
   allowed_ip_list = ["192.168.0.100/24"]
   blocked_ip_list = ["192.168.0.101"]
 }
+
+resource "snowflake_network_rule" "allowed" {
+  name       = "allowed_rule"
+  database   = "database"
+  schema     = "schema"
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_network_policy" "rule_based_policy" {
+  name    = "rule_based_policy"
+  comment = "A policy based on network rules."
+
+  allowed_network_rule_list = [snowflake_network_rule.allowed.qualified_name]
+}
@@ -6,3 +6,23 @@ resource "snowflake_oauth_integration" "tableau_desktop" {
   oauth_refresh_token_validity = 3600
   blocked_roles_list           = ["SYSADMIN"]
 }
+
+resource "snowflake_oauth_integration" "custom" {
+  name                         = "CUSTOM_CLIENT"
+  oauth_client                 = "CUSTOM"
+  oauth_client_type            = "CONFIDENTIAL"
+  oauth_redirect_uri           = "https://example.com/oauth2/callback"
+  enabled                      = true
+  oauth_issue_refresh_tokens   = true
+  oauth_refresh_token_validity = 86400
+  blocked_roles_list           = ["SYSADMIN"]
+}
+
+output "custom_client_id" {
+  value = snowflake_oauth_integration.custom.oauth_client_id
+}
+
+output "custom_client_secret" {
+  value     = snowflake_oauth_integration.custom.oauth_client_secret
+  sensitive = true
+}
@@ -0,0 +1,10 @@
+resource "snowflake_object_rename" "analyst" {
+  object_type = "ROLE"
+  from_name   = "ANALYST"
+  to_name     = "DATA_ANALYST"
+}
+
+resource "snowflake_role_grants" "analyst" {
+  role_name = snowflake_object_rename.analyst.name
+  users     = ["USER1"]
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | packages policy name
+terraform import snowflake_packages_policy.example 'dbName|schemaName|packagesPolicyName'
@@ -0,0 +1,10 @@
+resource "snowflake_packages_policy" "example" {
+  database = "database"
+  schema   = "schema"
+  name     = "packages_policy"
+
+  allowlist                     = ["numpy", "pandas==2.0.*"]
+  blocklist                     = ["requests"]
+  additional_creation_blocklist = ["scipy"]
+  comment                       = "Allows only approved Anaconda packages."
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | password policy name
+terraform import snowflake_password_policy.example 'dbName|schemaName|passwordPolicyName'
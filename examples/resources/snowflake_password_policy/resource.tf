@@ -0,0 +1,16 @@
+resource "snowflake_password_policy" "policy" {
+  database             = "database"
+  schema               = "schema"
+  name                 = "password_policy"
+  min_length           = 12
+  max_length           = 64
+  min_upper_case_chars = 1
+  min_lower_case_chars = 1
+  min_numeric_chars    = 1
+  min_special_chars    = 1
+  max_age_days         = 90
+  max_retries          = 5
+  lockout_time_mins    = 15
+  min_age_days         = 1
+  history              = 5
+}
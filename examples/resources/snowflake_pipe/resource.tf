@@ -10,4 +10,7 @@ resource "snowflake_pipe" "pipe" {
 
   aws_sns_topic_arn    = "..."
   notification_channel = "..."
+
+  error_integration     = "error_notification_integration"
+  pipe_execution_paused = false
 }
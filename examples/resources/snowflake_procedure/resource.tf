@@ -32,3 +32,42 @@ var X=1
 return X
 EOT
 }
+
+resource "snowflake_procedure" "python_proc" {
+  name            = "SAMPLE_PYTHON_PROC"
+  database        = snowflake_database.db.name
+  schema          = snowflake_schema.schema.name
+  language        = "PYTHON"
+  return_type     = "VARCHAR"
+  runtime_version = "3.8"
+  packages        = ["snowflake-snowpark-python", "requests"]
+  handler         = "run"
+  execute_as      = "CALLER"
+
+  external_access_integrations = ["MY_EXTERNAL_ACCESS_INTEGRATION"]
+  secrets {
+    secret_variable_name = "cred"
+    secret_id            = "MYDB.MYSCHEMA.MY_SECRET"
+  }
+
+  statement = <<EOT
+import _snowflake
+import requests
+
+def run(session):
+    token = _snowflake.get_generic_secret_string('cred')
+    return requests.get('https://example.com', headers={'Authorization': token}).text
+EOT
+}
+
+resource "snowflake_procedure" "java_staged_proc" {
+  name            = "SAMPLE_JAVA_PROC"
+  database        = snowflake_database.db.name
+  schema          = snowflake_schema.schema.name
+  language        = "JAVA"
+  return_type     = "VARCHAR"
+  runtime_version = "11"
+  packages        = ["com.snowflake:snowpark:latest"]
+  imports         = ["@MYDB.MYSCHEMA.MYSTAGE/handler.jar"]
+  handler         = "Handler.run"
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | projection policy name
+terraform import snowflake_projection_policy.example 'dbName|schemaName|projectionPolicyName'
@@ -0,0 +1,7 @@
+resource "snowflake_projection_policy" "example" {
+  name     = "EXAMPLE_PROJECTION_POLICY"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  body     = "CASE WHEN CURRENT_ROLE() IN ('ANALYST') THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END"
+  comment  = "Only the ANALYST role can project the column"
+}
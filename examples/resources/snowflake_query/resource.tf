@@ -0,0 +1,10 @@
+resource "snowflake_query" "event_table" {
+  execute   = "ALTER ACCOUNT SET EVENT_TABLE = LOGS.PUBLIC.EVENTS"
+  revert    = "ALTER ACCOUNT UNSET EVENT_TABLE"
+  query     = "SHOW PARAMETERS LIKE 'EVENT_TABLE' IN ACCOUNT"
+  query_tag = "terraform"
+}
+
+output "event_table" {
+  value = snowflake_query.event_table.query_results[0]["value"]
+}
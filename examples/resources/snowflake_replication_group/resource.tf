@@ -0,0 +1,19 @@
+resource "snowflake_database" "db" {
+  name = "db1"
+}
+
+resource "snowflake_replication_group" "source_replication_group" {
+  name              = "RG1"
+  object_types      = ["DATABASES", "SHARES"]
+  allowed_accounts  = ["<org_name>.<target_account_name1>", "<org_name>.<target_account_name2>"]
+  allowed_databases = [snowflake_database.db.name]
+  replication_schedule {
+    cron {
+      expression = "0 0 10-20 * TUE,THU"
+      time_zone  = "UTC"
+    }
+
+    // replication_schedule could also be specified with interval instead of cron
+    // interval = 10
+  }
+}
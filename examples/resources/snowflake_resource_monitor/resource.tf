@@ -6,9 +6,25 @@ resource "snowflake_resource_monitor" "monitor" {
   start_timestamp = "2020-12-07 00:00"
   end_timestamp   = "2021-12-07 00:00"
 
-  notify_triggers            = [40, 50]
-  suspend_triggers           = 50
-  suspend_immediate_triggers = 90
+  trigger {
+    threshold = 40
+    action    = "NOTIFY"
+  }
+
+  trigger {
+    threshold = 50
+    action    = "NOTIFY"
+  }
+
+  trigger {
+    threshold = 80
+    action    = "SUSPEND"
+  }
+
+  trigger {
+    threshold = 90
+    action    = "SUSPEND_IMMEDIATE"
+  }
 
   notify_users = ["USERONE", "USERTWO"]
 }
@@ -0,0 +1,2 @@
+# format is object type | object database name | object schema name | object name | policy database name | policy schema name | policy name
+terraform import snowflake_row_access_policy_attachment.example 'TABLE|dbName|schemaName|tableName|policyDbName|policySchemaName|policyName'
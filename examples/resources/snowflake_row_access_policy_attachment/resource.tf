@@ -0,0 +1,22 @@
+resource "snowflake_row_access_policy" "example" {
+  name     = "EXAMPLE_ROW_ACCESS_POLICY"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  signature = {
+    R = "VARCHAR",
+  }
+  row_access_expression = "case when current_role() in ('ANALYST') then true else R = 'EMEA' end"
+}
+
+resource "snowflake_row_access_policy_attachment" "table" {
+  row_access_policy_name = snowflake_row_access_policy.example.qualified_name
+  object_name            = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\".\"EXAMPLE_TABLE\""
+  on                     = ["REGION"]
+}
+
+resource "snowflake_row_access_policy_attachment" "view" {
+  row_access_policy_name = snowflake_row_access_policy.example.qualified_name
+  object_type            = "VIEW"
+  object_name            = "\"EXAMPLE_DB\".\"EXAMPLE_SCHEMA\".\"EXAMPLE_VIEW\""
+  on                     = ["REGION"]
+}
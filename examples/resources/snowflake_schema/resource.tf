@@ -6,4 +6,7 @@ resource "snowflake_schema" "schema" {
   is_transient        = false
   is_managed          = false
   data_retention_days = 1
+
+  max_data_extension_time_in_days = 7
+  log_level                       = "WARN"
 }
@@ -3,4 +3,12 @@ resource "snowflake_scim_integration" "aad" {
   network_policy   = "AAD_NETWORK_POLICY"
   provisioner_role = "AAD_PROVISIONER"
   scim_client      = "AZURE"
-}
\ No newline at end of file
+}
+resource "snowflake_scim_integration" "okta" {
+  name             = "OKTA_PROVISIONING"
+  provisioner_role = "OKTA_PROVISIONER"
+  scim_client      = "OKTA"
+  sync_password    = false
+  enabled          = true
+  comment          = "Okta user provisioning"
+}
@@ -0,0 +1 @@
+terraform import snowflake_secondary_database.example 'database_name'
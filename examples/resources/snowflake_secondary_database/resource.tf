@@ -0,0 +1,24 @@
+# refreshed manually, whenever a trigger value changes
+resource "snowflake_secondary_database" "manual" {
+  name          = "sales_replica"
+  as_replica_of = "myorg.primary_account.sales"
+  comment       = "Replica of the sales database"
+
+  refresh_triggers = {
+    release = "2024-01-01"
+  }
+}
+
+# refreshed on a schedule by a task created in another database
+resource "snowflake_secondary_database" "scheduled" {
+  name          = "finance_replica"
+  as_replica_of = "myorg.primary_account.finance"
+
+  refresh_task {
+    database  = "ops"
+    schema    = "tasks"
+    name      = "refresh_finance_replica"
+    schedule  = "USING CRON 0 * * * * UTC"
+    warehouse = "ops_wh"
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_secondary_replication_group.example 'rg1'
@@ -0,0 +1,17 @@
+provider "snowflake" {
+  alias = "account2"
+}
+
+resource "snowflake_secondary_replication_group" "target_replication_group" {
+  provider = snowflake.account2
+  name     = "RG1"
+  primary {
+    organization_name   = "..."
+    source_account_name = "..."
+    name                = "RG1"
+  }
+  refresh_on_create = true
+
+  // set to true to pause the scheduled refreshes of the replica
+  suspended = false
+}
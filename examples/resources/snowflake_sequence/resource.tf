@@ -12,3 +12,12 @@ resource "snowflake_sequence" "test_sequence" {
   schema   = snowflake_schema.test_schema.name
   name     = "thing_counter"
 }
+
+resource "snowflake_sequence" "ordered_sequence" {
+  database  = snowflake_database.test_database.name
+  schema    = snowflake_schema.test_schema.name
+  name      = "ordered_counter"
+  increment = 10
+  ordering  = "ORDER"
+  comment   = "Sequence generating increasing values."
+}
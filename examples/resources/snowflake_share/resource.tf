@@ -2,4 +2,11 @@ resource "snowflake_share" "test" {
   name     = "share_name"
   comment  = "cool comment"
   accounts = ["organizationName.accountName"]
+
+  // allows adding accounts on lower editions when sharing from a Business Critical account
+  share_restrictions = false
+}
+
+output "shared_objects" {
+  value = snowflake_share.test.shared_objects
 }
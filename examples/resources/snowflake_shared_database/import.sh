@@ -0,0 +1 @@
+terraform import snowflake_shared_database.example 'database_name'
@@ -0,0 +1,6 @@
+resource "snowflake_shared_database" "example" {
+  name             = "partner_data"
+  provider_account = "partner_org.partner_account"
+  share            = "sales_share"
+  comment          = "Sales data shared by the partner"
+}
@@ -7,6 +7,18 @@ resource "snowflake_stage" "example_stage" {
   credentials = "AWS_KEY_ID='${var.example_aws_key_id}' AWS_SECRET_KEY='${var.example_aws_secret_key}'"
 }
 
+resource "snowflake_stage" "example_stage_with_directory_table" {
+  name     = "EXAMPLE_STAGE_WITH_DIRECTORY_TABLE"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+
+  directory_table {
+    enable = true
+    # changing the value refreshes the directory table metadata
+    refresh_trigger = "2024-01-01"
+  }
+}
+
 resource "snowflake_stage_grant" "grant_example_stage" {
   database_name = snowflake_stage.example_stage.database
   schema_name   = snowflake_stage.example_stage.schema
@@ -0,0 +1,15 @@
+resource "snowflake_stage" "artifacts" {
+  name     = "ARTIFACTS"
+  database = "DATABASE"
+  schema   = "SCHEMA"
+}
+
+resource "snowflake_stage_file" "udf" {
+  database = snowflake_stage.artifacts.database
+  schema   = snowflake_stage.artifacts.schema
+  stage    = snowflake_stage.artifacts.name
+  path     = "jars"
+  source   = "${path.module}/build/udf.jar"
+}
+
+# e.g. imports = [snowflake_stage_file.udf.stage_location]
@@ -11,3 +11,16 @@ resource "snowflake_stream" "stream" {
 
   owner = "role1"
 }
+
+resource "snowflake_stream" "dynamic_table_stream" {
+  database = "database"
+  schema   = "schema"
+  name     = "dynamic_table_stream"
+
+  on_dynamic_table  = "database.schema.dynamic_table"
+  show_initial_rows = true
+
+  at {
+    offset = "-3600"
+  }
+}
@@ -37,18 +37,36 @@ resource "snowflake_table" "table" {
     identity {
       start_num = 1
       step_num  = 3
+      ordering  = "NOORDER"
     }
   }
 
   column {
-    name     = "data"
-    type     = "text"
-    nullable = false
+    name           = "data"
+    type           = "text"
+    nullable       = false
+    masking_policy = "database.schema.masking_policy"
+
+    tag {
+      tag_id    = "database.schema.classification"
+      tag_value = "pii"
+    }
   }
 
   column {
     name = "DATE"
     type = "TIMESTAMP_NTZ(9)"
+
+    default {
+      expression = "CURRENT_TIMESTAMP()"
+    }
+  }
+
+  column {
+    name    = "code"
+    type    = "VARCHAR(16)"
+    collate = "en-ci"
+    unique  = true
   }
 
   column {
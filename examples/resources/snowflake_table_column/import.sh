@@ -0,0 +1,2 @@
+# format is database name | schema name | table name | column name
+terraform import snowflake_table_column.example 'dbName|schemaName|tableName|columnName'
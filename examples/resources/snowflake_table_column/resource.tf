@@ -0,0 +1,14 @@
+resource "snowflake_table_column" "code" {
+  database = "database"
+  schema   = "schema"
+  table    = "table"
+  name     = "code"
+  type     = "VARCHAR(16)"
+  collate  = "en-ci"
+  nullable = true
+  comment  = "A column managed independently of the table."
+
+  default {
+    constant = "none"
+  }
+}
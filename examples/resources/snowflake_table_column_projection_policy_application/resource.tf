@@ -0,0 +1,23 @@
+resource "snowflake_projection_policy" "policy" {
+  name     = "EXAMPLE_PROJECTION_POLICY"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+resource "snowflake_table" "table" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "table"
+
+  column {
+    name = "secret"
+    type = "VARCHAR(16777216)"
+  }
+}
+
+resource "snowflake_table_column_projection_policy_application" "application" {
+  table             = snowflake_table.table.qualified_name
+  column            = "secret"
+  projection_policy = snowflake_projection_policy.policy.qualified_name
+}
@@ -67,8 +67,12 @@ resource "snowflake_table_constraint" "foreign_key" {
       table_id = snowflake_table.fk_t.id
       columns  = ["fk_col1"]
     }
+    match     = "SIMPLE"
+    on_update = "CASCADE"
+    on_delete = "SET NULL"
   }
   enforced   = false
+  rely       = false
   deferrable = false
   initially  = "IMMEDIATE"
   comment    = "hello fk"
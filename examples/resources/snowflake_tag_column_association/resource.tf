@@ -0,0 +1,30 @@
+resource "snowflake_tag" "pii" {
+  name     = "PII"
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+}
+
+resource "snowflake_table" "customers" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "CUSTOMERS"
+
+  column {
+    name = "EMAIL"
+    type = "VARCHAR(16777216)"
+  }
+
+  column {
+    name = "PHONE"
+    type = "VARCHAR(16777216)"
+  }
+}
+
+# Columns of the same table are tagged with a single ALTER TABLE statement.
+resource "snowflake_tag_column_association" "pii" {
+  tag_id    = snowflake_tag.pii.id
+  tag_value = "true"
+  column_identifiers = [
+    for column in ["EMAIL", "PHONE"] : "${snowflake_table.customers.qualified_name}.\"${column}\""
+  ]
+}
@@ -13,10 +13,12 @@ resource "snowflake_task" "task" {
     "foo" : "bar",
   }
 
-  user_task_timeout_ms = 10000
-  after                = "preceding_task"
-  when                 = "foo AND bar"
-  enabled              = true
+  user_task_timeout_ms            = 10000
+  suspend_task_after_num_failures = 3
+  task_auto_retry_attempts        = 2
+  after                           = "preceding_task"
+  when                            = "foo AND bar"
+  enabled                         = true
 }
 
 resource "snowflake_task" "serverless_task" {
@@ -52,3 +54,17 @@ resource "snowflake_task" "test_task" {
   allow_overlapping_execution = true
   enabled                     = true
 }
+
+resource "snowflake_task" "finalizer_task" {
+  comment = "finalizer task cleaning up after the serverless_root_task graph"
+
+  database = "database"
+  schema   = "schema"
+
+  name          = "finalizer_task"
+  sql_statement = "call cleanup();"
+
+  user_task_managed_initial_warehouse_size = "XSMALL"
+  finalize                                 = "serverless_root_task"
+  enabled                                  = true
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | task name | predecessor task name
+terraform import snowflake_task_dependency.example 'dbName|schemaName|taskName|predecessorName'
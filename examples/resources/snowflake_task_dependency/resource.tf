@@ -0,0 +1,30 @@
+resource "snowflake_task" "root" {
+  database      = "database"
+  schema        = "schema"
+  name          = "root_task"
+  warehouse     = "warehouse"
+  schedule      = "10 MINUTE"
+  sql_statement = "select 1;"
+  enabled       = true
+}
+
+resource "snowflake_task" "child" {
+  database      = "database"
+  schema        = "schema"
+  name          = "child_task"
+  warehouse     = "warehouse"
+  sql_statement = "select 2;"
+  enabled       = true
+
+  # dependencies of this task are managed by snowflake_task_dependency
+  lifecycle {
+    ignore_changes = [after]
+  }
+}
+
+resource "snowflake_task_dependency" "root_child" {
+  database    = "database"
+  schema      = "schema"
+  task        = snowflake_task.child.name
+  predecessor = snowflake_task.root.name
+}
@@ -18,3 +18,17 @@ resource "snowflake_user" "user" {
 
   must_change_password = false
 }
+
+resource "snowflake_user" "service" {
+  name      = "Snowflake Service"
+  user_type = "SERVICE"
+  comment   = "A workload identity authenticating with a key pair."
+
+  default_warehouse = "warehouse"
+  default_role      = "role1"
+
+  rsa_public_key = "..."
+
+  network_policy        = "service_network_policy"
+  authentication_policy = "\"db\".\"schema\".\"key_pair_only\""
+}
@@ -0,0 +1,2 @@
+# format is user name | database name | schema name | authentication policy name
+terraform import snowflake_user_authentication_policy_attachment.example 'userName|dbName|schemaName|authenticationPolicyName'
@@ -0,0 +1,8 @@
+resource "snowflake_user" "user" {
+  name = "USER_NAME"
+}
+
+resource "snowflake_user_authentication_policy_attachment" "apa" {
+  authentication_policy_name = "\"prod\".\"security\".\"default_authentication_policy\""
+  user_name                  = snowflake_user.user.name
+}
@@ -0,0 +1,2 @@
+# format is user name | database name | schema name | password policy name
+terraform import snowflake_user_password_policy_attachment.example 'userName|dbName|schemaName|passwordPolicyName'
@@ -0,0 +1,2 @@
+# format is user name | database name | schema name | session policy name
+terraform import snowflake_user_session_policy_attachment.example 'userName|dbName|schemaName|sessionPolicyName'
@@ -0,0 +1,8 @@
+resource "snowflake_user" "user" {
+  name = "USER_NAME"
+}
+
+resource "snowflake_user_session_policy_attachment" "spa" {
+  session_policy_name = "\"prod\".\"security\".\"default_session_policy\""
+  user_name           = snowflake_user.user.name
+}
@@ -11,3 +11,24 @@ SQL
   or_replace = false
   is_secure  = false
 }
+
+resource "snowflake_view" "view_with_columns" {
+  database = "database"
+  schema   = "schema"
+  name     = "view_with_columns"
+
+  statement = "select id, name from foo"
+
+  # grants are kept when the view is replaced because of statement or column changes
+  copy_grants     = true
+  change_tracking = true
+
+  column {
+    column_name = "ID"
+    comment     = "identifier"
+  }
+
+  column {
+    column_name = "NAME"
+  }
+}
@@ -57,6 +57,21 @@ var alertsSchema = map[string]*schema.Schema{
 					Computed:    true,
 					Description: "Role that owns the alert (i.e. has the OWNERSHIP privilege on the alert)",
 				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Warehouse that provides the compute resources for the alert; empty for the serverless alerts.",
+				},
+				"schedule": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Schedule of the alert, e.g. `5 MINUTE` or `USING CRON 0 9 * * * UTC`.",
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "State of the alert: `started` or `suspended`.",
+				},
 				"condition": {
 					Type:        schema.TypeString,
 					Computed:    true,
@@ -109,8 +124,15 @@ func ReadAlerts(d *schema.ResourceData, meta interface{}) error {
 	for _, alert := range listAlerts {
 		alertMap := map[string]any{}
 		alertMap["name"] = alert.Name
+		alertMap["database_name"] = alert.DatabaseName
+		alertMap["schema_name"] = alert.SchemaName
 		alertMap["comment"] = alert.Comment
 		alertMap["owner"] = alert.Owner
+		alertMap["warehouse"] = alert.Warehouse
+		alertMap["schedule"] = alert.Schedule
+		alertMap["state"] = string(alert.State)
+		alertMap["condition"] = alert.Condition
+		alertMap["action"] = alert.Action
 		alerts = append(alerts, alertMap)
 	}
 
@@ -0,0 +1,70 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Alerts(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	alertName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: alerts(databaseName, schemaName, alertName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.name", alertName),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.database_name", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.schema_name", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.warehouse", ""),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.schedule", "USING CRON 0 * * * * UTC"),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.state", "suspended"),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.condition", "select 0 as c"),
+					resource.TestCheckResourceAttr("data.snowflake_alerts.a", "alerts.0.action", "select 0 as c"),
+				),
+			},
+		},
+	})
+}
+
+func alerts(databaseName string, schemaName string, alertName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_alert "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+		schema   = snowflake_schema.test.name
+		alert_schedule {
+			cron {
+				expression = "0 * * * *"
+				time_zone  = "UTC"
+			}
+		}
+		condition = "select 0 as c"
+		action    = "select 0 as c"
+		enabled   = false
+	}
+
+	data snowflake_alerts "a" {
+		database   = snowflake_alert.test.database
+		schema     = snowflake_alert.test.schema
+		depends_on = [snowflake_alert.test]
+	}
+	`, databaseName, schemaName, alertName)
+}
@@ -0,0 +1,199 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackagesSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the application packages by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"with_versions": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs SHOW VERSIONS and SHOW RELEASE DIRECTIVES for each application package to return its versions and release directives. Disable it to list many application packages faster.",
+	},
+	"application_packages": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The application packages in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"distribution": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Distribution of the application package: `INTERNAL` or `EXTERNAL`.",
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"versions": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Versions and patches of the application package, only returned when with_versions is true.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"version": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"patch": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"label": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"comment": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"state": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"review_status": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "Status of the security review required to distribute the patch externally, e.g. `NOT_REVIEWED` or `APPROVED`.",
+							},
+							"created_on": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"release_directives": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Release directives of the application package, only returned when with_versions is true.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"target_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"target_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"version": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"patch": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"created_on": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// ApplicationPackages returns a pointer to the data source listing the application packages.
+func ApplicationPackages() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadApplicationPackages,
+		Schema: applicationPackagesSchema,
+	}
+}
+
+// ReadApplicationPackages implements schema.ReadFunc.
+func ReadApplicationPackages(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	opts := &sdk.ShowApplicationPackageOptions{}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.ApplicationPackages.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	applicationPackages := []map[string]interface{}{}
+	for _, applicationPackage := range result {
+		applicationPackageMap := map[string]interface{}{}
+		applicationPackageMap["name"] = applicationPackage.Name
+		applicationPackageMap["distribution"] = string(applicationPackage.Distribution)
+		applicationPackageMap["owner"] = applicationPackage.Owner
+		applicationPackageMap["comment"] = applicationPackage.Comment
+		applicationPackageMap["created_on"] = applicationPackage.CreatedOn.String()
+
+		if d.Get("with_versions").(bool) {
+			versions, err := client.ApplicationPackages.ShowVersions(ctx, applicationPackage.ID())
+			if err != nil {
+				return err
+			}
+			versionMaps := []map[string]interface{}{}
+			for _, version := range versions {
+				versionMaps = append(versionMaps, map[string]interface{}{
+					"version":       version.Version,
+					"patch":         version.Patch,
+					"label":         version.Label,
+					"comment":       version.Comment,
+					"state":         version.State,
+					"review_status": version.ReviewStatus,
+					"created_on":    version.CreatedOn.String(),
+				})
+			}
+			applicationPackageMap["versions"] = versionMaps
+
+			releaseDirectives, err := client.ApplicationPackages.ShowReleaseDirectives(ctx, applicationPackage.ID())
+			if err != nil {
+				return err
+			}
+			releaseDirectiveMaps := []map[string]interface{}{}
+			for _, releaseDirective := range releaseDirectives {
+				releaseDirectiveMaps = append(releaseDirectiveMaps, map[string]interface{}{
+					"name":        releaseDirective.Name,
+					"target_type": releaseDirective.TargetType,
+					"target_name": releaseDirective.TargetName,
+					"version":     releaseDirective.Version,
+					"patch":       releaseDirective.Patch,
+					"created_on":  releaseDirective.CreatedOn.String(),
+				})
+			}
+			applicationPackageMap["release_directives"] = releaseDirectiveMaps
+		}
+		applicationPackages = append(applicationPackages, applicationPackageMap)
+	}
+
+	d.SetId("application_packages")
+	return d.Set("application_packages", applicationPackages)
+}
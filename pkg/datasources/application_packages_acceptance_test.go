@@ -0,0 +1,94 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ApplicationPackages(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackages(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.name", name),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.distribution", "INTERNAL"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.comment", "packaged"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.versions.#", "0"),
+					resource.TestCheckResourceAttrSet("data.snowflake_application_packages.p", "application_packages.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ApplicationPackages_Versions(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// a stage location with the manifest and the setup script of the application, e.g. @db.schema.stage/app
+	location := os.Getenv("SNOWFLAKE_APPLICATION_PACKAGE_FILES")
+	if location == "" {
+		t.Skip("SNOWFLAKE_APPLICATION_PACKAGE_FILES must be set for ApplicationPackages versions acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackagesVersions(name, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.versions.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.versions.0.version", "V1_0"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.versions.0.patch", "0"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.release_directives.0.name", "DEFAULT"),
+					resource.TestCheckResourceAttr("data.snowflake_application_packages.p", "application_packages.0.release_directives.0.version", "V1_0"),
+				),
+			},
+		},
+	})
+}
+
+func applicationPackages(name string) string {
+	return fmt.Sprintf(`
+	resource snowflake_application_package "p" {
+		name    = "%v"
+		comment = "packaged"
+	}
+
+	data snowflake_application_packages "p" {
+		pattern    = snowflake_application_package.p.name
+		depends_on = [snowflake_application_package.p]
+	}
+	`, name)
+}
+
+func applicationPackagesVersions(name string, location string) string {
+	return fmt.Sprintf(`
+	resource snowflake_application_package "p" {
+		name = "%v"
+
+		version {
+			name  = "v1_0"
+			using = "%v"
+		}
+
+		default_release_directive {
+			version = "v1_0"
+			patch   = 0
+		}
+	}
+
+	data snowflake_application_packages "p" {
+		pattern    = snowflake_application_package.p.name
+		depends_on = [snowflake_application_package.p]
+	}
+	`, name, location)
+}
@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the applications by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"applications": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Native Apps installed in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the source of the application, e.g. `APPLICATION PACKAGE` or `LISTING`.",
+				},
+				"source": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the application package or the listing the application is installed from.",
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"patch": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Applications returns a pointer to the data source listing the installed applications.
+func Applications() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadApplications,
+		Schema: applicationsSchema,
+	}
+}
+
+// ReadApplications implements schema.ReadFunc.
+func ReadApplications(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	opts := &sdk.ShowApplicationOptions{}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.Applications.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	applications := []map[string]interface{}{}
+	for _, application := range result {
+		applicationMap := map[string]interface{}{}
+		applicationMap["name"] = application.Name
+		applicationMap["source_type"] = application.SourceType
+		applicationMap["source"] = application.Source
+		applicationMap["version"] = application.Version
+		applicationMap["label"] = application.Label
+		applicationMap["patch"] = application.Patch
+		applicationMap["owner"] = application.Owner
+		applicationMap["comment"] = application.Comment
+		applicationMap["created_on"] = application.CreatedOn.String()
+		applications = append(applications, applicationMap)
+	}
+
+	d.SetId("applications")
+	return d.Set("applications", applications)
+}
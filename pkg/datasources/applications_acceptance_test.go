@@ -0,0 +1,60 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Applications(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// a stage location with the manifest and the setup script of the application, e.g. @db.schema.stage/app
+	location := os.Getenv("SNOWFLAKE_APPLICATION_PACKAGE_FILES")
+	if location == "" {
+		t.Skip("SNOWFLAKE_APPLICATION_PACKAGE_FILES must be set for Applications acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applications(name, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_applications.a", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_applications.a", "applications.0.name", name+"_APP"),
+					resource.TestCheckResourceAttr("data.snowflake_applications.a", "applications.0.source", name),
+					resource.TestCheckResourceAttr("data.snowflake_applications.a", "applications.0.version", "V1_0"),
+					resource.TestCheckResourceAttr("data.snowflake_applications.a", "applications.0.patch", "0"),
+				),
+			},
+		},
+	})
+}
+
+func applications(name string, location string) string {
+	return fmt.Sprintf(`
+	resource snowflake_application_package "p" {
+		name = "%[1]v"
+
+		version {
+			name  = "v1_0"
+			using = "%[2]v"
+		}
+	}
+
+	resource snowflake_application "a" {
+		name                = "%[1]v_APP"
+		application_package = snowflake_application_package.p.name
+		version             = "v1_0"
+	}
+
+	data snowflake_applications "a" {
+		pattern    = snowflake_application.a.name
+		depends_on = [snowflake_application.a]
+	}
+	`, name, location)
+}
@@ -0,0 +1,137 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var computePoolsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the compute pools by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"compute_pools": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Snowpark Container Services compute pools in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "State of the compute pool, e.g. `IDLE`, `ACTIVE`, `STARTING` or `SUSPENDED`.",
+				},
+				"instance_family": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"active_nodes": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of the nodes running at least one service or job.",
+				},
+				"idle_nodes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"target_nodes": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of the nodes the compute pool is scaling to.",
+				},
+				"num_services": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"num_jobs": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_suspend_secs": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_resume": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// ComputePools returns a pointer to the data source listing the compute pools.
+func ComputePools() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadComputePools,
+		Schema: computePoolsSchema,
+	}
+}
+
+// ReadComputePools implements schema.ReadFunc.
+func ReadComputePools(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	opts := &sdk.ShowComputePoolOptions{}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.ComputePools.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	computePools := []map[string]interface{}{}
+	for _, computePool := range result {
+		computePoolMap := map[string]interface{}{}
+		computePoolMap["name"] = computePool.Name
+		computePoolMap["state"] = computePool.State
+		computePoolMap["instance_family"] = computePool.InstanceFamily
+		computePoolMap["min_nodes"] = computePool.MinNodes
+		computePoolMap["max_nodes"] = computePool.MaxNodes
+		computePoolMap["active_nodes"] = computePool.ActiveNodes
+		computePoolMap["idle_nodes"] = computePool.IdleNodes
+		computePoolMap["target_nodes"] = computePool.TargetNodes
+		computePoolMap["num_services"] = computePool.NumServices
+		computePoolMap["num_jobs"] = computePool.NumJobs
+		computePoolMap["auto_suspend_secs"] = computePool.AutoSuspendSecs
+		computePoolMap["auto_resume"] = computePool.AutoResume
+		computePoolMap["owner"] = computePool.Owner
+		computePoolMap["comment"] = computePool.Comment
+		computePoolMap["created_on"] = computePool.CreatedOn.String()
+		computePools = append(computePools, computePoolMap)
+	}
+
+	d.SetId("compute_pools")
+	return d.Set("compute_pools", computePools)
+}
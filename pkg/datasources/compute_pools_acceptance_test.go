@@ -0,0 +1,40 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ComputePools(t *testing.T) {
+	computePool := os.Getenv("SNOWFLAKE_COMPUTE_POOL")
+	if computePool == "" {
+		t.Skip("SNOWFLAKE_COMPUTE_POOL must be set for ComputePools acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: computePools(computePool),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.p", "compute_pools.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_compute_pools.p", "compute_pools.0.name", computePool),
+					resource.TestCheckResourceAttrSet("data.snowflake_compute_pools.p", "compute_pools.0.state"),
+					resource.TestCheckResourceAttrSet("data.snowflake_compute_pools.p", "compute_pools.0.instance_family"),
+					resource.TestCheckResourceAttrSet("data.snowflake_compute_pools.p", "compute_pools.0.max_nodes"),
+				),
+			},
+		},
+	})
+}
+
+func computePools(computePool string) string {
+	return fmt.Sprintf(`
+	data snowflake_compute_pools "p" {
+		pattern = "%v"
+	}
+	`, computePool)
+}
@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var connectionsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the connections by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"connections": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The primary and secondary connections in the organization visible from the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_primary": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the connection is the primary connection, to which the clients are redirected.",
+				},
+				"primary": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Fully qualified name of the primary connection, e.g. `\"ORG\".\"ACCOUNT\".\"CONNECTION\"`.",
+				},
+				"connection_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Host name used by the clients to connect to the account of the primary connection, e.g. the target of a CNAME record.",
+				},
+				"failover_allowed_to_accounts": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Accounts to which the connection can fail over, in the `ORG.ACCOUNT` format.",
+				},
+				"organization_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"account_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"account_locator": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"snowflake_region": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Connections returns a pointer to the data source listing the connections used for the client redirect.
+func Connections() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadConnections,
+		Schema: connectionsSchema,
+	}
+}
+
+// ReadConnections implements schema.ReadFunc.
+func ReadConnections(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	opts := &sdk.ShowConnectionOptions{}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.Connections.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	connections := []map[string]interface{}{}
+	for _, connection := range result {
+		failoverAllowedToAccounts := make([]string, len(connection.FailoverAllowedToAccounts))
+		for i, account := range connection.FailoverAllowedToAccounts {
+			failoverAllowedToAccounts[i] = account.Name()
+		}
+		connectionMap := map[string]interface{}{}
+		connectionMap["name"] = connection.Name
+		connectionMap["is_primary"] = connection.IsPrimary
+		connectionMap["primary"] = connection.Primary.FullyQualifiedName()
+		connectionMap["connection_url"] = connection.ConnectionURL
+		connectionMap["failover_allowed_to_accounts"] = failoverAllowedToAccounts
+		connectionMap["organization_name"] = connection.OrganizationName
+		connectionMap["account_name"] = connection.AccountName
+		connectionMap["account_locator"] = connection.AccountLocator
+		connectionMap["snowflake_region"] = connection.SnowflakeRegion
+		connectionMap["comment"] = connection.Comment
+		connectionMap["created_on"] = connection.CreatedOn.String()
+		connections = append(connections, connectionMap)
+	}
+
+	d.SetId("connections")
+	return d.Set("connections", connections)
+}
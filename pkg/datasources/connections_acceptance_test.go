@@ -0,0 +1,45 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Connections(t *testing.T) {
+	connectionName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: connections(connectionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_connections.c", "connections.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_connections.c", "connections.0.name", connectionName),
+					resource.TestCheckResourceAttr("data.snowflake_connections.c", "connections.0.is_primary", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_connections.c", "connections.0.comment", "client redirect"),
+					resource.TestCheckResourceAttrSet("data.snowflake_connections.c", "connections.0.connection_url"),
+					resource.TestCheckResourceAttrSet("data.snowflake_connections.c", "connections.0.primary"),
+				),
+			},
+		},
+	})
+}
+
+func connections(connectionName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_connection "c" {
+		name    = "%v"
+		comment = "client redirect"
+	}
+
+	data snowflake_connections "c" {
+		pattern    = snowflake_connection.c.name
+		depends_on = [snowflake_connection.c]
+	}
+	`, connectionName)
+}
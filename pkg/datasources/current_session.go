@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var currentSessionSchema = map[string]*schema.Schema{
+	"account": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the current account; as returned by CURRENT_ACCOUNT_NAME().",
+	},
+	"account_locator": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The locator of the current account; as returned by CURRENT_ACCOUNT().",
+	},
+	"region": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The region of the current account; as returned by CURRENT_REGION().",
+	},
+	"user": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the user logged in to the session; as returned by CURRENT_USER().",
+	},
+	"role": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the primary role in use for the session; as returned by CURRENT_ROLE().",
+	},
+	"secondary_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The names of the secondary roles available in the session; as returned by CURRENT_SECONDARY_ROLES().",
+	},
+	"warehouse": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the warehouse in use for the session, or an empty string when there is none; as returned by CURRENT_WAREHOUSE().",
+	},
+	"session_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The identifier of the session; as returned by CURRENT_SESSION().",
+	},
+}
+
+// CurrentSession the Snowflake current session data source.
+func CurrentSession() *schema.Resource {
+	return &schema.Resource{
+		Description: "Returns the context of the connection used by the provider, e.g. the account, the user and the role, so that configurations can depend on it.",
+
+		Read:   ReadCurrentSession,
+		Schema: currentSessionSchema,
+	}
+}
+
+// ReadCurrentSession reads the context of the current session.
+func ReadCurrentSession(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	sessionID, err := client.ContextFunctions.CurrentSession(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] current_session failed to decode")
+		d.SetId("")
+		return nil
+	}
+	d.SetId(sessionID)
+	if err := d.Set("session_id", sessionID); err != nil {
+		return err
+	}
+
+	functions := map[string]func(context.Context) (string, error){
+		"account":         client.ContextFunctions.CurrentAccountName,
+		"account_locator": client.ContextFunctions.CurrentAccount,
+		"region":          client.ContextFunctions.CurrentRegion,
+		"user":            client.ContextFunctions.CurrentUser,
+		"role":            client.ContextFunctions.CurrentRole,
+		"warehouse":       client.ContextFunctions.CurrentWarehouse,
+	}
+	for key, function := range functions {
+		value, err := function(ctx)
+		if err != nil {
+			return err
+		}
+		// lintignore:R001
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	secondaryRoles, err := client.ContextFunctions.CurrentSecondaryRoles(ctx)
+	if err != nil {
+		return err
+	}
+	roles := make([]string, len(secondaryRoles.Roles))
+	for i, role := range secondaryRoles.Roles {
+		roles[i] = role.Name()
+	}
+	return d.Set("secondary_roles", roles)
+}
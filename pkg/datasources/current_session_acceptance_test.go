@@ -0,0 +1,33 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrentSession(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: currentSession(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_current_session.s", "account"),
+					resource.TestCheckResourceAttrSet("data.snowflake_current_session.s", "account_locator"),
+					resource.TestCheckResourceAttrSet("data.snowflake_current_session.s", "region"),
+					resource.TestCheckResourceAttrSet("data.snowflake_current_session.s", "user"),
+					resource.TestCheckResourceAttrSet("data.snowflake_current_session.s", "role"),
+					resource.TestCheckResourceAttrSet("data.snowflake_current_session.s", "session_id"),
+				),
+			},
+		},
+	})
+}
+
+func currentSession() string {
+	s := `
+	data snowflake_current_session s {}
+	`
+	return s
+}
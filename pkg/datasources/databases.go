@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var databasesSchema = map[string]*schema.Schema{
@@ -29,7 +30,13 @@ var databasesSchema = map[string]*schema.Schema{
 	"starts_with": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Optionally filters the databases by a pattern",
+		Description: "Optionally filters the databases by the beginning of their names; the match is case-sensitive",
+	},
+	"limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Optionally limits the maximum number of databases returned, which keeps the reads fast in the accounts with many databases",
+		ValidateFunc: validation.IntAtLeast(1),
 	},
 	"databases": {
 		Type:        schema.TypeList,
@@ -73,6 +80,10 @@ var databasesSchema = map[string]*schema.Schema{
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"is_transient": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
 				"replication_configuration": {
 					Type:     schema.TypeList,
 					Computed: true,
@@ -123,6 +134,11 @@ func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
 	if startsWith, ok := d.GetOk("starts_with"); ok {
 		opts.StartsWith = sdk.String(startsWith.(string))
 	}
+	if limit, ok := d.GetOk("limit"); ok {
+		opts.LimitFrom = &sdk.LimitFrom{
+			Rows: sdk.Int(limit.(int)),
+		}
+	}
 	databases, err := client.Databases.Show(ctx, &opts)
 	if err != nil {
 		return err
@@ -140,6 +156,7 @@ func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
 		flattenedDatabase["created_on"] = database.CreatedOn.String()
 		flattenedDatabase["options"] = database.Options
 		flattenedDatabase["retention_time"] = database.RetentionTime
+		flattenedDatabase["is_transient"] = database.Transient
 		flattenedDatabases = append(flattenedDatabases, flattenedDatabase)
 	}
 	err = d.Set("databases", flattenedDatabases)
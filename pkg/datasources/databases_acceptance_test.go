@@ -28,6 +28,40 @@ func TestAcc_Databases(t *testing.T) {
 	})
 }
 
+func TestAcc_DatabasesFiltered(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: databasesFiltered(databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_databases.t", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_databases.t", "databases.0.name", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_databases.t", "databases.0.is_transient", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_databases.t", "databases.0.options", "TRANSIENT"),
+				),
+			},
+		},
+	})
+}
+
+func databasesFiltered(databaseName string) string {
+	return fmt.Sprintf(`
+		resource snowflake_database "test_database" {
+			name         = "%[1]v"
+			is_transient = true
+		}
+		data snowflake_databases "t" {
+			pattern     = "%[1]v"
+			starts_with = "%[1]v"
+			limit       = 1
+			depends_on  = [snowflake_database.test_database]
+		}
+	`, databaseName)
+}
+
 func databases(databaseName, comment string) string {
 	return fmt.Sprintf(`
 		resource snowflake_database "test_database" {
@@ -0,0 +1,110 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the event tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the event tables from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the event tables by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"event_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The event tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"fully_qualified_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Fully qualified name of the event table, e.g. to be set as the EVENT_TABLE parameter of the account.",
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// EventTables returns a pointer to the data source listing the event tables.
+func EventTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadEventTables,
+		Schema: eventTablesSchema,
+	}
+}
+
+// ReadEventTables implements schema.ReadFunc.
+func ReadEventTables(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	opts := &sdk.ShowEventTableOptions{
+		In: &sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)},
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.EventTables.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	eventTables := []map[string]interface{}{}
+	for _, eventTable := range result {
+		eventTableMap := map[string]interface{}{}
+		eventTableMap["name"] = eventTable.Name
+		eventTableMap["database"] = eventTable.DatabaseName
+		eventTableMap["schema"] = eventTable.SchemaName
+		eventTableMap["fully_qualified_name"] = eventTable.ID().FullyQualifiedName()
+		eventTableMap["owner"] = eventTable.Owner
+		eventTableMap["comment"] = eventTable.Comment
+		eventTableMap["created_on"] = eventTable.CreatedOn.String()
+		eventTables = append(eventTables, eventTableMap)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return d.Set("event_tables", eventTables)
+}
@@ -0,0 +1,57 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_EventTables(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTables(databaseName, schemaName, tableName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.t", "event_tables.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.t", "event_tables.0.name", tableName),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.t", "event_tables.0.fully_qualified_name", fmt.Sprintf(`"%v"."%v"."%v"`, databaseName, schemaName, tableName)),
+					resource.TestCheckResourceAttr("data.snowflake_event_tables.t", "event_tables.0.comment", "logs"),
+				),
+			},
+		},
+	})
+}
+
+func eventTables(databaseName string, schemaName string, tableName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "s" {
+		name     = "%[2]v"
+		database = snowflake_database.d.name
+	}
+
+	resource snowflake_query "event_table" {
+		execute = "CREATE EVENT TABLE \"%[1]v\".\"%[2]v\".\"%[3]v\" COMMENT = 'logs'"
+		revert  = "DROP TABLE \"%[1]v\".\"%[2]v\".\"%[3]v\""
+
+		depends_on = [snowflake_schema.s]
+	}
+
+	data snowflake_event_tables "t" {
+		database   = snowflake_database.d.name
+		schema     = snowflake_schema.s.name
+		depends_on = [snowflake_query.event_table]
+	}
+	`, databaseName, schemaName, tableName)
+}
@@ -44,6 +44,41 @@ var externalTablesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the external table.",
+				},
+				"location": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Location of the files of the external table, including the path in the stage.",
+				},
+				"file_format_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the named file format of the external table.",
+				},
+				"file_format_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the file format of the external table, e.g. `PARQUET`.",
+				},
+				"table_format": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Table format of the external table, e.g. `DELTA`.",
+				},
+				"notification_channel": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ARN of the SQS queue notifying the external table about new files; only set when the metadata is refreshed automatically from S3.",
+				},
+				"last_refreshed_on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Date and time of the last refresh of the metadata of the external table.",
+				},
 			},
 		},
 	},
@@ -82,6 +117,13 @@ func ReadExternalTables(d *schema.ResourceData, meta interface{}) error {
 		externalTableMap["database"] = externalTable.DatabaseName.String
 		externalTableMap["schema"] = externalTable.SchemaName.String
 		externalTableMap["comment"] = externalTable.Comment.String
+		externalTableMap["owner"] = externalTable.Owner.String
+		externalTableMap["location"] = externalTable.Location.String
+		externalTableMap["file_format_name"] = externalTable.FileFormatName.String
+		externalTableMap["file_format_type"] = externalTable.FileFormatType.String
+		externalTableMap["table_format"] = externalTable.TableFormat.String
+		externalTableMap["notification_channel"] = externalTable.NotificationChannel.String
+		externalTableMap["last_refreshed_on"] = externalTable.LastRefreshedOn.String
 
 		externalTables = append(externalTables, externalTableMap)
 	}
@@ -31,6 +31,10 @@ func TestAcc_ExternalTables(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_external_tables.t", "external_tables.#"),
 					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.0.name", externalTableName),
+					resource.TestCheckResourceAttrSet("data.snowflake_external_tables.t", "external_tables.0.owner"),
+					resource.TestCheckResourceAttrSet("data.snowflake_external_tables.t", "external_tables.0.location"),
+					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.0.file_format_type", "CSV"),
+					resource.TestCheckResourceAttr("data.snowflake_external_tables.t", "external_tables.0.notification_channel", ""),
 				),
 			},
 		},
@@ -3,6 +3,7 @@ package datasources
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +21,11 @@ var failoverGroupsSchema = map[string]*schema.Schema{
 		Description: "List of all the failover groups available in the system.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the failover group.",
+				},
 				"region_group": {
 					Type:        schema.TypeString,
 					Computed:    true,
@@ -108,6 +114,18 @@ var failoverGroupsSchema = map[string]*schema.Schema{
 					Computed:    true,
 					Description: "Name of the role with the OWNERSHIP privilege on the failover group. NULL if the failover group is in a different region.",
 				},
+				"databases": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Databases in the failover group. Only listed for the failover groups of the current account.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"shares": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Shares in the failover group. Only listed for the failover groups of the current account.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
 			},
 		},
 	},
@@ -136,10 +154,15 @@ func ReadFailoverGroups(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+	currentAccountLocator, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return err
+	}
 	d.SetId("failover_groups")
 	failoverGroupsFlatten := []map[string]interface{}{}
 	for _, failoverGroup := range failoverGroups {
 		m := map[string]interface{}{}
+		m["name"] = failoverGroup.Name
 		m["region_group"] = failoverGroup.RegionGroup
 		m["snowflake_region"] = failoverGroup.SnowflakeRegion
 		m["created_on"] = failoverGroup.CreatedOn.String()
@@ -170,6 +193,33 @@ func ReadFailoverGroups(d *schema.ResourceData, meta interface{}) error {
 		m["secondary_state"] = string(failoverGroup.SecondaryState)
 		m["next_scheduled_refresh"] = failoverGroup.NextScheduledRefresh
 		m["owner"] = failoverGroup.Owner
+
+		// the members can only be listed for the failover groups of the current account
+		var databases, shares []string
+		if strings.EqualFold(failoverGroup.AccountLocator, currentAccountLocator) {
+			for _, objectType := range failoverGroup.ObjectTypes {
+				switch objectType {
+				case sdk.PluralObjectTypeDatabases:
+					ids, err := client.FailoverGroups.ShowDatabases(ctx, failoverGroup.ID())
+					if err != nil {
+						return err
+					}
+					for _, id := range ids {
+						databases = append(databases, id.Name())
+					}
+				case sdk.PluralObjectTypeShares:
+					ids, err := client.FailoverGroups.ShowShares(ctx, failoverGroup.ID())
+					if err != nil {
+						return err
+					}
+					for _, id := range ids {
+						shares = append(shares, id.Name())
+					}
+				}
+			}
+		}
+		m["databases"] = databases
+		m["shares"] = shares
 		failoverGroupsFlatten = append(failoverGroupsFlatten, m)
 	}
 	if err := d.Set("failover_groups", failoverGroupsFlatten); err != nil {
@@ -48,6 +48,11 @@ var fileFormatsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the file format.",
+				},
 			},
 		},
 	},
@@ -88,6 +93,7 @@ func ReadFileFormats(d *schema.ResourceData, meta interface{}) error {
 		fileFormatMap["schema"] = fileFormat.Name.SchemaName()
 		fileFormatMap["comment"] = fileFormat.Comment
 		fileFormatMap["format_type"] = fileFormat.Type
+		fileFormatMap["owner"] = fileFormat.Owner
 
 		fileFormats = append(fileFormats, fileFormatMap)
 	}
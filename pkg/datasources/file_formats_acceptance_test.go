@@ -25,6 +25,7 @@ func TestAcc_FileFormats(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_file_formats.t", "file_formats.#"),
 					resource.TestCheckResourceAttr("data.snowflake_file_formats.t", "file_formats.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_file_formats.t", "file_formats.0.name", fileFormatName),
+					resource.TestCheckResourceAttrSet("data.snowflake_file_formats.t", "file_formats.0.owner"),
 				),
 			},
 		},
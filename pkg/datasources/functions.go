@@ -3,6 +3,7 @@ package datasources
 import (
 	"database/sql"
 	"log"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,6 +54,21 @@ var functionsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"signature": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name and the argument types, e.g. `ADD(NUMBER, VARCHAR)`, which identify the function among its overloads, e.g. in grants.",
+				},
+				"language": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The language of the function, e.g. SQL, JAVASCRIPT, PYTHON, JAVA or SCALA.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the function.",
+				},
 			},
 		},
 	},
@@ -65,7 +81,7 @@ func Functions() *schema.Resource {
 	}
 }
 
-// todo: fix this. ListUserFunctions isn't using the right struct right now and also the signature of this doesn't support all the features it could for example, database and schema should be optional, and you could also list by account.
+// todo: the signature of this doesn't support all the features it could for example, database and schema should be optional, and you could also list by account.
 func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	databaseName := d.Get("database").(string)
@@ -77,6 +93,11 @@ func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] error listing functions: %v", err)
 		return nil
 	}
+	functionDetails, err := snowflake.ListFunctionDetails(databaseName, schemaName, db)
+	if err != nil {
+		return err
+	}
+	details := callableDetailsBySignature(functionDetails)
 
 	functions := []map[string]interface{}{}
 
@@ -94,6 +115,11 @@ func ReadFunctions(d *schema.ResourceData, meta interface{}) error {
 		functionMap["comment"] = function.Description.String
 		functionMap["argument_types"] = functionSignatureMap["argumentTypes"].([]string)
 		functionMap["return_type"] = functionSignatureMap["returnType"].(string)
+		functionMap["signature"] = functionSignatureMap["signature"].(string)
+		functionMap["language"] = function.Language.String
+		if detail, ok := details[strings.ToUpper(functionMap["signature"].(string))]; ok {
+			functionMap["owner"] = detail.Owner.String
+		}
 
 		functions = append(functions, functionMap)
 	}
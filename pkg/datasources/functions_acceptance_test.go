@@ -22,6 +22,10 @@ func TestAcc_Functions(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.snowflake_functions.t", "database", databaseName),
 					resource.TestCheckResourceAttr("data.snowflake_functions.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_functions.t", "functions.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_functions.t", "functions.0.signature", fmt.Sprintf("%s()", functionName)),
+					resource.TestCheckResourceAttr("data.snowflake_functions.t", "functions.0.language", "SQL"),
+					resource.TestCheckResourceAttrSet("data.snowflake_functions.t", "functions.0.owner"),
 				),
 			},
 		},
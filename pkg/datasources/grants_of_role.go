@@ -0,0 +1,97 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantsOfRoleSchema = map[string]*schema.Schema{
+	"role": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the role whose grantees are returned.",
+	},
+	"recursive": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Also returns the users and roles which are granted the role indirectly, through the roles it is granted to.",
+	},
+	"users": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "Names of the users which are granted the role, sorted by name.",
+	},
+	"roles": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "Names of the roles which are granted the role, sorted by name.",
+	},
+}
+
+// GrantsOfRole returns a pointer to the data source listing the users and roles which are granted a role.
+func GrantsOfRole() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadGrantsOfRole,
+		Schema: grantsOfRoleSchema,
+	}
+}
+
+// ReadGrantsOfRole implements schema.ReadFunc.
+func ReadGrantsOfRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	roleName := d.Get("role").(string)
+	recursive := d.Get("recursive").(bool)
+
+	users := map[string]bool{}
+	roles := map[string]bool{}
+	queue := []string{roleName}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			Of: &sdk.ShowGrantsOf{Role: sdk.NewAccountObjectIdentifier(current)},
+		})
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			grantee := grant.GranteeName.Name()
+			switch grant.GrantedTo {
+			case sdk.ObjectTypeUser:
+				users[grantee] = true
+			case sdk.ObjectTypeRole:
+				// roles granted in cycles are expanded only once
+				if !roles[grantee] && recursive {
+					queue = append(queue, grantee)
+				}
+				roles[grantee] = true
+			}
+		}
+	}
+
+	d.SetId(roleName)
+	if err := d.Set("users", sortedKeys(users)); err != nil {
+		return err
+	}
+	return d.Set("roles", sortedKeys(roles))
+}
+
+// sortedKeys returns the names of the set sorted, so that the order does not change between the reads.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
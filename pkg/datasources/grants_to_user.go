@@ -0,0 +1,83 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantsToUserSchema = map[string]*schema.Schema{
+	"user": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the user whose roles are returned.",
+	},
+	"recursive": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Also returns the roles which the user has indirectly, through the hierarchy of the roles granted to the user.",
+	},
+	"roles": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "Names of the roles granted to the user, sorted by name.",
+	},
+}
+
+// GrantsToUser returns a pointer to the data source listing the roles granted to a user.
+func GrantsToUser() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadGrantsToUser,
+		Schema: grantsToUserSchema,
+	}
+}
+
+// ReadGrantsToUser implements schema.ReadFunc.
+func ReadGrantsToUser(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := d.Get("user").(string)
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		To: &sdk.ShowGrantsTo{User: sdk.NewAccountObjectIdentifier(userName)},
+	})
+	if err != nil {
+		return err
+	}
+
+	roles := map[string]bool{}
+	queue := []string{}
+	for _, grant := range grants {
+		roles[grant.Name.Name()] = true
+		queue = append(queue, grant.Name.Name())
+	}
+	for d.Get("recursive").(bool) && len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier(current)},
+		})
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			if grant.GrantedOn != sdk.ObjectTypeRole || grant.Privilege != "USAGE" {
+				continue
+			}
+			role := grant.Name.Name()
+			// roles granted in cycles are expanded only once
+			if !roles[role] {
+				roles[role] = true
+				queue = append(queue, role)
+			}
+		}
+	}
+
+	d.SetId(userName)
+	return d.Set("roles", sortedKeys(roles))
+}
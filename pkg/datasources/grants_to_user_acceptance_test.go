@@ -0,0 +1,68 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_GrantsToUser(t *testing.T) {
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	parentRoleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	userName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantsToUser(roleName, parentRoleName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_grants_to_user.direct", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_grants_to_user.direct", "roles.0", parentRoleName),
+					resource.TestCheckTypeSetElemAttr("data.snowflake_grants_to_user.recursive", "roles.*", parentRoleName),
+					resource.TestCheckTypeSetElemAttr("data.snowflake_grants_to_user.recursive", "roles.*", roleName),
+				),
+			},
+		},
+	})
+}
+
+func grantsToUser(roleName string, parentRoleName string, userName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_role "r" {
+		name = "%v"
+	}
+
+	resource snowflake_role "parent" {
+		name = "%v"
+	}
+
+	resource snowflake_user "u" {
+		name = "%v"
+	}
+
+	resource snowflake_role_grants "r" {
+		role_name = snowflake_role.r.name
+		roles     = [snowflake_role.parent.name]
+	}
+
+	resource snowflake_role_grants "parent" {
+		role_name = snowflake_role.parent.name
+		users     = [snowflake_user.u.name]
+	}
+
+	data snowflake_grants_to_user "direct" {
+		user       = snowflake_user.u.name
+		depends_on = [snowflake_role_grants.r, snowflake_role_grants.parent]
+	}
+
+	data snowflake_grants_to_user "recursive" {
+		user       = snowflake_user.u.name
+		recursive  = true
+		depends_on = [snowflake_role_grants.r, snowflake_role_grants.parent]
+	}
+	`, roleName, parentRoleName, userName)
+}
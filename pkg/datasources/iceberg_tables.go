@@ -0,0 +1,134 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var icebergTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the Iceberg tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the Iceberg tables from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the Iceberg tables by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"iceberg_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Iceberg tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the Iceberg table.",
+				},
+				"external_volume": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "External volume storing the files of the Iceberg table.",
+				},
+				"catalog": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Catalog of the Iceberg table, e.g. `SNOWFLAKE` or the name of a catalog integration.",
+				},
+				"iceberg_table_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the Iceberg table: `MANAGED` for the tables using Snowflake as the catalog, `UNMANAGED` otherwise.",
+				},
+				"catalog_table_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the table in the external catalog.",
+				},
+				"catalog_namespace": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Namespace of the table in the external catalog.",
+				},
+				"base_location": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Path of the files of the Iceberg table in the external volume.",
+				},
+			},
+		},
+	},
+}
+
+func IcebergTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadIcebergTables,
+		Schema: icebergTablesSchema,
+	}
+}
+
+func ReadIcebergTables(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	opts := &sdk.ShowIcebergTableOptions{
+		In: &sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)},
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.IcebergTables.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	icebergTables := []map[string]interface{}{}
+	for _, icebergTable := range result {
+		icebergTableMap := map[string]interface{}{}
+		icebergTableMap["name"] = icebergTable.Name
+		icebergTableMap["database"] = icebergTable.DatabaseName
+		icebergTableMap["schema"] = icebergTable.SchemaName
+		icebergTableMap["comment"] = icebergTable.Comment
+		icebergTableMap["owner"] = icebergTable.Owner
+		icebergTableMap["external_volume"] = icebergTable.ExternalVolumeName
+		icebergTableMap["catalog"] = icebergTable.CatalogName
+		icebergTableMap["iceberg_table_type"] = icebergTable.IcebergTableType
+		icebergTableMap["catalog_table_name"] = icebergTable.CatalogTableName
+		icebergTableMap["catalog_namespace"] = icebergTable.CatalogNamespace
+		icebergTableMap["base_location"] = icebergTable.BaseLocation
+		icebergTables = append(icebergTables, icebergTableMap)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return d.Set("iceberg_tables", icebergTables)
+}
@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_IcebergTables(t *testing.T) {
+	externalVolume, ok := os.LookupEnv("SNOWFLAKE_TEST_EXTERNAL_VOLUME")
+	if !ok {
+		t.Skip("Skipping TestAcc_IcebergTables since SNOWFLAKE_TEST_EXTERNAL_VOLUME is not set")
+	}
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: icebergTables(databaseName, schemaName, tableName, externalVolume),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_iceberg_tables.t", "iceberg_tables.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_iceberg_tables.t", "iceberg_tables.0.name", tableName),
+					resource.TestCheckResourceAttr("data.snowflake_iceberg_tables.t", "iceberg_tables.0.external_volume", externalVolume),
+					resource.TestCheckResourceAttr("data.snowflake_iceberg_tables.t", "iceberg_tables.0.catalog", "SNOWFLAKE"),
+					resource.TestCheckResourceAttr("data.snowflake_iceberg_tables.t", "iceberg_tables.0.iceberg_table_type", "MANAGED"),
+					resource.TestCheckResourceAttr("data.snowflake_iceberg_tables.t", "iceberg_tables.0.base_location", tableName),
+					resource.TestCheckResourceAttrSet("data.snowflake_iceberg_tables.t", "iceberg_tables.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func icebergTables(databaseName string, schemaName string, tableName string, externalVolume string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%[2]v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_iceberg_table "test" {
+		database        = snowflake_database.test.name
+		schema          = snowflake_schema.test.name
+		name            = "%[3]v"
+		external_volume = "%[4]v"
+		catalog         = "SNOWFLAKE"
+		base_location   = "%[3]v"
+
+		column {
+			name = "id"
+			type = "NUMBER(38,0)"
+		}
+	}
+
+	data snowflake_iceberg_tables "t" {
+		database   = snowflake_iceberg_table.test.database
+		schema     = snowflake_iceberg_table.test.schema
+		depends_on = [snowflake_iceberg_table.test]
+	}
+	`, databaseName, schemaName, tableName, externalVolume)
+}
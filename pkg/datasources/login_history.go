@@ -0,0 +1,147 @@
+package datasources
+
+import (
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var loginHistorySchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Returns only the login events of the user.",
+	},
+	"start_time": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Returns only the login events at or after the time, in the RFC 3339 format, e.g. `2023-01-01T00:00:00Z`.",
+		ValidateFunc: validation.IsRFC3339Time,
+	},
+	"end_time": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Returns only the login events before the time, in the RFC 3339 format, e.g. `2023-01-02T00:00:00Z`.",
+		ValidateFunc: validation.IsRFC3339Time,
+	},
+	"failed_only": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Returns only the unsuccessful login attempts.",
+	},
+	"limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      100,
+		Description:  "Maximum number of login events returned, the latest first.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"events": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The login events, the latest first",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"event_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"event_timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"event_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"user_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"client_ip": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reported_client_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reported_client_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"first_authentication_factor": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Method used to authenticate the user, e.g. `PASSWORD`, `RSA_KEYPAIR` or `OAUTH_ACCESS_TOKEN`.",
+				},
+				"second_authentication_factor": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Second factor used to authenticate the user, e.g. `DUO_PUSH`; empty when multi-factor authentication was not used.",
+				},
+				"is_success": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"error_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error_message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// LoginHistory returns a pointer to the data source reading the SNOWFLAKE.ACCOUNT_USAGE.LOGIN_HISTORY view.
+func LoginHistory() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadLoginHistory,
+		Schema: loginHistorySchema,
+	}
+}
+
+// ReadLoginHistory implements schema.ReadFunc.
+func ReadLoginHistory(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+
+	filter := snowflake.LoginHistoryFilter{
+		UserName:   d.Get("user_name").(string),
+		StartTime:  d.Get("start_time").(string),
+		EndTime:    d.Get("end_time").(string),
+		FailedOnly: d.Get("failed_only").(bool),
+		Limit:      d.Get("limit").(int),
+	}
+	loginHistory, err := snowflake.ListLoginHistory(db, filter)
+	if err != nil {
+		return err
+	}
+
+	events := []map[string]interface{}{}
+	for _, event := range loginHistory {
+		eventMap := map[string]interface{}{}
+		eventMap["event_id"] = event.EventID.String
+		eventMap["event_timestamp"] = event.EventTimestamp.String
+		eventMap["event_type"] = event.EventType.String
+		eventMap["user_name"] = event.UserName.String
+		eventMap["client_ip"] = event.ClientIP.String
+		eventMap["reported_client_type"] = event.ReportedClientType.String
+		eventMap["reported_client_version"] = event.ReportedClientVersion.String
+		eventMap["first_authentication_factor"] = event.FirstAuthenticationFactor.String
+		eventMap["second_authentication_factor"] = event.SecondAuthenticationFactor.String
+		eventMap["is_success"] = event.IsSuccess.String == "YES"
+		eventMap["error_code"] = event.ErrorCode.String
+		eventMap["error_message"] = event.ErrorMessage.String
+		events = append(events, eventMap)
+	}
+
+	d.SetId("login_history")
+	return d.Set("events", events)
+}
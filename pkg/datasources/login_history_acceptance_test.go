@@ -0,0 +1,39 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_LoginHistory(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: loginHistory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_login_history.h", "limit", "5"),
+					resource.TestCheckResourceAttrSet("data.snowflake_login_history.h", "events.#"),
+					resource.TestCheckResourceAttr("data.snowflake_login_history.failed", "failed_only", "true"),
+					resource.TestCheckResourceAttrSet("data.snowflake_login_history.failed", "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func loginHistory() string {
+	return `
+	data snowflake_login_history "h" {
+		start_time = "2023-01-01T00:00:00Z"
+		limit      = 5
+	}
+
+	data snowflake_login_history "failed" {
+		failed_only = true
+		limit       = 5
+	}
+	`
+}
@@ -3,6 +3,8 @@ package datasources
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -48,6 +50,26 @@ var maskingPoliciesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the masking policy.",
+				},
+				"signature": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Arguments of the masking policy, e.g. `(VAL VARCHAR)`.",
+				},
+				"return_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Data type returned by the masking policy.",
+				},
+				"body": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "SQL expression of the masking policy, as returned by DESCRIBE MASKING POLICY.",
+				},
 			},
 		},
 	},
@@ -76,12 +98,20 @@ func ReadMaskingPolicies(d *schema.ResourceData, meta interface{}) error {
 	}
 	maskingPoliciesList := []map[string]interface{}{}
 	for _, maskingPolicy := range maskingPolicies {
+		details, err := client.MaskingPolicies.Describe(ctx, maskingPolicy.ID())
+		if err != nil {
+			return fmt.Errorf("error describing masking policy %v err = %w", maskingPolicy.ID().FullyQualifiedName(), err)
+		}
 		maskingPolicyMap := map[string]interface{}{}
 		maskingPolicyMap["name"] = maskingPolicy.Name
 		maskingPolicyMap["database"] = maskingPolicy.DatabaseName
 		maskingPolicyMap["schema"] = maskingPolicy.SchemaName
 		maskingPolicyMap["comment"] = maskingPolicy.Comment
 		maskingPolicyMap["kind"] = maskingPolicy.Kind
+		maskingPolicyMap["owner"] = maskingPolicy.Owner
+		maskingPolicyMap["signature"] = maskingPolicySignature(details.Signature)
+		maskingPolicyMap["return_type"] = string(details.ReturnType)
+		maskingPolicyMap["body"] = details.Body
 		maskingPoliciesList = append(maskingPoliciesList, maskingPolicyMap)
 	}
 	if err := d.Set("masking_policies", maskingPoliciesList); err != nil {
@@ -90,3 +120,12 @@ func ReadMaskingPolicies(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
 	return nil
 }
+
+// maskingPolicySignature formats the arguments of the masking policy the way DESCRIBE MASKING POLICY returns them.
+func maskingPolicySignature(signature []sdk.TableColumnSignature) string {
+	arguments := make([]string, len(signature))
+	for i, argument := range signature {
+		arguments[i] = fmt.Sprintf("%v %v", argument.Name, argument.Type)
+	}
+	return "(" + strings.Join(arguments, ", ") + ")"
+}
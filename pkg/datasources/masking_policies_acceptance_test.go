@@ -25,6 +25,10 @@ func TestAcc_MaskingPolicies(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_masking_policies.t", "masking_policies.#"),
 					resource.TestCheckResourceAttr("data.snowflake_masking_policies.t", "masking_policies.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_masking_policies.t", "masking_policies.0.name", maskingPolicyName),
+					resource.TestCheckResourceAttrSet("data.snowflake_masking_policies.t", "masking_policies.0.owner"),
+					resource.TestCheckResourceAttr("data.snowflake_masking_policies.t", "masking_policies.0.signature", "(VAL VARCHAR)"),
+					resource.TestCheckResourceAttr("data.snowflake_masking_policies.t", "masking_policies.0.return_type", "VARCHAR(16777216)"),
+					resource.TestCheckResourceAttr("data.snowflake_masking_policies.t", "masking_policies.0.body", "case when current_role() in ('ANALYST') then val else sha2(val, 512) end"),
 				),
 			},
 		},
@@ -44,6 +44,21 @@ var materializedViewsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"is_secure": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Specifies whether the view is secure.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the view.",
+				},
+				"text": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The text of the command that created the view. It is empty for the secure views that are not owned by the current role.",
+				},
 			},
 		},
 	},
@@ -82,6 +97,9 @@ func ReadMaterializedViews(d *schema.ResourceData, meta interface{}) error {
 		viewMap["database"] = view.DatabaseName.String
 		viewMap["schema"] = view.SchemaName.String
 		viewMap["comment"] = view.Comment.String
+		viewMap["is_secure"] = view.IsSecure
+		viewMap["owner"] = view.Owner.String
+		viewMap["text"] = view.Text.String
 
 		views = append(views, viewMap)
 	}
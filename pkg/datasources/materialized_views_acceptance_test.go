@@ -27,6 +27,9 @@ func TestAcc_MaterializedViews(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_materialized_views.v", "materialized_views.#"),
 					resource.TestCheckResourceAttr("data.snowflake_materialized_views.v", "materialized_views.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_materialized_views.v", "materialized_views.0.name", viewName),
+					resource.TestCheckResourceAttr("data.snowflake_materialized_views.v", "materialized_views.0.is_secure", "true"),
+					resource.TestCheckResourceAttrSet("data.snowflake_materialized_views.v", "materialized_views.0.owner"),
+					resource.TestCheckResourceAttrSet("data.snowflake_materialized_views.v", "materialized_views.0.text"),
 				),
 			},
 		},
@@ -0,0 +1,123 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var networkPoliciesSchema = map[string]*schema.Schema{
+	"network_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The network policies in the account, with their entries returned by DESCRIBE NETWORK POLICY.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"allowed_ip_list": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "IPv4 addresses that are allowed access to Snowflake.",
+				},
+				"blocked_ip_list": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "IPv4 addresses that are denied access to Snowflake.",
+				},
+				"allowed_network_rule_list": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Fully qualified names of the network rules that allow access to Snowflake.",
+				},
+				"blocked_network_rule_list": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Fully qualified names of the network rules that deny access to Snowflake.",
+				},
+			},
+		},
+	},
+}
+
+func NetworkPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadNetworkPolicies,
+		Schema: networkPoliciesSchema,
+	}
+}
+
+func ReadNetworkPolicies(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	result, err := client.NetworkPolicies.Show(ctx, sdk.NewShowNetworkPolicyRequest())
+	if err != nil {
+		return err
+	}
+
+	networkPolicies := []map[string]interface{}{}
+	for _, networkPolicy := range result {
+		networkPolicyMap := map[string]interface{}{}
+		networkPolicyMap["name"] = networkPolicy.Name
+		networkPolicyMap["comment"] = networkPolicy.Comment
+
+		descriptions, err := client.NetworkPolicies.Describe(ctx, sdk.NewAccountObjectIdentifier(networkPolicy.Name))
+		if err != nil {
+			return fmt.Errorf("error describing network policy %v err = %w", networkPolicy.Name, err)
+		}
+		// DESCRIBE NETWORK POLICY omits the empty lists
+		for _, description := range descriptions {
+			switch description.Name {
+			case "ALLOWED_IP_LIST":
+				networkPolicyMap["allowed_ip_list"] = strings.Split(description.Value, ",")
+			case "BLOCKED_IP_LIST":
+				networkPolicyMap["blocked_ip_list"] = strings.Split(description.Value, ",")
+			case "ALLOWED_NETWORK_RULE_LIST":
+				networkRules, err := networkRuleNames(description.Value)
+				if err != nil {
+					return err
+				}
+				networkPolicyMap["allowed_network_rule_list"] = networkRules
+			case "BLOCKED_NETWORK_RULE_LIST":
+				networkRules, err := networkRuleNames(description.Value)
+				if err != nil {
+					return err
+				}
+				networkPolicyMap["blocked_network_rule_list"] = networkRules
+			}
+		}
+		networkPolicies = append(networkPolicies, networkPolicyMap)
+	}
+
+	d.SetId("network_policies")
+	return d.Set("network_policies", networkPolicies)
+}
+
+// networkRuleNames returns the fully qualified names of the network rules listed by DESCRIBE NETWORK POLICY.
+func networkRuleNames(value string) ([]string, error) {
+	networkRules, err := sdk.ParseNetworkRulesSnowflakeDto(value)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(networkRules))
+	for i, networkRule := range networkRules {
+		names[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(networkRule.FullyQualifiedRuleName).FullyQualifiedName()
+	}
+	return names, nil
+}
@@ -0,0 +1,49 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_NetworkPolicies(t *testing.T) {
+	networkPolicyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkPolicies(networkPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_network_policies.p", "network_policies.*", map[string]string{
+						"name":                        networkPolicyName,
+						"comment":                     "Terraform acceptance test",
+						"allowed_ip_list.#":           "1",
+						"allowed_ip_list.0":           "192.168.0.100/24",
+						"blocked_ip_list.#":           "1",
+						"blocked_ip_list.0":           "192.168.0.101",
+						"allowed_network_rule_list.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func networkPolicies(networkPolicyName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_network_policy "p" {
+		name            = "%v"
+		comment         = "Terraform acceptance test"
+		allowed_ip_list = ["192.168.0.100/24"]
+		blocked_ip_list = ["192.168.0.101"]
+	}
+
+	data snowflake_network_policies "p" {
+		depends_on = [snowflake_network_policy.p]
+	}
+	`, networkPolicyName)
+}
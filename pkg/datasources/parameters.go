@@ -40,10 +40,16 @@ var parametersSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "If parameter_type is set to \"OBJECT\" then object_name is the name of the object to display object parameters for.",
 	},
+	"level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Filters the parameters by the level on which they are set, e.g. \"ACCOUNT\" to return only the parameters overridden on the account. \"DEFAULT\" returns the parameters that are not set on any level.",
+		ValidateFunc: validation.StringInSlice([]string{"DEFAULT", "ACCOUNT", "USER", "SESSION", "WAREHOUSE", "DATABASE", "SCHEMA", "TASK", "TABLE"}, true),
+	},
 	"parameters": {
 		Type:        schema.TypeList,
 		Computed:    true,
-		Description: "The pipes in the schema",
+		Description: "The parameters matching the filters",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"key": {
@@ -111,7 +117,7 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 		}
 		opts.In.User = sdk.NewAccountObjectIdentifier(user)
 	case "OBJECT":
-		objectType := sdk.ObjectType(d.Get("object_type").(string))
+		objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
 		objectName := d.Get("object_name").(string)
 		switch objectType {
 		case sdk.ObjectTypeWarehouse:
@@ -135,8 +141,12 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId("parameters")
 
+	level := strings.ToUpper(d.Get("level").(string))
 	params := []map[string]interface{}{}
 	for _, param := range parameters {
+		if !parameterLevelMatches(param.Level, level) {
+			continue
+		}
 		paramMap := map[string]interface{}{}
 
 		paramMap["key"] = param.Key
@@ -149,3 +159,16 @@ func ReadParameters(d *schema.ResourceData, meta interface{}) error {
 	}
 	return d.Set("parameters", params)
 }
+
+// parameterLevelMatches reports whether the parameter is set on the level; the parameters left at their default
+// values are returned with an empty level.
+func parameterLevelMatches(parameterLevel sdk.ParameterType, level string) bool {
+	switch level {
+	case "":
+		return true
+	case "DEFAULT":
+		return parameterLevel == ""
+	default:
+		return string(parameterLevel) == level
+	}
+}
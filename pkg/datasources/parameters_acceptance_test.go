@@ -27,6 +27,26 @@ func TestAcc_ParametersOnAccount(t *testing.T) {
 	})
 }
 
+func TestAcc_ParametersOnObjectWithLevel(t *testing.T) {
+	dbName := "TEST_DB_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: parametersConfigOnObjectWithLevel(dbName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_parameters.p", "level", "DATABASE"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.p", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.p", "parameters.0.key", "DATA_RETENTION_TIME_IN_DAYS"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.p", "parameters.0.value", "5"),
+					resource.TestCheckResourceAttr("data.snowflake_parameters.p", "parameters.0.level", "DATABASE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ParametersOnSession(t *testing.T) {
 	userName := "TEST_USER_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
@@ -97,3 +117,18 @@ func parametersConfigOnObject(name string) string {
 	}`
 	return fmt.Sprintf(stmt, name)
 }
+
+func parametersConfigOnObjectWithLevel(name string) string {
+	stmt := `
+	resource "snowflake_database" "d" {
+		name                        = "%s"
+		data_retention_time_in_days = 5
+	}
+	data "snowflake_parameters" "p" {
+		parameter_type = "OBJECT"
+		object_type    = "DATABASE"
+		object_name    = snowflake_database.d.name
+		level          = "DATABASE"
+	}`
+	return fmt.Sprintf(stmt, name)
+}
@@ -0,0 +1,176 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var passwordPoliciesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the password policies from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the password policies from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the password policies by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"password_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The password policies in the schema, with their settings returned by DESCRIBE PASSWORD POLICY.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"min_length": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Minimum number of characters of the password.",
+				},
+				"max_length": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Maximum number of characters of the password.",
+				},
+				"min_upper_case_chars": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Minimum number of uppercase characters of the password.",
+				},
+				"min_lower_case_chars": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Minimum number of lowercase characters of the password.",
+				},
+				"min_numeric_chars": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Minimum number of numeric characters of the password.",
+				},
+				"min_special_chars": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Minimum number of special characters of the password.",
+				},
+				"min_age_days": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of days the user must wait before changing the recently changed password.",
+				},
+				"max_age_days": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of days after which the password must be changed.",
+				},
+				"max_retries": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of failed login attempts after which the user is locked out.",
+				},
+				"lockout_time_mins": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of minutes the user account is locked out after the failed login attempts.",
+				},
+				"history": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of the most recent passwords that cannot be reused.",
+				},
+			},
+		},
+	},
+}
+
+func PasswordPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadPasswordPolicies,
+		Schema: passwordPoliciesSchema,
+	}
+}
+
+func ReadPasswordPolicies(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	opts := &sdk.ShowPasswordPolicyOptions{
+		In: &sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)},
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.PasswordPolicies.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	passwordPolicies := []map[string]interface{}{}
+	for _, passwordPolicy := range result {
+		details, err := client.PasswordPolicies.Describe(ctx, passwordPolicy.ID())
+		if err != nil {
+			return fmt.Errorf("error describing password policy %v err = %w", passwordPolicy.ID().FullyQualifiedName(), err)
+		}
+		passwordPolicyMap := map[string]interface{}{}
+		passwordPolicyMap["name"] = passwordPolicy.Name
+		passwordPolicyMap["database"] = passwordPolicy.DatabaseName
+		passwordPolicyMap["schema"] = passwordPolicy.SchemaName
+		passwordPolicyMap["owner"] = passwordPolicy.Owner
+		passwordPolicyMap["comment"] = passwordPolicy.Comment
+		passwordPolicyMap["min_length"] = intPropertyValue(details.PasswordMinLength)
+		passwordPolicyMap["max_length"] = intPropertyValue(details.PasswordMaxLength)
+		passwordPolicyMap["min_upper_case_chars"] = intPropertyValue(details.PasswordMinUpperCaseChars)
+		passwordPolicyMap["min_lower_case_chars"] = intPropertyValue(details.PasswordMinLowerCaseChars)
+		passwordPolicyMap["min_numeric_chars"] = intPropertyValue(details.PasswordMinNumericChars)
+		passwordPolicyMap["min_special_chars"] = intPropertyValue(details.PasswordMinSpecialChars)
+		passwordPolicyMap["min_age_days"] = intPropertyValue(details.PasswordMinAgeDays)
+		passwordPolicyMap["max_age_days"] = intPropertyValue(details.PasswordMaxAgeDays)
+		passwordPolicyMap["max_retries"] = intPropertyValue(details.PasswordMaxRetries)
+		passwordPolicyMap["lockout_time_mins"] = intPropertyValue(details.PasswordLockoutTimeMins)
+		passwordPolicyMap["history"] = intPropertyValue(details.PasswordHistory)
+		passwordPolicies = append(passwordPolicies, passwordPolicyMap)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return d.Set("password_policies", passwordPolicies)
+}
+
+// intPropertyValue returns the value of the property returned by DESCRIBE, or zero when it is not set.
+func intPropertyValue(property *sdk.IntProperty) int {
+	if property == nil || property.Value == nil {
+		return 0
+	}
+	return *property.Value
+}
@@ -0,0 +1,62 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_PasswordPolicies(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	passwordPolicyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: passwordPolicies(databaseName, schemaName, passwordPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.0.name", passwordPolicyName),
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.0.database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.0.schema", schemaName),
+					resource.TestCheckResourceAttrSet("data.snowflake_password_policies.p", "password_policies.0.owner"),
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.0.min_length", "12"),
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.0.max_length", "24"),
+					resource.TestCheckResourceAttr("data.snowflake_password_policies.p", "password_policies.0.max_retries", "5"),
+				),
+			},
+		},
+	})
+}
+
+func passwordPolicies(databaseName string, schemaName string, passwordPolicyName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_password_policy "test" {
+		name       = "%v"
+		database   = snowflake_database.test.name
+		schema     = snowflake_schema.test.name
+		min_length = 12
+		max_length = 24
+	}
+
+	data snowflake_password_policies "p" {
+		database   = snowflake_password_policy.test.database
+		schema     = snowflake_password_policy.test.schema
+		depends_on = [snowflake_password_policy.test]
+	}
+	`, databaseName, schemaName, passwordPolicyName)
+}
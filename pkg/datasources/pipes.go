@@ -49,6 +49,11 @@ var pipesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"notification_channel": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ARN of the Amazon SQS queue to which the S3 event notifications of an auto-ingest pipe are sent, or an empty string otherwise.",
+				},
 			},
 		},
 	},
@@ -89,6 +94,7 @@ func ReadPipes(d *schema.ResourceData, meta interface{}) error {
 		pipeMap["schema"] = pipe.SchemaName
 		pipeMap["comment"] = pipe.Comment
 		pipeMap["integration"] = pipe.Integration
+		pipeMap["notification_channel"] = pipe.NotificationChannel
 
 		pipes = append(pipes, pipeMap)
 	}
@@ -57,6 +57,21 @@ var proceduresSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"signature": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name and the argument types, e.g. `ADD(NUMBER, VARCHAR)`, which identify the procedure among its overloads, e.g. in grants.",
+				},
+				"language": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The language of the procedure, e.g. SQL, JAVASCRIPT, PYTHON, JAVA or SCALA.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the procedure.",
+				},
 			},
 		},
 	},
@@ -86,6 +101,12 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	procedureDetails, err := snowflake.ListProcedureDetails(databaseName, schemaName, db)
+	if err != nil {
+		return err
+	}
+	details := callableDetailsBySignature(procedureDetails)
+
 	procedures := []map[string]interface{}{}
 
 	for _, procedure := range currentProcedures {
@@ -102,6 +123,11 @@ func ReadProcedures(d *schema.ResourceData, meta interface{}) error {
 		procedureMap["comment"] = procedure.Comment.String
 		procedureMap["argument_types"] = procedureSignatureMap["argumentTypes"].([]string)
 		procedureMap["return_type"] = procedureSignatureMap["returnType"].(string)
+		procedureMap["signature"] = procedureSignatureMap["signature"].(string)
+		if detail, ok := details[strings.ToUpper(procedureMap["signature"].(string))]; ok {
+			procedureMap["language"] = detail.Language.String
+			procedureMap["owner"] = detail.Owner.String
+		}
 
 		procedures = append(procedures, procedureMap)
 	}
@@ -118,11 +144,24 @@ func parseArguments(arguments string) (map[string]interface{}, error) {
 	}
 	callableSignatureMap := make(map[string]interface{})
 
-	argumentTypes := strings.Split(matches[2], ", ")
+	argumentTypes := []string{}
+	if matches[2] != "" {
+		argumentTypes = strings.Split(matches[2], ", ")
+	}
 
 	callableSignatureMap["callableName"] = matches[1]
 	callableSignatureMap["argumentTypes"] = argumentTypes
 	callableSignatureMap["returnType"] = matches[3]
+	callableSignatureMap["signature"] = fmt.Sprintf("%s(%s)", matches[1], matches[2])
 
 	return callableSignatureMap, nil
 }
+
+// callableDetailsBySignature indexes the details of the functions or procedures by their upper case signatures.
+func callableDetailsBySignature(callableDetails []snowflake.CallableDetails) map[string]snowflake.CallableDetails {
+	details := make(map[string]snowflake.CallableDetails, len(callableDetails))
+	for _, detail := range callableDetails {
+		details[strings.ToUpper(detail.Signature())] = detail
+	}
+	return details
+}
@@ -26,6 +26,11 @@ func TestAcc_Procedures(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_procedures.t", "procedures.#"),
 					// resource.TestCheckResourceAttr("data.snowflake_procedures.t", "procedures.#", "3"),
 					// Extra 1 in procedure count above due to ASSOCIATE_SEMANTIC_CATEGORY_TAGS appearing in all "SHOW PROCEDURES IN ..." commands
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_procedures.t", "procedures.*", map[string]string{
+						"name":      procedureWithArgumentsName,
+						"signature": fmt.Sprintf("%s(VARCHAR)", procedureWithArgumentsName),
+						"language":  "JAVASCRIPT",
+					}),
 				),
 			},
 		},
@@ -0,0 +1,89 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var regionsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the regions by the Snowflake Region name with the LIKE pattern, e.g. `aws_%`; the match is case-insensitive.",
+	},
+	"regions": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Snowflake Regions in which the accounts of the organization can be created.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"region_group": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Region group where the region is located, e.g. `PUBLIC`.",
+				},
+				"snowflake_region": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the Snowflake Region, e.g. `AWS_US_WEST_2`, used e.g. as the region of the accounts.",
+				},
+				"cloud": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Cloud platform of the region: `aws`, `azure` or `gcp`.",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the cloud platform region, e.g. `us-west-2`.",
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Human-readable name of the region, e.g. `US West (Oregon)`.",
+				},
+			},
+		},
+	},
+}
+
+// Regions returns a pointer to the data source listing the Snowflake Regions.
+func Regions() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadRegions,
+		Schema: regionsSchema,
+	}
+}
+
+// ReadRegions implements schema.ReadFunc.
+func ReadRegions(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	opts := &sdk.ShowRegionsOptions{}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.ReplicationFunctions.ShowRegions(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	regions := []map[string]interface{}{}
+	for _, region := range result {
+		regionMap := map[string]interface{}{}
+		regionMap["region_group"] = region.RegionGroup
+		regionMap["snowflake_region"] = region.SnowflakeRegion
+		regionMap["cloud"] = string(region.CloudType)
+		regionMap["region"] = region.Region
+		regionMap["display_name"] = region.DisplayName
+		regions = append(regions, regionMap)
+	}
+
+	d.SetId("regions")
+	return d.Set("regions", regions)
+}
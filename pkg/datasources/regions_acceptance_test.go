@@ -0,0 +1,36 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Regions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: regions(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_regions.all", "regions.#"),
+					resource.TestCheckResourceAttr("data.snowflake_regions.oregon", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_regions.oregon", "regions.0.snowflake_region", "AWS_US_WEST_2"),
+					resource.TestCheckResourceAttr("data.snowflake_regions.oregon", "regions.0.cloud", "aws"),
+					resource.TestCheckResourceAttr("data.snowflake_regions.oregon", "regions.0.region", "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func regions() string {
+	return `
+	data snowflake_regions "all" {}
+
+	data snowflake_regions "oregon" {
+		pattern = "aws_us_west_2"
+	}
+	`
+}
@@ -0,0 +1,96 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var replicationAccountsSchema = map[string]*schema.Schema{
+	"replication_accounts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Accounts of the organization for which replication is enabled, i.e. the accounts that can be added to the allowed accounts of the failover and replication groups.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the account.",
+				},
+				"organization_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the organization.",
+				},
+				"account_identifier": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Identifier of the account in the `organization.account` format, as used in the allowed accounts of the failover groups.",
+				},
+				"account_locator": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Account locator in the region.",
+				},
+				"snowflake_region": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Snowflake Region where the account is located.",
+				},
+				"created_on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Date and time the account was created.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Comment string.",
+				},
+				"is_org_admin": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the ORGADMIN role is enabled in the account.",
+				},
+			},
+		},
+	},
+}
+
+// ReplicationAccounts Snowflake replication accounts data source.
+func ReplicationAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadReplicationAccounts,
+		Schema: replicationAccountsSchema,
+	}
+}
+
+// ReadReplicationAccounts lists the accounts for which replication is enabled.
+func ReadReplicationAccounts(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	replicationAccounts, err := client.ReplicationFunctions.ShowReplicationAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	d.SetId("replication_accounts")
+	replicationAccountsFlatten := []map[string]interface{}{}
+	for _, replicationAccount := range replicationAccounts {
+		m := map[string]interface{}{}
+		m["account_name"] = replicationAccount.AccountName
+		m["organization_name"] = replicationAccount.OrganizationName
+		m["account_identifier"] = replicationAccount.ID().Name()
+		m["account_locator"] = replicationAccount.AccountLocator
+		m["snowflake_region"] = replicationAccount.SnowflakeRegion
+		m["created_on"] = replicationAccount.CreatedOn.String()
+		m["comment"] = replicationAccount.Comment
+		m["is_org_admin"] = replicationAccount.IsOrgAdmin
+		replicationAccountsFlatten = append(replicationAccountsFlatten, m)
+	}
+	return d.Set("replication_accounts", replicationAccountsFlatten)
+}
@@ -0,0 +1,32 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ReplicationAccounts(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: replicationAccounts(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snowflake_replication_accounts.r", "replication_accounts.#"),
+					resource.TestCheckResourceAttrSet("data.snowflake_replication_accounts.r", "replication_accounts.0.account_name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_replication_accounts.r", "replication_accounts.0.account_identifier"),
+					resource.TestCheckResourceAttrSet("data.snowflake_replication_accounts.r", "replication_accounts.0.account_locator"),
+					resource.TestCheckResourceAttrSet("data.snowflake_replication_accounts.r", "replication_accounts.0.snowflake_region"),
+				),
+			},
+		},
+	})
+}
+
+func replicationAccounts() string {
+	return `
+	data snowflake_replication_accounts "r" {}
+	`
+}
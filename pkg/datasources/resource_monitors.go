@@ -1,11 +1,13 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -30,6 +32,32 @@ var resourceMonitorsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"used_credits": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Credits used in the current interval.",
+				},
+				"remaining_credits": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Credits remaining in the current interval.",
+				},
+				"level": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Whether the resource monitor monitors the ACCOUNT or its WAREHOUSEs; empty when it is not assigned.",
+				},
+				"warehouses": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Warehouses assigned to the resource monitor.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the resource monitor.",
+				},
 				"comment": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -49,6 +77,8 @@ func ResourceMonitors() *schema.Resource {
 
 func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
 	account, err := snowflake.ReadCurrentAccount(db)
 	if err != nil {
@@ -71,6 +101,18 @@ func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	// the warehouses reference their resource monitors, so they are listed separately; SHOW WAREHOUSES returns "null" for the unassigned ones
+	warehouses, err := client.Warehouses.Show(ctx, nil)
+	if err != nil {
+		return err
+	}
+	assignedWarehouses := map[string][]string{}
+	for _, warehouse := range warehouses {
+		if warehouse.ResourceMonitor != "" && warehouse.ResourceMonitor != "null" {
+			assignedWarehouses[warehouse.ResourceMonitor] = append(assignedWarehouses[warehouse.ResourceMonitor], warehouse.Name)
+		}
+	}
+
 	resourceMonitors := []map[string]interface{}{}
 
 	for _, resourceMonitor := range currentResourceMonitors {
@@ -79,6 +121,11 @@ func ReadResourceMonitors(d *schema.ResourceData, meta interface{}) error {
 		resourceMonitorMap["name"] = resourceMonitor.Name.String
 		resourceMonitorMap["frequency"] = resourceMonitor.Frequency.String
 		resourceMonitorMap["credit_quota"] = resourceMonitor.CreditQuota.String
+		resourceMonitorMap["used_credits"] = resourceMonitor.UsedCredits.String
+		resourceMonitorMap["remaining_credits"] = resourceMonitor.RemainingCredits.String
+		resourceMonitorMap["level"] = resourceMonitor.Level.String
+		resourceMonitorMap["warehouses"] = assignedWarehouses[resourceMonitor.Name.String]
+		resourceMonitorMap["owner"] = resourceMonitor.Owner.String
 		resourceMonitorMap["comment"] = resourceMonitor.Comment.String
 
 		resourceMonitors = append(resourceMonitors, resourceMonitorMap)
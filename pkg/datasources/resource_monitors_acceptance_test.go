@@ -20,6 +20,15 @@ func TestAcc_ResourceMonitors(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.#"),
 					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.used_credits"),
+					resource.TestCheckResourceAttrSet("data.snowflake_resource_monitors.s", "resource_monitors.0.owner"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_resource_monitors.s", "resource_monitors.*", map[string]string{
+						"name":         resourceMonitorName,
+						"credit_quota": "5.00",
+						"level":        "WAREHOUSE",
+						"warehouses.#": "1",
+						"warehouses.0": resourceMonitorName,
+					}),
 				),
 			},
 		},
@@ -29,12 +38,19 @@ func TestAcc_ResourceMonitors(t *testing.T) {
 func resourceMonitors(resourceMonitorName string) string {
 	return fmt.Sprintf(`
 	resource snowflake_resource_monitor "s"{
-		name 		 = "%v"
+		name 		 = "%[1]v"
 		credit_quota = 5
 	}
 
+	resource snowflake_warehouse "s" {
+		name                = "%[1]v"
+		warehouse_size      = "XSMALL"
+		initially_suspended = true
+		resource_monitor    = snowflake_resource_monitor.s.name
+	}
+
 	data snowflake_resource_monitors "s" {
-		depends_on = [snowflake_resource_monitor.s]
+		depends_on = [snowflake_resource_monitor.s, snowflake_warehouse.s]
 	}
 	`, resourceMonitorName)
 }
@@ -1,11 +1,12 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
-	"errors"
 	"log"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	snowflakevalidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +16,12 @@ var rolesSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Filters the command output by object name.",
 	},
+	"in_class": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Filters the command output to the instance roles of the class, e.g. `SNOWFLAKE.CORE.BUDGET`.",
+		ValidateFunc: snowflakevalidation.ValidateFullyQualifiedObjectID,
+	},
 	"roles": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -36,6 +43,21 @@ var rolesSchema = map[string]*schema.Schema{
 					Computed:    true,
 					Description: "The owner of the role",
 				},
+				"assigned_to_users": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of users to which the role is granted.",
+				},
+				"granted_to_roles": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of roles to which the role is granted.",
+				},
+				"granted_roles": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of roles granted to the role.",
+				},
 			},
 		},
 	},
@@ -52,15 +74,21 @@ func Roles() *schema.Resource {
 // ReadRoles Reads the database metadata information.
 func ReadRoles(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 	d.SetId("roles_read")
-	rolePattern := d.Get("pattern").(string)
 
-	listRoles, err := snowflake.ListRoles(db, rolePattern)
-	if errors.Is(err, sql.ErrNoRows) {
-		log.Printf("[DEBUG] no roles found in account (%s)", d.Id())
-		d.SetId("")
-		return nil
-	} else if err != nil {
+	req := sdk.NewShowRoleRequest()
+	if rolePattern, ok := d.GetOk("pattern"); ok {
+		req.WithLike(sdk.NewLikeRequest(rolePattern.(string)))
+	}
+	if class, ok := d.GetOk("in_class"); ok {
+		databaseName, schemaName, className := snowflakevalidation.ParseFullyQualifiedObjectID(class.(string))
+		req.WithInClass(sdk.RolesInClass{Class: sdk.NewSchemaObjectIdentifier(databaseName, schemaName, className)})
+	}
+
+	listRoles, err := client.Roles.Show(ctx, req)
+	if err != nil {
 		log.Println("[DEBUG] failed to list roles")
 		d.SetId("")
 		return nil
@@ -71,12 +99,12 @@ func ReadRoles(d *schema.ResourceData, meta interface{}) error {
 	roles := []map[string]interface{}{}
 	for _, role := range listRoles {
 		roleMap := map[string]interface{}{}
-		if !role.Name.Valid {
-			continue
-		}
-		roleMap["name"] = role.Name.String
-		roleMap["comment"] = role.Comment.String
-		roleMap["owner"] = role.Owner.String
+		roleMap["name"] = role.Name
+		roleMap["comment"] = role.Comment
+		roleMap["owner"] = role.Owner
+		roleMap["assigned_to_users"] = role.AssignedToUsers
+		roleMap["granted_to_roles"] = role.GrantedToRoles
+		roleMap["granted_roles"] = role.GrantedRoles
 		roles = append(roles, roleMap)
 	}
 
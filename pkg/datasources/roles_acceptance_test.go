@@ -37,6 +37,8 @@ func TestAcc_Roles(t *testing.T) {
 					// resource.TestCheckResourceAttrSet("data.snowflake_roles.r", "roles.0.name"),
 					resource.TestCheckResourceAttr("data.snowflake_roles.r", "roles.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_roles.r", "roles.0.name", accountAdmin),
+					resource.TestCheckResourceAttrSet("data.snowflake_roles.r", "roles.0.assigned_to_users"),
+					resource.TestCheckResourceAttrSet("data.snowflake_roles.r", "roles.0.granted_roles"),
 				),
 			},
 		},
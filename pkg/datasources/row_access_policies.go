@@ -44,6 +44,26 @@ var rowAccessPoliciesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the row access policy.",
+				},
+				"signature": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Arguments of the row access policy, e.g. `(A VARCHAR)`.",
+				},
+				"return_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Data type returned by the row access policy.",
+				},
+				"body": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "SQL expression of the row access policy, as returned by DESCRIBE ROW ACCESS POLICY.",
+				},
 			},
 		},
 	},
@@ -76,12 +96,20 @@ func ReadRowAccessPolicies(d *schema.ResourceData, meta interface{}) error {
 	rowAccessPolicies := []map[string]interface{}{}
 
 	for _, rowAccessPolicy := range currentRowAccessPolicies {
+		description, err := snowflake.DescribeRowAccessPolicy(databaseName, schemaName, rowAccessPolicy.Name.String, db)
+		if err != nil {
+			return err
+		}
 		rowAccessPolicyMap := map[string]interface{}{}
 
 		rowAccessPolicyMap["name"] = rowAccessPolicy.Name.String
 		rowAccessPolicyMap["database"] = rowAccessPolicy.DatabaseName.String
 		rowAccessPolicyMap["schema"] = rowAccessPolicy.SchemaName.String
 		rowAccessPolicyMap["comment"] = rowAccessPolicy.Comment.String
+		rowAccessPolicyMap["owner"] = rowAccessPolicy.Owner.String
+		rowAccessPolicyMap["signature"] = description.Signature.String
+		rowAccessPolicyMap["return_type"] = description.ReturnType.String
+		rowAccessPolicyMap["body"] = description.Body.String
 
 		rowAccessPolicies = append(rowAccessPolicies, rowAccessPolicyMap)
 	}
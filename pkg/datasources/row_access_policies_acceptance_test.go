@@ -25,6 +25,9 @@ func TestAcc_RowAccessPolicies(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_row_access_policies.v", "row_access_policies.#"),
 					resource.TestCheckResourceAttr("data.snowflake_row_access_policies.v", "row_access_policies.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_row_access_policies.v", "row_access_policies.0.name", rowAccessPolicyName),
+					resource.TestCheckResourceAttrSet("data.snowflake_row_access_policies.v", "row_access_policies.0.owner"),
+					resource.TestCheckResourceAttr("data.snowflake_row_access_policies.v", "row_access_policies.0.return_type", "BOOLEAN"),
+					resource.TestCheckResourceAttr("data.snowflake_row_access_policies.v", "row_access_policies.0.body", "case when current_role() in ('ANALYST') then true else false end"),
 				),
 			},
 		},
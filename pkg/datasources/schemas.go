@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var schemasSchema = map[string]*schema.Schema{
@@ -15,6 +18,22 @@ var schemasSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The database from which to return the schemas from.",
 	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Optionally filters the schemas by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"starts_with": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Optionally filters the schemas by the beginning of their names; the match is case-sensitive.",
+	},
+	"limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Optionally limits the maximum number of schemas returned.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
 	"schemas": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -34,6 +53,38 @@ var schemasSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_default": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"is_current": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"retention_time": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"options": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_transient": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"is_managed": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
 			},
 		},
 	},
@@ -53,12 +104,22 @@ func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
 	databaseName := d.Get("database").(string)
 	databaseID := sdk.NewAccountObjectIdentifier(databaseName)
 
-	currentSchemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{
+	opts := &sdk.ShowSchemaOptions{
 		In: &sdk.SchemaIn{
 			Database: sdk.Bool(true),
 			Name:     databaseID,
 		},
-	})
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	if startsWith, ok := d.GetOk("starts_with"); ok {
+		opts.StartsWith = sdk.String(startsWith.(string))
+	}
+	if limit, ok := d.GetOk("limit"); ok {
+		opts.LimitFrom = &sdk.LimitFrom{Rows: sdk.Int(limit.(int))}
+	}
+	currentSchemas, err := client.Schemas.Show(ctx, opts)
 	if err != nil {
 		log.Printf("[DEBUG] unable to show schemas in database (%s)", databaseName)
 		d.SetId("")
@@ -67,10 +128,33 @@ func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
 
 	schemas := make([]map[string]any, len(currentSchemas))
 	for i, cs := range currentSchemas {
+		// "retention_time" may sometimes be empty string instead of an integer
+		retentionTime, _ := strconv.Atoi(cs.RetentionTime)
+		var options string
+		var isTransient, isManaged bool
+		if cs.Options != nil {
+			options = *cs.Options
+			for _, opt := range strings.Split(options, ",") {
+				switch strings.ToUpper(strings.TrimSpace(opt)) {
+				case "TRANSIENT":
+					isTransient = true
+				case "MANAGED ACCESS":
+					isManaged = true
+				}
+			}
+		}
 		schemas[i] = map[string]any{
-			"name":     cs.Name,
-			"database": cs.DatabaseName,
-			"comment":  cs.Comment,
+			"name":           cs.Name,
+			"database":       cs.DatabaseName,
+			"comment":        cs.Comment,
+			"owner":          cs.Owner,
+			"created_on":     cs.CreatedOn.String(),
+			"is_default":     cs.IsDefault,
+			"is_current":     cs.IsCurrent,
+			"retention_time": retentionTime,
+			"options":        options,
+			"is_transient":   isTransient,
+			"is_managed":     isManaged,
 		}
 	}
 
@@ -28,6 +28,29 @@ func TestAcc_Schemas(t *testing.T) {
 	})
 }
 
+func TestAcc_SchemasFiltered(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: schemasFiltered(databaseName, schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_schemas.s", "schemas.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_schemas.s", "schemas.0.name", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_schemas.s", "schemas.0.is_transient", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_schemas.s", "schemas.0.is_managed", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_schemas.s", "schemas.0.retention_time", "0"),
+					resource.TestCheckResourceAttrSet("data.snowflake_schemas.s", "schemas.0.owner"),
+					resource.TestCheckResourceAttrSet("data.snowflake_schemas.s", "schemas.0.created_on"),
+				),
+			},
+		},
+	})
+}
+
 func schemas(databaseName string, schemaName string) string {
 	return fmt.Sprintf(`
 
@@ -46,3 +69,32 @@ func schemas(databaseName string, schemaName string) string {
 	}
 	`, databaseName, schemaName)
 }
+
+func schemasFiltered(databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "s" {
+		name                = "%[2]v"
+		database            = snowflake_database.d.name
+		is_transient        = true
+		is_managed          = true
+		data_retention_days = 0
+	}
+
+	resource snowflake_schema "other" {
+		name     = "OTHER_%[2]v"
+		database = snowflake_database.d.name
+	}
+
+	data snowflake_schemas "s" {
+		database    = snowflake_schema.s.database
+		pattern     = "%[2]v"
+		starts_with = "%[2]v"
+		limit       = 10
+		depends_on  = [snowflake_schema.s, snowflake_schema.other]
+	}
+	`, databaseName, schemaName)
+}
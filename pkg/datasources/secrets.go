@@ -0,0 +1,111 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secretsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the secrets from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the secrets from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the secrets by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"secrets": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The secrets in the schema. The values of the secrets are never returned.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secret_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the secret: `PASSWORD`, `GENERIC_STRING` or `OAUTH2`.",
+				},
+				"oauth_scopes": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Scopes requested by the `OAUTH2` secrets.",
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Secrets returns a pointer to the data source listing the secrets.
+func Secrets() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSecrets,
+		Schema: secretsSchema,
+	}
+}
+
+// ReadSecrets implements schema.ReadFunc.
+func ReadSecrets(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	opts := &sdk.ShowSecretOptions{
+		In: &sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)},
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.Secrets.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	secrets := []map[string]interface{}{}
+	for _, secret := range result {
+		secretMap := map[string]interface{}{}
+		secretMap["name"] = secret.Name
+		secretMap["database"] = secret.DatabaseName
+		secretMap["schema"] = secret.SchemaName
+		secretMap["secret_type"] = secret.SecretType
+		secretMap["oauth_scopes"] = secret.OauthScopes
+		secretMap["owner"] = secret.Owner
+		secretMap["comment"] = secret.Comment
+		secrets = append(secrets, secretMap)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return d.Set("secrets", secrets)
+}
@@ -0,0 +1,58 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Secrets(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	secretName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secrets(databaseName, schemaName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_secrets.s", "secrets.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.s", "secrets.0.name", secretName),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.s", "secrets.0.secret_type", "GENERIC_STRING"),
+					resource.TestCheckResourceAttr("data.snowflake_secrets.s", "secrets.0.comment", "api key"),
+					resource.TestCheckResourceAttrSet("data.snowflake_secrets.s", "secrets.0.owner"),
+				),
+			},
+		},
+	})
+}
+
+func secrets(databaseName string, schemaName string, secretName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "d" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "s" {
+		name     = "%[2]v"
+		database = snowflake_database.d.name
+	}
+
+	resource snowflake_query "secret" {
+		execute = "CREATE SECRET \"%[1]v\".\"%[2]v\".\"%[3]v\" TYPE = GENERIC_STRING SECRET_STRING = 'value' COMMENT = 'api key'"
+		revert  = "DROP SECRET \"%[1]v\".\"%[2]v\".\"%[3]v\""
+
+		depends_on = [snowflake_schema.s]
+	}
+
+	data snowflake_secrets "s" {
+		database   = snowflake_database.d.name
+		schema     = snowflake_schema.s.name
+		depends_on = [snowflake_query.secret]
+	}
+	`, databaseName, schemaName, secretName)
+}
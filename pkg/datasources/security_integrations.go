@@ -0,0 +1,142 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var securityIntegrationsSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the security integrations by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Runs DESCRIBE SECURITY INTEGRATION for each of the listed integrations and returns their properties. Use it together with pattern to describe a specific integration.",
+	},
+	"security_integrations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The security integrations in the account",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Type of the integration, e.g. `SCIM - AZURE`, `OAUTH - CUSTOM` or `SAML2`.",
+				},
+				"category": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"properties": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Properties returned by DESCRIBE SECURITY INTEGRATION; only set when with_describe is true.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"default": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func SecurityIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSecurityIntegrations,
+		Schema: securityIntegrationsSchema,
+	}
+}
+
+func ReadSecurityIntegrations(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+
+	account, err := snowflake.ReadCurrentAccount(db)
+	if err != nil {
+		log.Print("[DEBUG] unable to retrieve current account")
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
+
+	currentSecurityIntegrations, err := snowflake.ListSecurityIntegrations(db, d.Get("pattern").(string))
+	if err != nil {
+		return err
+	}
+
+	securityIntegrations := []map[string]interface{}{}
+
+	for _, securityIntegration := range currentSecurityIntegrations {
+		securityIntegrationMap := map[string]interface{}{}
+
+		securityIntegrationMap["name"] = securityIntegration.Name.String
+		securityIntegrationMap["type"] = securityIntegration.IntegrationType.String
+		securityIntegrationMap["category"] = securityIntegration.Category.String
+		securityIntegrationMap["enabled"] = securityIntegration.Enabled.Bool
+		securityIntegrationMap["comment"] = securityIntegration.Comment.String
+		securityIntegrationMap["created_on"] = securityIntegration.CreatedOn.String
+
+		if d.Get("with_describe").(bool) {
+			properties, err := snowflake.DescribeIntegration(db, snowflake.NewSecurityIntegrationBuilder(securityIntegration.Name.String))
+			if err != nil {
+				return fmt.Errorf("error describing security integration %v err = %w", securityIntegration.Name.String, err)
+			}
+			propertiesList := []map[string]interface{}{}
+			for _, property := range properties {
+				propertiesList = append(propertiesList, map[string]interface{}{
+					"name":    property.Property.String,
+					"type":    property.PropertyType.String,
+					"value":   property.PropertyValue.String,
+					"default": property.PropertyDefault.String,
+				})
+			}
+			securityIntegrationMap["properties"] = propertiesList
+		}
+
+		securityIntegrations = append(securityIntegrations, securityIntegrationMap)
+	}
+
+	return d.Set("security_integrations", securityIntegrations)
+}
@@ -0,0 +1,59 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SecurityIntegrations(t *testing.T) {
+	integrationName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: securityIntegrations(integrationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.s", "security_integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.s", "security_integrations.0.name", integrationName),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.s", "security_integrations.0.type", "OAUTH - CUSTOM"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.s", "security_integrations.0.category", "SECURITY"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.s", "security_integrations.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.s", "security_integrations.0.properties.#", "0"),
+					resource.TestCheckResourceAttr("data.snowflake_security_integrations.d", "security_integrations.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_security_integrations.d", "security_integrations.0.properties.*", map[string]string{
+						"name":  "OAUTH_REDIRECT_URI",
+						"value": "https://www.example.com/oauth2/callback",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func securityIntegrations(integrationName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_oauth_integration "test" {
+		name               = "%v"
+		oauth_client       = "CUSTOM"
+		oauth_client_type  = "PUBLIC"
+		oauth_redirect_uri = "https://www.example.com/oauth2/callback"
+		enabled            = true
+	}
+
+	data snowflake_security_integrations "s" {
+		pattern    = snowflake_oauth_integration.test.name
+		depends_on = [snowflake_oauth_integration.test]
+	}
+
+	data snowflake_security_integrations "d" {
+		pattern       = snowflake_oauth_integration.test.name
+		with_describe = true
+		depends_on    = [snowflake_oauth_integration.test]
+	}
+	`, integrationName)
+}
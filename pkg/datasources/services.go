@@ -0,0 +1,201 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var servicesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the services from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the services from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the services by name with the LIKE pattern; the match is case-insensitive.",
+	},
+	"with_endpoints": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs SHOW ENDPOINTS for each service to return its endpoints. Disable it to list many services faster.",
+	},
+	"services": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Snowpark Container Services services in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Status of the service, e.g. `PENDING`, `RUNNING`, `FAILED` or `SUSPENDED`.",
+				},
+				"compute_pool": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"dns_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "DNS name of the service, used by the other services in the account to reach it.",
+				},
+				"min_instances": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_instances": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"auto_resume": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"query_warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"endpoints": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Endpoints of the service, only returned when with_endpoints is true.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"port": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"port_range": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"is_public": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"ingress_url": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "URL of the public endpoint; empty until it is provisioned.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// Services returns a pointer to the data source listing the services.
+func Services() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadServices,
+		Schema: servicesSchema,
+	}
+}
+
+// ReadServices implements schema.ReadFunc.
+func ReadServices(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	opts := &sdk.ShowServiceOptions{
+		In: &sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)},
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.Services.Show(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	services := []map[string]interface{}{}
+	for _, service := range result {
+		serviceMap := map[string]interface{}{}
+		serviceMap["name"] = service.Name
+		serviceMap["database"] = service.DatabaseName
+		serviceMap["schema"] = service.SchemaName
+		serviceMap["status"] = service.Status
+		serviceMap["compute_pool"] = service.ComputePool
+		serviceMap["dns_name"] = service.DnsName
+		serviceMap["min_instances"] = service.MinInstances
+		serviceMap["max_instances"] = service.MaxInstances
+		serviceMap["auto_resume"] = service.AutoResume
+		serviceMap["query_warehouse"] = service.QueryWarehouse
+		serviceMap["owner"] = service.Owner
+		serviceMap["comment"] = service.Comment
+		serviceMap["created_on"] = service.CreatedOn.String()
+
+		// the job services run to completion and do not expose any endpoints
+		if d.Get("with_endpoints").(bool) && !service.IsJob {
+			endpoints, err := client.Services.ShowEndpoints(ctx, service.ID())
+			if err != nil {
+				return err
+			}
+			endpointMaps := []map[string]interface{}{}
+			for _, endpoint := range endpoints {
+				endpointMaps = append(endpointMaps, map[string]interface{}{
+					"name":        endpoint.Name,
+					"port":        endpoint.Port,
+					"port_range":  endpoint.PortRange,
+					"protocol":    endpoint.Protocol,
+					"is_public":   endpoint.IsPublic,
+					"ingress_url": endpoint.IngressURL,
+				})
+			}
+			serviceMap["endpoints"] = endpointMaps
+		}
+		services = append(services, serviceMap)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return d.Set("services", services)
+}
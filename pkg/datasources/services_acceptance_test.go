@@ -0,0 +1,54 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Services(t *testing.T) {
+	// fully qualified name of a running service exposing at least one endpoint, e.g. DB.SCHEMA.SERVICE
+	service := os.Getenv("SNOWFLAKE_TEST_SERVICE")
+	parts := strings.Split(service, ".")
+	if len(parts) != 3 {
+		t.Skip("SNOWFLAKE_TEST_SERVICE must be set to the fully qualified name of a service for Services acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: services(parts[0], parts[1], parts[2]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_services.s", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_services.s", "services.0.name", parts[2]),
+					resource.TestCheckResourceAttrSet("data.snowflake_services.s", "services.0.status"),
+					resource.TestCheckResourceAttrSet("data.snowflake_services.s", "services.0.compute_pool"),
+					resource.TestCheckResourceAttrSet("data.snowflake_services.s", "services.0.dns_name"),
+					resource.TestCheckResourceAttrSet("data.snowflake_services.s", "services.0.endpoints.0.name"),
+					resource.TestCheckResourceAttr("data.snowflake_services.without_endpoints", "services.0.endpoints.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func services(databaseName string, schemaName string, serviceName string) string {
+	return fmt.Sprintf(`
+	data snowflake_services "s" {
+		database = "%[1]v"
+		schema   = "%[2]v"
+		pattern  = "%[3]v"
+	}
+
+	data snowflake_services "without_endpoints" {
+		database       = "%[1]v"
+		schema         = "%[2]v"
+		pattern        = "%[3]v"
+		with_endpoints = false
+	}
+	`, databaseName, schemaName, serviceName)
+}
@@ -40,11 +40,16 @@ var sharesSchema = map[string]*schema.Schema{
 					Computed:    true,
 					Description: "The kind of the share.",
 				},
+				"database_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The database shared by the OUTBOUND share, or created from the INBOUND share.",
+				},
 				"to": {
 					Type:        schema.TypeList,
 					Computed:    true,
-					Description: "For the OUTBOUND share, list of consumers.",
-					Elem:        schema.TypeString,
+					Description: "For the OUTBOUND share, list of consumer accounts in the `organization.account` format.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
 			},
 		},
@@ -83,6 +88,7 @@ func ReadShares(d *schema.ResourceData, meta interface{}) error {
 		m["comment"] = share.Comment
 		m["owner"] = share.Owner
 		m["kind"] = share.Kind
+		m["database_name"] = share.DatabaseName.Name()
 		var to []string
 		for _, consumer := range share.To {
 			to = append(to, consumer.Name())
@@ -33,6 +33,8 @@ func TestAcc_Shares(t *testing.T) {
 					resource.TestCheckResourceAttr("data.snowflake_shares.r", "shares.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_shares.r", "shares.0.kind", "OUTBOUND"),
 					resource.TestCheckResourceAttr("data.snowflake_shares.r", "shares.0.comment", comment),
+					resource.TestCheckResourceAttr("data.snowflake_shares.r", "shares.0.to.#", "0"),
+					resource.TestCheckResourceAttrSet("data.snowflake_shares.r", "shares.0.owner"),
 				),
 			},
 		},
@@ -49,6 +49,21 @@ var stagesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the stage; either INTERNAL or EXTERNAL, optionally prefixed with DIRECTORY when it has a directory table.",
+				},
+				"url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The URL of the external stage, or an empty string for internal stages.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the stage.",
+				},
 			},
 		},
 	},
@@ -88,6 +103,9 @@ func ReadStages(d *schema.ResourceData, meta interface{}) error {
 		stageMap["schema"] = stage.SchemaName
 		stageMap["comment"] = stage.Comment
 		stageMap["storage_integration"] = stage.StorageIntegration
+		stageMap["type"] = stage.Type
+		stageMap["url"] = stage.URL
+		stageMap["owner"] = stage.Owner
 
 		stages = append(stages, stageMap)
 	}
@@ -25,6 +25,9 @@ func TestAcc_Stages(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_stages.t", "stages.#"),
 					resource.TestCheckResourceAttr("data.snowflake_stages.t", "stages.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_stages.t", "stages.0.name", stageName),
+					resource.TestCheckResourceAttr("data.snowflake_stages.t", "stages.0.type", "INTERNAL"),
+					resource.TestCheckResourceAttr("data.snowflake_stages.t", "stages.0.url", ""),
+					resource.TestCheckResourceAttrSet("data.snowflake_stages.t", "stages.0.owner"),
 				),
 			},
 		},
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,7 +15,7 @@ var storageIntegrationsSchema = map[string]*schema.Schema{
 	"storage_integrations": {
 		Type:        schema.TypeList,
 		Computed:    true,
-		Description: "The storage integrations in the database",
+		Description: "The storage integrations in the account, with the cloud identities returned by DESCRIBE INTEGRATION",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
@@ -35,6 +36,53 @@ var storageIntegrationsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"storage_provider": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Cloud storage provider of the integration, e.g. `S3`, `GCS` or `AZURE`.",
+				},
+				"storage_allowed_locations": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Locations that the integration can access.",
+				},
+				"storage_blocked_locations": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Locations that the integration cannot access.",
+				},
+				"storage_aws_role_arn": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ARN of the AWS IAM role that the integration assumes.",
+				},
+				"storage_aws_iam_user_arn": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ARN of the AWS IAM user created for the Snowflake account, to be trusted by the IAM role.",
+				},
+				"storage_aws_external_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "External ID that Snowflake uses to assume the IAM role, to be set in the trust policy of the role.",
+				},
+				"storage_gcp_service_account": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "GCP service account created for the Snowflake account, to be granted access to the buckets.",
+				},
+				"azure_consent_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "URL of the Microsoft permissions request page, where the Snowflake application is granted access to the storage accounts.",
+				},
+				"azure_multi_tenant_app_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the Snowflake client application created for the Snowflake account, to be granted access to the storage accounts.",
+				},
 			},
 		},
 	},
@@ -81,6 +129,37 @@ func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
 		storageIntegrationMap["comment"] = storageIntegration.Comment.String
 		storageIntegrationMap["enabled"] = storageIntegration.Enabled.Bool
 
+		properties, err := snowflake.DescribeIntegration(db, snowflake.NewStorageIntegrationBuilder(storageIntegration.Name.String))
+		if err != nil {
+			return fmt.Errorf("error describing storage integration %v err = %w", storageIntegration.Name.String, err)
+		}
+		for _, property := range properties {
+			switch property.Property.String {
+			case "STORAGE_PROVIDER":
+				storageIntegrationMap["storage_provider"] = property.PropertyValue.String
+			case "STORAGE_ALLOWED_LOCATIONS":
+				if value := property.PropertyValue.String; value != "" {
+					storageIntegrationMap["storage_allowed_locations"] = strings.Split(value, ",")
+				}
+			case "STORAGE_BLOCKED_LOCATIONS":
+				if value := property.PropertyValue.String; value != "" {
+					storageIntegrationMap["storage_blocked_locations"] = strings.Split(value, ",")
+				}
+			case "STORAGE_AWS_ROLE_ARN":
+				storageIntegrationMap["storage_aws_role_arn"] = property.PropertyValue.String
+			case "STORAGE_AWS_IAM_USER_ARN":
+				storageIntegrationMap["storage_aws_iam_user_arn"] = property.PropertyValue.String
+			case "STORAGE_AWS_EXTERNAL_ID":
+				storageIntegrationMap["storage_aws_external_id"] = property.PropertyValue.String
+			case "STORAGE_GCP_SERVICE_ACCOUNT":
+				storageIntegrationMap["storage_gcp_service_account"] = property.PropertyValue.String
+			case "AZURE_CONSENT_URL":
+				storageIntegrationMap["azure_consent_url"] = property.PropertyValue.String
+			case "AZURE_MULTI_TENANT_APP_NAME":
+				storageIntegrationMap["azure_multi_tenant_app_name"] = property.PropertyValue.String
+			}
+		}
+
 		storageIntegrations = append(storageIntegrations, storageIntegrationMap)
 	}
 
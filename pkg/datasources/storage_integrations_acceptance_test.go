@@ -20,6 +20,13 @@ func TestAcc_StorageIntegrations(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.snowflake_storage_integrations.s", "storage_integrations.#"),
 					resource.TestCheckResourceAttrSet("data.snowflake_storage_integrations.s", "storage_integrations.0.name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snowflake_storage_integrations.s", "storage_integrations.*", map[string]string{
+						"name":                        storageIntegrationName,
+						"storage_provider":            "S3",
+						"storage_allowed_locations.#": "1",
+						"storage_allowed_locations.0": "s3://foo/",
+						"storage_aws_role_arn":        "arn:aws:iam::000000000001:/role/test",
+					}),
 				),
 			},
 		},
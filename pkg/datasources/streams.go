@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
@@ -50,6 +52,21 @@ var streamsSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"stale": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Specifies whether the stream is stale, i.e. its offset is outside of the data retention period of its source.",
+				},
+				"stale_after": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time in RFC 3339 format after which the stream may become stale, or an empty string when it is unknown.",
+				},
+				"mode": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The mode of the stream; one of DEFAULT, APPEND_ONLY or INSERT_ONLY.",
+				},
 			},
 		},
 	},
@@ -82,11 +99,17 @@ func ReadStreams(d *schema.ResourceData, meta interface{}) error {
 	streams := make([]map[string]any, len(currentStreams))
 	for i, stream := range currentStreams {
 		streams[i] = map[string]any{
-			"name":     stream.Name,
-			"database": stream.DatabaseName,
-			"schema":   stream.SchemaName,
-			"comment":  stream.Comment,
-			"table":    stream.TableName,
+			"name":        stream.Name,
+			"database":    stream.DatabaseName,
+			"schema":      stream.SchemaName,
+			"comment":     stream.Comment,
+			"table":       stream.TableName,
+			"stale":       stream.Stale != nil && strings.EqualFold(*stream.Stale, "true"),
+			"stale_after": "",
+			"mode":        stream.Mode,
+		}
+		if stream.StaleAfter != nil {
+			streams[i]["stale_after"] = stream.StaleAfter.Format(time.RFC3339)
 		}
 	}
 
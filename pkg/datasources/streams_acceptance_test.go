@@ -26,6 +26,8 @@ func TestAcc_Streams(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_streams.t", "streams.#"),
 					resource.TestCheckResourceAttr("data.snowflake_streams.t", "streams.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_streams.t", "streams.0.name", streamName),
+					resource.TestCheckResourceAttr("data.snowflake_streams.t", "streams.0.stale", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_streams.t", "streams.0.mode", "DEFAULT"),
 				),
 			},
 		},
@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var systemGetTagSchema = map[string]*schema.Schema{
+	"tag_id": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Identifier of the tag. Note: format must follow: \"databaseName\".\"schemaName\".\"tagName\" or \"databaseName.schemaName.tagName\" or \"databaseName|schemaName|tagName\" (snowflake_tag.tag.id)",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Type of the object from which the tag value is read, e.g. 'DATABASE', 'TABLE' or 'COLUMN'.",
+		ValidateFunc: validation.StringInSlice([]string{
+			"ACCOUNT", "COLUMN", "DATABASE", "INTEGRATION", "PIPE", "ROLE", "SCHEMA", "STREAM", "SHARE", "STAGE",
+			"TABLE", "TASK", "USER", "VIEW", "WAREHOUSE",
+		}, true),
+	},
+	"object_identifier": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Identifier of the object, e.g. `database.schema.table` or `database.schema.table.column` for columns. Parts containing dots have to be quoted, e.g. `\"database\".\"schema\".\"table.name\"`.",
+	},
+	"tag_value": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Value of the tag on the object, inherited from its parents when not set on the object itself; empty when the tag is not set.",
+	},
+}
+
+func SystemGetTag() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSystemGetTag,
+		Schema: systemGetTagSchema,
+	}
+}
+
+// ReadSystemGetTag implements schema.ReadFunc.
+func ReadSystemGetTag(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	tagDatabase, tagSchema, tagName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("tag_id").(string))
+	tagID := sdk.NewSchemaObjectIdentifier(tagDatabase, tagSchema, tagName)
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	objectID, err := helpers.DecodeSnowflakeParameterID(d.Get("object_identifier").(string))
+	if err != nil {
+		return err
+	}
+
+	tagValue, err := client.SystemFunctions.GetTag(ctx, tagID, objectID, objectType)
+	if err != nil && !errors.Is(err, sdk.ErrTagNotSet) {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(tagDatabase, tagSchema, tagName, objectType.String(), objectID.FullyQualifiedName()))
+	return d.Set("tag_value", tagValue)
+}
@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SystemGetTag(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: systemGetTag(databaseName, schemaName, tagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_system_get_tag.database", "tag_value", "finance"),
+					// the schema inherits the tag value from its database
+					resource.TestCheckResourceAttr("data.snowflake_system_get_tag.schema", "tag_value", "finance"),
+				),
+			},
+		},
+	})
+}
+
+func systemGetTag(databaseName string, schemaName string, tagName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%[2]v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_tag "test" {
+		name     = "%[3]v"
+		database = snowflake_database.test.name
+		schema   = snowflake_schema.test.name
+	}
+
+	resource snowflake_tag_association "test" {
+		object_identifier {
+			name = snowflake_database.test.name
+		}
+		object_type = "DATABASE"
+		tag_id      = snowflake_tag.test.id
+		tag_value   = "finance"
+	}
+
+	data snowflake_system_get_tag "database" {
+		tag_id            = snowflake_tag.test.id
+		object_type       = "DATABASE"
+		object_identifier = snowflake_database.test.name
+		depends_on        = [snowflake_tag_association.test]
+	}
+
+	data snowflake_system_get_tag "schema" {
+		tag_id            = snowflake_tag.test.id
+		object_type       = "SCHEMA"
+		object_identifier = "%[1]v.%[2]v"
+		depends_on        = [snowflake_tag_association.test]
+	}
+	`, databaseName, schemaName, tagName)
+}
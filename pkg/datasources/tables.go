@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +19,8 @@ var tablesSchema = map[string]*schema.Schema{
 	},
 	"schema": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The schema from which to return the tables from.",
+		Optional:    true,
+		Description: "The schema from which to return the tables from. When not set, the tables of all the schemas of the database are returned.",
 	},
 	"tables": {
 		Type:        schema.TypeList,
@@ -44,6 +45,36 @@ var tablesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"kind": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The kind of the table; one of TABLE for permanent tables, TRANSIENT or TEMPORARY.",
+				},
+				"cluster_by": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The clustering key of the table, e.g. `LINEAR(id)`, or an empty string when the table is not clustered.",
+				},
+				"rows": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of rows in the table.",
+				},
+				"bytes": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of bytes scanned when the whole table is read.",
+				},
+				"change_tracking": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Specifies whether change tracking is enabled on the table.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the table.",
+				},
 			},
 		},
 	},
@@ -86,6 +117,12 @@ func ReadTables(d *schema.ResourceData, meta interface{}) error {
 		tableMap["database"] = table.DatabaseName.String
 		tableMap["schema"] = table.SchemaName.String
 		tableMap["comment"] = table.Comment.String
+		tableMap["kind"] = table.Kind.String
+		tableMap["cluster_by"] = table.ClusterBy.String
+		tableMap["rows"], _ = strconv.Atoi(table.Rows.String)
+		tableMap["bytes"], _ = strconv.Atoi(table.Bytes.String)
+		tableMap["change_tracking"] = table.ChangeTracking.String == "ON"
+		tableMap["owner"] = table.Owner.String
 
 		tables = append(tables, tableMap)
 	}
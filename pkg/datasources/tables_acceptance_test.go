@@ -27,6 +27,11 @@ func TestAcc_Tables(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_tables.t", "tables.#"),
 					resource.TestCheckResourceAttr("data.snowflake_tables.t", "tables.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_tables.t", "tables.0.name", tableName),
+					resource.TestCheckResourceAttr("data.snowflake_tables.t", "tables.0.kind", "TABLE"),
+					resource.TestCheckResourceAttr("data.snowflake_tables.t", "tables.0.rows", "0"),
+					resource.TestCheckResourceAttr("data.snowflake_tables.t", "tables.0.change_tracking", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_tables.d", "tables.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_tables.d", "tables.0.schema", schemaName),
 				),
 			},
 		},
@@ -80,5 +85,10 @@ func tables(databaseName string, schemaName string, tableName string, stageName
 		schema = snowflake_table.t.schema
 		depends_on = [snowflake_table.t, snowflake_external_table.et]
 	}
+
+	data snowflake_tables "d" {
+		database = snowflake_table.t.database
+		depends_on = [snowflake_table.t, snowflake_external_table.et]
+	}
 	`, databaseName, schemaName, tableName, stageName, externalTableName)
 }
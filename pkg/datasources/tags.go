@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tagsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the tags from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the tags from.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the tags by name with the LIKE pattern, e.g. `COST_%`; the match is case-insensitive.",
+	},
+	"tags": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The tags in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"allowed_values": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "Values that can be assigned to the tag; empty when any value is allowed.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Role that owns the tag.",
+				},
+			},
+		},
+	},
+}
+
+func Tags() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadTags,
+		Schema: tagsSchema,
+	}
+}
+
+func ReadTags(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	request := sdk.NewShowTagRequest().WithIn(&sdk.In{Schema: sdk.NewDatabaseObjectIdentifier(databaseName, schemaName)})
+	if pattern, ok := d.GetOk("pattern"); ok {
+		request.WithLike(pattern.(string))
+	}
+	result, err := client.Tags.Show(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	tags := []map[string]interface{}{}
+	for _, tag := range result {
+		tagMap := map[string]interface{}{}
+		tagMap["name"] = tag.Name
+		tagMap["database"] = tag.DatabaseName
+		tagMap["schema"] = tag.SchemaName
+		tagMap["comment"] = tag.Comment
+		tagMap["allowed_values"] = tag.AllowedValues
+		tagMap["owner"] = tag.Owner
+		tags = append(tags, tagMap)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return d.Set("tags", tags)
+}
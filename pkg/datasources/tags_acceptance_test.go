@@ -0,0 +1,69 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Tags(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tags(databaseName, schemaName, tagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_tags.t", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_tags.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_tags.t", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_tags.t", "tags.0.name", tagName),
+					resource.TestCheckResourceAttr("data.snowflake_tags.t", "tags.0.comment", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr("data.snowflake_tags.t", "tags.0.allowed_values.#", "2"),
+					resource.TestCheckResourceAttrSet("data.snowflake_tags.t", "tags.0.owner"),
+					resource.TestCheckResourceAttr("data.snowflake_tags.p", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func tags(databaseName string, schemaName string, tagName string) string {
+	return fmt.Sprintf(`
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource snowflake_tag "test" {
+		name           = "%v"
+		database       = snowflake_database.test.name
+		schema         = snowflake_schema.test.name
+		allowed_values = ["finance", "hr"]
+		comment        = "Terraform acceptance test"
+	}
+
+	data snowflake_tags "t" {
+		database   = snowflake_tag.test.database
+		schema     = snowflake_tag.test.schema
+		depends_on = [snowflake_tag.test]
+	}
+
+	data snowflake_tags "p" {
+		database   = snowflake_tag.test.database
+		schema     = snowflake_tag.test.schema
+		pattern    = "NOT_MATCHING_%%"
+		depends_on = [snowflake_tag.test]
+	}
+	`, databaseName, schemaName, tagName)
+}
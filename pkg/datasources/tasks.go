@@ -49,6 +49,16 @@ var tasksSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The state of the task; either started or suspended.",
+				},
+				"schedule": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The schedule of the task, e.g. `60 MINUTE` or `USING CRON 0 9 * * * UTC`, or an empty string for the tasks run after their predecessors.",
+				},
 			},
 		},
 	},
@@ -86,6 +96,8 @@ func ReadTasks(d *schema.ResourceData, meta interface{}) error {
 		taskMap["schema"] = task.SchemaName
 		taskMap["comment"] = task.Comment
 		taskMap["warehouse"] = task.Warehouse
+		taskMap["state"] = string(task.State)
+		taskMap["schedule"] = task.Schedule
 
 		tasks = append(tasks, taskMap)
 	}
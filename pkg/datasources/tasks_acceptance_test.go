@@ -25,6 +25,8 @@ func TestAcc_Tasks(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_tasks.t", "tasks.#"),
 					resource.TestCheckResourceAttr("data.snowflake_tasks.t", "tasks.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_tasks.t", "tasks.0.name", taskName),
+					resource.TestCheckResourceAttr("data.snowflake_tasks.t", "tasks.0.state", "started"),
+					resource.TestCheckResourceAttr("data.snowflake_tasks.t", "tasks.0.schedule", "15 MINUTES"),
 				),
 			},
 		},
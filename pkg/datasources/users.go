@@ -1,25 +1,38 @@
 package datasources
 
 import (
+	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
-
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var usersSchema = map[string]*schema.Schema{
 	"pattern": {
 		Type:     schema.TypeString,
-		Required: true,
+		Optional: true,
 		Description: "Users pattern for which to return metadata. Please refer to LIKE keyword from " +
 			"snowflake documentation : https://docs.snowflake.com/en/sql-reference/sql/show-users.html#parameters",
 	},
+	"starts_with": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the users by the prefix of their name; the match is case-sensitive.",
+	},
+	"limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Limits the number of returned users, ordered by name.",
+	},
 	"users": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -70,6 +83,11 @@ var usersSchema = map[string]*schema.Schema{
 					Type:     schema.TypeBool,
 					Computed: true,
 				},
+				"last_success_login": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time of the last successful login of the user in RFC 3339 format, or an empty string when the user never logged in.",
+				},
 				"email": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -107,7 +125,8 @@ func Users() *schema.Resource {
 
 func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	userPattern := d.Get("pattern").(string)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
 	account, err := snowflake.ReadCurrentAccount(db)
 	if err != nil {
@@ -118,13 +137,18 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	currentUsers, err := snowflake.ListUsers(userPattern, db)
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] no users found in account (%s)", d.Id())
-		d.SetId("")
-		return nil
-	} else if err != nil {
+	opts := &sdk.ShowUserOptions{}
+	if v, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(v.(string))}
+	}
+	if v, ok := d.GetOk("starts_with"); ok {
+		opts.StartsWith = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("limit"); ok {
+		opts.Limit = sdk.Int(v.(int))
+	}
+	currentUsers, err := client.Users.Show(ctx, opts)
+	if err != nil {
 		log.Printf("[DEBUG] unable to parse users in account (%s)", d.Id())
 		d.SetId("")
 		return nil
@@ -134,20 +158,24 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 
 	for _, user := range currentUsers {
 		userMap := map[string]interface{}{}
-		userMap["name"] = user.Name.String
-		userMap["login_name"] = user.LoginName.String
-		userMap["comment"] = user.Comment.String
+		userMap["name"] = user.Name
+		userMap["login_name"] = user.LoginName
+		userMap["comment"] = user.Comment
 		userMap["disabled"] = user.Disabled
-		userMap["default_warehouse"] = user.DefaultWarehouse.String
-		userMap["default_namespace"] = user.DefaultNamespace.String
-		userMap["default_role"] = user.DefaultRole.String
+		userMap["default_warehouse"] = user.DefaultWarehouse
+		userMap["default_namespace"] = user.DefaultNamespace
+		userMap["default_role"] = user.DefaultRole
 		userMap["default_secondary_roles"] = strings.Split(
-			helpers.ListContentToString(user.DefaultSecondaryRoles.String), ",")
+			helpers.ListContentToString(user.DefaultSecondaryRoles), ",")
 		userMap["has_rsa_public_key"] = user.HasRsaPublicKey
-		userMap["email"] = user.Email.String
-		userMap["display_name"] = user.DisplayName.String
-		userMap["first_name"] = user.FirstName.String
-		userMap["last_name"] = user.LastName.String
+		userMap["email"] = user.Email
+		userMap["display_name"] = user.DisplayName
+		userMap["first_name"] = user.FirstName
+		userMap["last_name"] = user.LastName
+		userMap["last_success_login"] = ""
+		if !user.LastSuccessLogin.IsZero() {
+			userMap["last_success_login"] = user.LastSuccessLogin.Format(time.RFC3339)
+		}
 
 		users = append(users, userMap)
 	}
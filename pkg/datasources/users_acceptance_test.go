@@ -21,6 +21,10 @@ func TestAccUsers(t *testing.T) {
 					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.name", userName),
 					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.disabled", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.has_rsa_public_key", "false"),
+					resource.TestCheckResourceAttr("data.snowflake_users.u", "users.0.last_success_login", ""),
+					resource.TestCheckResourceAttr("data.snowflake_users.s", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_users.s", "users.0.name", userName),
 				),
 			},
 		},
@@ -48,5 +52,11 @@ func users(userName string) string {
 		pattern = "%s"
 		depends_on = [snowflake_user.u]
 	}
-	`, userName, userName, userName)
+
+	data snowflake_users "s" {
+		starts_with = "%s"
+		limit       = 1
+		depends_on  = [snowflake_user.u]
+	}
+	`, userName, userName, userName, userName)
 }
@@ -25,6 +25,9 @@ func TestAcc_Views(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.snowflake_views.v", "views.#"),
 					resource.TestCheckResourceAttr("data.snowflake_views.v", "views.#", "1"),
 					resource.TestCheckResourceAttr("data.snowflake_views.v", "views.0.name", viewName),
+					resource.TestCheckResourceAttr("data.snowflake_views.v", "views.0.is_secure", "false"),
+					resource.TestCheckResourceAttrSet("data.snowflake_views.v", "views.0.owner"),
+					resource.TestCheckResourceAttrSet("data.snowflake_views.v", "views.0.text"),
 				),
 			},
 		},
@@ -0,0 +1,90 @@
+package datasources
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var warehouseMeteringHistorySchema = map[string]*schema.Schema{
+	"warehouse_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Returns only the credits used by the warehouse. By default, the credits of all the warehouses are returned.",
+	},
+	"days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      7,
+		Description:  "Number of the last days over which the credits are summed.",
+		ValidateFunc: validation.IntBetween(1, 365),
+	},
+	"warehouses": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The credits used by the warehouses, the most expensive first",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"credits_used": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "Total credits used by the warehouse, the sum of the compute and cloud services credits.",
+				},
+				"credits_used_compute": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"credits_used_cloud_services": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"metered_hours": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of the hours in which the warehouse used credits.",
+				},
+			},
+		},
+	},
+}
+
+// WarehouseMeteringHistory returns a pointer to the data source summing the credits used by the warehouses.
+func WarehouseMeteringHistory() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadWarehouseMeteringHistory,
+		Schema: warehouseMeteringHistorySchema,
+	}
+}
+
+// ReadWarehouseMeteringHistory implements schema.ReadFunc.
+func ReadWarehouseMeteringHistory(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+
+	warehouseName := d.Get("warehouse_name").(string)
+	days := d.Get("days").(int)
+	meterings, err := snowflake.ListWarehouseMetering(db, warehouseName, days)
+	if err != nil {
+		return err
+	}
+
+	warehouses := []map[string]interface{}{}
+	for _, metering := range meterings {
+		warehouseMap := map[string]interface{}{}
+		warehouseMap["name"] = metering.WarehouseName.String
+		warehouseMap["credits_used"] = metering.CreditsUsed.Float64
+		warehouseMap["credits_used_compute"] = metering.CreditsUsedCompute.Float64
+		warehouseMap["credits_used_cloud_services"] = metering.CreditsUsedCloudServices.Float64
+		warehouseMap["metered_hours"] = metering.MeteredHours.Int64
+		warehouses = append(warehouses, warehouseMap)
+	}
+
+	d.SetId(fmt.Sprintf("%v|%d", warehouseName, days))
+	return d.Set("warehouses", warehouses)
+}
@@ -0,0 +1,31 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_WarehouseMeteringHistory(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: warehouseMeteringHistory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_warehouse_metering_history.h", "days", "30"),
+					resource.TestCheckResourceAttrSet("data.snowflake_warehouse_metering_history.h", "warehouses.#"),
+				),
+			},
+		},
+	})
+}
+
+func warehouseMeteringHistory() string {
+	return `
+	data snowflake_warehouse_metering_history "h" {
+		days = 30
+	}
+	`
+}
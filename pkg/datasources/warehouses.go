@@ -11,6 +11,11 @@ import (
 )
 
 var warehousesSchema = map[string]*schema.Schema{
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the warehouses by name with the LIKE pattern, e.g. `ETL_%`; the match is case-insensitive.",
+	},
 	"warehouses": {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -45,6 +50,21 @@ var warehousesSchema = map[string]*schema.Schema{
 					Optional: true,
 					Computed: true,
 				},
+				"auto_suspend": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of seconds of inactivity after which the warehouse is suspended; 0 when it is never suspended.",
+				},
+				"owner": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The role that owns the warehouse.",
+				},
+				"resource_monitor": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The resource monitor assigned to the warehouse, or `null` when there is none.",
+				},
 			},
 		},
 	},
@@ -69,7 +89,11 @@ func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
 
-	result, err := client.Warehouses.Show(ctx, nil)
+	opts := &sdk.ShowWarehouseOptions{}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		opts.Like = &sdk.Like{Pattern: sdk.String(pattern.(string))}
+	}
+	result, err := client.Warehouses.Show(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -85,6 +109,9 @@ func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
 		warehouseMap["scaling_policy"] = warehouse.ScalingPolicy
 		warehouseMap["state"] = warehouse.State
 		warehouseMap["comment"] = warehouse.Comment
+		warehouseMap["auto_suspend"] = warehouse.AutoSuspend
+		warehouseMap["owner"] = warehouse.Owner
+		warehouseMap["resource_monitor"] = warehouse.ResourceMonitor
 
 		warehouses = append(warehouses, warehouseMap)
 	}
@@ -20,6 +20,10 @@ func TestAcc_Warehouses(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.snowflake_warehouses.s", "warehouses.#"),
 					resource.TestCheckResourceAttrSet("data.snowflake_warehouses.s", "warehouses.0.name"),
+					resource.TestCheckResourceAttr("data.snowflake_warehouses.p", "warehouses.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_warehouses.p", "warehouses.0.name", warehouseName),
+					resource.TestCheckResourceAttr("data.snowflake_warehouses.p", "warehouses.0.auto_suspend", "60"),
+					resource.TestCheckResourceAttrSet("data.snowflake_warehouses.p", "warehouses.0.owner"),
 				),
 			},
 		},
@@ -41,5 +45,10 @@ func warehouses(warehouseName string) string {
 	data snowflake_warehouses "s" {
 		depends_on = [snowflake_warehouse.s]
 	}
-	`, warehouseName)
+
+	data snowflake_warehouses "p" {
+		pattern    = "%v"
+		depends_on = [snowflake_warehouse.s]
+	}
+	`, warehouseName, warehouseName)
 }
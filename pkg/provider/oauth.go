@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// oauthTokenExpiryMargin is subtracted from the lifetime of the access tokens, so that a token does not expire
+// between being fetched and being used to log in.
+const oauthTokenExpiryMargin = time.Minute
+
+// oauthDefaultTokenLifetime is the lifetime assumed for the access tokens returned without expires_in, which matches
+// the default lifetime of the Snowflake OAuth access tokens.
+const oauthDefaultTokenLifetime = 10 * time.Minute
+
+// oauthTokenSource caches the access token of the OAuth provider and fetches a new one when it is about to expire.
+type oauthTokenSource struct {
+	fetch func(ctx context.Context) (*GetRefreshTokenResponseBody, error)
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// newClientCredentialsTokenSource returns a token source using the OAuth client credentials grant.
+func newClientCredentialsTokenSource(tokenEndpoint string, clientID string, clientSecret string, scopes []string) *oauthTokenSource {
+	return &oauthTokenSource{
+		fetch: func(ctx context.Context) (*GetRefreshTokenResponseBody, error) {
+			data := url.Values{}
+			data.Set("grant_type", "client_credentials")
+			if len(scopes) > 0 {
+				data.Set("scope", strings.Join(scopes, " "))
+			}
+			return requestAccessToken(ctx, tokenEndpoint, clientID, clientSecret, data)
+		},
+	}
+}
+
+// newRefreshTokenSource returns a token source using the OAuth refresh token grant. When the OAuth provider returns
+// a new refresh token with the access token, it is used for the next refresh.
+func newRefreshTokenSource(tokenEndpoint string, clientID string, clientSecret string, refreshToken string, redirectURI string) *oauthTokenSource {
+	return &oauthTokenSource{
+		fetch: func(ctx context.Context) (*GetRefreshTokenResponseBody, error) {
+			data := url.Values{}
+			data.Set("grant_type", "refresh_token")
+			data.Set("refresh_token", refreshToken)
+			if redirectURI != "" {
+				data.Set("redirect_uri", redirectURI)
+			}
+			result, err := requestAccessToken(ctx, tokenEndpoint, clientID, clientSecret, data)
+			if err != nil {
+				return nil, err
+			}
+			if result.RefreshToken != "" {
+				refreshToken = result.RefreshToken
+			}
+			return result, nil
+		},
+	}
+}
+
+// Token returns the cached access token, or a new one when the cached token expires within oauthTokenExpiryMargin.
+func (s *oauthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && time.Now().Add(oauthTokenExpiryMargin).Before(s.expiresAt) {
+		return s.accessToken, nil
+	}
+	result, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	lifetime := time.Duration(result.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = oauthDefaultTokenLifetime
+	}
+	s.accessToken = result.AccessToken
+	s.expiresAt = time.Now().Add(lifetime)
+	return s.accessToken, nil
+}
+
+// oauthConnector logs in every new connection with an access token of the token source, so that the connections
+// opened after the first access token expired, e.g. during long applies, are not rejected.
+type oauthConnector struct {
+	config gosnowflake.Config
+	tokens *oauthTokenSource
+}
+
+var _ driver.Connector = (*oauthConnector)(nil)
+
+func newOAuthConnector(config *gosnowflake.Config, tokens *oauthTokenSource) *oauthConnector {
+	return &oauthConnector{config: *config, tokens: tokens}
+}
+
+func (c *oauthConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve OAuth access token err = %w", err)
+	}
+	config := c.config
+	config.Token = token
+	config.Authenticator = gosnowflake.AuthTypeOAuth
+	// the connections update their session parameters, so they cannot share them
+	config.Params = make(map[string]*string, len(c.config.Params))
+	for key, value := range c.config.Params {
+		v := *value
+		config.Params[key] = &v
+	}
+	return gosnowflake.NewConnector(gosnowflake.SnowflakeDriver{}, config).Connect(ctx)
+}
+
+func (c *oauthConnector) Driver() driver.Driver {
+	return gosnowflake.SnowflakeDriver{}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func oauthTokenServer(t *testing.T, expiresIn int, handle func(r *http.Request)) (*httptest.Server, *int) {
+	t.Helper()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		handle(r)
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`, requests, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requests the token with the client credentials grant", func(t *testing.T) {
+		server, _ := oauthTokenServer(t, 3600, func(r *http.Request) {
+			clientID, clientSecret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "client", clientID)
+			assert.Equal(t, "secret", clientSecret)
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "session:role:TERRAFORM refresh_token", r.PostForm.Get("scope"))
+		})
+		tokens := newClientCredentialsTokenSource(server.URL, "client", "secret", []string{"session:role:TERRAFORM", "refresh_token"})
+
+		token, err := tokens.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+	})
+
+	t.Run("caches the token until it is about to expire", func(t *testing.T) {
+		server, requests := oauthTokenServer(t, 3600, func(r *http.Request) {})
+		tokens := newClientCredentialsTokenSource(server.URL, "client", "secret", nil)
+
+		for i := 0; i < 3; i++ {
+			token, err := tokens.Token(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "token-1", token)
+		}
+		require.Equal(t, 1, *requests)
+	})
+
+	t.Run("requests a new token when the token is about to expire", func(t *testing.T) {
+		server, requests := oauthTokenServer(t, 30, func(r *http.Request) {})
+		tokens := newClientCredentialsTokenSource(server.URL, "client", "secret", nil)
+
+		token, err := tokens.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		token, err = tokens.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token)
+		require.Equal(t, 2, *requests)
+	})
+
+	t.Run("caches the token without expires_in for the default lifetime", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer"}`, requests)
+		}))
+		t.Cleanup(server.Close)
+		tokens := newClientCredentialsTokenSource(server.URL, "client", "secret", nil)
+
+		for i := 0; i < 3; i++ {
+			token, err := tokens.Token(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "token-1", token)
+		}
+		require.Equal(t, 1, requests)
+	})
+
+	t.Run("fails when the client is rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		t.Cleanup(server.Close)
+		tokens := newClientCredentialsTokenSource(server.URL, "client", "wrong", nil)
+
+		_, err := tokens.Token(ctx)
+		require.ErrorContains(t, err, "401")
+	})
+}
+
+func TestRefreshTokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requests the token with the refresh token grant", func(t *testing.T) {
+		server, _ := oauthTokenServer(t, 3600, func(r *http.Request) {
+			clientID, clientSecret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "client", clientID)
+			assert.Equal(t, "secret", clientSecret)
+			assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+			assert.Equal(t, "https://localhost.com", r.PostForm.Get("redirect_uri"))
+		})
+		tokens := newRefreshTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com")
+
+		token, err := tokens.Token(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+	})
+
+	t.Run("omits the redirect URI when not set", func(t *testing.T) {
+		server, _ := oauthTokenServer(t, 3600, func(r *http.Request) {
+			_, ok := r.PostForm["redirect_uri"]
+			assert.False(t, ok)
+		})
+		tokens := newRefreshTokenSource(server.URL, "client", "secret", "refresh", "")
+
+		_, err := tokens.Token(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("uses the rotated refresh token for the next request", func(t *testing.T) {
+		var refreshTokens []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, r.ParseForm())
+			refreshTokens = append(refreshTokens, r.PostForm.Get("refresh_token"))
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 30, "refresh_token": "refresh-%d"}`, len(refreshTokens), len(refreshTokens))
+		}))
+		t.Cleanup(server.Close)
+		tokens := newRefreshTokenSource(server.URL, "client", "secret", "refresh", "")
+
+		for i := 1; i <= 3; i++ {
+			token, err := tokens.Token(ctx)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("token-%d", i), token)
+		}
+		require.Equal(t, []string{"refresh", "refresh-1", "refresh-2"}, refreshTokens)
+	})
+}
@@ -194,9 +194,10 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN", nil),
 			},
 			"token_accessor": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Description: "Authenticates with access tokens requested from the OAuth provider with the refresh token. A new access token is requested when the previous one is about to expire, so that long applies do not fail. When the OAuth provider rotates the refresh tokens, the returned refresh token is used for the next request.",
+				Optional:    true,
+				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"token_endpoint": {
@@ -229,14 +230,51 @@ func Provider() *schema.Provider {
 						},
 						"redirect_uri": {
 							Type:        schema.TypeString,
-							Description: "The redirect URI for the OAuth provider when using a refresh token to renew access token. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI` environment variable.",
-							Required:    true,
+							Description: "The redirect URI for the OAuth provider, sent with the refresh token when the OAuth provider requires it. Can also be sourced from the `SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI` environment variable.",
+							Optional:    true,
 							Sensitive:   true,
 							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_TOKEN_ACCESSOR_REDIRECT_URI", nil),
 						},
 					},
 				},
 			},
+			"oauth_client_credentials": {
+				Type:          schema.TypeList,
+				Description:   "Authenticates with access tokens requested from the OAuth provider with the client credentials grant. A new access token is requested when the previous one is about to expire, so that long applies do not fail. Cannot be used with `token`, `token_accessor`, `password` or the private key. The environment variables of the block fields are only read when the block is declared, e.g. `oauth_client_credentials {}`.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"token", "token_accessor", "password", "private_key", "private_key_path", "browser_auth", "oauth_access_token", "oauth_refresh_token"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"token_endpoint": {
+							Type:        schema.TypeString,
+							Description: "The token endpoint of the OAuth provider, e.g. https://{yourDomain}/oauth2/token. Can also be sourced from the `SNOWFLAKE_OAUTH_CLIENT_CREDENTIALS_TOKEN_ENDPOINT` environment variable.",
+							Required:    true,
+							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_CREDENTIALS_TOKEN_ENDPOINT", nil),
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Description: "The client ID of the OAuth client. Can also be sourced from the `SNOWFLAKE_OAUTH_CLIENT_CREDENTIALS_CLIENT_ID` environment variable.",
+							Required:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_CREDENTIALS_CLIENT_ID", nil),
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Description: "The client secret of the OAuth client. Can also be sourced from the `SNOWFLAKE_OAUTH_CLIENT_CREDENTIALS_CLIENT_SECRET` environment variable.",
+							Required:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_CREDENTIALS_CLIENT_SECRET", nil),
+						},
+						"scopes": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The scopes requested for the access tokens, e.g. `session:role:TERRAFORM` for the role used by the provider.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 			"keep_session_alive": {
 				Type:        schema.TypeBool,
 				Description: "Enables the session to persist even after the connection is closed. Can also be sourced from the `SNOWFLAKE_KEEP_SESSION_ALIVE` environment variable.",
@@ -245,7 +283,7 @@ func Provider() *schema.Provider {
 			},
 			"private_key": {
 				Type:          schema.TypeString,
-				Description:   "Private Key for username+private-key auth. Cannot be used with `browser_auth` or `password`. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY` environment variable.",
+				Description:   "Private Key in the PEM format for username+private-key auth, decrypted with `private_key_passphrase` when it is an encrypted PKCS#8 key. Cannot be used with `browser_auth` or `password`. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY` environment variable.",
 				Optional:      true,
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY", nil),
@@ -253,7 +291,7 @@ func Provider() *schema.Provider {
 			},
 			"private_key_passphrase": {
 				Type:          schema.TypeString,
-				Description:   "Supports the encryption ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY_PASSPHRASE` environment variable.",
+				Description:   "Passphrase of the encrypted PKCS#8 private key set in `private_key` or `private_key_path`. Supports the encryption ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc. Can also be sourced from `SNOWFLAKE_PRIVATE_KEY_PASSPHRASE` environment variable.",
 				Optional:      true,
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PASSPHRASE", nil),
@@ -323,12 +361,12 @@ func Provider() *schema.Provider {
 			},
 			"oauth_refresh_token": {
 				Type:          schema.TypeString,
-				Description:   "Token for use with OAuth. Setup and generation of the token is left to other tools. Should be used in conjunction with `oauth_client_id`, `oauth_client_secret`, `oauth_endpoint`, `oauth_redirect_url`. Cannot be used with `browser_auth`, `private_key_path`, `oauth_access_token` or `password`. Can also be sourced from `SNOWFLAKE_OAUTH_REFRESH_TOKEN` environment variable.",
+				Description:   "Token for use with OAuth. Setup and generation of the token is left to other tools. Should be used in conjunction with `oauth_client_id`, `oauth_client_secret`, `oauth_endpoint` and optionally `oauth_redirect_url`. A new access token is requested with the refresh token when the previous one is about to expire. Cannot be used with `browser_auth`, `private_key_path`, `oauth_access_token` or `password`. Can also be sourced from `SNOWFLAKE_OAUTH_REFRESH_TOKEN` environment variable.",
 				Optional:      true,
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REFRESH_TOKEN", nil),
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
+				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint"},
 				Deprecated:    "Use `token_accessor.0.refresh_token` instead",
 			},
 			"oauth_client_id": {
@@ -338,7 +376,7 @@ func Provider() *schema.Provider {
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_ID", nil),
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_refresh_token", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
+				RequiredWith:  []string{"oauth_refresh_token", "oauth_client_secret", "oauth_endpoint"},
 				Deprecated:    "Use `token_accessor.0.client_id` instead",
 			},
 			"oauth_client_secret": {
@@ -348,7 +386,7 @@ func Provider() *schema.Provider {
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_SECRET", nil),
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_refresh_token", "oauth_endpoint", "oauth_redirect_url"},
+				RequiredWith:  []string{"oauth_client_id", "oauth_refresh_token", "oauth_endpoint"},
 				Deprecated:    "Use `token_accessor.0.client_secret` instead",
 			},
 			"oauth_endpoint": {
@@ -358,12 +396,12 @@ func Provider() *schema.Provider {
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ENDPOINT", nil),
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
-				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_refresh_token", "oauth_redirect_url"},
+				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_refresh_token"},
 				Deprecated:    "Use `token_accessor.0.token_endpoint` instead",
 			},
 			"oauth_redirect_url": {
 				Type:          schema.TypeString,
-				Description:   "Required when `oauth_refresh_token` is used. Can also be sourced from `SNOWFLAKE_OAUTH_REDIRECT_URL` environment variable.",
+				Description:   "Sent with `oauth_refresh_token` when the OAuth provider requires it. Can also be sourced from `SNOWFLAKE_OAUTH_REDIRECT_URL` environment variable.",
 				Optional:      true,
 				Sensitive:     true,
 				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REDIRECT_URL", nil),
@@ -429,61 +467,92 @@ func GetGrantResources() resources.TerraformGrantResources {
 func getResources() map[string]*schema.Resource {
 	// NOTE(): do not add grant resources here
 	others := map[string]*schema.Resource{
-		"snowflake_account":                                 resources.Account(),
-		"snowflake_account_password_policy_attachment":      resources.AccountPasswordPolicyAttachment(),
-		"snowflake_account_parameter":                       resources.AccountParameter(),
-		"snowflake_alert":                                   resources.Alert(),
-		"snowflake_api_integration":                         resources.APIIntegration(),
-		"snowflake_database":                                resources.Database(),
-		"snowflake_database_role":                           resources.DatabaseRole(),
-		"snowflake_database_role_grants":                    resources.DatabaseRoleGrants(),
-		"snowflake_dynamic_table":                           resources.DynamicTable(),
-		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
-		"snowflake_external_function":                       resources.ExternalFunction(),
-		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
-		"snowflake_external_table":                          resources.ExternalTable(),
-		"snowflake_failover_group":                          resources.FailoverGroup(),
-		"snowflake_file_format":                             resources.FileFormat(),
-		"snowflake_function":                                resources.Function(),
-		"snowflake_grant_privileges_to_database_role":       resources.GrantPrivilegesToDatabaseRole(),
-		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
-		"snowflake_managed_account":                         resources.ManagedAccount(),
-		"snowflake_masking_policy":                          resources.MaskingPolicy(),
-		"snowflake_materialized_view":                       resources.MaterializedView(),
-		"snowflake_network_policy":                          resources.NetworkPolicy(),
-		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
-		"snowflake_notification_integration":                resources.NotificationIntegration(),
-		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
-		"snowflake_object_parameter":                        resources.ObjectParameter(),
-		"snowflake_password_policy":                         resources.PasswordPolicy(),
-		"snowflake_pipe":                                    resources.Pipe(),
-		"snowflake_procedure":                               resources.Procedure(),
-		"snowflake_resource_monitor":                        resources.ResourceMonitor(),
-		"snowflake_role":                                    resources.Role(),
-		"snowflake_role_grants":                             resources.RoleGrants(),
-		"snowflake_role_ownership_grant":                    resources.RoleOwnershipGrant(),
-		"snowflake_row_access_policy":                       resources.RowAccessPolicy(),
-		"snowflake_saml_integration":                        resources.SAMLIntegration(),
-		"snowflake_schema":                                  resources.Schema(),
-		"snowflake_scim_integration":                        resources.SCIMIntegration(),
-		"snowflake_sequence":                                resources.Sequence(),
-		"snowflake_session_parameter":                       resources.SessionParameter(),
-		"snowflake_share":                                   resources.Share(),
-		"snowflake_stage":                                   resources.Stage(),
-		"snowflake_storage_integration":                     resources.StorageIntegration(),
-		"snowflake_stream":                                  resources.Stream(),
-		"snowflake_table":                                   resources.Table(),
-		"snowflake_table_column_masking_policy_application": resources.TableColumnMaskingPolicyApplication(),
-		"snowflake_table_constraint":                        resources.TableConstraint(),
-		"snowflake_tag":                                     resources.Tag(),
-		"snowflake_tag_association":                         resources.TagAssociation(),
-		"snowflake_tag_masking_policy_association":          resources.TagMaskingPolicyAssociation(),
-		"snowflake_task":                                    resources.Task(),
-		"snowflake_user":                                    resources.User(),
-		"snowflake_user_ownership_grant":                    resources.UserOwnershipGrant(),
-		"snowflake_user_public_keys":                        resources.UserPublicKeys(),
-		"snowflake_view":                                    resources.View(),
-		"snowflake_warehouse":                               resources.Warehouse(),
+		"snowflake_account": resources.Account(),
+		"snowflake_account_authentication_policy_attachment":   resources.AccountAuthenticationPolicyAttachment(),
+		"snowflake_account_packages_policy_attachment":         resources.AccountPackagesPolicyAttachment(),
+		"snowflake_account_password_policy_attachment":         resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_parameter":                          resources.AccountParameter(),
+		"snowflake_account_role":                               resources.AccountRole(),
+		"snowflake_account_session_policy_attachment":          resources.AccountSessionPolicyAttachment(),
+		"snowflake_alert":                                      resources.Alert(),
+		"snowflake_api_authentication_integration":             resources.APIAuthenticationIntegration(),
+		"snowflake_api_integration":                            resources.APIIntegration(),
+		"snowflake_application":                                resources.Application(),
+		"snowflake_application_package":                        resources.ApplicationPackage(),
+		"snowflake_authentication_policy":                      resources.AuthenticationPolicy(),
+		"snowflake_budget":                                     resources.Budget(),
+		"snowflake_connection":                                 resources.Connection(),
+		"snowflake_database":                                   resources.Database(),
+		"snowflake_database_role":                              resources.DatabaseRole(),
+		"snowflake_database_role_grants":                       resources.DatabaseRoleGrants(),
+		"snowflake_dynamic_table":                              resources.DynamicTable(),
+		"snowflake_email_notification_integration":             resources.EmailNotificationIntegration(),
+		"snowflake_external_function":                          resources.ExternalFunction(),
+		"snowflake_external_oauth_integration":                 resources.ExternalOauthIntegration(),
+		"snowflake_external_table":                             resources.ExternalTable(),
+		"snowflake_failover_group":                             resources.FailoverGroup(),
+		"snowflake_file_format":                                resources.FileFormat(),
+		"snowflake_function":                                   resources.Function(),
+		"snowflake_grant_privileges_to_database_role":          resources.GrantPrivilegesToDatabaseRole(),
+		"snowflake_grant_privileges_to_role":                   resources.GrantPrivilegesToRole(),
+		"snowflake_iceberg_table":                              resources.IcebergTable(),
+		"snowflake_job_service":                                resources.JobService(),
+		"snowflake_listing":                                    resources.Listing(),
+		"snowflake_managed_account":                            resources.ManagedAccount(),
+		"snowflake_masking_policy":                             resources.MaskingPolicy(),
+		"snowflake_materialized_view":                          resources.MaterializedView(),
+		"snowflake_network_policy":                             resources.NetworkPolicy(),
+		"snowflake_network_policy_attachment":                  resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                               resources.NetworkRule(),
+		"snowflake_notification_integration":                   resources.NotificationIntegration(),
+		"snowflake_oauth_integration":                          resources.OAuthIntegration(),
+		"snowflake_object_parameter":                           resources.ObjectParameter(),
+		"snowflake_object_rename":                              resources.ObjectRename(),
+		"snowflake_packages_policy":                            resources.PackagesPolicy(),
+		"snowflake_password_policy":                            resources.PasswordPolicy(),
+		"snowflake_pipe":                                       resources.Pipe(),
+		"snowflake_procedure":                                  resources.Procedure(),
+		"snowflake_projection_policy":                          resources.ProjectionPolicy(),
+		"snowflake_query":                                      resources.Query(),
+		"snowflake_replication_group":                          resources.ReplicationGroup(),
+		"snowflake_resource_monitor":                           resources.ResourceMonitor(),
+		"snowflake_role":                                       resources.Role(),
+		"snowflake_role_grants":                                resources.RoleGrants(),
+		"snowflake_role_ownership_grant":                       resources.RoleOwnershipGrant(),
+		"snowflake_row_access_policy":                          resources.RowAccessPolicy(),
+		"snowflake_row_access_policy_attachment":               resources.RowAccessPolicyAttachment(),
+		"snowflake_saml_integration":                           resources.SAMLIntegration(),
+		"snowflake_schema":                                     resources.Schema(),
+		"snowflake_scim_integration":                           resources.SCIMIntegration(),
+		"snowflake_secondary_database":                         resources.SecondaryDatabase(),
+		"snowflake_secondary_replication_group":                resources.SecondaryReplicationGroup(),
+		"snowflake_sequence":                                   resources.Sequence(),
+		"snowflake_session_parameter":                          resources.SessionParameter(),
+		"snowflake_share":                                      resources.Share(),
+		"snowflake_shared_database":                            resources.SharedDatabase(),
+		"snowflake_stage":                                      resources.Stage(),
+		"snowflake_stage_file":                                 resources.StageFile(),
+		"snowflake_storage_integration":                        resources.StorageIntegration(),
+		"snowflake_stream":                                     resources.Stream(),
+		"snowflake_table":                                      resources.Table(),
+		"snowflake_table_column":                               resources.TableColumn(),
+		"snowflake_table_column_masking_policy_application":    resources.TableColumnMaskingPolicyApplication(),
+		"snowflake_table_column_projection_policy_application": resources.TableColumnProjectionPolicyApplication(),
+		"snowflake_table_constraint":                           resources.TableConstraint(),
+		"snowflake_tag":                                        resources.Tag(),
+		"snowflake_tag_association":                            resources.TagAssociation(),
+		"snowflake_tag_column_association":                     resources.TagColumnAssociation(),
+		"snowflake_tag_masking_policy_association":             resources.TagMaskingPolicyAssociation(),
+		"snowflake_task":                                       resources.Task(),
+		"snowflake_task_dependency":                            resources.TaskDependency(),
+		"snowflake_user":                                       resources.User(),
+		"snowflake_user_authentication_policy_attachment":      resources.UserAuthenticationPolicyAttachment(),
+		"snowflake_user_ownership_grant":                       resources.UserOwnershipGrant(),
+		"snowflake_user_password_policy_attachment":            resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_public_keys":                           resources.UserPublicKeys(),
+		"snowflake_user_session_policy_attachment":             resources.UserSessionPolicyAttachment(),
+		"snowflake_view":                                       resources.View(),
+		"snowflake_warehouse":                                  resources.Warehouse(),
 	}
 
 	return mergeSchemas(
@@ -496,29 +565,46 @@ func getDataSources() map[string]*schema.Resource {
 	dataSources := map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_alerts":                             datasources.Alerts(),
+		"snowflake_application_packages":               datasources.ApplicationPackages(),
+		"snowflake_applications":                       datasources.Applications(),
+		"snowflake_compute_pools":                      datasources.ComputePools(),
+		"snowflake_connections":                        datasources.Connections(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
+		"snowflake_current_session":                    datasources.CurrentSession(),
 		"snowflake_database":                           datasources.Database(),
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_event_tables":                       datasources.EventTables(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
 		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_grants_of_role":                     datasources.GrantsOfRole(),
+		"snowflake_grants_to_user":                     datasources.GrantsToUser(),
+		"snowflake_iceberg_tables":                     datasources.IcebergTables(),
+		"snowflake_login_history":                      datasources.LoginHistory(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
+		"snowflake_network_policies":                   datasources.NetworkPolicies(),
 		"snowflake_parameters":                         datasources.Parameters(),
+		"snowflake_password_policies":                  datasources.PasswordPolicies(),
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
+		"snowflake_regions":                            datasources.Regions(),
+		"snowflake_replication_accounts":               datasources.ReplicationAccounts(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
 		"snowflake_role":                               datasources.Role(),
 		"snowflake_roles":                              datasources.Roles(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
+		"snowflake_secrets":                            datasources.Secrets(),
+		"snowflake_security_integrations":              datasources.SecurityIntegrations(),
 		"snowflake_sequences":                          datasources.Sequences(),
+		"snowflake_services":                           datasources.Services(),
 		"snowflake_shares":                             datasources.Shares(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
@@ -527,10 +613,13 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_system_get_aws_sns_iam_policy":      datasources.SystemGetAWSSNSIAMPolicy(),
 		"snowflake_system_get_privatelink_config":      datasources.SystemGetPrivateLinkConfig(),
 		"snowflake_system_get_snowflake_platform_info": datasources.SystemGetSnowflakePlatformInfo(),
+		"snowflake_system_get_tag":                     datasources.SystemGetTag(),
 		"snowflake_tables":                             datasources.Tables(),
+		"snowflake_tags":                               datasources.Tags(),
 		"snowflake_tasks":                              datasources.Tasks(),
 		"snowflake_users":                              datasources.Users(),
 		"snowflake_views":                              datasources.Views(),
+		"snowflake_warehouse_metering_history":         datasources.WarehouseMeteringHistory(),
 		"snowflake_warehouses":                         datasources.Warehouses(),
 	}
 
@@ -685,6 +774,7 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		config.Authenticator = gosnowflake.AuthTypeOAuth
 	}
 
+	var tokens *oauthTokenSource
 	if v, ok := s.GetOk("token_accessor"); ok {
 		if len(v.([]interface{})) > 0 {
 			tokenAccessor := v.([]interface{})[0].(map[string]interface{})
@@ -693,11 +783,32 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 			clientID := tokenAccessor["client_id"].(string)
 			clientSecret := tokenAccessor["client_secret"].(string)
 			redirectURI := tokenAccessor["redirect_uri"].(string)
-			accessToken, err := GetAccessTokenWithRefreshToken(tokenEndpoint, clientID, clientSecret, refreshToken, redirectURI)
-			if err != nil {
-				return nil, fmt.Errorf("could not retrieve access token from refresh token")
+			tokens = newRefreshTokenSource(tokenEndpoint, clientID, clientSecret, refreshToken, redirectURI)
+			config.Authenticator = gosnowflake.AuthTypeOAuth
+		}
+	}
+
+	// backwards compatibility until we can remove this
+	if v, ok := s.GetOk("oauth_refresh_token"); ok && v.(string) != "" {
+		tokenEndpoint := s.Get("oauth_endpoint").(string)
+		clientID := s.Get("oauth_client_id").(string)
+		clientSecret := s.Get("oauth_client_secret").(string)
+		redirectURI := s.Get("oauth_redirect_url").(string)
+		tokens = newRefreshTokenSource(tokenEndpoint, clientID, clientSecret, v.(string), redirectURI)
+		config.Authenticator = gosnowflake.AuthTypeOAuth
+	}
+
+	if v, ok := s.GetOk("oauth_client_credentials"); ok {
+		if len(v.([]interface{})) > 0 {
+			clientCredentials := v.([]interface{})[0].(map[string]interface{})
+			tokenEndpoint := clientCredentials["token_endpoint"].(string)
+			clientID := clientCredentials["client_id"].(string)
+			clientSecret := clientCredentials["client_secret"].(string)
+			var scopes []string
+			for _, scope := range clientCredentials["scopes"].(*schema.Set).List() {
+				scopes = append(scopes, scope.(string))
 			}
-			config.Token = accessToken
+			tokens = newClientCredentialsTokenSource(tokenEndpoint, clientID, clientSecret, scopes)
 			config.Authenticator = gosnowflake.AuthTypeOAuth
 		}
 	}
@@ -709,8 +820,16 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	privateKeyPath := s.Get("private_key_path").(string)
 	privateKey := s.Get("private_key").(string)
 	privateKeyPassphrase := s.Get("private_key_passphrase").(string)
-	if v, err := getPrivateKey(privateKeyPath, privateKey, privateKeyPassphrase); err != nil && v != nil {
+	if privateKeyPath != "" || privateKey != "" {
+		v, err := getPrivateKey(privateKeyPath, privateKey, privateKeyPassphrase)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve private key err = %w", err)
+		}
 		config.PrivateKey = v
+		// the key pair authentication requires the JWT authenticator, so it is used unless another one was chosen
+		if config.Authenticator == gosnowflake.AuthTypeSnowflake {
+			config.Authenticator = gosnowflake.AuthTypeJwt
+		}
 	}
 
 	if v, ok := s.GetOk("disable_telemetry"); ok && v.(bool) {
@@ -753,7 +872,13 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 			config = sdk.MergeConfig(config, profileConfig)
 		}
 	}
-	client, err := sdk.NewClient(config)
+	var client *sdk.Client
+	var err error
+	if tokens != nil {
+		client, err = sdk.NewClientFromConnector(config, newOAuthConnector(config, tokens))
+	} else {
+		client, err = sdk.NewClient(config)
+	}
 	if err != nil {
 		return nil, err
 	}
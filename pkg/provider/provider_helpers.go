@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/rsa"
 	"encoding/json"
 	"encoding/pem"
@@ -92,46 +93,42 @@ type GetRefreshTokenResponseBody struct {
 	AccessToken string `json:"access_token"`
 	TokenType   string `json:"token_type"`
 	ExpiresIn   int    `json:"expires_in"`
+	// RefreshToken is set when the OAuth provider rotates the refresh tokens, i.e. the used one is no longer valid.
+	RefreshToken string `json:"refresh_token"`
 }
 
-func GetAccessTokenWithRefreshToken(
-	tokenEndPoint string,
-	clientID string,
-	clientSecret string,
-	refreshToken string,
-	redirectURI string,
-) (string, error) {
+// requestAccessToken requests an access token from the token endpoint of the OAuth provider with the grant in data,
+// authenticating with the client ID and secret.
+func requestAccessToken(ctx context.Context, tokenEndPoint string, clientID string, clientSecret string, data url.Values) (*GetRefreshTokenResponseBody, error) {
 	client := &http.Client{}
-
-	data := url.Values{}
-	data.Set("grant_type", "refresh_token")
-	data.Set("refresh_token", refreshToken)
-	data.Set("redirect_uri", redirectURI)
 	body := strings.NewReader(data.Encode())
 
-	request, err := http.NewRequest("POST", tokenEndPoint, body)
+	request, err := http.NewRequestWithContext(ctx, "POST", tokenEndPoint, body)
 	if err != nil {
-		return "", fmt.Errorf("request to the endpoint could not be completed %w", err)
+		return nil, fmt.Errorf("request to the endpoint could not be completed %w", err)
 	}
 	request.SetBasicAuth(clientID, clientSecret)
 	request.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=UTF-8")
 
 	response, err := client.Do(request)
 	if err != nil {
-		return "", fmt.Errorf("response status returned an err = %w", err)
+		return nil, fmt.Errorf("response status returned an err = %w", err)
 	}
+	defer response.Body.Close()
 	if response.StatusCode != 200 {
-		return "", fmt.Errorf("response status code: %s: %s err = %w", strconv.Itoa(response.StatusCode), http.StatusText(response.StatusCode), err)
+		return nil, fmt.Errorf("response status code: %s: %s", strconv.Itoa(response.StatusCode), http.StatusText(response.StatusCode))
 	}
-	defer response.Body.Close()
 	dat, err := io.ReadAll(response.Body)
 	if err != nil {
-		return "", fmt.Errorf("response body was not able to be parsed err = %w", err)
+		return nil, fmt.Errorf("response body was not able to be parsed err = %w", err)
 	}
 	var result GetRefreshTokenResponseBody
 	err = json.Unmarshal(dat, &result)
 	if err != nil {
-		return "", fmt.Errorf("error parsing JSON from Snowflake err = %w", err)
+		return nil, fmt.Errorf("error parsing JSON from Snowflake err = %w", err)
+	}
+	if result.AccessToken == "" {
+		return nil, errors.New("response does not contain an access token")
 	}
-	return result.AccessToken, nil
+	return &result, nil
 }
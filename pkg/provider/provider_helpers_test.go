@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
+)
+
+func encodePrivateKey(t *testing.T, key *rsa.PrivateKey, passphrase string) string {
+	t.Helper()
+	der, err := pkcs8.MarshalPrivateKey(key, []byte(passphrase), nil)
+	require.NoError(t, err)
+	blockType := "PRIVATE KEY"
+	if passphrase != "" {
+		blockType = "ENCRYPTED PRIVATE KEY"
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+}
+
+func TestGetPrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("unencrypted key", func(t *testing.T) {
+		privateKey, err := getPrivateKey("", encodePrivateKey(t, key, ""), "")
+		require.NoError(t, err)
+		require.True(t, key.Equal(privateKey))
+	})
+
+	t.Run("encrypted key", func(t *testing.T) {
+		privateKey, err := getPrivateKey("", encodePrivateKey(t, key, "secret"), "secret")
+		require.NoError(t, err)
+		require.True(t, key.Equal(privateKey))
+	})
+
+	t.Run("encrypted key from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "snowflake_key.p8")
+		require.NoError(t, os.WriteFile(path, []byte(encodePrivateKey(t, key, "secret")), 0o600))
+		privateKey, err := getPrivateKey(path, "", "secret")
+		require.NoError(t, err)
+		require.True(t, key.Equal(privateKey))
+	})
+
+	t.Run("encrypted key without passphrase", func(t *testing.T) {
+		_, err := getPrivateKey("", encodePrivateKey(t, key, "secret"), "")
+		require.ErrorContains(t, err, "private_key_passphrase was not supplied")
+	})
+
+	t.Run("encrypted key with wrong passphrase", func(t *testing.T) {
+		_, err := getPrivateKey("", encodePrivateKey(t, key, "secret"), "wrong")
+		require.ErrorContains(t, err, "could not parse encrypted private key")
+	})
+
+	t.Run("not a PEM key", func(t *testing.T) {
+		_, err := getPrivateKey("", "not a key", "")
+		require.ErrorContains(t, err, "not in PEM format")
+	})
+}
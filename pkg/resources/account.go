@@ -3,7 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -14,7 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// Note: no test case was created for account since we cannot actually delete them after creation, which is a critical part of the test suite. Instead, this resource
+// Note: no acceptance test case was created for account since we cannot actually delete them after creation, which is a critical part of the test suite. Instead, this resource
 // was manually tested
 
 var accountSchema = map[string]*schema.Schema{
@@ -204,6 +206,37 @@ var accountSchema = map[string]*schema.Schema{
 		Default:     3,
 		Description: "Specifies the number of days to wait before dropping the account. The default is 3 days.",
 	},
+	"save_old_url": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the original account URL keeps working when the account is renamed. The old URL can be dropped later with ALTER ACCOUNT ... DROP OLD URL.",
+	},
+	"organization_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the organization the account belongs to.",
+	},
+	"account_locator": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The account locator, i.e. the system-assigned identifier of the account.",
+	},
+	"account_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the account in the `<orgname>-<account_name>.snowflakecomputing.com` format.",
+	},
+	"account_locator_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the account based on its account locator.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the account was created.",
+	},
 }
 
 func Account() *schema.Resource {
@@ -304,7 +337,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(helpers.EncodeSnowflakeID(account.AccountLocator))
-	return nil
+	return ReadAccount(d, meta)
 }
 
 // ReadAccount implements schema.ReadFunc.
@@ -316,14 +349,19 @@ func ReadAccount(d *schema.ResourceData, meta interface{}) error {
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
 
 	var acc *sdk.Account
-	var err error
-	err = helpers.Retry(5, 3*time.Second, func() (error, bool) {
-		acc, err = client.Accounts.ShowByID(ctx, id)
-		if err != nil {
+	var showErr error
+	err := helpers.Retry(5, 3*time.Second, func() (error, bool) {
+		acc, showErr = client.Accounts.ShowByID(ctx, id)
+		if showErr != nil {
 			return nil, false
 		}
 		return nil, true
 	})
+	if errors.Is(showErr, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] account (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -352,11 +390,50 @@ func ReadAccount(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting is_org_admin: %w", err)
 	}
 
+	if err = d.Set("organization_name", acc.OrganizationName); err != nil {
+		return fmt.Errorf("error setting organization_name: %w", err)
+	}
+
+	if err = d.Set("account_locator", acc.AccountLocator); err != nil {
+		return fmt.Errorf("error setting account_locator: %w", err)
+	}
+
+	if err = d.Set("account_url", acc.AccountURL); err != nil {
+		return fmt.Errorf("error setting account_url: %w", err)
+	}
+
+	if err = d.Set("account_locator_url", acc.AccountLocatorURL); err != nil {
+		return fmt.Errorf("error setting account_locator_url: %w", err)
+	}
+
+	if err = d.Set("created_on", acc.CreatedOn.String()); err != nil {
+		return fmt.Errorf("error setting created_on: %w", err)
+	}
+
 	return nil
 }
 
 // UpdateAccount implements schema.UpdateFunc.
 func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	// the id is the account locator, which does not change when the account is renamed
+	if d.HasChange("name") {
+		o, n := d.GetChange("name")
+		err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Rename: &sdk.AccountRename{
+				Name:       sdk.NewAccountObjectIdentifier(o.(string)),
+				NewName:    sdk.NewAccountObjectIdentifier(n.(string)),
+				SaveOldURL: sdk.Bool(d.Get("save_old_url").(bool)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error renaming account %v to %v err = %w", o, n, err)
+		}
+	}
+
 	/*
 		todo: comments may eventually work again for accounts, so this can be uncommented when that happens
 		db := meta.(*sql.DB)
@@ -378,7 +455,7 @@ func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	*/
-	return nil
+	return ReadAccount(d, meta)
 }
 
 // DeleteAccount implements schema.DeleteFunc.
@@ -387,7 +464,8 @@ func DeleteAccount(d *schema.ResourceData, meta interface{}) error {
 	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 	gracePeriodInDays := d.Get("grace_period_in_days").(int)
-	err := client.Accounts.Drop(ctx, helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier), gracePeriodInDays, &sdk.DropAccountOptions{
+	// DROP ACCOUNT expects the account name, the id is the account locator
+	err := client.Accounts.Drop(ctx, sdk.NewAccountObjectIdentifier(d.Get("name").(string)), gracePeriodInDays, &sdk.DropAccountOptions{
 		IfExists: sdk.Bool(true),
 	})
 	return err
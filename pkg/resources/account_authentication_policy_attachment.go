@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountAuthenticationPolicyAttachmentSchema = map[string]*schema.Schema{
+	"authentication_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the authentication policy to apply to the current account.",
+	},
+}
+
+// AccountAuthenticationPolicyAttachment returns a pointer to the resource representing an account authentication policy attachment.
+func AccountAuthenticationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the authentication policy to use for the current account. To set the authentication policy of a different account, use a provider alias.",
+
+		Create: CreateAccountAuthenticationPolicyAttachment,
+		Read:   ReadAccountAuthenticationPolicyAttachment,
+		Delete: DeleteAccountAuthenticationPolicyAttachment,
+
+		Schema: accountAuthenticationPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAccountAuthenticationPolicyAttachment implements schema.CreateFunc.
+func CreateAccountAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	authenticationPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("authentication_policy").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("authentication_policy %s is not a valid authentication policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("authentication_policy"))
+	}
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Set: &sdk.AccountSet{
+			AuthenticationPolicy: authenticationPolicy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(authenticationPolicy))
+
+	return ReadAccountAuthenticationPolicyAttachment(d, meta)
+}
+
+// ReadAccountAuthenticationPolicyAttachment implements schema.ReadFunc.
+func ReadAccountAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	account, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return err
+	}
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, account, sdk.PolicyEntityDomainAccount)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindAuthenticationPolicy {
+			if err := d.Set("authentication_policy", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] authentication policy is not attached to the current account (%s)", account)
+	d.SetId("")
+	return nil
+}
+
+// DeleteAccountAuthenticationPolicyAttachment implements schema.DeleteFunc.
+func DeleteAccountAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			AuthenticationPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AccountAuthenticationPolicyAttachment(t *testing.T) {
+	policyName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountAuthenticationPolicyAttachmentConfig(acc.TestDatabaseName, acc.TestSchemaName, policyName, "snowflake_authentication_policy.pa.qualified_name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snowflake_account_authentication_policy_attachment.att", "id"),
+					resource.TestCheckResourceAttrPair("snowflake_account_authentication_policy_attachment.att", "authentication_policy", "snowflake_authentication_policy.pa", "qualified_name"),
+				),
+			},
+			// unquoted policy name does not recreate the attachment
+			{
+				Config:   accountAuthenticationPolicyAttachmentConfig(acc.TestDatabaseName, acc.TestSchemaName, policyName, `"${snowflake_authentication_policy.pa.database}.${snowflake_authentication_policy.pa.schema}.${snowflake_authentication_policy.pa.name}"`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "snowflake_account_authentication_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountAuthenticationPolicyAttachmentConfig(databaseName, schemaName, policyName, policyReference string) string {
+	return fmt.Sprintf(`
+resource "snowflake_authentication_policy" "pa" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%v"
+}
+
+resource "snowflake_account_authentication_policy_attachment" "att" {
+	authentication_policy = %s
+}
+`, databaseName, schemaName, policyName, policyReference)
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountPackagesPolicyAttachmentSchema = map[string]*schema.Schema{
+	"packages_policy": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Qualified name (`\"db\".\"schema\".\"policy_name\"`) of the packages policy to apply to the current account.",
+	},
+}
+
+// AccountPackagesPolicyAttachment returns a pointer to the resource representing an account packages policy attachment.
+func AccountPackagesPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the packages policy to use for the current account. To set the packages policy of a different account, use a provider alias.",
+
+		Create: CreateAccountPackagesPolicyAttachment,
+		Read:   ReadAccountPackagesPolicyAttachment,
+		Delete: DeleteAccountPackagesPolicyAttachment,
+
+		Schema: accountPackagesPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateAccountPackagesPolicyAttachment implements schema.CreateFunc.
+func CreateAccountPackagesPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	packagesPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("packages_policy").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("packages_policy %s is not a valid packages policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("packages_policy"))
+	}
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Set: &sdk.AccountSet{
+			PackagesPolicy: packagesPolicy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(packagesPolicy))
+
+	return ReadAccountPackagesPolicyAttachment(d, meta)
+}
+
+// ReadAccountPackagesPolicyAttachment implements schema.ReadFunc.
+func ReadAccountPackagesPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	account, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return err
+	}
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, account, sdk.PolicyEntityDomainAccount)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindPackagesPolicy {
+			if err := d.Set("packages_policy", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] packages policy is not attached to the current account (%s)", account)
+	d.SetId("")
+	return nil
+}
+
+// DeleteAccountPackagesPolicyAttachment implements schema.DeleteFunc.
+func DeleteAccountPackagesPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+		Unset: &sdk.AccountUnset{
+			PackagesPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AccountPackagesPolicyAttachment(t *testing.T) {
+	policyName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountPackagesPolicyAttachmentConfig(acc.TestDatabaseName, acc.TestSchemaName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snowflake_account_packages_policy_attachment.att", "id"),
+					resource.TestCheckResourceAttrPair("snowflake_account_packages_policy_attachment.att", "packages_policy", "snowflake_packages_policy.pa", "qualified_name"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_account_packages_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountPackagesPolicyAttachmentConfig(databaseName, schemaName, policyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_packages_policy" "pa" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%v"
+}
+
+resource "snowflake_account_packages_policy_attachment" "att" {
+	packages_policy = snowflake_packages_policy.pa.qualified_name
+}
+`, databaseName, schemaName, policyName)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,10 @@ var accountParameterSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 		Description: "Name of account parameter. Valid values are those in [account parameters](https://docs.snowflake.com/en/sql-reference/parameters.html#account-parameters).",
+		// parameter names are case-insensitive
+		StateFunc: func(val interface{}) string {
+			return strings.ToUpper(val.(string))
+		},
 	},
 	"value": {
 		Type:        schema.TypeString,
@@ -25,6 +30,8 @@ var accountParameterSchema = map[string]*schema.Schema{
 
 func AccountParameter() *schema.Resource {
 	return &schema.Resource{
+		Description: "Sets a parameter on the account level with ALTER ACCOUNT SET. The parameter is unset, i.e. reset to its default value, when the resource is destroyed.",
+
 		Create: CreateAccountParameter,
 		Read:   ReadAccountParameter,
 		Update: UpdateAccountParameter,
@@ -40,7 +47,7 @@ func AccountParameter() *schema.Resource {
 // CreateAccountParameter implements schema.CreateFunc.
 func CreateAccountParameter(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	key := d.Get("key").(string)
+	key := strings.ToUpper(d.Get("key").(string))
 	value := d.Get("value").(string)
 	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
@@ -63,6 +70,9 @@ func ReadAccountParameter(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error reading account parameter err = %w", err)
 	}
+	if err := d.Set("key", parameter.Key); err != nil {
+		return fmt.Errorf("error setting account parameter err = %w", err)
+	}
 	err = d.Set("value", parameter.Value)
 	if err != nil {
 		return fmt.Errorf("error setting account parameter err = %w", err)
@@ -78,18 +88,12 @@ func UpdateAccountParameter(d *schema.ResourceData, meta interface{}) error {
 // DeleteAccountParameter implements schema.DeleteFunc.
 func DeleteAccountParameter(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	key := d.Get("key").(string)
+	key := d.Id()
 	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
-	parameter := sdk.AccountParameter(key)
-	defaultParameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameter(key))
-	if err != nil {
-		return err
-	}
-	defaultValue := defaultParameter.Default
-	err = client.Parameters.SetAccountParameter(ctx, parameter, defaultValue)
+	err := client.Parameters.UnsetAccountParameter(ctx, sdk.AccountParameter(key))
 	if err != nil {
-		return fmt.Errorf("error resetting account parameter err = %w", err)
+		return fmt.Errorf("error unsetting account parameter err = %w", err)
 	}
 
 	d.SetId("")
@@ -68,3 +68,26 @@ func TestAcc_AccountParameter_REQUIRE_STORAGE_INTEGRATION_FOR_STAGE_CREATION(t *
 		},
 	})
 }
+
+func TestAcc_AccountParameter_PREVENT_UNLOAD_TO_INLINE_URL(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountParameterBasic("prevent_unload_to_inline_url", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_parameter.p", "key", "PREVENT_UNLOAD_TO_INLINE_URL"),
+					resource.TestCheckResourceAttr("snowflake_account_parameter.p", "value", "true"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_account_parameter.p",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
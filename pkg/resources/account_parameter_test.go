@@ -0,0 +1,58 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountParameter(t *testing.T) {
+	r := require.New(t)
+	err := resources.AccountParameter().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAccountParameterCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":   "prevent_unload_to_inline_url",
+		"value": "true",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET PREVENT_UNLOAD_TO_INLINE_URL = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+			AddRow("PREVENT_UNLOAD_TO_INLINE_URL", "true", "false", "ACCOUNT", "")
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'PREVENT_UNLOAD_TO_INLINE_URL' IN ACCOUNT$`).WillReturnRows(rows)
+		err := resources.CreateAccountParameter(d, db)
+		r.NoError(err)
+		r.Equal("PREVENT_UNLOAD_TO_INLINE_URL", d.Id())
+		r.Equal("true", d.Get("value").(string))
+	})
+}
+
+func TestAccountParameterDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":   "REQUIRE_STORAGE_INTEGRATION_FOR_STAGE_CREATION",
+		"value": "true",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, in)
+	d.SetId("REQUIRE_STORAGE_INTEGRATION_FOR_STAGE_CREATION")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT UNSET REQUIRE_STORAGE_INTEGRATION_FOR_STAGE_CREATION$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteAccountParameter(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -45,7 +46,6 @@ func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 	if !ok {
 		return fmt.Errorf("password_policy %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("password_policy"))
 	}
-	// passwordPolicy := sdk.NewAccountObjectIdentifier(d.Get("password_policy").(string))
 
 	err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
 		Set: &sdk.AccountSet{
@@ -58,15 +58,35 @@ func CreateAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interfac
 
 	d.SetId(helpers.EncodeSnowflakeID(passwordPolicy))
 
-	return nil
+	return ReadAccountPasswordPolicyAttachment(d, meta)
 }
 
+// ReadAccountPasswordPolicyAttachment implements schema.ReadFunc.
 func ReadAccountPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
-	passwordPolicy := helpers.DecodeSnowflakeID(d.Id())
-	if err := d.Set("password_policy", passwordPolicy.FullyQualifiedName()); err != nil {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	account, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
 		return err
 	}
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, account, sdk.PolicyEntityDomainAccount)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindPasswordPolicy {
+			if err := d.Set("password_policy", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
 
+	log.Printf("[DEBUG] password policy is not attached to the current account (%s)", account)
+	d.SetId("")
 	return nil
 }
 
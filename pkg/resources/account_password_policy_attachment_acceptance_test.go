@@ -22,6 +22,7 @@ func TestAcc_AccountPasswordPolicyAttachment(t *testing.T) {
 				Config: accountPasswordPolicyAttachmentConfig(acc.TestDatabaseName, acc.TestSchemaName, prefix),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("snowflake_account_password_policy_attachment.att", "id"),
+					resource.TestCheckResourceAttrPair("snowflake_account_password_policy_attachment.att", "password_policy", "snowflake_password_policy.pa", "qualified_name"),
 				),
 			},
 			{
@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountRoleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the role; must be unique for the account. Changing the name renames the role in place.",
+		ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+			additionalCharsToIgnoreValidation := []string{".", " ", ":", "(", ")"}
+			return sdk.ValidateIdentifier(val, additionalCharsToIgnoreValidation)
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the role.",
+	},
+	"tag": tagReferenceSchema,
+	"show_output": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of SHOW ROLES for the given role.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_default": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"is_current": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"is_inherited": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"assigned_to_users": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"granted_to_roles": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"granted_roles": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// AccountRole returns a pointer to the resource representing an account role.
+func AccountRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "An account role groups privileges that can be granted to users and other roles.",
+
+		Create: CreateAccountRole,
+		Read:   ReadAccountRole,
+		Update: UpdateAccountRole,
+		Delete: DeleteAccountRole,
+
+		Schema: accountRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// Role returns the account role resource under its former snowflake_role name.
+func Role() *schema.Resource {
+	role := AccountRole()
+	role.DeprecationMessage = "This resource is deprecated and will be removed in a future major version release. Please use snowflake_account_role instead."
+	return role
+}
+
+// CreateAccountRole implements schema.CreateFunc.
+func CreateAccountRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	req := sdk.NewCreateRoleRequest(sdk.NewAccountObjectIdentifier(name))
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(v.(string))
+	}
+	if tags := getPropertyTags(d, "tag"); len(tags) > 0 {
+		req.WithTag(tags)
+	}
+	if err := client.Roles.Create(ctx, req); err != nil {
+		return fmt.Errorf("error creating account role %v err = %w", name, err)
+	}
+	d.SetId(name)
+
+	return ReadAccountRole(d, meta)
+}
+
+// ReadAccountRole implements schema.ReadFunc.
+func ReadAccountRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	role, err := client.Roles.ShowByID(ctx, sdk.NewShowByIdRoleRequest(sdk.NewAccountObjectIdentifier(d.Id())))
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] account role (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading account role %v err = %w", d.Id(), err)
+	}
+
+	if err := d.Set("name", role.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", role.Comment); err != nil {
+		return err
+	}
+	return d.Set("show_output", []interface{}{
+		map[string]interface{}{
+			"created_on":        role.CreatedOn.String(),
+			"name":              role.Name,
+			"is_default":        role.IsDefault,
+			"is_current":        role.IsCurrent,
+			"is_inherited":      role.IsInherited,
+			"assigned_to_users": role.AssignedToUsers,
+			"granted_to_roles":  role.GrantedToRoles,
+			"granted_roles":     role.GrantedRoles,
+			"owner":             role.Owner,
+			"comment":           role.Comment,
+		},
+	})
+}
+
+// UpdateAccountRole implements schema.UpdateFunc.
+func UpdateAccountRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+
+	if d.HasChange("name") {
+		newID := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+		if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithRenameTo(newID)); err != nil {
+			return fmt.Errorf("error renaming account role %v err = %w", d.Id(), err)
+		}
+		d.SetId(newID.Name())
+		id = newID
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithSetComment(comment)); err != nil {
+				return fmt.Errorf("error setting comment on account role %v err = %w", d.Id(), err)
+			}
+		} else {
+			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithUnsetComment(true)); err != nil {
+				return fmt.Errorf("error unsetting comment on account role %v err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tag") {
+		o, n := d.GetChange("tag")
+		removed, added, changed := getTags(o).diffs(getTags(n))
+		if len(removed) > 0 {
+			unsetTags := make([]sdk.ObjectIdentifier, len(removed))
+			for i, t := range removed {
+				unsetTags[i] = sdk.NewSchemaObjectIdentifier(t.database, t.schema, t.name)
+			}
+			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return fmt.Errorf("error unsetting tags on account role %v err = %w", d.Id(), err)
+			}
+		}
+		if len(added)+len(changed) > 0 {
+			setTags := make([]sdk.TagAssociation, 0, len(added)+len(changed))
+			for _, t := range append(added, changed...) {
+				setTags = append(setTags, sdk.TagAssociation{
+					Name:  sdk.NewSchemaObjectIdentifier(t.database, t.schema, t.name),
+					Value: t.value,
+				})
+			}
+			if err := client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(id).WithSetTags(setTags)); err != nil {
+				return fmt.Errorf("error setting tags on account role %v err = %w", d.Id(), err)
+			}
+		}
+	}
+
+	return ReadAccountRole(d, meta)
+}
+
+// DeleteAccountRole implements schema.DeleteFunc.
+func DeleteAccountRole(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	if err := client.Roles.Drop(ctx, sdk.NewDropRoleRequest(sdk.NewAccountObjectIdentifier(d.Id()))); err != nil {
+		return fmt.Errorf("error dropping account role %v err = %w", d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AccountRole(t *testing.T) {
+	name := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	name2 := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: accountRoleConfig(name, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "name", name),
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "comment", "test comment"),
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "show_output.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "show_output.0.name", name),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_account_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// RENAME IN PLACE
+			{
+				Config: accountRoleConfig(name2, "test comment 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "id", name2),
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "name", name2),
+					resource.TestCheckResourceAttr("snowflake_account_role.role", "comment", "test comment 2"),
+				),
+			},
+		},
+	})
+}
+
+func accountRoleConfig(name, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_account_role" "role" {
+	name    = "%s"
+	comment = "%s"
+}
+`, name, comment)
+}
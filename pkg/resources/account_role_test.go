@@ -0,0 +1,120 @@
+package resources_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRole(t *testing.T) {
+	r := require.New(t)
+	err := resources.AccountRole().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAccountRoleCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":    "role1",
+		"comment": "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AccountRole().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE ROLE "role1" COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccountRole(mock, "role1", "great comment")
+		err := resources.CreateAccountRole(d, db)
+		r.NoError(err)
+		r.Equal("role1", d.Id())
+		r.Equal("great comment", d.Get("show_output.0.comment").(string))
+		r.Equal(2, d.Get("show_output.0.assigned_to_users").(int))
+		r.Equal("SECURITYADMIN", d.Get("show_output.0.owner").(string))
+	})
+}
+
+func TestAccountRoleUpdate(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "role1",
+		Attributes: map[string]string{
+			"name":    "role1",
+			"comment": "great comment",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"name":    {Old: "role1", New: "role2"},
+			"comment": {Old: "great comment", New: ""},
+		},
+	}
+	d, err := schema.InternalMap(resources.AccountRole().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ROLE "role1" RENAME TO "role2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER ROLE "role2" UNSET COMMENT$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccountRole(mock, "role2", "")
+		err := resources.UpdateAccountRole(d, db)
+		r.NoError(err)
+		r.Equal("role2", d.Id())
+		r.Equal("role2", d.Get("name").(string))
+	})
+}
+
+func TestAccountRoleDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.AccountRole().Schema, map[string]interface{}{"name": "role1"})
+	d.SetId("role1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP ROLE "role1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteAccountRole(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestAccountRoleRead(t *testing.T) {
+	t.Run("removes the missing role from the state", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resources.AccountRole().Schema, map[string]interface{}{"name": "role1"})
+		d.SetId("role1")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles", "granted_roles", "owner", "comment"})
+			mock.ExpectQuery(`^SHOW ROLES LIKE 'role1'$`).WillReturnRows(rows)
+			err := resources.ReadAccountRole(d, db)
+			require.NoError(t, err)
+			require.Empty(t, d.Id())
+		})
+	})
+
+	t.Run("returns the other errors", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resources.AccountRole().Schema, map[string]interface{}{"name": "role1"})
+		d.SetId("role1")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^SHOW ROLES LIKE 'role1'$`).WillReturnError(errors.New("connection lost"))
+			err := resources.ReadAccountRole(d, db)
+			require.ErrorContains(t, err, "connection lost")
+			require.Equal(t, "role1", d.Id())
+		})
+	})
+}
+
+func expectReadAccountRole(mock sqlmock.Sqlmock, name string, comment string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles", "granted_roles", "owner", "comment"}).
+		AddRow(time.Now(), name, "N", "N", "N", 2, 1, 0, "SECURITYADMIN", comment)
+	mock.ExpectQuery(`^SHOW ROLES LIKE '` + name + `'$`).WillReturnRows(rows)
+}
@@ -0,0 +1,71 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+// sessionPolicyForAttachment returns the identifier of a session policy and a function creating it directly
+// through the SDK (to be used as PreConfig), as there is no resource managing session policies.
+func sessionPolicyForAttachment(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+	id := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, "tst-terraform"+strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)))
+	return id, func() {
+		client, err := sdk.NewDefaultClient()
+		require.NoError(t, err)
+		ctx := context.Background()
+
+		err = client.SessionPolicies.Create(ctx, sdk.NewCreateSessionPolicyRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.SessionPolicies.Drop(ctx, sdk.NewDropSessionPolicyRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAcc_AccountSessionPolicyAttachment(t *testing.T) {
+	sessionPolicy, createSessionPolicy := sessionPolicyForAttachment(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: createSessionPolicy,
+				Config:    accountSessionPolicyAttachmentConfig(sessionPolicy.FullyQualifiedName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snowflake_account_session_policy_attachment.att", "id"),
+					resource.TestCheckResourceAttr("snowflake_account_session_policy_attachment.att", "session_policy", sessionPolicy.FullyQualifiedName()),
+				),
+			},
+			// unquoted policy name does not recreate the attachment
+			{
+				Config:   accountSessionPolicyAttachmentConfig(fmt.Sprintf("%s.%s.%s", sessionPolicy.DatabaseName(), sessionPolicy.SchemaName(), sessionPolicy.Name())),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "snowflake_account_session_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func accountSessionPolicyAttachmentConfig(sessionPolicyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_account_session_policy_attachment" "att" {
+	session_policy = %q
+}
+`, sessionPolicyName)
+}
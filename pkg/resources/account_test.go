@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount(t *testing.T) {
+	r := require.New(t)
+	err := resources.Account().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+// accountResourceData returns the data of an existing account with the given changes applied to it.
+func accountResourceData(t *testing.T, name string, changes map[string]*terraform.ResourceAttrDiff) *schema.ResourceData {
+	t.Helper()
+	state := &terraform.InstanceState{
+		ID: "ABC12345",
+		Attributes: map[string]string{
+			"name":                 name,
+			"admin_name":           "admin",
+			"email":                "admin@example.com",
+			"edition":              "STANDARD",
+			"grace_period_in_days": "3",
+			"save_old_url":         "true",
+		},
+	}
+	d, err := schema.InternalMap(resources.Account().Schema).Data(state, &terraform.InstanceDiff{Attributes: changes})
+	require.NoError(t, err)
+	return d
+}
+
+func expectReadAccount(mock sqlmock.Sqlmock, name string) {
+	rows := sqlmock.NewRows([]string{
+		"organization_name", "account_name", "snowflake_region", "edition", "account_url", "created_on", "comment",
+		"account_locator", "account_locator_url", "managed_accounts", "consumption_billing_entity_name", "old_account_url", "is_org_admin",
+	}).AddRow(
+		"ORG", name, "AWS_US_WEST_2", "STANDARD", "https://org-"+name+".snowflakecomputing.com", time.Now(), "",
+		"ABC12345", "https://abc12345.snowflakecomputing.com", 0, "ORG", "", false,
+	)
+	mock.ExpectQuery(`^SHOW ORGANIZATION ACCOUNTS LIKE 'ABC12345'$`).WillReturnRows(rows)
+}
+
+func TestAccountRename(t *testing.T) {
+	r := require.New(t)
+
+	d := accountResourceData(t, "OLD_ACCOUNT", map[string]*terraform.ResourceAttrDiff{
+		"name": {Old: "OLD_ACCOUNT", New: "NEW_ACCOUNT"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT "OLD_ACCOUNT" RENAME TO "NEW_ACCOUNT" SAVE_OLD_URL = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccount(mock, "NEW_ACCOUNT")
+		err := resources.UpdateAccount(d, db)
+		r.NoError(err)
+		r.Equal("ABC12345", d.Id())
+		r.Equal("NEW_ACCOUNT", d.Get("name").(string))
+		r.Equal("ORG", d.Get("organization_name").(string))
+		r.Equal("ABC12345", d.Get("account_locator").(string))
+		r.Equal("https://org-NEW_ACCOUNT.snowflakecomputing.com", d.Get("account_url").(string))
+		r.Equal("https://abc12345.snowflakecomputing.com", d.Get("account_locator_url").(string))
+	})
+}
+
+func TestAccountDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := accountResourceData(t, "TEST_ACCOUNT", nil)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP ACCOUNT IF EXISTS "TEST_ACCOUNT" GRACE_PERIOD_IN_DAYS = 3$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteAccount(d, db)
+		r.NoError(err)
+	})
+}
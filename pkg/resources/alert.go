@@ -40,13 +40,12 @@ var alertSchema = map[string]*schema.Schema{
 	},
 	"warehouse": {
 		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The warehouse the alert will use.",
-		ForceNew:    true,
+		Optional:    true,
+		Description: "The warehouse the alert will use. If not specified, the alert is serverless and runs on Snowflake-managed compute resources.",
 	},
 	"alert_schedule": {
 		Type:        schema.TypeList,
-		Optional:    true,
+		Required:    true,
 		MaxItems:    1,
 		Description: "The schedule for periodically running an alert.",
 		Elem: &schema.Resource{
@@ -55,7 +54,7 @@ var alertSchema = map[string]*schema.Schema{
 					Type:          schema.TypeList,
 					Optional:      true,
 					MaxItems:      1,
-					ConflictsWith: []string{"alert_schedule.interval"},
+					ConflictsWith: []string{"alert_schedule.0.interval"},
 					Description:   "Specifies the cron expression for the alert. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -75,7 +74,7 @@ var alertSchema = map[string]*schema.Schema{
 				"interval": {
 					Type:          schema.TypeInt,
 					Optional:      true,
-					ConflictsWith: []string{"alert_schedule.cron"},
+					ConflictsWith: []string{"alert_schedule.0.cron"},
 					Description:   "Specifies the interval in minutes for the alert schedule. The interval must be greater than 0 and less than 1440 (24 hours).",
 				},
 			},
@@ -291,11 +290,14 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 	runSetStatement := false
 
 	if d.HasChange("warehouse") {
-		runSetStatement = true
 		_, v := d.GetChange("warehouse")
-		warehouseName := v.(string)
-		warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
-		opts.Set.Warehouse = &warehouse
+		if warehouseName := v.(string); warehouseName != "" {
+			runSetStatement = true
+			warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
+			opts.Set.Warehouse = &warehouse
+		} else {
+			opts.Unset.Warehouse = sdk.Bool(true)
+		}
 	}
 
 	if d.HasChange("alert_schedule") {
@@ -320,6 +322,14 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if opts.Unset.Warehouse != nil {
+		unsetOptions := &sdk.AlterAlertOptions{Unset: opts.Unset}
+		err := client.Alerts.Alter(ctx, objectIdentifier, unsetOptions)
+		if err != nil {
+			return fmt.Errorf("error updating alert %v: %w", objectIdentifier.Name(), err)
+		}
+	}
+
 	if d.HasChange("condition") {
 		condition := d.Get("condition").(string)
 		alterOptions := &sdk.AlterAlertOptions{}
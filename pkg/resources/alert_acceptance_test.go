@@ -199,3 +199,59 @@ resource "snowflake_alert" "test_alert" {
 	}
 	return result.String()
 }
+
+func TestAcc_Alert_Serverless(t *testing.T) {
+	name := "a_" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: serverlessAlertConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", name),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.cron.0.expression", "0 * * * *"),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.cron.0.time_zone", "UTC"),
+				),
+			},
+			// resume without recreation
+			{
+				Config: serverlessAlertConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", name),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", "true"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_alert.test_alert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func serverlessAlertConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test_alert" {
+	name      = "%[1]s"
+	database  = "%[2]s"
+	schema    = "%[3]s"
+	alert_schedule {
+		cron {
+			expression = "0 * * * *"
+			time_zone  = "UTC"
+		}
+	}
+	condition = "select 0 as c"
+	action    = "select 0 as c"
+	enabled   = %[4]t
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, enabled)
+}
@@ -0,0 +1,391 @@
+package resources
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	apiAuthenticationGrantClientCredentials = "CLIENT_CREDENTIALS"
+	apiAuthenticationGrantAuthorizationCode = "AUTHORIZATION_CODE"
+)
+
+var apiAuthenticationIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the API authentication integration. This name follows the rules for Object Identifiers. The name should be unique among security integrations in your account.",
+	},
+	"oauth_grant": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Specifies the OAuth flow used to obtain access tokens. Valid values are CLIENT_CREDENTIALS and AUTHORIZATION_CODE.",
+		ValidateFunc: validation.StringInSlice([]string{apiAuthenticationGrantClientCredentials, apiAuthenticationGrantAuthorizationCode}, true),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the API authentication integration is enabled.",
+	},
+	"oauth_client_id": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the client ID of the OAuth application registered in the external service.",
+	},
+	"oauth_client_secret": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Specifies the client secret of the OAuth application registered in the external service. Snowflake does not return the secret, so changes made outside of Terraform are not detected.",
+	},
+	"oauth_token_endpoint": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the token endpoint used by the client to obtain an access token by presenting its authorization grant or refresh token.",
+	},
+	"oauth_authorization_endpoint": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the URL for authenticating to the external service. Only used with the AUTHORIZATION_CODE grant.",
+	},
+	"oauth_client_auth_method": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies the method by which the client authenticates to the token endpoint. Currently only CLIENT_SECRET_POST is supported.",
+		ValidateFunc: validation.StringInSlice([]string{"CLIENT_SECRET_POST"}, true),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"oauth_access_token_validity": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies the default lifetime of the OAuth access token (in seconds) issued by the OAuth server.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"oauth_refresh_token_validity": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies the value to determine the validity of the refresh token (in seconds) obtained from the OAuth server.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"oauth_allowed_scopes": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the scopes to use when making a request to the OAuth server. Only used with the CLIENT_CREDENTIALS grant.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the API authentication integration.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the API authentication integration was created.",
+	},
+}
+
+// APIAuthenticationIntegration returns a pointer to the resource representing an api authentication integration.
+func APIAuthenticationIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "An API authentication security integration stores the OAuth client configuration used by external access integrations and connectors to authenticate to external services.",
+
+		Create: CreateAPIAuthenticationIntegration,
+		Read:   ReadAPIAuthenticationIntegration,
+		Update: UpdateAPIAuthenticationIntegration,
+		Delete: DeleteAPIAuthenticationIntegration,
+
+		Schema: apiAuthenticationIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// validateAPIAuthenticationGrantSettings checks that only the settings supported by the configured grant are set.
+func validateAPIAuthenticationGrantSettings(d *schema.ResourceData) error {
+	grant := strings.ToUpper(d.Get("oauth_grant").(string))
+	if _, ok := d.GetOk("oauth_allowed_scopes"); ok && grant != apiAuthenticationGrantClientCredentials {
+		return fmt.Errorf("oauth_allowed_scopes can only be set with the %v oauth_grant", apiAuthenticationGrantClientCredentials)
+	}
+	if _, ok := d.GetOk("oauth_authorization_endpoint"); ok && grant != apiAuthenticationGrantAuthorizationCode {
+		return fmt.Errorf("oauth_authorization_endpoint can only be set with the %v oauth_grant", apiAuthenticationGrantAuthorizationCode)
+	}
+	return nil
+}
+
+// CreateAPIAuthenticationIntegration implements schema.CreateFunc.
+func CreateAPIAuthenticationIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+
+	if err := validateAPIAuthenticationGrantSettings(d); err != nil {
+		return err
+	}
+
+	stmt := snowflake.NewAPIAuthenticationIntegrationBuilder(name).Create()
+
+	// Set required fields
+	stmt.SetRaw(`TYPE=API_AUTHENTICATION AUTH_TYPE=OAUTH2`)
+	stmt.SetString(`OAUTH_GRANT`, strings.ToUpper(d.Get("oauth_grant").(string)))
+	stmt.SetString(`OAUTH_CLIENT_ID`, d.Get("oauth_client_id").(string))
+	stmt.SetString(`OAUTH_CLIENT_SECRET`, d.Get("oauth_client_secret").(string))
+	stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+
+	// Set optional fields
+	if v, ok := d.GetOk("oauth_client_auth_method"); ok {
+		stmt.SetRaw(`OAUTH_CLIENT_AUTH_METHOD=` + strings.ToUpper(v.(string)))
+	}
+	if v, ok := d.GetOk("oauth_token_endpoint"); ok {
+		stmt.SetString(`OAUTH_TOKEN_ENDPOINT`, v.(string))
+	}
+	if v, ok := d.GetOk("oauth_authorization_endpoint"); ok {
+		stmt.SetString(`OAUTH_AUTHORIZATION_ENDPOINT`, v.(string))
+	}
+	if v, ok := d.GetOk("oauth_access_token_validity"); ok {
+		stmt.SetInt(`OAUTH_ACCESS_TOKEN_VALIDITY`, v.(int))
+	}
+	if v, ok := d.GetOk("oauth_refresh_token_validity"); ok {
+		stmt.SetInt(`OAUTH_REFRESH_TOKEN_VALIDITY`, v.(int))
+	}
+	if v, ok := d.GetOk("oauth_allowed_scopes"); ok {
+		stmt.SetStringList(`OAUTH_ALLOWED_SCOPES`, expandStringList(v.(*schema.Set).List()))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		stmt.SetString(`COMMENT`, v.(string))
+	}
+
+	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
+		return fmt.Errorf("error creating security integration err = %w", err)
+	}
+
+	d.SetId(name)
+
+	return ReadAPIAuthenticationIntegration(d, meta)
+}
+
+// ReadAPIAuthenticationIntegration implements schema.ReadFunc.
+func ReadAPIAuthenticationIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id := d.Id()
+
+	stmt := snowflake.NewAPIAuthenticationIntegrationBuilder(id).Show()
+	row := snowflake.QueryRow(db, stmt)
+
+	// Some properties can come from the SHOW INTEGRATION call
+
+	s, err := snowflake.ScanAPIAuthenticationIntegration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] security integration (%s) not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not show security integration err = %w", err)
+	}
+
+	// Note: category must be Security or something is broken
+	if c := s.Category.String; c != "SECURITY" {
+		return fmt.Errorf("expected %v to be an Security integration, got %v", id, c)
+	}
+
+	if err := d.Set("name", s.Name.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return err
+	}
+
+	// Some properties come from the DESCRIBE INTEGRATION call
+	// We need to grab them in a loop
+	var k, pType string
+	var v, unused interface{}
+	stmt = snowflake.NewAPIAuthenticationIntegrationBuilder(id).Describe()
+	rows, err := db.Query(stmt)
+	if err != nil {
+		return fmt.Errorf("could not describe security integration err = %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
+			return fmt.Errorf("unable to parse security integration rows err = %w", err)
+		}
+		switch k {
+		case "OAUTH_GRANT":
+			if err := d.Set("oauth_grant", v.(string)); err != nil {
+				return fmt.Errorf("unable to set oauth grant for security integration err = %w", err)
+			}
+		case "OAUTH_CLIENT_ID":
+			if err := d.Set("oauth_client_id", v.(string)); err != nil {
+				return fmt.Errorf("unable to set oauth client id for security integration err = %w", err)
+			}
+		case "OAUTH_CLIENT_AUTH_METHOD":
+			if err := d.Set("oauth_client_auth_method", v.(string)); err != nil {
+				return fmt.Errorf("unable to set oauth client auth method for security integration err = %w", err)
+			}
+		case "OAUTH_TOKEN_ENDPOINT":
+			if err := d.Set("oauth_token_endpoint", v.(string)); err != nil {
+				return fmt.Errorf("unable to set oauth token endpoint for security integration err = %w", err)
+			}
+		case "OAUTH_AUTHORIZATION_ENDPOINT":
+			if err := d.Set("oauth_authorization_endpoint", v.(string)); err != nil {
+				return fmt.Errorf("unable to set oauth authorization endpoint for security integration err = %w", err)
+			}
+		case "OAUTH_ACCESS_TOKEN_VALIDITY":
+			i, err := strconv.Atoi(v.(string))
+			if err != nil {
+				return fmt.Errorf("returned oauth access token validity that is not integer err = %w", err)
+			}
+			if err := d.Set("oauth_access_token_validity", i); err != nil {
+				return fmt.Errorf("unable to set oauth access token validity for security integration err = %w", err)
+			}
+		case "OAUTH_REFRESH_TOKEN_VALIDITY":
+			i, err := strconv.Atoi(v.(string))
+			if err != nil {
+				return fmt.Errorf("returned oauth refresh token validity that is not integer err = %w", err)
+			}
+			if err := d.Set("oauth_refresh_token_validity", i); err != nil {
+				return fmt.Errorf("unable to set oauth refresh token validity for security integration err = %w", err)
+			}
+		case "OAUTH_ALLOWED_SCOPES":
+			scopes := helpers.StringListToList(helpers.ListContentToString(v.(string)))
+			if err := d.Set("oauth_allowed_scopes", scopes); err != nil {
+				return fmt.Errorf("unable to set oauth allowed scopes for security integration err = %w", err)
+			}
+		case "ENABLED", "COMMENT":
+			// We set these using the SHOW INTEGRATION call so let's ignore them here
+		case "AUTH_TYPE", "OAUTH_CLIENT_SECRET":
+			// AUTH_TYPE is always OAUTH2 and the secret is never returned
+		default:
+			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAPIAuthenticationIntegration implements schema.UpdateFunc.
+func UpdateAPIAuthenticationIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	id := d.Id()
+
+	if err := validateAPIAuthenticationGrantSettings(d); err != nil {
+		return err
+	}
+
+	stmt := snowflake.NewAPIAuthenticationIntegrationBuilder(id).Alter()
+
+	var runSetStatement bool
+
+	if d.HasChange("oauth_grant") {
+		runSetStatement = true
+		stmt.SetString(`OAUTH_GRANT`, strings.ToUpper(d.Get("oauth_grant").(string)))
+	}
+
+	if d.HasChange("enabled") {
+		runSetStatement = true
+		stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("oauth_client_id") {
+		runSetStatement = true
+		stmt.SetString(`OAUTH_CLIENT_ID`, d.Get("oauth_client_id").(string))
+	}
+
+	if d.HasChange("oauth_client_secret") {
+		runSetStatement = true
+		stmt.SetString(`OAUTH_CLIENT_SECRET`, d.Get("oauth_client_secret").(string))
+	}
+
+	if d.HasChange("oauth_client_auth_method") {
+		runSetStatement = true
+		stmt.SetRaw(`OAUTH_CLIENT_AUTH_METHOD=` + strings.ToUpper(d.Get("oauth_client_auth_method").(string)))
+	}
+
+	if d.HasChange("oauth_token_endpoint") {
+		runSetStatement = true
+		stmt.SetString(`OAUTH_TOKEN_ENDPOINT`, d.Get("oauth_token_endpoint").(string))
+	}
+
+	if d.HasChange("oauth_authorization_endpoint") {
+		runSetStatement = true
+		stmt.SetString(`OAUTH_AUTHORIZATION_ENDPOINT`, d.Get("oauth_authorization_endpoint").(string))
+	}
+
+	if d.HasChange("oauth_access_token_validity") {
+		runSetStatement = true
+		stmt.SetInt(`OAUTH_ACCESS_TOKEN_VALIDITY`, d.Get("oauth_access_token_validity").(int))
+	}
+
+	if d.HasChange("oauth_refresh_token_validity") {
+		runSetStatement = true
+		stmt.SetInt(`OAUTH_REFRESH_TOKEN_VALIDITY`, d.Get("oauth_refresh_token_validity").(int))
+	}
+
+	// We need to UNSET this if we remove all scopes.
+	if d.HasChange("oauth_allowed_scopes") {
+		v := expandStringList(d.Get("oauth_allowed_scopes").(*schema.Set).List())
+		if len(v) == 0 {
+			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION "%v" UNSET OAUTH_ALLOWED_SCOPES`, id)); err != nil {
+				return fmt.Errorf("error unsetting oauth_allowed_scopes err = %w", err)
+			}
+		} else {
+			runSetStatement = true
+			stmt.SetStringList(`OAUTH_ALLOWED_SCOPES`, v)
+		}
+	}
+
+	if d.HasChange("comment") {
+		v := d.Get("comment").(string)
+		if len(v) == 0 {
+			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION "%v" UNSET COMMENT`, id)); err != nil {
+				return fmt.Errorf("error unsetting comment err = %w", err)
+			}
+		} else {
+			runSetStatement = true
+			stmt.SetString(`COMMENT`, v)
+		}
+	}
+
+	if runSetStatement {
+		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
+			return fmt.Errorf("error updating security integration err = %w", err)
+		}
+	}
+
+	return ReadAPIAuthenticationIntegration(d, meta)
+}
+
+// DeleteAPIAuthenticationIntegration implements schema.DeleteFunc.
+func DeleteAPIAuthenticationIntegration(d *schema.ResourceData, meta interface{}) error {
+	return DeleteResource("", snowflake.NewAPIAuthenticationIntegrationBuilder)(d, meta)
+}
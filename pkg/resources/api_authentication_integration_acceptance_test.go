@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_APIAuthenticationIntegration(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: apiAuthenticationIntegrationConfig(name, "test integration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "oauth_grant", "CLIENT_CREDENTIALS"),
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "oauth_client_id", "test_client"),
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "oauth_token_endpoint", "https://example.com/oauth/token"),
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "oauth_allowed_scopes.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "comment", "test integration"),
+					resource.TestCheckResourceAttrSet("snowflake_api_authentication_integration.test", "created_on"),
+				),
+			},
+			{
+				Config: apiAuthenticationIntegrationConfig(name, "updated integration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_api_authentication_integration.test", "comment", "updated integration"),
+				),
+			},
+			{
+				ResourceName:            "snowflake_api_authentication_integration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_client_secret"},
+			},
+		},
+	})
+}
+
+func apiAuthenticationIntegrationConfig(name string, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_api_authentication_integration" "test" {
+		name                 = "%s"
+		oauth_grant          = "CLIENT_CREDENTIALS"
+		oauth_client_id      = "test_client"
+		oauth_client_secret  = "test_secret"
+		oauth_token_endpoint = "https://example.com/oauth/token"
+		oauth_allowed_scopes = ["read"]
+		comment              = "%s"
+	}
+	`, name, comment)
+}
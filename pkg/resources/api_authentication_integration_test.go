@@ -0,0 +1,101 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIAuthenticationIntegration(t *testing.T) {
+	r := require.New(t)
+	err := resources.APIAuthenticationIntegration().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAPIAuthenticationIntegrationCreateClientCredentials(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                        "test_api_auth",
+		"oauth_grant":                 "CLIENT_CREDENTIALS",
+		"oauth_client_id":             "client_id",
+		"oauth_client_secret":         "client_secret",
+		"oauth_client_auth_method":    "CLIENT_SECRET_POST",
+		"oauth_token_endpoint":        "https://example.com/oauth/token",
+		"oauth_access_token_validity": 3600,
+		"oauth_allowed_scopes":        []interface{}{"read"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.APIAuthenticationIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECURITY INTEGRATION "test_api_auth" TYPE=API_AUTHENTICATION AUTH_TYPE=OAUTH2 OAUTH_CLIENT_AUTH_METHOD=CLIENT_SECRET_POST OAUTH_CLIENT_ID='client_id' OAUTH_CLIENT_SECRET='client_secret' OAUTH_GRANT='CLIENT_CREDENTIALS' OAUTH_TOKEN_ENDPOINT='https://example.com/oauth/token' OAUTH_ALLOWED_SCOPES=\('read'\) ENABLED=true OAUTH_ACCESS_TOKEN_VALIDITY=3600$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAPIAuthenticationIntegration(mock)
+
+		err := resources.CreateAPIAuthenticationIntegration(d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"read"}, d.Get("oauth_allowed_scopes").(*schema.Set).List())
+		r.Equal(3600, d.Get("oauth_access_token_validity").(int))
+	})
+}
+
+func TestAPIAuthenticationIntegrationCreateScopesWithAuthorizationCode(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                 "test_api_auth",
+		"oauth_grant":          "AUTHORIZATION_CODE",
+		"oauth_client_id":      "client_id",
+		"oauth_client_secret":  "client_secret",
+		"oauth_allowed_scopes": []interface{}{"read"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.APIAuthenticationIntegration().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateAPIAuthenticationIntegration(d, db)
+		r.ErrorContains(err, "oauth_allowed_scopes can only be set with the CLIENT_CREDENTIALS oauth_grant")
+	})
+}
+
+func TestAPIAuthenticationIntegrationReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.APIAuthenticationIntegration().Schema, map[string]interface{}{"name": "test_api_auth"})
+	d.SetId("test_api_auth")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_api_auth'$`).WillReturnError(sql.ErrNoRows)
+
+		err := resources.ReadAPIAuthenticationIntegration(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadAPIAuthenticationIntegration(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	}).AddRow("test_api_auth", "API_AUTHENTICATION", "SECURITY", true, nil, "now")
+	mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_api_auth'$`).WillReturnRows(showRows)
+
+	descRows := sqlmock.NewRows([]string{
+		"property", "property_type", "property_value", "property_default",
+	}).AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("AUTH_TYPE", "String", "OAUTH2", "").
+		AddRow("OAUTH_GRANT", "String", "CLIENT_CREDENTIALS", "").
+		AddRow("OAUTH_CLIENT_ID", "String", "client_id", "").
+		AddRow("OAUTH_CLIENT_AUTH_METHOD", "String", "CLIENT_SECRET_POST", "CLIENT_SECRET_POST").
+		AddRow("OAUTH_TOKEN_ENDPOINT", "String", "https://example.com/oauth/token", "").
+		AddRow("OAUTH_ACCESS_TOKEN_VALIDITY", "Integer", "3600", "0").
+		AddRow("OAUTH_REFRESH_TOKEN_VALIDITY", "Integer", "7776000", "7776000").
+		AddRow("OAUTH_ALLOWED_SCOPES", "List", "[read]", "[]")
+	mock.ExpectQuery(`^DESCRIBE SECURITY INTEGRATION "test_api_auth"$`).WillReturnRows(descRows)
+}
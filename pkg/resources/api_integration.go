@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -21,8 +22,8 @@ var apiIntegrationSchema = map[string]*schema.Schema{
 	"api_provider": {
 		Type:         schema.TypeString,
 		Required:     true,
-		ValidateFunc: validation.StringInSlice([]string{"aws_api_gateway", "aws_private_api_gateway", "azure_api_management", "aws_gov_api_gateway", "aws_gov_private_api_gateway", "google_api_gateway"}, false),
-		Description:  "Specifies the HTTPS proxy service type.",
+		ValidateFunc: validation.StringInSlice([]string{"aws_api_gateway", "aws_private_api_gateway", "azure_api_management", "aws_gov_api_gateway", "aws_gov_private_api_gateway", "google_api_gateway", "git_https_api"}, false),
+		Description:  "Specifies the HTTPS proxy service type. Use git_https_api for integrations used by Git repositories.",
 	},
 	"api_aws_role_arn": {
 		Type:        schema.TypeString,
@@ -89,6 +90,12 @@ var apiIntegrationSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Lists the endpoints and resources in the HTTPS proxy service that are not allowed to be called from Snowflake.",
 	},
+	"allowed_authentication_secrets": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Only for the git_https_api provider. Specifies the fully qualified names of the secrets that Git repositories using the integration can authenticate with, e.g. `database.schema.secret`. Use [\"all\"] to allow any secret. When empty, no secrets are allowed.",
+	},
 	"api_key": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -262,6 +269,11 @@ func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("api_gcp_service_account", v.(string)); err != nil {
 				return err
 			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			secrets := parseAllowedAuthenticationSecrets(v.(string), d.Get("allowed_authentication_secrets").(*schema.Set).List())
+			if err := d.Set("allowed_authentication_secrets", secrets); err != nil {
+				return err
+			}
 		default:
 			log.Printf("[WARN] unexpected api integration property %v returned from Snowflake", k)
 		}
@@ -335,6 +347,10 @@ func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 			runSetStatement = true
 			stmt.SetString("GOOGLE_AUDIENCE", d.Get("google_audience").(string))
 		}
+		if d.HasChange("allowed_authentication_secrets") {
+			runSetStatement = true
+			stmt.SetRaw("ALLOWED_AUTHENTICATION_SECRETS=" + formatAllowedAuthenticationSecrets(expandStringList(d.Get("allowed_authentication_secrets").(*schema.Set).List())))
+		}
 	}
 
 	if runSetStatement {
@@ -380,9 +396,58 @@ func setAPIProviderSettings(data *schema.ResourceData, stmt snowflake.SettingBui
 			return fmt.Errorf("if you use GCP api provider you must specify a google_audience")
 		}
 		stmt.SetString(`GOOGLE_AUDIENCE`, v.(string))
+	case "git_https_api":
+		if v, ok := data.GetOk("allowed_authentication_secrets"); ok {
+			stmt.SetRaw("ALLOWED_AUTHENTICATION_SECRETS=" + formatAllowedAuthenticationSecrets(expandStringList(v.(*schema.Set).List())))
+		}
+		return nil
 	default:
 		return fmt.Errorf("unexpected provider %v", apiProvider)
 	}
 
+	if _, ok := data.GetOk("allowed_authentication_secrets"); ok {
+		return fmt.Errorf("allowed_authentication_secrets can only be used with the git_https_api api provider")
+	}
+
 	return nil
 }
+
+// formatAllowedAuthenticationSecrets renders the secrets list, or the all / none keywords, of a git_https_api integration.
+func formatAllowedAuthenticationSecrets(secrets []string) string {
+	if len(secrets) == 0 {
+		return "none"
+	}
+	if len(secrets) == 1 && (strings.EqualFold(secrets[0], "all") || strings.EqualFold(secrets[0], "none")) {
+		return strings.ToLower(secrets[0])
+	}
+	formatted := make([]string, len(secrets))
+	for i, secret := range secrets {
+		if strings.Contains(secret, ".") || strings.Contains(secret, "|") {
+			formatted[i] = snowflakeValidation.ParseAndFormatFullyQualifiedObectID(secret)
+		} else {
+			formatted[i] = secret
+		}
+	}
+	return fmt.Sprintf("(%v)", strings.Join(formatted, ", "))
+}
+
+// parseAllowedAuthenticationSecrets parses the [secret_a,secret_b] list returned by DESCRIBE INTEGRATION,
+// keeping the configured format of the secret identifiers pointing to the same secret.
+func parseAllowedAuthenticationSecrets(value string, configured []interface{}) []interface{} {
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"))
+	secrets := []interface{}{}
+	if value == "" || strings.EqualFold(value, "none") {
+		return secrets
+	}
+	for _, secret := range strings.Split(value, ",") {
+		secret = strings.TrimSpace(secret)
+		for _, c := range configured {
+			if suppressQualifiedObjectIDDiff("", c.(string), secret, nil) {
+				secret = c.(string)
+				break
+			}
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets
+}
@@ -19,6 +19,7 @@ func TestAcc_ApiIntegration(t *testing.T) {
 	apiIntNameAWS := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	apiIntNameAzure := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	apiIntNameGCP := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	apiIntNameGit := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
 		Providers:    acc.TestAccProviders(),
@@ -60,6 +61,16 @@ func TestAcc_ApiIntegration(t *testing.T) {
 					resource.TestCheckResourceAttrSet("snowflake_api_integration.test_gcp_int", "api_gcp_service_account"),
 				),
 			},
+			{
+				Config: apiIntegrationConfigGit(apiIntNameGit, []string{"https://github.com/Snowflake-Labs"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "name", apiIntNameGit),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_provider", "git_https_api"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "api_allowed_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_api_integration.test_git_int", "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttrSet("snowflake_api_integration.test_git_int", "created_on"),
+				),
+			},
 		},
 	})
 }
@@ -105,3 +116,15 @@ func apiIntegrationConfigGCP(name string, prefixes []string) string {
 	}
 	`, name, prefixes)
 }
+
+func apiIntegrationConfigGit(name string, prefixes []string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_api_integration" "test_git_int" {
+		name = "%s"
+		api_provider = "git_https_api"
+		api_allowed_prefixes = %q
+		comment = "acceptance test"
+		enabled = true
+	}
+	`, name, prefixes)
+}
@@ -64,6 +64,56 @@ func TestAPIIntegrationCreate(t *testing.T) {
 	})
 }
 
+func TestAPIIntegrationCreateGit(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                           "test_git_api_integration",
+		"api_allowed_prefixes":           []interface{}{"https://github.com/my-account"},
+		"api_provider":                   "git_https_api",
+		"allowed_authentication_secrets": []interface{}{"my_db.my_schema.my_secret"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.APIIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE API INTEGRATION "test_git_api_integration" API_PROVIDER=git_https_api ALLOWED_AUTHENTICATION_SECRETS=\("my_db"."my_schema"."my_secret"\) API_ALLOWED_PREFIXES=\('https://github.com/my-account'\) ENABLED=true$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		showRows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "created_on"}).
+			AddRow("test_git_api_integration", "EXTERNAL_API", "API", true, "now")
+		mock.ExpectQuery(`^SHOW API INTEGRATIONS LIKE 'test_git_api_integration'$`).WillReturnRows(showRows)
+		descRows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", true, false).
+			AddRow("API_ALLOWED_PREFIXES", "List", "https://github.com/my-account", nil).
+			AddRow("ALLOWED_AUTHENTICATION_SECRETS", "List", `[MY_DB.MY_SCHEMA.MY_SECRET]`, nil)
+		mock.ExpectQuery(`DESCRIBE API INTEGRATION "test_git_api_integration"$`).WillReturnRows(descRows)
+
+		err := resources.CreateAPIIntegration(d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"my_db.my_schema.my_secret"}, d.Get("allowed_authentication_secrets").(*schema.Set).List())
+	})
+}
+
+func TestAPIIntegrationCreateSecretsWithoutGit(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                           "test_api_integration",
+		"api_allowed_prefixes":           []interface{}{"https://123456.execute-api.us-west-2.amazonaws.com/prod/"},
+		"api_provider":                   "aws_api_gateway",
+		"api_aws_role_arn":               "arn:aws:iam::000000000001:/role/test",
+		"allowed_authentication_secrets": []interface{}{"all"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.APIIntegration().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateAPIIntegration(d, db)
+		r.ErrorContains(err, "allowed_authentication_secrets can only be used with the git_https_api api provider")
+	})
+}
+
 func TestAPIIntegrationRead(t *testing.T) {
 	r := require.New(t)
 
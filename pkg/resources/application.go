@@ -0,0 +1,295 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the application.",
+	},
+	"application_package": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the application package the application is installed from.",
+		ExactlyOneOf: []string{"application_package", "listing"},
+	},
+	"listing": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the listing the application is installed from.",
+		ExactlyOneOf: []string{"application_package", "listing"},
+	},
+	"version": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Specifies the version of the application package to install. Changing it upgrades the application in place. If not set, the version of the release directive is installed and upgrades are left to the provider of the application.",
+		ConflictsWith:    []string{"listing"},
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"patch": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		Description:  "Specifies the patch of the version to install. The default value of -1 means the latest patch of the version.",
+		RequiredWith: []string{"version"},
+		ValidateFunc: validation.IntAtLeast(-1),
+	},
+	"debug_mode": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the debug mode is enabled, allowing the provider to see the objects of the application. It is only available for applications installed from an application package in the same account.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application.",
+	},
+	"installed_version": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The version of the application currently installed.",
+	},
+	"installed_patch": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The patch of the version of the application currently installed.",
+	},
+	"label": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The label of the version of the application currently installed.",
+	},
+	"source_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the source of the application: APPLICATION PACKAGE or LISTING.",
+	},
+	"upgrade_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The state of the latest upgrade of the application, e.g. COMPLETE or FAILED.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the application.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the application was created.",
+	},
+}
+
+// Application returns a pointer to the resource representing an application.
+func Application() *schema.Resource {
+	return &schema.Resource{
+		Description: "An application is a Snowflake Native App installed in the consumer account from an application package or from a listing.",
+
+		Create: CreateApplication,
+		Read:   ReadApplication,
+		Update: UpdateApplication,
+		Delete: DeleteApplication,
+
+		Schema: applicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// applicationVersion returns the version and patch configured for the application, or nil when the release directive decides the version.
+func applicationVersion(d *schema.ResourceData) *sdk.ApplicationVersion {
+	version := d.Get("version").(string)
+	if version == "" {
+		return nil
+	}
+	using := &sdk.ApplicationVersion{Version: version}
+	if patch := d.Get("patch").(int); patch >= 0 {
+		using.Patch = sdk.Int(patch)
+	}
+	return using
+}
+
+// CreateApplication implements schema.CreateFunc.
+func CreateApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	opts := &sdk.CreateApplicationOptions{
+		Using: applicationVersion(d),
+	}
+	if v, ok := d.GetOk("application_package"); ok {
+		opts.FromApplicationPackage = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("listing"); ok {
+		opts.FromListing = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("debug_mode"); ok {
+		opts.DebugMode = sdk.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.Applications.Create(ctx, id, opts); err != nil {
+		return fmt.Errorf("error creating application %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return ReadApplication(d, meta)
+}
+
+// ReadApplication implements schema.ReadFunc.
+func ReadApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	application, err := client.Applications.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] application (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", application.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", application.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("installed_version", application.Version); err != nil {
+		return err
+	}
+	if err := d.Set("installed_patch", application.Patch); err != nil {
+		return err
+	}
+	if err := d.Set("label", application.Label); err != nil {
+		return err
+	}
+	if err := d.Set("source_type", application.SourceType); err != nil {
+		return err
+	}
+	if err := d.Set("owner", application.Owner); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", application.CreatedOn.String()); err != nil {
+		return err
+	}
+	switch {
+	case strings.EqualFold(application.SourceType, "LISTING"):
+		if err := d.Set("listing", application.Source); err != nil {
+			return err
+		}
+	default:
+		if err := d.Set("application_package", application.Source); err != nil {
+			return err
+		}
+	}
+
+	// the version and the patch are only read when they are managed by Terraform, so that an upgrade made outside of Terraform shows up as a difference
+	if d.Get("version").(string) != "" {
+		if err := d.Set("version", application.Version); err != nil {
+			return err
+		}
+		if d.Get("patch").(int) >= 0 {
+			if err := d.Set("patch", application.Patch); err != nil {
+				return err
+			}
+		}
+	}
+
+	details, err := client.Applications.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("debug_mode", details.DebugMode); err != nil {
+		return err
+	}
+	if err := d.Set("upgrade_state", details.UpgradeState); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateApplication implements schema.UpdateFunc.
+func UpdateApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	// removing the version leaves the installed version unchanged, as the application is then upgraded by its provider
+	if d.HasChanges("version", "patch") {
+		if using := applicationVersion(d); using != nil {
+			opts := &sdk.AlterApplicationOptions{Upgrade: &sdk.ApplicationUpgrade{Using: using}}
+			if err := client.Applications.Alter(ctx, id, opts); err != nil {
+				return fmt.Errorf("error upgrading application %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("debug_mode") {
+		set := &sdk.ApplicationSet{DebugMode: sdk.Bool(d.Get("debug_mode").(bool))}
+		if err := client.Applications.Alter(ctx, id, &sdk.AlterApplicationOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating debug mode of application %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		opts := &sdk.AlterApplicationOptions{Unset: &sdk.ApplicationUnset{Comment: sdk.Bool(true)}}
+		if v, ok := d.GetOk("comment"); ok {
+			opts = &sdk.AlterApplicationOptions{Set: &sdk.ApplicationSet{Comment: sdk.String(v.(string))}}
+		}
+		if err := client.Applications.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating comment of application %v err = %w", name, err)
+		}
+	}
+
+	return ReadApplication(d, meta)
+}
+
+// DeleteApplication implements schema.DeleteFunc.
+func DeleteApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if err := client.Applications.Drop(ctx, id, &sdk.DropApplicationOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting application %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
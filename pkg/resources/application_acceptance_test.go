@@ -0,0 +1,80 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Application(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// a stage location with the manifest and the setup script of the application, e.g. @db.schema.stage/app
+	location := os.Getenv("SNOWFLAKE_APPLICATION_PACKAGE_FILES")
+	if location == "" {
+		t.Skip("SNOWFLAKE_APPLICATION_PACKAGE_FILES must be set for Application acceptance tests")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationConfig(name, location, "v1_0", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application.a", "name", name+"_APP"),
+					resource.TestCheckResourceAttr("snowflake_application.a", "application_package", name),
+					resource.TestCheckResourceAttr("snowflake_application.a", "installed_version", "V1_0"),
+					resource.TestCheckResourceAttr("snowflake_application.a", "installed_patch", "0"),
+					resource.TestCheckResourceAttr("snowflake_application.a", "debug_mode", "false"),
+				),
+			},
+			// upgrade in place
+			{
+				Config: applicationConfig(name, location, "v2_0", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application.a", "installed_version", "V2_0"),
+					resource.TestCheckResourceAttr("snowflake_application.a", "debug_mode", "true"),
+					resource.TestCheckResourceAttr("snowflake_application.a", "upgrade_state", "COMPLETE"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_application.a",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version", "patch"},
+			},
+		},
+	})
+}
+
+func applicationConfig(name string, location string, version string, debugMode bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "p" {
+	name = "%[1]s"
+
+	version {
+		name  = "v1_0"
+		using = "%[2]s"
+	}
+
+	version {
+		name  = "v2_0"
+		using = "%[2]s"
+	}
+}
+
+resource "snowflake_application" "a" {
+	name                = "%[1]s_APP"
+	application_package = snowflake_application_package.p.name
+	version             = "%[3]s"
+	debug_mode          = %[4]t
+}
+`, name, location, version, debugMode)
+}
@@ -0,0 +1,401 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationPackageDistributions = []string{string(sdk.DistributionInternal), string(sdk.DistributionExternal)}
+
+var applicationPackageSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the application package.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application package.",
+	},
+	"distribution": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      fmt.Sprintf("Specifies whether the application package is offered to consumers in the same organization (INTERNAL) or outside of it (EXTERNAL), in which case its versions are subject to the security review. Valid values are (case-insensitive): %s.", strings.Join(applicationPackageDistributions, " | ")),
+		ValidateFunc:     validation.StringInSlice(applicationPackageDistributions, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"version": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Versions of the application package, each one created from the files (manifest, setup script, ...) in a stage location. Changing the location or the label of a version adds a new patch to it; removing a version drops it.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier of the version, e.g. `v1_0`.",
+				},
+				"using": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the stage location containing the files of the version, e.g. `@db.schema.stage/v1_0`. The location is not read from Snowflake.",
+				},
+				"label": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the label of the version displayed to the consumers.",
+				},
+				"patch": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The latest patch of the version.",
+				},
+			},
+		},
+	},
+	"default_release_directive": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the version and patch installed by the consumers when no custom release directive applies to them. Removing the block leaves the release directive unchanged, as the default release directive cannot be unset.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the version of the default release directive.",
+				},
+				"patch": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "Specifies the patch of the version of the default release directive.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the application package.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the application package was created.",
+	},
+}
+
+// ApplicationPackage returns a pointer to the resource representing an application package.
+func ApplicationPackage() *schema.Resource {
+	return &schema.Resource{
+		Description: "An application package contains the versions and patches of a Snowflake Native App, as well as the release directive deciding which of them the consumers install.",
+
+		Create: CreateApplicationPackage,
+		Read:   ReadApplicationPackage,
+		Update: UpdateApplicationPackage,
+		Delete: DeleteApplicationPackage,
+
+		Schema: applicationPackageSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type applicationPackageVersion struct {
+	name  string
+	using string
+	label string
+}
+
+func expandApplicationPackageVersions(v any) []applicationPackageVersion {
+	versions := make([]applicationPackageVersion, 0)
+	for _, raw := range v.([]any) {
+		version := raw.(map[string]any)
+		versions = append(versions, applicationPackageVersion{
+			name:  version["name"].(string),
+			using: version["using"].(string),
+			label: version["label"].(string),
+		})
+	}
+	return versions
+}
+
+func findApplicationPackageVersion(versions []applicationPackageVersion, name string) (applicationPackageVersion, bool) {
+	for _, version := range versions {
+		if strings.EqualFold(version.name, name) {
+			return version, true
+		}
+	}
+	return applicationPackageVersion{}, false
+}
+
+// addApplicationPackageVersion adds the version, or a new patch of the version when it already exists.
+func addApplicationPackageVersion(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, version applicationPackageVersion, patch bool) error {
+	var label *string
+	if version.label != "" {
+		label = sdk.String(version.label)
+	}
+	opts := &sdk.AlterApplicationPackageOptions{
+		AddVersion: &sdk.AddApplicationPackageVersion{VersionIdentifier: version.name, Using: version.using, Label: label},
+	}
+	if patch {
+		opts = &sdk.AlterApplicationPackageOptions{
+			AddPatch: &sdk.AddApplicationPackagePatch{VersionIdentifier: version.name, Using: version.using, Label: label},
+		}
+	}
+	if err := client.ApplicationPackages.Alter(ctx, id, opts); err != nil {
+		return fmt.Errorf("error adding version %v to application package %v err = %w", version.name, id.Name(), err)
+	}
+	return nil
+}
+
+func setApplicationPackageReleaseDirective(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	v, ok := d.GetOk("default_release_directive")
+	if !ok {
+		return nil
+	}
+	directive := v.([]any)[0].(map[string]any)
+	opts := &sdk.AlterApplicationPackageOptions{
+		SetDefaultReleaseDirective: &sdk.ApplicationPackageReleaseDirectiveSet{
+			Version: directive["version"].(string),
+			Patch:   directive["patch"].(int),
+		},
+	}
+	if err := client.ApplicationPackages.Alter(ctx, id, opts); err != nil {
+		return fmt.Errorf("error setting default release directive of application package %v err = %w", id.Name(), err)
+	}
+	return nil
+}
+
+// CreateApplicationPackage implements schema.CreateFunc.
+func CreateApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	opts := &sdk.CreateApplicationPackageOptions{}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("distribution"); ok {
+		opts.Distribution = sdk.Pointer(sdk.Distribution(strings.ToUpper(v.(string))))
+	}
+
+	if err := client.ApplicationPackages.Create(ctx, id, opts); err != nil {
+		return fmt.Errorf("error creating application package %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	for _, version := range expandApplicationPackageVersions(d.Get("version")) {
+		if err := addApplicationPackageVersion(ctx, client, id, version, false); err != nil {
+			return err
+		}
+	}
+	if err := setApplicationPackageReleaseDirective(ctx, client, d, id); err != nil {
+		return err
+	}
+
+	return ReadApplicationPackage(d, meta)
+}
+
+// ReadApplicationPackage implements schema.ReadFunc.
+func ReadApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	applicationPackage, err := client.ApplicationPackages.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] application package (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", applicationPackage.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", applicationPackage.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("distribution", string(applicationPackage.Distribution)); err != nil {
+		return err
+	}
+	if err := d.Set("owner", applicationPackage.Owner); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", applicationPackage.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	if err := readApplicationPackageVersions(ctx, client, d, id); err != nil {
+		return err
+	}
+	return readApplicationPackageReleaseDirective(ctx, client, d, id)
+}
+
+// readApplicationPackageVersions reads the latest patch of every version. The versions keep the order and the names of the configuration,
+// as Snowflake upper-cases the unquoted version identifiers, and the versions dropped outside of Terraform are removed from the state.
+func readApplicationPackageVersions(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	patches, err := client.ApplicationPackages.ShowVersions(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error reading versions of application package %v err = %w", id.Name(), err)
+	}
+	latest := make(map[string]sdk.ApplicationPackageVersion)
+	names := make([]string, 0)
+	for _, patch := range patches {
+		key := strings.ToUpper(patch.Version)
+		current, ok := latest[key]
+		if !ok {
+			names = append(names, patch.Version)
+		}
+		if !ok || patch.Patch >= current.Patch {
+			latest[key] = patch
+		}
+	}
+
+	configured := expandApplicationPackageVersions(d.Get("version"))
+	// on import, all the versions are read from Snowflake
+	if len(configured) == 0 {
+		for _, name := range names {
+			configured = append(configured, applicationPackageVersion{name: name})
+		}
+	}
+
+	versions := make([]any, 0, len(configured))
+	for _, version := range configured {
+		patch, ok := latest[strings.ToUpper(version.name)]
+		if !ok {
+			continue
+		}
+		versions = append(versions, map[string]any{
+			"name":  version.name,
+			"using": version.using,
+			"label": patch.Label,
+			"patch": patch.Patch,
+		})
+	}
+	return d.Set("version", versions)
+}
+
+func readApplicationPackageReleaseDirective(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	directives, err := client.ApplicationPackages.ShowReleaseDirectives(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error reading release directives of application package %v err = %w", id.Name(), err)
+	}
+	for _, directive := range directives {
+		if directive.Name != "DEFAULT" {
+			continue
+		}
+		version := directive.Version
+		if v, ok := d.GetOk("default_release_directive.0.version"); ok && strings.EqualFold(v.(string), version) {
+			version = v.(string)
+		}
+		return d.Set("default_release_directive", []any{map[string]any{
+			"version": version,
+			"patch":   directive.Patch,
+		}})
+	}
+	return d.Set("default_release_directive", nil)
+}
+
+// UpdateApplicationPackage implements schema.UpdateFunc.
+func UpdateApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if d.HasChange("comment") {
+		opts := &sdk.AlterApplicationPackageOptions{Unset: &sdk.ApplicationPackageUnset{Comment: sdk.Bool(true)}}
+		if v, ok := d.GetOk("comment"); ok {
+			opts = &sdk.AlterApplicationPackageOptions{Set: &sdk.ApplicationPackageSet{Comment: sdk.String(v.(string))}}
+		}
+		if err := client.ApplicationPackages.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating comment of application package %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("distribution") {
+		if v, ok := d.GetOk("distribution"); ok {
+			set := &sdk.ApplicationPackageSet{Distribution: sdk.Pointer(sdk.Distribution(strings.ToUpper(v.(string))))}
+			if err := client.ApplicationPackages.Alter(ctx, id, &sdk.AlterApplicationPackageOptions{Set: set}); err != nil {
+				return fmt.Errorf("error updating distribution of application package %v err = %w", name, err)
+			}
+		}
+	}
+
+	// the versions are dropped only after the release directive is updated, as a version used by a release directive cannot be dropped
+	var removed []applicationPackageVersion
+	if d.HasChange("version") {
+		o, n := d.GetChange("version")
+		oldVersions, newVersions := expandApplicationPackageVersions(o), expandApplicationPackageVersions(n)
+		for _, version := range newVersions {
+			old, ok := findApplicationPackageVersion(oldVersions, version.name)
+			if ok && old.using == version.using && old.label == version.label {
+				continue
+			}
+			if err := addApplicationPackageVersion(ctx, client, id, version, ok); err != nil {
+				return err
+			}
+		}
+		for _, version := range oldVersions {
+			if _, ok := findApplicationPackageVersion(newVersions, version.name); !ok {
+				removed = append(removed, version)
+			}
+		}
+	}
+
+	if d.HasChange("default_release_directive") {
+		if err := setApplicationPackageReleaseDirective(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	for _, version := range removed {
+		if err := client.ApplicationPackages.Alter(ctx, id, &sdk.AlterApplicationPackageOptions{DropVersion: sdk.String(version.name)}); err != nil {
+			return fmt.Errorf("error dropping version %v of application package %v err = %w", version.name, name, err)
+		}
+	}
+
+	return ReadApplicationPackage(d, meta)
+}
+
+// DeleteApplicationPackage implements schema.DeleteFunc.
+func DeleteApplicationPackage(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if err := client.ApplicationPackages.Drop(ctx, id, &sdk.DropApplicationPackageOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting application package %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
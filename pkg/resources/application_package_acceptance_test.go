@@ -0,0 +1,111 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ApplicationPackage(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageConfig(name, "first comment", "INTERNAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "name", name),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "comment", "first comment"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "distribution", "INTERNAL"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "version.#", "0"),
+					resource.TestCheckResourceAttrSet("snowflake_application_package.p", "owner"),
+				),
+			},
+			{
+				Config: applicationPackageConfig(name, "second comment", "EXTERNAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "comment", "second comment"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "distribution", "EXTERNAL"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_application_package.p",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ApplicationPackage_Versions(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// a stage location with the manifest and the setup script of the application, e.g. @db.schema.stage/app
+	location := os.Getenv("SNOWFLAKE_APPLICATION_PACKAGE_FILES")
+	if location == "" {
+		t.Skip("SNOWFLAKE_APPLICATION_PACKAGE_FILES must be set for ApplicationPackage versions acceptance tests")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: applicationPackageVersionsConfig(name, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "version.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "version.0.name", "v1_0"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "version.0.patch", "0"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "default_release_directive.0.version", "v1_0"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "default_release_directive.0.patch", "0"),
+				),
+			},
+			// changing the label adds a patch
+			{
+				Config: applicationPackageVersionsConfig(name, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "version.0.label", "second"),
+					resource.TestCheckResourceAttr("snowflake_application_package.p", "version.0.patch", "1"),
+				),
+			},
+		},
+	})
+}
+
+func applicationPackageConfig(name string, comment string, distribution string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "p" {
+	name         = "%s"
+	comment      = "%s"
+	distribution = "%s"
+}
+`, name, comment, distribution)
+}
+
+func applicationPackageVersionsConfig(name string, location string, label string) string {
+	return fmt.Sprintf(`
+resource "snowflake_application_package" "p" {
+	name = "%s"
+
+	version {
+		name  = "v1_0"
+		using = "%s"
+		label = "%s"
+	}
+
+	default_release_directive {
+		version = "v1_0"
+		patch   = 0
+	}
+}
+`, name, location, label)
+}
@@ -0,0 +1,112 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationPackage(t *testing.T) {
+	r := require.New(t)
+	err := resources.ApplicationPackage().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestApplicationPackageCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "pkg1",
+		"comment":      "native app",
+		"distribution": "internal",
+		"version": []interface{}{
+			map[string]interface{}{"name": "v1_0", "using": "@db1.schema1.stage1/v1_0", "label": "first"},
+		},
+		"default_release_directive": []interface{}{
+			map[string]interface{}{"version": "v1_0", "patch": 0},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ApplicationPackage().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE APPLICATION PACKAGE "pkg1" COMMENT = 'native app' DISTRIBUTION = INTERNAL$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER APPLICATION PACKAGE "pkg1" ADD VERSION v1_0 USING '@db1.schema1.stage1/v1_0' LABEL = 'first'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER APPLICATION PACKAGE "pkg1" SET DEFAULT RELEASE DIRECTIVE VERSION = v1_0 PATCH = 0$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadApplicationPackage(mock, [][]any{{"V1_0", 0, "first", nil}}, "V1_0", 0)
+		err := resources.CreateApplicationPackage(d, db)
+		r.NoError(err)
+		r.Equal("pkg1", d.Id())
+		r.Equal("v1_0", d.Get("version.0.name").(string))
+		r.Equal("@db1.schema1.stage1/v1_0", d.Get("version.0.using").(string))
+		r.Equal("v1_0", d.Get("default_release_directive.0.version").(string))
+	})
+}
+
+func TestApplicationPackageUpdateVersions(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "pkg1",
+		Attributes: map[string]string{
+			"name":                                "pkg1",
+			"version.#":                           "2",
+			"version.0.name":                      "v1_0",
+			"version.0.using":                     "@db1.schema1.stage1/v1_0",
+			"version.0.patch":                     "0",
+			"version.1.name":                      "v2_0",
+			"version.1.using":                     "@db1.schema1.stage1/v2_0",
+			"version.1.patch":                     "0",
+			"default_release_directive.#":         "1",
+			"default_release_directive.0.version": "v1_0",
+			"default_release_directive.0.patch":   "0",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"version.#":                           {Old: "2", New: "1"},
+			"version.0.name":                      {Old: "v1_0", New: "v2_0"},
+			"version.0.using":                     {Old: "@db1.schema1.stage1/v1_0", New: "@db1.schema1.stage1/v2_1"},
+			"version.1.name":                      {Old: "v2_0", New: "", NewRemoved: true},
+			"version.1.using":                     {Old: "@db1.schema1.stage1/v2_0", New: "", NewRemoved: true},
+			"default_release_directive.0.version": {Old: "v1_0", New: "v2_0"},
+			"default_release_directive.0.patch":   {Old: "0", New: "1"},
+		},
+	}
+	d, err := schema.InternalMap(resources.ApplicationPackage().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the version is dropped only after the release directive stops using it
+		mock.ExpectExec(`^ALTER APPLICATION PACKAGE "pkg1" ADD PATCH FOR VERSION v2_0 USING '@db1.schema1.stage1/v2_1'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER APPLICATION PACKAGE "pkg1" SET DEFAULT RELEASE DIRECTIVE VERSION = v2_0 PATCH = 1$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER APPLICATION PACKAGE "pkg1" DROP VERSION v1_0$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadApplicationPackage(mock, [][]any{{"V1_0", 0, nil, time.Now().String()}, {"V2_0", 0, nil, nil}, {"V2_0", 1, nil, nil}}, "V2_0", 1)
+		err := resources.UpdateApplicationPackage(d, db)
+		r.NoError(err)
+		r.Equal(1, d.Get("version.#").(int))
+		r.Equal("v2_0", d.Get("version.0.name").(string))
+		r.Equal(1, d.Get("version.0.patch").(int))
+	})
+}
+
+func expectReadApplicationPackage(mock sqlmock.Sqlmock, versions [][]any, releaseVersion string, releasePatch int) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "distribution", "owner", "comment", "retention_time", "options", "dropped_on", "application_class"}).
+		AddRow(time.Now(), "pkg1", "N", "N", "INTERNAL", "ACCOUNTADMIN", "native app", 1, "", nil, nil)
+	mock.ExpectQuery(`^SHOW APPLICATION PACKAGES LIKE 'pkg1'$`).WillReturnRows(rows)
+	versionRows := sqlmock.NewRows([]string{"version", "patch", "label", "comment", "created_on", "dropped_on", "state", "review_status"})
+	for _, version := range versions {
+		versionRows.AddRow(version[0], version[1], version[2], nil, time.Now(), version[3], "READY", "NOT_REVIEWED")
+	}
+	mock.ExpectQuery(`^SHOW VERSIONS IN APPLICATION PACKAGE "pkg1"$`).WillReturnRows(versionRows)
+	directiveRows := sqlmock.NewRows([]string{"name", "target_type", "target_name", "created_on", "version", "patch"}).
+		AddRow("DEFAULT", nil, nil, time.Now(), releaseVersion, releasePatch)
+	mock.ExpectQuery(`^SHOW RELEASE DIRECTIVES IN APPLICATION PACKAGE "pkg1"$`).WillReturnRows(directiveRows)
+}
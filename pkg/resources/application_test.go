@@ -0,0 +1,89 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplication(t *testing.T) {
+	r := require.New(t)
+	err := resources.Application().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestApplicationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                "app1",
+		"application_package": "pkg1",
+		"version":             "v1_0",
+		"debug_mode":          true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Application().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE APPLICATION "app1" FROM APPLICATION PACKAGE "pkg1" USING VERSION v1_0 DEBUG_MODE = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadApplication(mock, "V1_0", 3, "true")
+		err := resources.CreateApplication(d, db)
+		r.NoError(err)
+		r.Equal("app1", d.Id())
+		r.Equal("V1_0", d.Get("installed_version").(string))
+		r.Equal(3, d.Get("installed_patch").(int))
+		// the latest patch is installed, so the patch is not read
+		r.Equal(-1, d.Get("patch").(int))
+		r.True(d.Get("debug_mode").(bool))
+	})
+}
+
+func TestApplicationUpgrade(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "app1",
+		Attributes: map[string]string{
+			"name":                "app1",
+			"application_package": "pkg1",
+			"version":             "v1_0",
+			"patch":               "0",
+			"debug_mode":          "false",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"version": {Old: "v1_0", New: "v2_0"},
+			"patch":   {Old: "0", New: "1"},
+		},
+	}
+	d, err := schema.InternalMap(resources.Application().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER APPLICATION "app1" UPGRADE USING VERSION v2_0 PATCH 1$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadApplication(mock, "V2_0", 1, "false")
+		err := resources.UpdateApplication(d, db)
+		r.NoError(err)
+		r.Equal("V2_0", d.Get("version").(string))
+		r.Equal(1, d.Get("patch").(int))
+	})
+}
+
+func expectReadApplication(mock sqlmock.Sqlmock, version string, patch int, debugMode string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "source_type", "source", "owner", "comment", "version", "label", "patch", "options", "retention_time"}).
+		AddRow(time.Now(), "app1", "N", "N", "APPLICATION PACKAGE", "pkg1", "ACCOUNTADMIN", nil, version, nil, patch, "", 1)
+	mock.ExpectQuery(`^SHOW APPLICATIONS LIKE 'app1'$`).WillReturnRows(rows)
+	describeRows := sqlmock.NewRows([]string{"property", "value"}).
+		AddRow("name", "APP1").
+		AddRow("debug_mode", debugMode).
+		AddRow("upgrade_state", "COMPLETE")
+	mock.ExpectQuery(`^DESCRIBE APPLICATION "app1"$`).WillReturnRows(describeRows)
+}
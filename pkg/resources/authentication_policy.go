@@ -0,0 +1,342 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var authenticationPolicySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database this authentication policy belongs to.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema this authentication policy belongs to.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Identifier for the authentication policy; must be unique for the schema in which the authentication policy is created.",
+	},
+	"authentication_methods": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"ALL", "SAML", "PASSWORD", "OAUTH", "KEYPAIR"}, false),
+		},
+		Optional:    true,
+		Computed:    true,
+		Description: "A list of authentication methods that are allowed during login. Valid values are (case-sensitive): ALL | SAML | PASSWORD | OAUTH | KEYPAIR. Snowflake defaults to ALL when not set.",
+	},
+	"mfa_authentication_methods": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"ALL", "SAML", "PASSWORD"}, false),
+		},
+		Optional:    true,
+		Computed:    true,
+		Description: "A list of authentication methods that enforce multi-factor authentication (MFA) during login. Valid values are (case-sensitive): ALL | SAML | PASSWORD. Snowflake defaults to PASSWORD when not set.",
+	},
+	"mfa_enrollment": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.MfaEnrollmentRequired), string(sdk.MfaEnrollmentOptional)}, false),
+		Description:  "Determines whether a user must enroll in multi-factor authentication. Valid values are (case-sensitive): REQUIRED | OPTIONAL. Snowflake defaults to OPTIONAL when not set.",
+	},
+	"client_types": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"ALL", "SNOWFLAKE_UI", "DRIVERS", "SNOWSQL"}, false),
+		},
+		Optional:    true,
+		Computed:    true,
+		Description: "A list of clients that can authenticate with Snowflake. Valid values are (case-sensitive): ALL | SNOWFLAKE_UI | DRIVERS | SNOWSQL. Snowflake defaults to ALL when not set.",
+	},
+	"security_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Computed:    true,
+		Description: "A list of security integrations the authentication policy is associated with. Use ALL to allow all security integrations. Snowflake defaults to ALL when not set.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the authentication policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The qualified name for the authentication policy.",
+	},
+}
+
+// AuthenticationPolicy returns a pointer to the resource representing an authentication policy.
+func AuthenticationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "An authentication policy specifies the authentication methods, MFA requirements and clients that can be used to log in to Snowflake.",
+		Create:      CreateAuthenticationPolicy,
+		Read:        ReadAuthenticationPolicy,
+		Update:      UpdateAuthenticationPolicy,
+		Delete:      DeleteAuthenticationPolicy,
+
+		Schema: authenticationPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandAuthenticationMethods(v interface{}) []sdk.AuthenticationMethods {
+	methods := expandStringList(v.(*schema.Set).List())
+	result := make([]sdk.AuthenticationMethods, len(methods))
+	for i, method := range methods {
+		result[i] = sdk.AuthenticationMethods{Method: sdk.AuthenticationMethodsOption(method)}
+	}
+	return result
+}
+
+func expandMfaAuthenticationMethods(v interface{}) []sdk.MfaAuthenticationMethods {
+	methods := expandStringList(v.(*schema.Set).List())
+	result := make([]sdk.MfaAuthenticationMethods, len(methods))
+	for i, method := range methods {
+		result[i] = sdk.MfaAuthenticationMethods{Method: sdk.MfaAuthenticationMethodsOption(method)}
+	}
+	return result
+}
+
+func expandClientTypes(v interface{}) []sdk.ClientTypes {
+	clientTypes := expandStringList(v.(*schema.Set).List())
+	result := make([]sdk.ClientTypes, len(clientTypes))
+	for i, clientType := range clientTypes {
+		result[i] = sdk.ClientTypes{ClientType: sdk.ClientTypesOption(clientType)}
+	}
+	return result
+}
+
+func expandSecurityIntegrations(v interface{}) []sdk.SecurityIntegrationsOption {
+	integrations := expandStringList(v.(*schema.Set).List())
+	result := make([]sdk.SecurityIntegrationsOption, len(integrations))
+	for i, integration := range integrations {
+		result[i] = sdk.SecurityIntegrationsOption{Name: integration}
+	}
+	return result
+}
+
+// CreateAuthenticationPolicy implements schema.CreateFunc.
+func CreateAuthenticationPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	name := d.Get("name").(string)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.CreateAuthenticationPolicyOptions{}
+	if v, ok := d.GetOk("authentication_methods"); ok {
+		createOptions.AuthenticationMethods = expandAuthenticationMethods(v)
+	}
+	if v, ok := d.GetOk("mfa_authentication_methods"); ok {
+		createOptions.MfaAuthenticationMethods = expandMfaAuthenticationMethods(v)
+	}
+	if v, ok := d.GetOk("mfa_enrollment"); ok {
+		mfaEnrollment := sdk.MfaEnrollmentOption(v.(string))
+		createOptions.MfaEnrollment = &mfaEnrollment
+	}
+	if v, ok := d.GetOk("client_types"); ok {
+		createOptions.ClientTypes = expandClientTypes(v)
+	}
+	if v, ok := d.GetOk("security_integrations"); ok {
+		createOptions.SecurityIntegrations = expandSecurityIntegrations(v)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.AuthenticationPolicies.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadAuthenticationPolicy(d, meta)
+}
+
+// ReadAuthenticationPolicy implements schema.ReadFunc.
+func ReadAuthenticationPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, objectIdentifier)
+	if err != nil {
+		log.Printf("[DEBUG] authentication policy (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("qualified_name", objectIdentifier.FullyQualifiedName()); err != nil {
+		return err
+	}
+	if err := d.Set("database", authenticationPolicy.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", authenticationPolicy.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", authenticationPolicy.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", authenticationPolicy.Comment); err != nil {
+		return err
+	}
+
+	authenticationPolicyDetails, err := client.AuthenticationPolicies.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+
+	listProperties := map[string]*sdk.StringProperty{
+		"authentication_methods":     authenticationPolicyDetails.AuthenticationMethods,
+		"mfa_authentication_methods": authenticationPolicyDetails.MfaAuthenticationMethods,
+		"client_types":               authenticationPolicyDetails.ClientTypes,
+		"security_integrations":      authenticationPolicyDetails.SecurityIntegrations,
+	}
+	for key, property := range listProperties {
+		if property == nil {
+			continue
+		}
+		if err := d.Set(key, sdk.ParseAuthenticationPolicyList(property.Value)); err != nil {
+			return err
+		}
+	}
+	if err := setStringProperty(d, "mfa_enrollment", authenticationPolicyDetails.MfaEnrollment); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAuthenticationPolicy implements schema.UpdateFunc.
+func UpdateAuthenticationPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newID := sdk.NewSchemaObjectIdentifier(objectIdentifier.DatabaseName(), objectIdentifier.SchemaName(), d.Get("name").(string))
+		err := client.AuthenticationPolicies.Alter(ctx, objectIdentifier, &sdk.AlterAuthenticationPolicyOptions{
+			RenameTo: &newID,
+		})
+		if err != nil {
+			return err
+		}
+		d.SetId(helpers.EncodeSnowflakeID(newID))
+		objectIdentifier = newID
+	}
+
+	set, unset := &sdk.AuthenticationPolicySet{}, &sdk.AuthenticationPolicyUnset{}
+	runSet, runUnset := false, false
+
+	if d.HasChange("authentication_methods") {
+		if v, ok := d.GetOk("authentication_methods"); ok {
+			set.AuthenticationMethods = expandAuthenticationMethods(v)
+			runSet = true
+		} else {
+			unset.AuthenticationMethods = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("mfa_authentication_methods") {
+		if v, ok := d.GetOk("mfa_authentication_methods"); ok {
+			set.MfaAuthenticationMethods = expandMfaAuthenticationMethods(v)
+			runSet = true
+		} else {
+			unset.MfaAuthenticationMethods = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("mfa_enrollment") {
+		if v, ok := d.GetOk("mfa_enrollment"); ok {
+			mfaEnrollment := sdk.MfaEnrollmentOption(v.(string))
+			set.MfaEnrollment = &mfaEnrollment
+			runSet = true
+		} else {
+			unset.MfaEnrollment = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("client_types") {
+		if v, ok := d.GetOk("client_types"); ok {
+			set.ClientTypes = expandClientTypes(v)
+			runSet = true
+		} else {
+			unset.ClientTypes = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("security_integrations") {
+		if v, ok := d.GetOk("security_integrations"); ok {
+			set.SecurityIntegrations = expandSecurityIntegrations(v)
+			runSet = true
+		} else {
+			unset.SecurityIntegrations = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.Comment = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset.Comment = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		err := client.AuthenticationPolicies.Alter(ctx, objectIdentifier, &sdk.AlterAuthenticationPolicyOptions{Set: set})
+		if err != nil {
+			return err
+		}
+	}
+	if runUnset {
+		err := client.AuthenticationPolicies.Alter(ctx, objectIdentifier, &sdk.AlterAuthenticationPolicyOptions{Unset: unset})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ReadAuthenticationPolicy(d, meta)
+}
+
+// DeleteAuthenticationPolicy implements schema.DeleteFunc.
+func DeleteAuthenticationPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	err := client.AuthenticationPolicies.Drop(ctx, objectIdentifier, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
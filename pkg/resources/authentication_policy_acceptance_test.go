@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_AuthenticationPolicy(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: authenticationPolicyConfig(accName, `["PASSWORD", "SAML"]`, "OPTIONAL", "this is a test resource", acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "authentication_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.pa", "authentication_methods.*", "PASSWORD"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.pa", "authentication_methods.*", "SAML"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "mfa_enrollment", "OPTIONAL"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "comment", "this is a test resource"),
+				),
+			},
+			{
+				Config: authenticationPolicyConfig(accName, `["KEYPAIR"]`, "REQUIRED", "updated comment", acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "authentication_methods.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_authentication_policy.pa", "authentication_methods.*", "KEYPAIR"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "mfa_enrollment", "REQUIRED"),
+					resource.TestCheckResourceAttr("snowflake_authentication_policy.pa", "comment", "updated comment"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_authentication_policy.pa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func authenticationPolicyConfig(name string, authenticationMethods string, mfaEnrollment string, comment string, databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_authentication_policy" "pa" {
+		name                   = "%v"
+		database               = "%s"
+		schema                 = "%s"
+		authentication_methods = %s
+		mfa_enrollment         = "%s"
+		client_types           = ["SNOWFLAKE_UI", "DRIVERS"]
+		comment                = "%s"
+	}
+	`, name, databaseName, schemaName, authenticationMethods, mfaEnrollment, comment)
+}
@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var budgetSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the budget; must be unique for the database and schema in which the budget is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the budget.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the budget.",
+	},
+	"spending_limit": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Specifies the monthly spending limit (in credits) of the budget.",
+	},
+	"notification_integration": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"notification_emails"},
+		Description:  "Specifies the name of the email notification integration used to send the budget notifications.",
+	},
+	"notification_emails": {
+		Type:         schema.TypeSet,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Optional:     true,
+		RequiredWith: []string{"notification_integration"},
+		Description:  "Specifies the email addresses that receive a notification when the spending is projected to exceed the spending limit. The addresses must be allowed by the notification integration. Removing the email notifications recreates the budget, as Snowflake does not allow removing them in place.",
+	},
+	"warehouses": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the names of the warehouses whose usage is tracked by the budget.",
+	},
+	"databases": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the names of the databases whose usage is tracked by the budget.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the budget.",
+	},
+}
+
+// Budget returns a pointer to the resource representing a budget.
+func Budget() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateBudget,
+		Read:   ReadBudget,
+		Update: UpdateBudget,
+		Delete: DeleteBudget,
+
+		Schema: budgetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("notification_emails", func(ctx context.Context, old, new, meta any) bool {
+			return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+		}),
+	}
+}
+
+// budgetLinkedResourceTypes maps the budget attributes to the types of the objects they link to the budget.
+var budgetLinkedResourceTypes = map[string]sdk.ObjectType{
+	"warehouses": sdk.ObjectTypeWarehouse,
+	"databases":  sdk.ObjectTypeDatabase,
+}
+
+func updateBudgetLinkedResources(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, key string, o, n interface{}) error {
+	objectType := budgetLinkedResourceTypes[key]
+	for _, name := range expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List()) {
+		if err := client.Budgets.RemoveResource(ctx, id, objectType, sdk.NewAccountObjectIdentifier(name)); err != nil {
+			return fmt.Errorf("error removing %s %v from budget %v err = %w", objectType, name, id.FullyQualifiedName(), err)
+		}
+	}
+	for _, name := range expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List()) {
+		if err := client.Budgets.AddResource(ctx, id, objectType, sdk.NewAccountObjectIdentifier(name)); err != nil {
+			return fmt.Errorf("error adding %s %v to budget %v err = %w", objectType, name, id.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+func setBudgetEmailNotifications(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	integration := sdk.NewAccountObjectIdentifier(d.Get("notification_integration").(string))
+	emails := expandStringList(d.Get("notification_emails").(*schema.Set).List())
+	if err := client.Budgets.SetEmailNotifications(ctx, id, integration, emails); err != nil {
+		return fmt.Errorf("error setting email notifications of budget %v err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+// CreateBudget implements schema.CreateFunc.
+func CreateBudget(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	if err := client.Budgets.Create(ctx, id, nil); err != nil {
+		return fmt.Errorf("error creating budget %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if v, ok := d.GetOk("spending_limit"); ok {
+		if err := client.Budgets.SetSpendingLimit(ctx, id, v.(int)); err != nil {
+			return fmt.Errorf("error setting spending limit of budget %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if _, ok := d.GetOk("notification_integration"); ok {
+		if err := setBudgetEmailNotifications(ctx, client, id, d); err != nil {
+			return err
+		}
+	}
+
+	for key := range budgetLinkedResourceTypes {
+		if v, ok := d.GetOk(key); ok {
+			if err := updateBudgetLinkedResources(ctx, client, id, key, new(schema.Set), v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ReadBudget(d, meta)
+}
+
+// ReadBudget implements schema.ReadFunc.
+func ReadBudget(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	budget, err := client.Budgets.ShowByID(ctx, id)
+	if budget == nil || err != nil {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] budget (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	spendingLimit, err := client.Budgets.GetSpendingLimit(ctx, id)
+	if err != nil {
+		return err
+	}
+	emails, err := client.Budgets.GetNotificationEmails(ctx, id)
+	if err != nil {
+		return err
+	}
+	linkedResources, err := client.Budgets.GetLinkedResources(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", budget.Name); err != nil {
+		return err
+	}
+	if err := d.Set("database", budget.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", budget.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("spending_limit", spendingLimit); err != nil {
+		return err
+	}
+	if err := d.Set("notification_emails", emails); err != nil {
+		return err
+	}
+	if len(emails) > 0 {
+		integration, err := client.Budgets.GetNotificationIntegrationName(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("notification_integration", integration); err != nil {
+			return err
+		}
+	}
+	for key, objectType := range budgetLinkedResourceTypes {
+		names := make([]string, 0)
+		for _, linkedResource := range linkedResources {
+			if linkedResource.Domain == string(objectType) {
+				names = append(names, linkedResource.Name)
+			}
+		}
+		if err := d.Set(key, names); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateBudget implements schema.UpdateFunc.
+func UpdateBudget(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("spending_limit") {
+		if err := client.Budgets.SetSpendingLimit(ctx, id, d.Get("spending_limit").(int)); err != nil {
+			return fmt.Errorf("error setting spending limit of budget %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	// removing the email notifications recreates the budget, so only changes to the new values are applied
+	if _, ok := d.GetOk("notification_integration"); ok && d.HasChanges("notification_integration", "notification_emails") {
+		if err := setBudgetEmailNotifications(ctx, client, id, d); err != nil {
+			return err
+		}
+	}
+
+	for key := range budgetLinkedResourceTypes {
+		if d.HasChange(key) {
+			o, n := d.GetChange(key)
+			if err := updateBudgetLinkedResources(ctx, client, id, key, o, n); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ReadBudget(d, meta)
+}
+
+// DeleteBudget implements schema.DeleteFunc.
+func DeleteBudget(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.Budgets.Drop(ctx, id, nil); err != nil {
+		return fmt.Errorf("error deleting budget %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,64 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Budget(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	warehouseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: budgetConfig(name, warehouseName, 100, `[snowflake_warehouse.test.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_budget.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_budget.test", "spending_limit", "100"),
+					resource.TestCheckResourceAttr("snowflake_budget.test", "warehouses.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_budget.test", "warehouses.*", warehouseName),
+					resource.TestCheckResourceAttr("snowflake_budget.test", "qualified_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+				),
+			},
+			// CHANGE SPENDING LIMIT AND REMOVE WAREHOUSE
+			{
+				Config: budgetConfig(name, warehouseName, 200, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_budget.test", "spending_limit", "200"),
+					resource.TestCheckResourceAttr("snowflake_budget.test", "warehouses.#", "0"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_budget.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func budgetConfig(name string, warehouseName string, spendingLimit int, warehouses string) string {
+	return fmt.Sprintf(`
+resource "snowflake_warehouse" "test" {
+	name = "%[2]s"
+}
+
+resource "snowflake_budget" "test" {
+	name           = "%[1]s"
+	database       = "%[3]s"
+	schema         = "%[4]s"
+	spending_limit = %[5]d
+	warehouses     = %[6]s
+}
+`, name, warehouseName, acc.TestDatabaseName, acc.TestSchemaName, spendingLimit, warehouses)
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetNotificationEmailsRemoval(t *testing.T) {
+	emailHash := strconv.Itoa(budgetSchema["notification_emails"].ZeroValue().(*schema.Set).F("admin@example.com"))
+	diff := func(config map[string]interface{}) *terraform.InstanceDiff {
+		config["name"] = "budget"
+		config["database"] = "db"
+		config["schema"] = "schema"
+		state := &terraform.InstanceState{ID: `"db"|"schema"|"budget"`, Attributes: map[string]string{
+			"name":                             "budget",
+			"database":                         "db",
+			"schema":                           "schema",
+			"notification_integration":         "integration",
+			"notification_emails.#":            "1",
+			"notification_emails." + emailHash: "admin@example.com",
+		}}
+		d, err := Budget().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+		return d
+	}
+
+	t.Run("removing the email notifications recreates the budget", func(t *testing.T) {
+		require.True(t, diff(map[string]interface{}{}).RequiresNew())
+	})
+
+	t.Run("changing the email notifications is done in place", func(t *testing.T) {
+		d := diff(map[string]interface{}{
+			"notification_integration": "integration",
+			"notification_emails":      []interface{}{"other@example.com"},
+		})
+		require.False(t, d.RequiresNew())
+	})
+}
@@ -0,0 +1,304 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+var connectionSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the connection. A secondary connection must have the same name as its primary connection.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"as_replica_of": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Creates a secondary connection as a replica of the given primary connection.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organization_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the organization of the account containing the primary connection.",
+				},
+				"source_account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the account containing the primary connection.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the primary connection.",
+				},
+			},
+		},
+	},
+	"enable_failover_to_accounts": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the accounts in which the primary connection can be replicated and promoted. Expected in the form <org_name>.<target_account_name>",
+	},
+	"ignore_edition_check": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows enabling failover to accounts on lower editions.",
+	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Specifies whether the connection is the primary connection. Setting it to true on a secondary connection promotes it to the primary connection, which redirects the clients to this account; the previous primary becomes a secondary connection. A primary connection cannot be demoted, promote another connection instead.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the connection.",
+	},
+	"primary": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fully qualified name of the primary connection.",
+	},
+	"connection_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The connection URL used by the clients to connect to the account of the primary connection.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the connection was created.",
+	},
+}
+
+// Connection returns a pointer to the resource representing a connection used for Client Redirect.
+func Connection() *schema.Resource {
+	return &schema.Resource{
+		Description: "A connection redirects the client connections between the accounts of an organization (Client Redirect). The primary connection can be replicated to other accounts, and any of the secondary connections can be promoted to primary.",
+
+		Create: CreateConnection,
+		Read:   ReadConnection,
+		Update: UpdateConnection,
+		Delete: DeleteConnection,
+
+		Schema: connectionSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateConnection implements schema.CreateFunc.
+func CreateConnection(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	var comment *string
+	if v, ok := d.GetOk("comment"); ok {
+		comment = sdk.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("as_replica_of"); ok {
+		primary := v.([]interface{})[0].(map[string]interface{})
+		primaryID := sdk.NewExternalObjectIdentifier(
+			sdk.NewAccountIdentifier(primary["organization_name"].(string), primary["source_account_name"].(string)),
+			sdk.NewAccountObjectIdentifier(primary["name"].(string)),
+		)
+		if err := client.Connections.CreateReplica(ctx, id, primaryID, &sdk.CreateReplicaConnectionOptions{Comment: comment}); err != nil {
+			return fmt.Errorf("error creating secondary connection %v err = %w", name, err)
+		}
+	} else {
+		if err := client.Connections.Create(ctx, id, &sdk.CreateConnectionOptions{Comment: comment}); err != nil {
+			return fmt.Errorf("error creating connection %v err = %w", name, err)
+		}
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("is_primary"); ok && v.(bool) {
+		if _, ok := d.GetOk("as_replica_of"); ok {
+			if err := client.Connections.Alter(ctx, id, &sdk.AlterConnectionOptions{Primary: sdk.Bool(true)}); err != nil {
+				return fmt.Errorf("error promoting connection %v to primary err = %w", name, err)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("enable_failover_to_accounts"); ok {
+		accounts, err := expandAllowedAccounts(v)
+		if err != nil {
+			return err
+		}
+		if err := enableConnectionFailover(ctx, client, id, accounts, d.Get("ignore_edition_check").(bool)); err != nil {
+			return fmt.Errorf("error enabling failover for connection %v err = %w", name, err)
+		}
+	}
+
+	return ReadConnection(d, meta)
+}
+
+func enableConnectionFailover(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, accounts []sdk.AccountIdentifier, ignoreEditionCheck bool) error {
+	enableFailover := &sdk.ConnectionEnableFailover{ToAccounts: accounts}
+	if ignoreEditionCheck {
+		enableFailover.IgnoreEditionCheck = sdk.Bool(true)
+	}
+	return client.Connections.Alter(ctx, id, &sdk.AlterConnectionOptions{EnableFailover: enableFailover})
+}
+
+// ReadConnection implements schema.ReadFunc.
+func ReadConnection(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	connection, err := client.Connections.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] connection (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", connection.Name); err != nil {
+		return err
+	}
+	if err := d.Set("is_primary", connection.IsPrimary); err != nil {
+		return err
+	}
+	if err := d.Set("comment", connection.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("primary", connection.Primary.FullyQualifiedName()); err != nil {
+		return err
+	}
+	if err := d.Set("connection_url", connection.ConnectionURL); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", connection.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	// the failover settings are replicated to the secondary connections, but they are managed on the primary only
+	if connection.IsPrimary {
+		currentAccount := sdk.NewAccountIdentifier(connection.OrganizationName, connection.AccountName).Name()
+		accounts := make([]string, 0, len(connection.FailoverAllowedToAccounts))
+		for _, v := range connection.FailoverAllowedToAccounts {
+			if v.Name() == currentAccount {
+				continue
+			}
+			accounts = append(accounts, v.Name())
+		}
+		if err := d.Set("enable_failover_to_accounts", accounts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateConnection implements schema.UpdateFunc.
+func UpdateConnection(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if d.HasChange("is_primary") {
+		if !d.Get("is_primary").(bool) {
+			return fmt.Errorf("connection %v cannot be demoted, promote the connection in another account instead", name)
+		}
+		if err := client.Connections.Alter(ctx, id, &sdk.AlterConnectionOptions{Primary: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error promoting connection %v to primary err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("enable_failover_to_accounts") {
+		o, n := d.GetChange("enable_failover_to_accounts")
+		oldAccounts, err := expandAllowedAccounts(o)
+		if err != nil {
+			return err
+		}
+		newAccounts, err := expandAllowedAccounts(n)
+		if err != nil {
+			return err
+		}
+		var removed, added []sdk.AccountIdentifier
+		for _, v := range oldAccounts {
+			if !slices.Contains(newAccounts, v) {
+				removed = append(removed, v)
+			}
+		}
+		for _, v := range newAccounts {
+			if !slices.Contains(oldAccounts, v) {
+				added = append(added, v)
+			}
+		}
+		if len(removed) > 0 {
+			if err := client.Connections.Alter(ctx, id, &sdk.AlterConnectionOptions{DisableFailover: &sdk.ConnectionDisableFailover{ToAccounts: removed}}); err != nil {
+				return fmt.Errorf("error disabling failover for connection %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			if err := enableConnectionFailover(ctx, client, id, added, d.Get("ignore_edition_check").(bool)); err != nil {
+				return fmt.Errorf("error enabling failover for connection %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("comment") {
+		opts := &sdk.AlterConnectionOptions{Unset: &sdk.ConnectionUnset{Comment: sdk.Bool(true)}}
+		if comment := d.Get("comment").(string); comment != "" {
+			opts = &sdk.AlterConnectionOptions{Set: &sdk.ConnectionSet{Comment: sdk.String(comment)}}
+		}
+		if err := client.Connections.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating comment of connection %v err = %w", name, err)
+		}
+	}
+
+	return ReadConnection(d, meta)
+}
+
+// DeleteConnection implements schema.DeleteFunc.
+func DeleteConnection(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+	if err := client.Connections.Drop(ctx, id, &sdk.DropConnectionOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting connection %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
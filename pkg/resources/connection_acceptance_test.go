@@ -0,0 +1,53 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Connection(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: connectionConfig(name, "first comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_connection.c", "name", name),
+					resource.TestCheckResourceAttr("snowflake_connection.c", "comment", "first comment"),
+					resource.TestCheckResourceAttr("snowflake_connection.c", "is_primary", "true"),
+					resource.TestCheckResourceAttrSet("snowflake_connection.c", "connection_url"),
+				),
+			},
+			{
+				Config: connectionConfig(name, "second comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_connection.c", "comment", "second comment"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_connection.c",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func connectionConfig(name string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_connection" "c" {
+	name    = "%s"
+	comment = "%s"
+}
+`, name, comment)
+}
@@ -0,0 +1,104 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnection(t *testing.T) {
+	r := require.New(t)
+	err := resources.Connection().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestConnectionCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                        "conn1",
+		"comment":                     "great comment",
+		"enable_failover_to_accounts": []interface{}{"org.account"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.Connection().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE CONNECTION "conn1" COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER CONNECTION "conn1" ENABLE FAILOVER TO ACCOUNTS "org.account"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadConnection(mock)
+		err := resources.CreateConnection(d, db)
+		r.NoError(err)
+		r.Equal("conn1", d.Id())
+		r.Equal(true, d.Get("is_primary").(bool))
+		r.Equal("org.account.conn1.example.snowflakecomputing.com", d.Get("connection_url").(string))
+	})
+}
+
+func TestConnectionCreateReplica(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "conn1",
+		"as_replica_of": []interface{}{
+			map[string]interface{}{
+				"organization_name":   "org",
+				"source_account_name": "source",
+				"name":                "conn1",
+			},
+		},
+		"is_primary": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Connection().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE CONNECTION "conn1" AS REPLICA OF org.source."conn1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER CONNECTION "conn1" PRIMARY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadConnection(mock)
+		err := resources.CreateConnection(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestConnectionReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Connection().Schema, map[string]interface{}{"name": "conn1"})
+	d.SetId("conn1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) as CURRENT_ACCOUNT$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ACCOUNT"}).AddRow("ABC123"))
+		mock.ExpectQuery(`^SHOW CONNECTIONS LIKE 'conn1'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		err := resources.ReadConnection(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestConnectionDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Connection().Schema, map[string]interface{}{"name": "drop_it"})
+	d.SetId("drop_it")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP CONNECTION IF EXISTS "drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteConnection(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadConnection(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) as CURRENT_ACCOUNT$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ACCOUNT"}).AddRow("ABC123"))
+	rows := sqlmock.NewRows([]string{
+		"snowflake_region", "created_on", "account_name", "name", "comment", "is_primary", "primary", "failover_allowed_to_accounts", "connection_url", "organization_name", "account_locator",
+	}).AddRow("AWS_US_WEST_2", time.Now(), "ACCOUNT", "conn1", "great comment", true, "ORG.ACCOUNT.conn1", "ORG.ACCOUNT, org.account", "org.account.conn1.example.snowflakecomputing.com", "ORG", "ABC123")
+	mock.ExpectQuery(`^SHOW CONNECTIONS LIKE 'conn1'$`).WillReturnRows(rows)
+}
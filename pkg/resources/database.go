@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/exp/slices"
@@ -38,6 +39,7 @@ var databaseSchema = map[string]*schema.Schema{
 		Type:          schema.TypeMap,
 		Elem:          &schema.Schema{Type: schema.TypeString},
 		Description:   "Specify a provider and a share in this map to create a database from a share.",
+		Deprecated:    "Use the snowflake_shared_database resource instead.",
 		Optional:      true,
 		ForceNew:      true,
 		ConflictsWith: []string{"from_database", "from_replica"},
@@ -55,7 +57,12 @@ var databaseSchema = map[string]*schema.Schema{
 		Optional:      true,
 		ForceNew:      true,
 		ConflictsWith: []string{"from_share", "from_database"},
+		Deprecated:    "Use the snowflake_secondary_database resource instead.",
 	},
+	"max_data_extension_time_in_days": maxDataExtensionTimeInDaysSchema,
+	"default_ddl_collation":           defaultDDLCollationSchema,
+	"log_level":                       logLevelSchema,
+	"trace_level":                     traceLevelSchema,
 	"replication_configuration": {
 		Type:        schema.TypeList,
 		Description: "When set, specifies the configurations for database replication.",
@@ -64,21 +71,42 @@ var databaseSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"accounts": {
-					Type:     schema.TypeList,
-					Required: true,
-					MinItems: 1,
-					Elem:     &schema.Schema{Type: schema.TypeString},
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the accounts the database can be replicated to, in the <organization_name>.<account_name> format.",
 				},
 				"ignore_edition_check": {
-					Type:     schema.TypeBool,
-					Default:  true,
-					Optional: true,
+					Type:        schema.TypeBool,
+					Default:     true,
+					Optional:    true,
+					Description: "Allows replicating the database to accounts on lower editions. It is not read from Snowflake.",
+				},
+				"enable_failover": {
+					Type:        schema.TypeBool,
+					Default:     false,
+					Optional:    true,
+					Description: "Specifies whether the replicas of the database in the accounts can be promoted to serve as the primary database.",
 				},
 			},
 		},
 	},
+	"is_primary": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the database is the primary database of a replication. It is false when the replication is not enabled for the database.",
+	},
+	"primary_database": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The fully qualified name of the primary database the database is replicated from, or the database itself when it is the primary database. It is empty when the replication is not enabled for the database.",
+	},
 }
 
+// databaseParameters are the object parameters exposed as attributes of the database.
+var databaseParameters = []string{"max_data_extension_time_in_days", "default_ddl_collation", "log_level", "trace_level"}
+
 // Database returns a pointer to the resource representing a database.
 func Database() *schema.Resource {
 	return &schema.Resource{
@@ -116,25 +144,8 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error creating database %v: %w", name, err)
 		}
 		d.SetId(name)
-		if v, ok := d.GetOk("replication_configuration"); ok {
-			replicationConfiguration := v.([]interface{})[0].(map[string]interface{})
-			accounts := replicationConfiguration["accounts"].([]interface{})
-			accountIDs := make([]sdk.AccountIdentifier, len(accounts))
-			for i, account := range accounts {
-				accountIDs[i] = sdk.NewAccountIdentifierFromAccountLocator(account.(string))
-			}
-			opts := &sdk.AlterDatabaseReplicationOptions{
-				EnableReplication: &sdk.EnableReplication{
-					ToAccounts: accountIDs,
-				},
-			}
-			if ignoreEditionCheck, ok := replicationConfiguration["ignore_edition_check"]; ok {
-				opts.EnableReplication.IgnoreEditionCheck = sdk.Bool(ignoreEditionCheck.(bool))
-			}
-			err := client.Databases.AlterReplication(ctx, id, opts)
-			if err != nil {
-				return fmt.Errorf("error enabling replication for database %v: %w", name, err)
-			}
+		if err := enableDatabaseReplication(ctx, client, d, id); err != nil {
+			return err
 		}
 		return ReadDatabase(d, meta)
 	}
@@ -150,6 +161,9 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 		d.SetId(name)
 		// todo: add failover_configuration block
+		if err := createObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: id}, databaseParameters...); err != nil {
+			return err
+		}
 		return ReadDatabase(d, meta)
 	}
 
@@ -178,9 +192,163 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating database %v: %w", name, err)
 	}
 	d.SetId(name)
+	if err := createObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: id}, databaseParameters...); err != nil {
+		return err
+	}
+	if err := enableDatabaseReplication(ctx, client, d, id); err != nil {
+		return err
+	}
 	return ReadDatabase(d, meta)
 }
 
+// expandDatabaseReplicationConfiguration returns the accounts of the replication configuration, whether the edition check is ignored,
+// and the accounts the failover is enabled to.
+func expandDatabaseReplicationConfiguration(v interface{}) ([]sdk.AccountIdentifier, bool, []sdk.AccountIdentifier) {
+	configurations := v.([]interface{})
+	if len(configurations) == 0 || configurations[0] == nil {
+		return nil, false, nil
+	}
+	replicationConfiguration := configurations[0].(map[string]interface{})
+	accounts := replicationConfiguration["accounts"].([]interface{})
+	accountIDs := make([]sdk.AccountIdentifier, len(accounts))
+	for i, account := range accounts {
+		accountIDs[i] = sdk.NewAccountIdentifierFromAccountLocator(account.(string))
+	}
+	var failoverAccountIDs []sdk.AccountIdentifier
+	if replicationConfiguration["enable_failover"].(bool) {
+		failoverAccountIDs = accountIDs
+	}
+	return accountIDs, replicationConfiguration["ignore_edition_check"].(bool), failoverAccountIDs
+}
+
+// databaseAccountsDifference returns the accounts which are not in the other accounts.
+func databaseAccountsDifference(accounts []sdk.AccountIdentifier, other []sdk.AccountIdentifier) []sdk.AccountIdentifier {
+	difference := make([]sdk.AccountIdentifier, 0)
+	for _, account := range accounts {
+		if !slices.Contains(other, account) {
+			difference = append(difference, account)
+		}
+	}
+	return difference
+}
+
+// enableDatabaseReplication enables the replication of the database, and the failover if requested, to the accounts of the replication configuration.
+func enableDatabaseReplication(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	accounts, ignoreEditionCheck, failoverAccounts := expandDatabaseReplicationConfiguration(d.Get("replication_configuration"))
+	if len(accounts) == 0 {
+		return nil
+	}
+	opts := &sdk.AlterDatabaseReplicationOptions{
+		EnableReplication: &sdk.EnableReplication{
+			ToAccounts:         accounts,
+			IgnoreEditionCheck: sdk.Bool(ignoreEditionCheck),
+		},
+	}
+	if err := client.Databases.AlterReplication(ctx, id, opts); err != nil {
+		return fmt.Errorf("error enabling replication for database %v: %w", id.Name(), err)
+	}
+	if len(failoverAccounts) > 0 {
+		opts := &sdk.AlterDatabaseFailoverOptions{
+			EnableFailover: &sdk.EnableFailover{
+				ToAccounts: failoverAccounts,
+			},
+		}
+		if err := client.Databases.AlterFailover(ctx, id, opts); err != nil {
+			return fmt.Errorf("error enabling failover for database %v: %w", id.Name(), err)
+		}
+	}
+	return nil
+}
+
+// readDatabaseReplication reads the replication status of the database with SHOW REPLICATION DATABASES, which lists the databases of all the
+// accounts of the organization. The configured accounts are kept in their configured format, and the accounts the replication was enabled to
+// outside of Terraform are added, unless some accounts are configured with their account locator, which cannot be compared.
+func readDatabaseReplication(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return err
+	}
+	replicationDatabases, err := client.ReplicationFunctions.ShowReplicationDatabases(ctx, &sdk.ShowReplicationDatabasesOptions{
+		Like: &sdk.Like{Pattern: sdk.String(id.Name())},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading replication of database %v err = %w", id.Name(), err)
+	}
+	var replicationDatabase *sdk.ReplicationDatabase
+	for _, candidate := range replicationDatabases {
+		if candidate.Name == id.Name() && candidate.AccountLocator == currentAccount {
+			replicationDatabase = candidate
+		}
+	}
+	if replicationDatabase == nil {
+		if err := d.Set("is_primary", false); err != nil {
+			return err
+		}
+		if err := d.Set("primary_database", ""); err != nil {
+			return err
+		}
+		return d.Set("replication_configuration", nil)
+	}
+	if err := d.Set("is_primary", replicationDatabase.IsPrimary); err != nil {
+		return err
+	}
+	if err := d.Set("primary_database", replicationDatabase.Primary); err != nil {
+		return err
+	}
+	if !replicationDatabase.IsPrimary {
+		return nil
+	}
+
+	// the accounts include the account of the primary database itself
+	self := sdk.NewAccountIdentifier(replicationDatabase.OrganizationName, replicationDatabase.AccountName).Name()
+	allowed := make([]string, 0)
+	for _, account := range replicationDatabase.ReplicationAllowedToAccounts {
+		if !strings.EqualFold(account.Name(), self) {
+			allowed = append(allowed, account.Name())
+		}
+	}
+	failover := false
+	for _, account := range replicationDatabase.FailoverAllowedToAccounts {
+		if !strings.EqualFold(account.Name(), self) {
+			failover = true
+		}
+	}
+	if len(allowed) == 0 {
+		return d.Set("replication_configuration", nil)
+	}
+
+	ignoreEditionCheck := true
+	configured := make([]string, 0)
+	if v, ok := d.GetOk("replication_configuration"); ok {
+		replicationConfiguration := v.([]interface{})[0].(map[string]interface{})
+		configured = expandStringList(replicationConfiguration["accounts"].([]interface{}))
+		ignoreEditionCheck = replicationConfiguration["ignore_edition_check"].(bool)
+	}
+	accounts := make([]string, 0)
+	qualified := true
+	for _, account := range configured {
+		switch {
+		case !strings.Contains(account, "."):
+			qualified = false
+			accounts = append(accounts, account)
+		case slices.ContainsFunc(allowed, func(a string) bool { return strings.EqualFold(a, account) }):
+			accounts = append(accounts, account)
+		}
+	}
+	if qualified {
+		for _, account := range allowed {
+			if !slices.ContainsFunc(configured, func(c string) bool { return strings.EqualFold(c, account) }) {
+				accounts = append(accounts, account)
+			}
+		}
+	}
+	return d.Set("replication_configuration", []interface{}{map[string]interface{}{
+		"accounts":             accounts,
+		"ignore_edition_check": ignoreEditionCheck,
+		"enable_failover":      failover,
+	}})
+}
+
 func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
@@ -207,7 +375,11 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return nil
+	if err := readObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: id}, databaseParameters...); err != nil {
+		return err
+	}
+
+	return readDatabaseReplication(ctx, client, d, id)
 }
 
 func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
@@ -259,58 +431,63 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	// If replication configuration changes, need to update accounts that have permission to replicate database
+	if err := updateObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: id}, databaseParameters...); err != nil {
+		return err
+	}
+
+	// If replication configuration changes, need to update accounts that have permission to replicate database.
+	// The failover is disabled before the replication, and enabled after it.
 	if d.HasChange("replication_configuration") {
 		oldConfig, newConfig := d.GetChange("replication_configuration")
-		newAccounts := newConfig.([]interface{})[0].(map[string]interface{})["accounts"].([]interface{})
-		newAccountIDs := make([]sdk.AccountIdentifier, len(newAccounts))
-		for i, account := range newAccounts {
-			newAccountIDs[i] = sdk.NewAccountIdentifierFromAccountLocator(account.(string))
-		}
-		oldAccounts := oldConfig.([]interface{})[0].(map[string]interface{})["accounts"].([]interface{})
-		oldAccountIDs := make([]sdk.AccountIdentifier, len(oldAccounts))
-		for i, account := range oldAccounts {
-			oldAccountIDs[i] = sdk.NewAccountIdentifierFromAccountLocator(account.(string))
-		}
-		accountsToRemove := make([]sdk.AccountIdentifier, 0)
-		accountsToAdd := make([]sdk.AccountIdentifier, 0)
-		// Find accounts to remove
-		for _, oldAccountID := range oldAccountIDs {
-			if !slices.Contains(newAccountIDs, oldAccountID) {
-				accountsToRemove = append(accountsToRemove, oldAccountID)
+		oldAccountIDs, _, oldFailoverAccountIDs := expandDatabaseReplicationConfiguration(oldConfig)
+		newAccountIDs, ignoreEditionCheck, newFailoverAccountIDs := expandDatabaseReplicationConfiguration(newConfig)
+
+		if accountsToRemove := databaseAccountsDifference(oldFailoverAccountIDs, newFailoverAccountIDs); len(accountsToRemove) > 0 {
+			opts := &sdk.AlterDatabaseFailoverOptions{
+				DisableFailover: &sdk.DisableFailover{
+					ToAccounts: accountsToRemove,
+				},
+			}
+			err := client.Databases.AlterFailover(ctx, id, opts)
+			if err != nil {
+				return fmt.Errorf("error disabling failover configuration on %v err = %w", d.Id(), err)
 			}
 		}
 
-		// Find accounts to add
-		for _, newAccountID := range newAccountIDs {
-			if !slices.Contains(oldAccountIDs, newAccountID) {
-				accountsToAdd = append(accountsToAdd, newAccountID)
+		if accountsToRemove := databaseAccountsDifference(oldAccountIDs, newAccountIDs); len(accountsToRemove) > 0 {
+			opts := &sdk.AlterDatabaseReplicationOptions{
+				DisableReplication: &sdk.DisableReplication{
+					ToAccounts: accountsToRemove,
+				},
+			}
+			err := client.Databases.AlterReplication(ctx, id, opts)
+			if err != nil {
+				return fmt.Errorf("error disabling replication configuration on %v err = %w", d.Id(), err)
 			}
 		}
-		if len(accountsToAdd) > 0 {
+
+		if accountsToAdd := databaseAccountsDifference(newAccountIDs, oldAccountIDs); len(accountsToAdd) > 0 {
 			opts := &sdk.AlterDatabaseReplicationOptions{
 				EnableReplication: &sdk.EnableReplication{
-					ToAccounts: accountsToAdd,
+					ToAccounts:         accountsToAdd,
+					IgnoreEditionCheck: sdk.Bool(ignoreEditionCheck),
 				},
 			}
-			if ignoreEditionCheck := d.Get("ignore_edition_check").(bool); ignoreEditionCheck {
-				opts.EnableReplication.IgnoreEditionCheck = sdk.Bool(ignoreEditionCheck)
-			}
 			err := client.Databases.AlterReplication(ctx, id, opts)
 			if err != nil {
 				return fmt.Errorf("error enabling replication configuration on %v err = %w", d.Id(), err)
 			}
 		}
 
-		if len(accountsToRemove) > 0 {
-			opts := &sdk.AlterDatabaseReplicationOptions{
-				DisableReplication: &sdk.DisableReplication{
-					ToAccounts: accountsToRemove,
+		if accountsToAdd := databaseAccountsDifference(newFailoverAccountIDs, oldFailoverAccountIDs); len(accountsToAdd) > 0 {
+			opts := &sdk.AlterDatabaseFailoverOptions{
+				EnableFailover: &sdk.EnableFailover{
+					ToAccounts: accountsToAdd,
 				},
 			}
-			err := client.Databases.AlterReplication(ctx, id, opts)
+			err := client.Databases.AlterFailover(ctx, id, opts)
 			if err != nil {
-				return fmt.Errorf("error disabling replication configuration on %v err = %w", d.Id(), err)
+				return fmt.Errorf("error enabling failover configuration on %v err = %w", d.Id(), err)
 			}
 		}
 	}
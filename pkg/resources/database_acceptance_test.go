@@ -103,3 +103,112 @@ resource "snowflake_database" "db" {
 `
 	return fmt.Sprintf(s, prefix)
 }
+
+func TestAcc_Database_Parameters(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_DATABASE_TESTS"); ok {
+		t.Skip("Skipping TestAccDatabase")
+	}
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dbParametersConfig(name, `
+	max_data_extension_time_in_days = 7
+	default_ddl_collation           = "en-ci"
+	log_level                       = "INFO"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.db", "max_data_extension_time_in_days", "7"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "default_ddl_collation", "en-ci"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "log_level", "INFO"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "trace_level", ""),
+				),
+			},
+			// UNSET
+			{
+				Config: dbParametersConfig(name, `
+	trace_level = "ON_EVENT"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.db", "max_data_extension_time_in_days", "-1"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "default_ddl_collation", ""),
+					resource.TestCheckResourceAttr("snowflake_database.db", "log_level", ""),
+					resource.TestCheckResourceAttr("snowflake_database.db", "trace_level", "ON_EVENT"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_database.db",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func dbParametersConfig(name string, parameters string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "db" {
+	name = "%v"
+%v
+}
+`, name, parameters)
+}
+
+func TestAcc_Database_Replication(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// the second account of the organization, in the <organization_name>.<account_name> format
+	account2 := os.Getenv("SNOWFLAKE_ACCOUNT_SECOND")
+	if account2 == "" {
+		t.Skip("SNOWFLAKE_ACCOUNT_SECOND must be set for Database replication acceptance tests")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dbReplicationConfig(name, account2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.db", "replication_configuration.0.accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "replication_configuration.0.enable_failover", "false"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "is_primary", "true"),
+					resource.TestCheckResourceAttrSet("snowflake_database.db", "primary_database"),
+				),
+			},
+			{
+				Config: dbReplicationConfig(name, account2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.db", "replication_configuration.0.enable_failover", "true"),
+				),
+			},
+			{
+				Config: dbConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.db", "replication_configuration.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_database.db", "is_primary", "false"),
+				),
+			},
+		},
+	})
+}
+
+func dbReplicationConfig(name string, account string, enableFailover bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "db" {
+	name    = "%s"
+	comment = "test comment"
+
+	replication_configuration {
+		accounts        = ["%s"]
+		enable_failover = %t
+	}
+}
+`, name, account, enableFailover)
+}
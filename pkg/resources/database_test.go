@@ -0,0 +1,187 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase(t *testing.T) {
+	r := require.New(t)
+	err := resources.Database().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestDatabaseCreateWithParameters(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                  "db1",
+		"default_ddl_collation": "en-ci",
+		"log_level":             "info",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE DATABASE "db1" DATA_RETENTION_TIME_IN_DAYS = 1$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" SET DEFAULT_DDL_COLLATION = 'en-ci'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" SET LOG_LEVEL = INFO$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDatabase(mock, map[string][]string{
+			"MAX_DATA_EXTENSION_TIME_IN_DAYS": {"14", "ACCOUNT"},
+			"DEFAULT_DDL_COLLATION":           {"en-ci", "DATABASE"},
+			"LOG_LEVEL":                       {"INFO", "DATABASE"},
+			"TRACE_LEVEL":                     {"OFF", ""},
+		})
+		err := resources.CreateDatabase(d, db)
+		r.NoError(err)
+		r.Equal(-1, d.Get("max_data_extension_time_in_days").(int))
+		r.Equal("en-ci", d.Get("default_ddl_collation").(string))
+		r.Equal("INFO", d.Get("log_level").(string))
+		r.Equal("", d.Get("trace_level").(string))
+	})
+}
+
+func TestDatabaseUpdateParameters(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "db1",
+		Attributes: map[string]string{
+			"name":                            "db1",
+			"data_retention_time_in_days":     "1",
+			"max_data_extension_time_in_days": "-1",
+			"log_level":                       "WARN",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"max_data_extension_time_in_days": {Old: "-1", New: "7"},
+			"log_level":                       {Old: "WARN", New: ""},
+		},
+	}
+	d, err := schema.InternalMap(resources.Database().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DATABASE "db1" SET MAX_DATA_EXTENSION_TIME_IN_DAYS = 7$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" UNSET LOG_LEVEL$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDatabase(mock, map[string][]string{
+			"MAX_DATA_EXTENSION_TIME_IN_DAYS": {"7", "DATABASE"},
+			"DEFAULT_DDL_COLLATION":           {"", ""},
+			"LOG_LEVEL":                       {"OFF", ""},
+			"TRACE_LEVEL":                     {"OFF", ""},
+		})
+		err := resources.UpdateDatabase(d, db)
+		r.NoError(err)
+		r.Equal(7, d.Get("max_data_extension_time_in_days").(int))
+		r.Equal("", d.Get("log_level").(string))
+	})
+}
+
+func TestDatabaseCreateWithReplication(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "db1",
+		"replication_configuration": []interface{}{
+			map[string]interface{}{"accounts": []interface{}{"myorg.account2"}, "ignore_edition_check": true, "enable_failover": true},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE DATABASE "db1" DATA_RETENTION_TIME_IN_DAYS = 1$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" ENABLE REPLICATION TO ACCOUNTS "myorg.account2" IGNORE EDITION CHECK$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" ENABLE FAILOVER TO ACCOUNTS "myorg.account2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDatabaseWithReplication(mock, "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG.ACCOUNT1, MYORG.ACCOUNT2")
+		err := resources.CreateDatabase(d, db)
+		r.NoError(err)
+		r.True(d.Get("is_primary").(bool))
+		r.Equal("MYORG.ACCOUNT1.DB1", d.Get("primary_database").(string))
+		r.Equal([]interface{}{"myorg.account2"}, d.Get("replication_configuration.0.accounts").([]interface{}))
+		r.True(d.Get("replication_configuration.0.enable_failover").(bool))
+	})
+}
+
+func TestDatabaseUpdateReplication(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "db1",
+		Attributes: map[string]string{
+			"name":                                             "db1",
+			"data_retention_time_in_days":                      "1",
+			"replication_configuration.#":                      "1",
+			"replication_configuration.0.accounts.#":           "2",
+			"replication_configuration.0.accounts.0":           "myorg.account2",
+			"replication_configuration.0.accounts.1":           "myorg.account3",
+			"replication_configuration.0.ignore_edition_check": "true",
+			"replication_configuration.0.enable_failover":      "true",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"replication_configuration.0.accounts.#":      {Old: "2", New: "2"},
+			"replication_configuration.0.accounts.1":      {Old: "myorg.account3", New: "myorg.account4"},
+			"replication_configuration.0.enable_failover": {Old: "true", New: "false"},
+		},
+	}
+	d, err := schema.InternalMap(resources.Database().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DATABASE "db1" DISABLE FAILOVER TO ACCOUNTS "myorg.account2", "myorg.account3"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" DISABLE REPLICATION TO ACCOUNTS "myorg.account3"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" ENABLE REPLICATION TO ACCOUNTS "myorg.account4" IGNORE EDITION CHECK$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// the replication to account5 was enabled outside of Terraform
+		expectReadDatabaseWithReplication(mock, "MYORG.ACCOUNT1, MYORG.ACCOUNT2, MYORG.ACCOUNT4, MYORG.ACCOUNT5", "MYORG.ACCOUNT1")
+		err := resources.UpdateDatabase(d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"myorg.account2", "myorg.account4", "MYORG.ACCOUNT5"}, d.Get("replication_configuration.0.accounts").([]interface{}))
+		r.False(d.Get("replication_configuration.0.enable_failover").(bool))
+	})
+}
+
+func expectReadDatabaseWithReplication(mock sqlmock.Sqlmock, replicationAccounts string, failoverAccounts string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "comment", "options", "retention_time"}).
+		AddRow(time.Now(), "db1", "", "", "1")
+	mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+	for _, key := range []string{"MAX_DATA_EXTENSION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION", "LOG_LEVEL", "TRACE_LEVEL"} {
+		parameterRows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).AddRow(key, "", "", "", "")
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE '` + key + `' IN DATABASE "db1"$`).WillReturnRows(parameterRows)
+	}
+	expectReadDatabaseReplication(mock, replicationAccounts, failoverAccounts)
+}
+
+// expectReadDatabase expects the database to be read along with its parameters, given as the value and the level they are set on.
+func expectReadDatabase(mock sqlmock.Sqlmock, parameters map[string][]string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "comment", "options", "retention_time"}).
+		AddRow(time.Now(), "db1", "", "", "1")
+	mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+	for _, key := range []string{"MAX_DATA_EXTENSION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION", "LOG_LEVEL", "TRACE_LEVEL"} {
+		parameterRows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+			AddRow(key, parameters[key][0], "", parameters[key][1], "")
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE '` + key + `' IN DATABASE "db1"$`).WillReturnRows(parameterRows)
+	}
+	expectReadDatabaseReplication(mock, "", "")
+}
+
+// expectReadDatabaseReplication expects the replication of the database to be read, given the accounts it is replicated and failed over to.
+// No replication database is returned when the accounts are empty.
+func expectReadDatabaseReplication(mock sqlmock.Sqlmock, replicationAccounts string, failoverAccounts string) {
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) as CURRENT_ACCOUNT$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ACCOUNT"}).AddRow("AB12345"))
+	rows := sqlmock.NewRows([]string{"region_group", "snowflake_region", "created_on", "account_name", "name", "comment", "is_primary", "primary", "replication_allowed_to_accounts", "failover_allowed_to_accounts", "organization_name", "account_locator", "difference_in_seconds"})
+	if replicationAccounts != "" {
+		rows.AddRow("PUBLIC", "AWS_US_WEST_2", time.Now(), "ACCOUNT1", "db1", nil, true, "MYORG.ACCOUNT1.DB1", replicationAccounts, failoverAccounts, "MYORG", "AB12345", nil).
+			AddRow("PUBLIC", "AWS_US_EAST_1", time.Now(), "ACCOUNT2", "db1", nil, false, "MYORG.ACCOUNT1.DB1", replicationAccounts, failoverAccounts, "MYORG", "CD67890", nil)
+	}
+	mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+}
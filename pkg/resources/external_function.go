@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -100,26 +101,22 @@ var externalFunctionSchema = map[string]*schema.Schema{
 	"api_integration": {
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 		Description: "The name of the API integration object that should be used to authenticate the call to the proxy service.",
 	},
 	"header": {
 		Type:        schema.TypeSet,
 		Optional:    true,
-		ForceNew:    true,
 		Description: "Allows users to specify key-value metadata that is sent with every request as HTTP headers.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
 					Type:        schema.TypeString,
 					Required:    true,
-					ForceNew:    true,
 					Description: "Header name",
 				},
 				"value": {
 					Type:        schema.TypeString,
 					Required:    true,
-					ForceNew:    true,
 					Description: "Header value",
 				},
 			},
@@ -129,7 +126,6 @@ var externalFunctionSchema = map[string]*schema.Schema{
 		Type:     schema.TypeList,
 		Elem:     &schema.Schema{Type: schema.TypeString},
 		Optional: true,
-		ForceNew: true,
 		// Suppress the diff shown if the values are equal when both compared in lower case.
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			return strings.EqualFold(strings.ToLower(old), strings.ToLower(new))
@@ -139,28 +135,26 @@ var externalFunctionSchema = map[string]*schema.Schema{
 	"max_batch_rows": {
 		Type:        schema.TypeInt,
 		Optional:    true,
-		ForceNew:    true,
 		Description: "This specifies the maximum number of rows in each batch sent to the proxy service.",
 	},
 	"compression": {
 		Type:         schema.TypeString,
 		Optional:     true,
 		Default:      "AUTO",
-		ForceNew:     true,
 		ValidateFunc: validation.StringInSlice([]string{"NONE", "AUTO", "GZIP", "DEFLATE"}, false),
 		Description:  "If specified, the JSON payload is compressed when sent from Snowflake to the proxy service, and when sent back from the proxy service to Snowflake.",
 	},
 	"request_translator": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		ForceNew:    true,
-		Description: "This specifies the name of the request translator function",
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "This specifies the fully qualified name of the request translator function, e.g. `database.schema.translator`.",
 	},
 	"response_translator": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		ForceNew:    true,
-		Description: "This specifies the name of the response translator function.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "This specifies the fully qualified name of the response translator function, e.g. `database.schema.translator`.",
 	},
 	"url_of_proxy_and_resource": {
 		Type:        schema.TypeString,
@@ -172,7 +166,6 @@ var externalFunctionSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Default:     "user-defined function",
-		ForceNew:    true,
 		Description: "A description of the external function.",
 	},
 	"created_on": {
@@ -187,6 +180,7 @@ func ExternalFunction() *schema.Resource {
 	return &schema.Resource{
 		Create: CreateExternalFunction,
 		Read:   ReadExternalFunction,
+		Update: UpdateExternalFunction,
 		Delete: DeleteExternalFunction,
 
 		Schema: externalFunctionSchema,
@@ -288,16 +282,7 @@ func CreateExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if _, ok := d.GetOk("header"); ok {
-		headers := []map[string]string{}
-		for _, header := range d.Get("header").(*schema.Set).List() {
-			headerDef := map[string]string{}
-			for key, val := range header.(map[string]interface{}) {
-				headerDef[key] = val.(string)
-			}
-			headers = append(headers, headerDef)
-		}
-
-		builder.WithHeaders(headers)
+		builder.WithHeaders(expandExternalFunctionHeaders(d.Get("header").(*schema.Set)))
 	}
 
 	if v, ok := d.GetOk("context_headers"); ok {
@@ -475,6 +460,8 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 				if err := d.Set("header", headers); err != nil {
 					return err
 				}
+			} else if err := d.Set("header", []interface{}{}); err != nil {
+				return err
 			}
 		case "context_headers":
 			if desc.Value.Valid && desc.Value.String != "null" {
@@ -484,9 +471,15 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 				if err := d.Set("context_headers", contextHeaders); err != nil {
 					return err
 				}
+			} else if err := d.Set("context_headers", []interface{}{}); err != nil {
+				return err
 			}
 		case "max_batch_rows":
-			if desc.Value.String != "not set" {
+			if desc.Value.String == "not set" {
+				if err := d.Set("max_batch_rows", 0); err != nil {
+					return err
+				}
+			} else {
 				i, err := strconv.ParseInt(desc.Value.String, 10, 64)
 				if err != nil {
 					return err
@@ -504,6 +497,10 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("url_of_proxy_and_resource", desc.Value.String); err != nil {
 				return err
 			}
+		case "request_translator", "response_translator":
+			if err := d.Set(desc.Property.String, readExternalFunctionTranslator(d.Get(desc.Property.String).(string), desc.Value)); err != nil {
+				return err
+			}
 		case "language":
 			// To ignore
 		default:
@@ -514,6 +511,51 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// UpdateExternalFunction implements schema.UpdateFunc.
+func UpdateExternalFunction(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	externalFunctionID, err := externalFunctionIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	builder := snowflake.NewExternalFunctionBuilder(externalFunctionID.ExternalFunctionName, externalFunctionID.DatabaseName, externalFunctionID.SchemaName).WithArgTypes(externalFunctionID.ExternalFunctionArgTypes)
+
+	var stmts []string
+	if d.HasChange("api_integration") {
+		stmts = append(stmts, builder.ChangeAPIIntegration(d.Get("api_integration").(string)))
+	}
+	if d.HasChange("header") {
+		stmts = append(stmts, builder.ChangeHeaders(expandExternalFunctionHeaders(d.Get("header").(*schema.Set))))
+	}
+	if d.HasChange("context_headers") {
+		stmts = append(stmts, builder.ChangeContextHeaders(expandStringList(d.Get("context_headers").([]interface{}))))
+	}
+	if d.HasChange("max_batch_rows") {
+		stmts = append(stmts, builder.ChangeMaxBatchRows(d.Get("max_batch_rows").(int)))
+	}
+	if d.HasChange("compression") {
+		stmts = append(stmts, builder.ChangeCompression(d.Get("compression").(string)))
+	}
+	if d.HasChange("request_translator") {
+		stmts = append(stmts, builder.ChangeRequestTranslator(d.Get("request_translator").(string)))
+	}
+	if d.HasChange("response_translator") {
+		stmts = append(stmts, builder.ChangeResponseTranslator(d.Get("response_translator").(string)))
+	}
+	if d.HasChange("comment") {
+		stmts = append(stmts, builder.ChangeComment(d.Get("comment").(string)))
+	}
+
+	for _, stmt := range stmts {
+		if err := snowflake.Exec(db, stmt); err != nil {
+			return fmt.Errorf("error updating external function %v err = %w", d.Id(), err)
+		}
+	}
+
+	return ReadExternalFunction(d, meta)
+}
+
 // DeleteExternalFunction implements schema.DeleteFunc.
 func DeleteExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -535,3 +577,37 @@ func DeleteExternalFunction(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+func expandExternalFunctionHeaders(set *schema.Set) []map[string]string {
+	headers := []map[string]string{}
+	for _, header := range set.List() {
+		headerDef := map[string]string{}
+		for key, val := range header.(map[string]interface{}) {
+			headerDef[key] = val.(string)
+		}
+		headers = append(headers, headerDef)
+	}
+	return headers
+}
+
+// suppressQualifiedObjectIDDiff suppresses the diff between spellings of the same, possibly fully qualified, object identifier.
+func suppressQualifiedObjectIDDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if suppressQuotedIdentifierDiff("", old, new, nil) {
+		return true
+	}
+	if !strings.Contains(old, ".") || !strings.Contains(new, ".") {
+		return false
+	}
+	return strings.EqualFold(snowflakeValidation.ParseAndFormatFullyQualifiedObectID(old), snowflakeValidation.ParseAndFormatFullyQualifiedObectID(new))
+}
+
+// readExternalFunctionTranslator keeps the configured spelling of the translator when it identifies the described one.
+func readExternalFunctionTranslator(configured string, described sql.NullString) string {
+	if !described.Valid || described.String == "null" {
+		return ""
+	}
+	if suppressQualifiedObjectIDDiff("", configured, described.String, nil) {
+		return configured
+	}
+	return described.String
+}
@@ -24,20 +24,32 @@ func TestAcc_ExternalFunction(t *testing.T) {
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
 			{
-				Config: externalFunctionConfig(accName, []string{"https://123456.execute-api.us-west-2.amazonaws.com/prod/"}, "https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func"),
+				Config: externalFunctionConfig(accName, []string{"https://123456.execute-api.us-west-2.amazonaws.com/prod/"}, "https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func", "Terraform acceptance test", 500),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_external_function.test_func", "name", accName),
 					resource.TestCheckResourceAttr("snowflake_external_function.test_func", "comment", "Terraform acceptance test"),
 					resource.TestCheckResourceAttrSet("snowflake_external_function.test_func", "created_on"),
 					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "request_translator", fmt.Sprintf("%s.%s.TEST_FUNC_REQ_TRANSLATOR", accName, accName)),
 					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "response_translator", fmt.Sprintf("%s.%s.TEST_FUNC_RES_TRANSLATOR", accName, accName)),
+					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "max_batch_rows", "500"),
+				),
+			},
+			// headers, batch size and comment are altered in place
+			{
+				Config: externalFunctionConfig(accName, []string{"https://123456.execute-api.us-west-2.amazonaws.com/prod/"}, "https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func", "Terraform acceptance test - updated", 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "comment", "Terraform acceptance test - updated"),
+					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "max_batch_rows", "100"),
+					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "header.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "context_headers.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_function.test_func_2", "context_headers.0", "CURRENT_TIMESTAMP"),
 				),
 			},
 		},
 	})
 }
 
-func externalFunctionConfig(name string, prefixes []string, url string) string {
+func externalFunctionConfig(name string, prefixes []string, url string, comment string, maxBatchRows int) string {
 	return fmt.Sprintf(`
 	resource "snowflake_database" "test_database" {
 		name    = "%s"
@@ -115,7 +127,7 @@ func externalFunctionConfig(name string, prefixes []string, url string) string {
 		name = "%s"
 		database = snowflake_database.test_database.name
 		schema   = snowflake_schema.test_schema.name
-		comment = "Terraform acceptance test"
+		comment = "%s"
 		return_type = "variant"
 		return_behavior = "IMMUTABLE"
 		api_integration = snowflake_api_integration.test_api_int.name
@@ -123,10 +135,11 @@ func externalFunctionConfig(name string, prefixes []string, url string) string {
 			name = "x-custom-header"
 			value = "snowflake"
 		}
-		max_batch_rows = 500
+		context_headers = ["CURRENT_TIMESTAMP"]
+		max_batch_rows = %d
 		request_translator = "${snowflake_database.test_database.name}.${snowflake_schema.test_schema.name}.${snowflake_function.test_func_req_translator.name}"
 		response_translator = "${snowflake_database.test_database.name}.${snowflake_schema.test_schema.name}.${snowflake_function.test_func_res_translator.name}"
 		url_of_proxy_and_resource = "%s"
 	}
-	`, name, name, name, prefixes, name, url, name, url+"_2")
+	`, name, name, name, prefixes, name, url, name, comment, maxBatchRows, url+"_2")
 }
@@ -151,6 +151,73 @@ func TestExternalFunctionReadReturnTypeVariant(t *testing.T) {
 	})
 }
 
+func TestExternalFunctionReadTranslators(t *testing.T) {
+	r := require.New(t)
+
+	d := externalFunction(t, "database_name|schema_name|my_test_function|varchar", map[string]interface{}{
+		"name":                "my_test_function",
+		"request_translator":  "database_name.schema_name.request_translator",
+		"response_translator": "database_name.schema_name.old_response_translator",
+		"max_batch_rows":      100,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "description", "catalog_name", "is_external_function", "language"}).AddRow("now", "my_test_function", "schema_name", "mock comment", "database_name", "Y", "EXTERNAL")
+		mock.ExpectQuery(`SHOW EXTERNAL FUNCTIONS LIKE 'my_test_function' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
+
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("returns", "VARIANT").
+			AddRow("headers", "null").
+			AddRow("context_headers", "null").
+			AddRow("max_batch_rows", "not set").
+			AddRow("compression", "AUTO").
+			AddRow("request_translator", `"DATABASE_NAME"."SCHEMA_NAME"."REQUEST_TRANSLATOR"`).
+			AddRow("response_translator", `"DATABASE_NAME"."SCHEMA_NAME"."RESPONSE_TRANSLATOR"`)
+		mock.ExpectQuery(`DESCRIBE FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\)`).WillReturnRows(describeRows)
+
+		err := resources.ReadExternalFunction(d, db)
+		r.NoError(err)
+		r.Equal("database_name.schema_name.request_translator", d.Get("request_translator").(string))
+		r.Equal(`"DATABASE_NAME"."SCHEMA_NAME"."RESPONSE_TRANSLATOR"`, d.Get("response_translator").(string))
+		r.Equal(0, d.Get("max_batch_rows").(int))
+		r.Empty(d.Get("header").(*schema.Set).List())
+		r.Empty(d.Get("context_headers").([]interface{}))
+	})
+}
+
+func TestExternalFunctionUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := externalFunction(t, "database_name|schema_name|my_test_function|varchar", map[string]interface{}{
+		"name":                "my_test_function",
+		"database":            "database_name",
+		"schema":              "schema_name",
+		"max_batch_rows":      100,
+		"compression":         "GZIP",
+		"request_translator":  "database_name.schema_name.request_translator",
+		"comment":             "new comment",
+		"api_integration":     "test_api_integration_01",
+		"context_headers":     []interface{}{"current_timestamp"},
+		"return_type":         "varchar",
+		"return_behavior":     "IMMUTABLE",
+		"response_translator": "",
+	})
+	d.MarkNewResource()
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\) SET API_INTEGRATION = 'test_api_integration_01'`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\) SET CONTEXT_HEADERS = \(current_timestamp\)`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\) SET MAX_BATCH_ROWS = 100`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\) SET COMPRESSION = 'GZIP'`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\) SET REQUEST_TRANSLATOR = database_name.schema_name.request_translator`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER FUNCTION "database_name"."schema_name"."my_test_function" \(varchar\) SET COMMENT = 'new comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectExternalFunctionRead(mock)
+
+		err := resources.UpdateExternalFunction(d, db)
+		r.NoError(err)
+	})
+}
+
 func TestExternalFunctionDelete(t *testing.T) {
 	r := require.New(t)
 
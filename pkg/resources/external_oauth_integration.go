@@ -2,7 +2,9 @@ package resources
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
@@ -125,6 +127,7 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 	"scope_delimiter": {
 		Type:        schema.TypeString,
 		Optional:    true,
+		Computed:    true,
 		Description: "Specifies the scope delimiter in the authorization token.",
 	},
 	"comment": {
@@ -139,7 +142,7 @@ var oauthExternalIntegrationSchema = map[string]*schema.Schema{
 	},
 }
 
-// ExternalOauthIntegration returns a pointer to the resource representing a network policy.
+// ExternalOauthIntegration returns a pointer to the resource representing an external oauth integration.
 func ExternalOauthIntegration() *schema.Resource {
 	return &schema.Resource{
 		Description: "An External OAuth security integration allows a client to use a third-party authorization server to obtain the access tokens needed to interact with Snowflake.",
@@ -217,7 +220,7 @@ func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(ExternalOauthIntegrationID(&input.ExternalOauthIntegration3))
 
-	return nil
+	return ReadExternalOauthIntegration(d, meta)
 }
 
 // ReadExternalOauthIntegration implements schema.ReadFunc.
@@ -240,11 +243,13 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	row := snowflake.QueryRow(db, stmt)
-	if err != nil {
-		return fmt.Errorf("error querying external oauth integration: %w", err)
-	}
-
 	showOutput, err := manager.ParseShow(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] external oauth integration (%v) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("error parsing show result: %w", err)
 	}
@@ -261,9 +266,9 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("comment", showOutput.Comment.String); err != nil {
 		return fmt.Errorf("error setting comment: %w", err)
 	}
-	// if err := d.Set("created_on", showOutput.CreatedOn.String); err != nil {
-	// 	return fmt.Errorf("error setting created_on: %w", err)
-	// }
+	if err := d.Set("created_on", showOutput.CreatedOn.String); err != nil {
+		return fmt.Errorf("error setting created_on: %w", err)
+	}
 
 	// DESCRIBE
 	stmt, err = manager.ReadDescribe(input)
@@ -288,7 +293,7 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("jws_keys_urls", describeOutput.ExternalOauthJwsKeysURL); err != nil {
 		return fmt.Errorf("error setting jws_keys_urls: %w", err)
 	}
-	if err := d.Set("any_role_mode", describeOutput.ExternalOauthAnyRoleMode); err != nil {
+	if err := d.Set("any_role_mode", string(describeOutput.ExternalOauthAnyRoleMode)); err != nil {
 		return fmt.Errorf("error setting any_role_mode: %w", err)
 	}
 	if err := d.Set("rsa_public_key", describeOutput.ExternalOauthRsaPublicKey); err != nil {
@@ -317,14 +322,17 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("token_user_mapping_claims", describeOutput.ExternalOauthTokenUserMappingClaim); err != nil {
 		return fmt.Errorf("error setting token_user_mapping_claims: %w", err)
 	}
-	if err := d.Set("snowflake_user_mapping_attribute", describeOutput.ExternalOauthSnowflakeUserMappingAttribute); err != nil {
+	if err := d.Set("snowflake_user_mapping_attribute", string(describeOutput.ExternalOauthSnowflakeUserMappingAttribute)); err != nil {
 		return fmt.Errorf("error setting snowflake_user_mapping_attribute: %w", err)
 	}
 	if err := d.Set("scope_mapping_attribute", describeOutput.ExternalOauthScopeMappingAttribute); err != nil {
 		return fmt.Errorf("error setting scope_mapping_attribute: %w", err)
 	}
+	if err := d.Set("scope_delimiter", describeOutput.ExternalOauthScopeDelimiter); err != nil {
+		return fmt.Errorf("error setting scope_delimiter: %w", err)
+	}
 
-	return err
+	return nil
 }
 
 // UpdateExternalOauthIntegration implements schema.UpdateFunc.
@@ -352,26 +360,14 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("enabled") {
-		val, ok := d.GetOk("enabled")
-		if ok {
-			alterInput.Enabled = val.(bool)
-			alterInput.EnabledOk = true
-			runAlter = true
-		} else {
-			unsetInput.EnabledOk = true
-			runUnset = true
-		}
+		alterInput.Enabled = d.Get("enabled").(bool)
+		alterInput.EnabledOk = true
+		runAlter = true
 	}
 	if d.HasChange("type") {
-		val, ok := d.GetOk("type")
-		if ok {
-			alterInput.Type = val.(string)
-			alterInput.TypeOk = true
-			runAlter = true
-		} else {
-			unsetInput.TypeOk = true
-			runUnset = true
-		}
+		alterInput.ExternalOauthType = snowflake.ExternalOauthType(d.Get("type").(string))
+		alterInput.ExternalOauthTypeOk = true
+		runAlter = true
 	}
 	if d.HasChange("issuer") {
 		val, ok := d.GetOk("issuer")
@@ -398,7 +394,7 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("snowflake_user_mapping_attribute") {
 		val, ok := d.GetOk("snowflake_user_mapping_attribute")
 		if ok {
-			alterInput.ExternalOauthSnowflakeUserMappingAttribute = val.(snowflake.SFUserMappingAttribute)
+			alterInput.ExternalOauthSnowflakeUserMappingAttribute = snowflake.SFUserMappingAttribute(val.(string))
 			alterInput.ExternalOauthSnowflakeUserMappingAttributeOk = true
 			runAlter = true
 		} else {
@@ -475,7 +471,7 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("any_role_mode") {
 		val, ok := d.GetOk("any_role_mode")
 		if ok {
-			alterInput.ExternalOauthAnyRoleMode = val.(snowflake.AnyRoleMode)
+			alterInput.ExternalOauthAnyRoleMode = snowflake.AnyRoleMode(val.(string))
 			alterInput.ExternalOauthAnyRoleModeOk = true
 			runAlter = true
 		} else {
@@ -543,7 +539,7 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return nil
+	return ReadExternalOauthIntegration(d, meta)
 }
 
 // DeleteExternalOauthIntegration implements schema.DeleteFunc.
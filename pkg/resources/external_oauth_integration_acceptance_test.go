@@ -35,6 +35,13 @@ func TestAcc_ExternalOauthIntegration(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_external_oauth_integration.test", "token_user_mapping_claims.1", "upn"),
 				),
 			},
+			{
+				Config: externalOauthIntegrationConfig(oauthIntName, integrationType, issuer, "updated test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_oauth_integration.test", "name", oauthIntName),
+					resource.TestCheckResourceAttr("snowflake_external_oauth_integration.test", "comment", "updated test resource"),
+				),
+			},
 			{
 				ResourceName:      "snowflake_external_oauth_integration.test",
 				ImportState:       true,
@@ -0,0 +1,106 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalOauthIntegration(t *testing.T) {
+	r := require.New(t)
+	err := resources.ExternalOauthIntegration().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestExternalOauthIntegrationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                             "test_external_oauth",
+		"type":                             "AZURE",
+		"enabled":                          true,
+		"issuer":                           "https://sts.windows.net/tenant",
+		"token_user_mapping_claims":        []interface{}{"upn"},
+		"snowflake_user_mapping_attribute": "LOGIN_NAME",
+		"audience_urls":                    []interface{}{"https://analysis.windows.net/powerbi/connector/Snowflake"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.ExternalOauthIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECURITY INTEGRATION "test_external_oauth" type = 'EXTERNAL_OAUTH' enabled = true EXTERNAL_OAUTH_TYPE = 'AZURE' EXTERNAL_OAUTH_ISSUER = 'https://sts.windows.net/tenant' EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = \('upn'\) EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'LOGIN_NAME' EXTERNAL_OAUTH_AUDIENCE_LIST = \('https://analysis.windows.net/powerbi/connector/Snowflake'\) EXTERNAL_OAUTH_ANY_ROLE_MODE = 'DISABLE';$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadExternalOauthIntegration(mock)
+
+		err := resources.CreateExternalOauthIntegration(d, db)
+		r.NoError(err)
+		r.Equal("AZURE", d.Get("type").(string))
+		r.Equal("now", d.Get("created_on").(string))
+	})
+}
+
+func TestExternalOauthIntegrationUpdate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                             "test_external_oauth",
+		"type":                             "AZURE",
+		"enabled":                          true,
+		"issuer":                           "https://sts.windows.net/tenant",
+		"token_user_mapping_claims":        []interface{}{"upn"},
+		"snowflake_user_mapping_attribute": "EMAIL_ADDRESS",
+		"any_role_mode":                    "ENABLE",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ExternalOauthIntegration().Schema, in)
+	d.MarkNewResource()
+	d.SetId("test_external_oauth")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER SECURITY INTEGRATION "test_external_oauth" SET enabled = true EXTERNAL_OAUTH_TYPE = 'AZURE' EXTERNAL_OAUTH_ISSUER = 'https://sts.windows.net/tenant' EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM = \('upn'\) EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE = 'EMAIL_ADDRESS' EXTERNAL_OAUTH_ANY_ROLE_MODE = 'ENABLE';$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadExternalOauthIntegration(mock)
+
+		err := resources.UpdateExternalOauthIntegration(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestExternalOauthIntegrationReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ExternalOauthIntegration().Schema, map[string]interface{}{"name": "test_external_oauth"})
+	d.SetId("test_external_oauth")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_external_oauth';$`).WillReturnError(sql.ErrNoRows)
+
+		err := resources.ReadExternalOauthIntegration(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadExternalOauthIntegration(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	}).AddRow("test_external_oauth", "EXTERNAL_OAUTH - AZURE", "SECURITY", true, nil, "now")
+	mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_external_oauth';$`).WillReturnRows(showRows)
+
+	descRows := sqlmock.NewRows([]string{
+		"property", "property_type", "property_value", "property_default",
+	}).AddRow("EXTERNAL_OAUTH_ISSUER", "String", "https://sts.windows.net/tenant", "").
+		AddRow("EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM", "List", "['upn']", "[]").
+		AddRow("EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE", "String", "LOGIN_NAME", "LOGIN_NAME").
+		AddRow("EXTERNAL_OAUTH_BLOCKED_ROLES_LIST", "List", "ACCOUNTADMIN,SECURITYADMIN", "[]").
+		AddRow("EXTERNAL_OAUTH_ANY_ROLE_MODE", "String", "DISABLE", "DISABLE").
+		AddRow("EXTERNAL_OAUTH_SCOPE_DELIMITER", "String", ",", ",")
+	mock.ExpectQuery(`^DESCRIBE SECURITY INTEGRATION "test_external_oauth";$`).WillReturnRows(descRows)
+}
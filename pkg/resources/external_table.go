@@ -10,6 +10,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -71,10 +72,21 @@ var externalTableSchema = map[string]*schema.Schema{
 		Description: "Specifies a location for the external table.",
 	},
 	"file_format": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "Specifies the file format for the external table.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the file format for the external table.",
+		DiffSuppressFunc: externalTableFileFormatDiffSuppress,
+	},
+	"table_format": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{"DELTA"}, true),
+		StateFunc: func(v interface{}) string {
+			return strings.ToUpper(v.(string))
+		},
+		Description: "Specifies that the external table references a Delta Lake on the cloud storage location. The only supported value is DELTA.",
 	},
 	"pattern": {
 		Type:        schema.TypeString,
@@ -105,9 +117,18 @@ var externalTableSchema = map[string]*schema.Schema{
 	"auto_refresh": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		Description: "Specifies whether to automatically refresh the external table metadata once, immediately after the external table is created.",
+		Description: "Specifies whether Snowflake should enable triggering automatic refreshes of the external table metadata when new or updated data files are available in the external stage. Can be changed in place.",
 		Default:     true,
-		ForceNew:    true,
+	},
+	"refresh_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary value; whenever it changes, the external table metadata is refreshed manually with `ALTER EXTERNAL TABLE ... REFRESH`.",
+	},
+	"refresh_path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Relative path limiting the manual refresh triggered by `refresh_trigger` to a subset of the staged files.",
 	},
 	"copy_grants": {
 		Type:        schema.TypeBool,
@@ -189,6 +210,30 @@ func externalTableIDFromString(stringID string) (*externalTableID, error) {
 	return externalTableResult, nil
 }
 
+// normalizeExternalTableFileFormat upper-cases the file format definition, drops quotes and
+// collapses whitespace, so that equivalent definitions written differently compare as equal.
+func normalizeExternalTableFileFormat(fileFormat string) string {
+	fileFormat = strings.ToUpper(fileFormat)
+	fileFormat = strings.NewReplacer(`'`, "", `"`, "", "=", " = ").Replace(fileFormat)
+	return strings.Join(strings.Fields(fileFormat), " ")
+}
+
+func externalTableFileFormatDiffSuppress(_, o, n string, _ *schema.ResourceData) bool {
+	return normalizeExternalTableFileFormat(o) == normalizeExternalTableFileFormat(n)
+}
+
+// externalTableFileFormatMatches checks if the file format definition from the configuration
+// references the same file format name or type as returned by SHOW EXTERNAL TABLES.
+func externalTableFileFormatMatches(fileFormat string, externalTable *snowflake.ExternalTable) bool {
+	normalized := normalizeExternalTableFileFormat(fileFormat)
+	if externalTable.FileFormatName.String != "" {
+		nameParts := strings.Split(externalTable.FileFormatName.String, ".")
+		name := normalizeExternalTableFileFormat(nameParts[len(nameParts)-1])
+		return strings.Contains(normalized, "FORMAT_NAME") && strings.Contains(normalized, name)
+	}
+	return strings.Contains(normalized, normalizeExternalTableFileFormat("TYPE = "+externalTable.FileFormatType.String))
+}
+
 // CreateExternalTable implements schema.CreateFunc.
 func CreateExternalTable(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -229,6 +274,10 @@ func CreateExternalTable(d *schema.ResourceData, meta interface{}) error {
 		builder.WithAwsSNSTopic(v.(string))
 	}
 
+	if v, ok := d.GetOk("table_format"); ok {
+		builder.WithTableFormat(strings.ToUpper(v.(string)))
+	}
+
 	if v, ok := d.GetOk("comment"); ok {
 		builder.WithComment(v.(string))
 	}
@@ -288,6 +337,35 @@ func ReadExternalTable(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("owner", externalTable.Owner.String); err != nil {
 		return err
 	}
+
+	if err := d.Set("comment", externalTable.Comment.String); err != nil {
+		return err
+	}
+
+	// Snowflake returns only the file format name or type, so the configured definition is kept as long as it matches
+	if fileFormat := d.Get("file_format").(string); fileFormat == "" || !externalTableFileFormatMatches(fileFormat, externalTable) {
+		readFileFormat := ""
+		switch {
+		case externalTable.FileFormatName.String != "":
+			readFileFormat = fmt.Sprintf("FORMAT_NAME = '%s'", externalTable.FileFormatName.String)
+		case externalTable.FileFormatType.String != "":
+			readFileFormat = fmt.Sprintf("TYPE = %s", externalTable.FileFormatType.String)
+		}
+		if readFileFormat != "" {
+			if err := d.Set("file_format", readFileFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	tableFormat := ""
+	if strings.EqualFold(externalTable.TableFormat.String, "DELTA") {
+		tableFormat = "DELTA"
+	}
+	if err := d.Set("table_format", tableFormat); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -304,11 +382,25 @@ func UpdateExternalTable(d *schema.ResourceData, meta interface{}) error {
 		v := d.Get("tag")
 		tags := getTags(v)
 		builder.WithTags(tags.toSnowflakeTagValues())
+
+		stmt := builder.Update()
+		if err := snowflake.Exec(db, stmt); err != nil {
+			return fmt.Errorf("error updating externalTable %v err = %w", name, err)
+		}
 	}
 
-	stmt := builder.Update()
-	if err := snowflake.Exec(db, stmt); err != nil {
-		return fmt.Errorf("error updating externalTable %v err = %w", name, err)
+	if d.HasChange("auto_refresh") {
+		stmt := builder.ChangeAutoRefresh(d.Get("auto_refresh").(bool))
+		if err := snowflake.Exec(db, stmt); err != nil {
+			return fmt.Errorf("error updating auto_refresh on externalTable %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("refresh_trigger") {
+		stmt := builder.Refresh(d.Get("refresh_path").(string))
+		if err := snowflake.Exec(db, stmt); err != nil {
+			return fmt.Errorf("error refreshing externalTable %v err = %w", name, err)
+		}
 	}
 
 	externalTableID := &externalTableID{
@@ -40,6 +40,15 @@ func TestAcc_ExternalTable(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_external_table.test_table", "comment", "Terraform acceptance test"),
 				),
 			},
+			// disable auto refresh and refresh manually without recreating the table
+			{
+				Config: externalTableManualRefreshConfig(accName, bucketURL, roleName, acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_table.test_table", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_external_table.test_table", "auto_refresh", "false"),
+					resource.TestCheckResourceAttr("snowflake_external_table.test_table", "refresh_trigger", "1"),
+				),
+			},
 		},
 	})
 }
@@ -82,3 +91,44 @@ resource "snowflake_external_table" "test_table" {
 `
 	return fmt.Sprintf(s, name, bucketURL, roleName, name, bucketURL, databaseName, schemaName, name, databaseName, schemaName, databaseName, schemaName)
 }
+
+func externalTableManualRefreshConfig(name string, bucketURL string, roleName string, databaseName string, schemaName string) string {
+	s := `
+resource "snowflake_storage_integration" "i" {
+	name = "%v"
+	storage_allowed_locations = ["%s"]
+	storage_provider = "S3"
+	storage_aws_role_arn = "%s"
+}
+
+resource "snowflake_stage" "test" {
+	name = "%v"
+	url = "%s"
+	database = "%s"
+	schema = "%s"
+	storage_integration = snowflake_storage_integration.i.name
+}
+
+resource "snowflake_external_table" "test_table" {
+	name     = "%s"
+	database = "%s"
+	schema = "%s"
+	comment  = "Terraform acceptance test"
+	column {
+		name = "column1"
+		type = "STRING"
+		as   = "TO_VARCHAR(TO_TIMESTAMP_NTZ(value:unix_timestamp_property::NUMBER, 3), 'yyyy-mm-dd-hh')"
+	}
+	column {
+		name = "column2"
+		type = "TIMESTAMP_NTZ(9)"
+		as   = "($1:\"CreatedDate\"::timestamp)"
+	}
+  file_format     = "type = csv"
+  location        = "@\"%s\".\"%s\".\"${snowflake_stage.test.name}\""
+  auto_refresh    = false
+  refresh_trigger = "1"
+}
+`
+	return fmt.Sprintf(s, name, bucketURL, roleName, name, bucketURL, databaseName, schemaName, name, databaseName, schemaName, databaseName, schemaName)
+}
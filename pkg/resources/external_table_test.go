@@ -62,6 +62,53 @@ func TestExternalTableRead(t *testing.T) {
 	})
 }
 
+func TestExternalTableReadFileFormat(t *testing.T) {
+	expectRead := func(mock sqlmock.Sqlmock, fileFormatName string, fileFormatType string) {
+		rows := sqlmock.NewRows([]string{"name", "comment", "file_format_name", "file_format_type", "table_format"}).AddRow("good_name", "", fileFormatName, fileFormatType, "DELTA")
+		mock.ExpectQuery(`SHOW EXTERNAL TABLES LIKE 'good_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
+	}
+
+	t.Run("keeps equivalent file format name", func(t *testing.T) {
+		r := require.New(t)
+		d := externalTable(t, "database_name|schema_name|good_name", map[string]interface{}{"name": "good_name", "file_format": "format_name='my_format'"})
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectRead(mock, "MY_FORMAT", "")
+
+			err := resources.ReadExternalTable(d, db)
+			r.NoError(err)
+			r.Equal("format_name='my_format'", d.Get("file_format").(string))
+			r.Equal("DELTA", d.Get("table_format").(string))
+		})
+	})
+
+	t.Run("keeps equivalent file format type", func(t *testing.T) {
+		r := require.New(t)
+		d := externalTable(t, "database_name|schema_name|good_name", map[string]interface{}{"name": "good_name", "file_format": "type=parquet"})
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectRead(mock, "", "PARQUET")
+
+			err := resources.ReadExternalTable(d, db)
+			r.NoError(err)
+			r.Equal("type=parquet", d.Get("file_format").(string))
+		})
+	})
+
+	t.Run("detects a different file format", func(t *testing.T) {
+		r := require.New(t)
+		d := externalTable(t, "database_name|schema_name|good_name", map[string]interface{}{"name": "good_name", "file_format": "TYPE = CSV"})
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectRead(mock, "", "PARQUET")
+
+			err := resources.ReadExternalTable(d, db)
+			r.NoError(err)
+			r.Equal("TYPE = PARQUET", d.Get("file_format").(string))
+		})
+	})
+}
+
 func TestExternalTableDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -43,6 +43,7 @@ var formatTypeOptions = map[string][]string{
 		"empty_field_as_null",
 		"skip_byte_order_mark",
 		"encoding",
+		"multi_line",
 	},
 	"JSON": {
 		"compression",
@@ -60,6 +61,7 @@ var formatTypeOptions = map[string][]string{
 		"replace_invalid_characters",
 		"ignore_utf8_errors",
 		"skip_byte_order_mark",
+		"multi_line",
 	},
 	"AVRO": {
 		"compression",
@@ -75,6 +77,8 @@ var formatTypeOptions = map[string][]string{
 		"binary_as_text",
 		"trim_space",
 		"null_if",
+		"use_logical_type",
+		"use_vectorized_scanner",
 	},
 	"XML": {
 		"compression",
@@ -107,17 +111,19 @@ var fileFormatSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"format_type": {
-		Type:         schema.TypeString,
-		Required:     true,
-		Description:  "Specifies the format of the input files (for data loading) or output files (for data unloading).",
-		ForceNew:     true,
-		ValidateFunc: validation.StringInSlice([]string{"CSV", "JSON", "AVRO", "ORC", "PARQUET", "XML"}, true),
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the format of the input files (for data loading) or output files (for data unloading).",
+		ForceNew:         true,
+		ValidateFunc:     validation.StringInSlice([]string{"CSV", "JSON", "AVRO", "ORC", "PARQUET", "XML"}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
 	},
 	"compression": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Specifies the current compression algorithm for the data file.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Specifies the current compression algorithm for the data file.",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"record_delimiter": {
 		Type:        schema.TypeString,
@@ -152,40 +158,46 @@ var fileFormatSchema = map[string]*schema.Schema{
 		Description: "Boolean that specifies to skip any blank lines encountered in the data files.",
 	},
 	"date_format": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Defines the format of date values in the data files (data loading) or table (data unloading).",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Defines the format of date values in the data files (data loading) or table (data unloading).",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"time_format": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Defines the format of time values in the data files (data loading) or table (data unloading).",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Defines the format of time values in the data files (data loading) or table (data unloading).",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"timestamp_format": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Defines the format of timestamp values in the data files (data loading) or table (data unloading).",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Defines the format of timestamp values in the data files (data loading) or table (data unloading).",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"binary_format": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Defines the encoding format for binary input or output.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Defines the encoding format for binary input or output.",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"escape": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Single character string used as the escape character for field values.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Single character string used as the escape character for field values.",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"escape_unenclosed_field": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Single character string used as the escape character for unenclosed field values only.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Single character string used as the escape character for unenclosed field values only.",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"trim_space": {
 		Type:        schema.TypeBool,
@@ -193,10 +205,11 @@ var fileFormatSchema = map[string]*schema.Schema{
 		Description: "Boolean that specifies whether to remove white space from fields.",
 	},
 	"field_optionally_enclosed_by": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "Character used to enclose strings.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "Character used to enclose strings.",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"null_if": {
 		Type:        schema.TypeList,
@@ -226,10 +239,11 @@ var fileFormatSchema = map[string]*schema.Schema{
 		Description: "Boolean that specifies whether to skip the BOM (byte order mark), if present in a data file.",
 	},
 	"encoding": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Computed:    true,
-		Description: "String (constant) that specifies the character set of the source data when loading data into a table.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "String (constant) that specifies the character set of the source data when loading data into a table.",
+		DiffSuppressFunc: diffFileFormatOption,
 	},
 	"enable_octal": {
 		Type:        schema.TypeBool,
@@ -281,6 +295,22 @@ var fileFormatSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Boolean that specifies whether the XML parser disables automatic conversion of numeric and Boolean values from text to native representation.",
 	},
+	"multi_line": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Boolean that specifies whether multiple lines are allowed in a single record, e.g. in a quoted field or a JSON document.",
+	},
+	"use_logical_type": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Boolean that specifies whether to use Parquet logical types when loading data.",
+	},
+	"use_vectorized_scanner": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Boolean that specifies whether to use a vectorized scanner for loading Parquet files.",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -288,6 +318,34 @@ var fileFormatSchema = map[string]*schema.Schema{
 	},
 }
 
+// fileFormatCanonicalOptions are the values Snowflake returns for options that are case-insensitive, e.g. GZIP for gzip, keyed by the spelled alternatives.
+var fileFormatCanonicalOptions = map[string]string{
+	"UTF-8":  "UTF8",
+	"UTF-16": "UTF16",
+	"UTF-32": "UTF32",
+}
+
+// diffFileFormatOption suppresses the differences between an option and the canonical form Snowflake returns for it.
+func diffFileFormatOption(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	canonical := func(v string) string {
+		v = strings.ToUpper(v)
+		if c, ok := fileFormatCanonicalOptions[v]; ok {
+			return c
+		}
+		return v
+	}
+	switch k {
+	case "date_format", "time_format", "timestamp_format":
+		// formats are case-sensitive, apart from the AUTO keyword
+		return oldValue == newValue || (strings.EqualFold(oldValue, "AUTO") && strings.EqualFold(newValue, "AUTO"))
+	case "escape", "escape_unenclosed_field", "field_optionally_enclosed_by":
+		// the single characters are case-sensitive, only the NONE keyword is not
+		return oldValue == newValue || (strings.EqualFold(oldValue, "NONE") && strings.EqualFold(newValue, "NONE"))
+	default:
+		return canonical(oldValue) == canonical(newValue)
+	}
+}
+
 type fileFormatID struct {
 	DatabaseName   string
 	SchemaName     string
@@ -332,7 +390,7 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 	id := sdk.NewSchemaObjectIdentifier(dbName, schemaName, fileFormatName)
 
 	opts := sdk.CreateFileFormatOptions{
-		Type:                  sdk.FileFormatType(d.Get("format_type").(string)),
+		Type:                  sdk.FileFormatType(strings.ToUpper(d.Get("format_type").(string))),
 		FileFormatTypeOptions: sdk.FileFormatTypeOptions{},
 	}
 
@@ -397,6 +455,7 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 			enc := sdk.CSVEncoding(v.(string))
 			opts.CSVEncoding = &enc
 		}
+		opts.CSVMultiLine = sdk.Bool(d.Get("multi_line").(bool))
 	case sdk.FileFormatTypeJSON:
 		if v, ok := d.GetOk("compression"); ok {
 			comp := sdk.JSONCompression(v.(string))
@@ -438,6 +497,7 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 		opts.JSONReplaceInvalidCharacters = sdk.Bool(d.Get("replace_invalid_characters").(bool))
 		opts.JSONIgnoreUTF8Errors = sdk.Bool(d.Get("ignore_utf8_errors").(bool))
 		opts.JSONSkipByteOrderMark = sdk.Bool(d.Get("skip_byte_order_mark").(bool))
+		opts.JSONMultiLine = sdk.Bool(d.Get("multi_line").(bool))
 	case sdk.FileFormatTypeAvro:
 		if v, ok := d.GetOk("compression"); ok {
 			comp := sdk.AvroCompression(v.(string))
@@ -489,6 +549,12 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 			}
 			opts.ParquetNullIf = &nullIf
 		}
+		if v, ok := d.GetOk("use_logical_type"); ok {
+			opts.ParquetUseLogicalType = sdk.Bool(v.(bool))
+		}
+		if v, ok := d.GetOk("use_vectorized_scanner"); ok {
+			opts.ParquetUseVectorizedScanner = sdk.Bool(v.(bool))
+		}
 	case sdk.FileFormatTypeXML:
 		if v, ok := d.GetOk("compression"); ok {
 			comp := sdk.XMLCompression(v.(string))
@@ -627,6 +693,9 @@ func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("encoding", fileFormat.Options.CSVEncoding); err != nil {
 			return err
 		}
+		if err := d.Set("multi_line", fileFormat.Options.CSVMultiLine); err != nil {
+			return err
+		}
 	case sdk.FileFormatTypeJSON:
 		if err := d.Set("compression", fileFormat.Options.JSONCompression); err != nil {
 			return err
@@ -677,6 +746,9 @@ func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("skip_byte_order_mark", fileFormat.Options.JSONSkipByteOrderMark); err != nil {
 			return err
 		}
+		if err := d.Set("multi_line", fileFormat.Options.JSONMultiLine); err != nil {
+			return err
+		}
 	case sdk.FileFormatTypeAvro:
 		if err := d.Set("compression", fileFormat.Options.AvroCompression); err != nil {
 			return err
@@ -719,6 +791,12 @@ func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("null_if", nullIf); err != nil {
 			return err
 		}
+		if err := d.Set("use_logical_type", fileFormat.Options.ParquetUseLogicalType); err != nil {
+			return err
+		}
+		if err := d.Set("use_vectorized_scanner", fileFormat.Options.ParquetUseVectorizedScanner); err != nil {
+			return err
+		}
 	case sdk.FileFormatTypeXML:
 		if err := d.Set("compression", fileFormat.Options.XMLCompression); err != nil {
 			return err
@@ -778,9 +856,9 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 		id = newId
 	}
 
-	opts := sdk.AlterFileFormatOptions{}
+	opts := sdk.AlterFileFormatOptions{Set: &sdk.FileFormatTypeOptions{}}
 
-	switch d.Get("format_type") {
+	switch sdk.FileFormatType(strings.ToUpper(d.Get("format_type").(string))) {
 	case sdk.FileFormatTypeCSV:
 		if d.HasChange("compression") {
 			v := sdk.CSVCompression(d.Get("compression").(string))
@@ -874,6 +952,10 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 			v := sdk.CSVEncoding(d.Get("encoding").(string))
 			opts.Set.CSVEncoding = &v
 		}
+		if d.HasChange("multi_line") {
+			v := d.Get("multi_line").(bool)
+			opts.Set.CSVMultiLine = &v
+		}
 	case sdk.FileFormatTypeJSON:
 		if d.HasChange("compression") {
 			comp := sdk.JSONCompression(d.Get("compression").(string))
@@ -943,6 +1025,10 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 			v := d.Get("skip_byte_order_mark").(bool)
 			opts.Set.JSONSkipByteOrderMark = &v
 		}
+		if d.HasChange("multi_line") {
+			v := d.Get("multi_line").(bool)
+			opts.Set.JSONMultiLine = &v
+		}
 	case sdk.FileFormatTypeAvro:
 		if d.HasChange("compression") {
 			comp := sdk.AvroCompression(d.Get("compression").(string))
@@ -1006,6 +1092,14 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 			}
 			opts.Set.ParquetNullIf = &nullIf
 		}
+		if d.HasChange("use_logical_type") {
+			v := d.Get("use_logical_type").(bool)
+			opts.Set.ParquetUseLogicalType = &v
+		}
+		if d.HasChange("use_vectorized_scanner") {
+			v := d.Get("use_vectorized_scanner").(bool)
+			opts.Set.ParquetUseVectorizedScanner = &v
+		}
 	case sdk.FileFormatTypeXML:
 		if d.HasChange("compression") {
 			comp := sdk.XMLCompression(d.Get("compression").(string))
@@ -1037,10 +1131,16 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	err = client.FileFormats.Alter(ctx, id, &opts)
+	if d.HasChange("comment") {
+		v := d.Get("comment").(string)
+		opts.Set.Comment = &v
+	}
 
-	if err != nil {
-		return err
+	// renaming alone leaves nothing to set
+	if (*opts.Set != sdk.FileFormatTypeOptions{}) {
+		if err := client.FileFormats.Alter(ctx, id, &opts); err != nil {
+			return err
+		}
 	}
 
 	return ReadFileFormat(d, meta)
@@ -363,6 +363,56 @@ func TestAcc_FileFormatXMLDefaults(t *testing.T) {
 	})
 }
 
+// TestAcc_FileFormatCanonicalOptions checks that options spelled differently than Snowflake returns them do not show up as differences, and that options are updated in place.
+func TestAcc_FileFormatCanonicalOptions(t *testing.T) {
+	accName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: fileFormatConfigCanonicalOptions(accName, acc.TestDatabaseName, acc.TestSchemaName, false, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_file_format.test", "format_type", "CSV"),
+					resource.TestCheckResourceAttr("snowflake_file_format.test", "compression", "GZIP"),
+					resource.TestCheckResourceAttr("snowflake_file_format.test", "binary_format", "HEX"),
+					resource.TestCheckResourceAttr("snowflake_file_format.test", "multi_line", "false"),
+				),
+			},
+			{
+				Config: fileFormatConfigCanonicalOptions(accName, acc.TestDatabaseName, acc.TestSchemaName, true, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_file_format.test", "multi_line", "true"),
+					resource.TestCheckResourceAttr("snowflake_file_format.test", "comment", "second"),
+				),
+			},
+			// no differences after the apply
+			{
+				Config:   fileFormatConfigCanonicalOptions(accName, acc.TestDatabaseName, acc.TestSchemaName, true, "second"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func fileFormatConfigCanonicalOptions(n string, databaseName string, schemaName string, multiLine bool, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_file_format" "test" {
+	name = "%v"
+	database = "%s"
+	schema = "%s"
+	format_type = "csv"
+	compression = "gzip"
+	binary_format = "hex"
+	date_format = "auto"
+	multi_line = %t
+	comment = "%s"
+}
+`, n, databaseName, schemaName, multiLine, comment)
+}
+
 func fileFormatConfigCSV(n string, databaseName string, schemaName string) string {
 	return fmt.Sprintf(`
 resource "snowflake_file_format" "test" {
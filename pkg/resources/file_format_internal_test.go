@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffFileFormatOption(t *testing.T) {
+	testCases := map[string]struct {
+		key      string
+		old      string
+		new      string
+		expected bool
+	}{
+		"compression case":         {key: "compression", old: "GZIP", new: "gzip", expected: true},
+		"compression changed":      {key: "compression", old: "GZIP", new: "BZ2", expected: false},
+		"encoding spelling":        {key: "encoding", old: "UTF8", new: "utf-8", expected: true},
+		"encoding changed":         {key: "encoding", old: "UTF8", new: "UTF-16", expected: false},
+		"binary format case":       {key: "binary_format", old: "HEX", new: "hex", expected: true},
+		"date format auto":         {key: "date_format", old: "AUTO", new: "auto", expected: true},
+		"date format case":         {key: "date_format", old: "YYYY-MM-DD", new: "yyyy-mm-dd", expected: false},
+		"escape none":              {key: "escape", old: "NONE", new: "none", expected: true},
+		"escape character case":    {key: "escape_unenclosed_field", old: "A", new: "a", expected: false},
+		"enclosed by unchanged":    {key: "field_optionally_enclosed_by", old: "'", new: "'", expected: true},
+		"enclosed by none changed": {key: "field_optionally_enclosed_by", old: "NONE", new: "'", expected: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffFileFormatOption(tc.key, tc.old, tc.new, nil))
+		})
+	}
+}
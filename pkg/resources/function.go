@@ -1,7 +1,9 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -9,11 +11,12 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var languages = []string{"javascript", "java", "sql", "python"}
+var languages = []string{"javascript", "java", "scala", "sql", "python"}
 
 var functionSchema = map[string]*schema.Schema{
 	"name": {
@@ -59,16 +62,16 @@ var functionSchema = map[string]*schema.Schema{
 	},
 	"return_type": {
 		Type:        schema.TypeString,
-		Description: "The return type of the function",
+		Description: "The return type of the function. For table functions (UDTFs) use `TABLE (<col_name> <col_type>, ...)`.",
 		// Suppress the diff shown if the values are equal when both compared in lower case.
-		DiffSuppressFunc: DiffTypes,
+		DiffSuppressFunc: diffFunctionReturnType,
 		Required:         true,
 		ForceNew:         true,
 	},
 	"statement": {
 		Type:             schema.TypeString,
-		Required:         true,
-		Description:      "Specifies the javascript / java / sql / python code used to create the function.",
+		Optional:         true,
+		Description:      "Specifies the javascript / java / scala / sql / python code used to create the function. Can be omitted for Java / Scala functions whose handler is in a staged jar listed in `imports`.",
 		ForceNew:         true,
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
@@ -76,8 +79,11 @@ var functionSchema = map[string]*schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
 		ForceNew:     true,
-		ValidateFunc: validation.StringInSlice(languages, false),
+		ValidateFunc: validation.StringInSlice(languages, true),
 		Description:  "The language of the statement",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
 	},
 	"null_input_behavior": {
 		Type:     schema.TypeString,
@@ -112,7 +118,7 @@ var functionSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
-		Description: "Required for Python functions. Specifies Python runtime version.",
+		Description: "Required for Python and Scala functions. Specifies the Python, Java or Scala runtime version, e.g. 3.10, 11 or 2.12.",
 	},
 	"packages": {
 		Type: schema.TypeList,
@@ -121,7 +127,7 @@ var functionSchema = map[string]*schema.Schema{
 		},
 		Optional:    true,
 		ForceNew:    true,
-		Description: "List of package imports to use for Java / Python functions. For Java, package imports should be of the form: package_name:version_number, where package_name is snowflake_domain:package. For Python use it should be: ('numpy','pandas','xgboost==1.5.0').",
+		Description: "List of package imports to use for Java / Scala / Python functions. For Java, package imports should be of the form: package_name:version_number, where package_name is snowflake_domain:package. For Python use it should be: ('numpy','pandas','xgboost==1.5.0').",
 	},
 	"imports": {
 		Type: schema.TypeList,
@@ -130,13 +136,13 @@ var functionSchema = map[string]*schema.Schema{
 		},
 		Optional:    true,
 		ForceNew:    true,
-		Description: "Imports for Java / Python functions. For Java this a list of jar files, for Python this is a list of Python files.",
+		Description: "Imports for Java / Scala / Python functions. For Java and Scala this a list of staged jar files (e.g. `@my_stage/handler.jar`), for Python this is a list of Python files.",
 	},
 	"handler": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
-		Description: "The handler method for Java / Python function.",
+		Description: "The handler method for Java / Scala / Python function, e.g. `MyClass.myMethod`. For table functions it is the handler class.",
 	},
 	"target_path": {
 		Type:        schema.TypeString,
@@ -144,6 +150,32 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The target path for the Java / Python functions. For Java, it is the path of compiled jar files and for the Python it is the path of the Python files.",
 	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The names of the external access integrations needed in order for the Java / Python handler code to access external networks.",
+	},
+	"secrets": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The secrets the Java / Python handler code can read, bound to the variable names used to retrieve them in the code. Requires `external_access_integrations` allowing the secrets.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name used to retrieve the secret in the handler code.",
+				},
+				"secret_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Identifier of the secret. Note: format must follow: \"databaseName\".\"schemaName\".\"secretName\" or \"databaseName.schemaName.secretName\" or \"databaseName|schemaName.secretName\"",
+					ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+				},
+			},
+		},
+	},
 }
 
 // Function returns a pointer to the resource representing a stored function.
@@ -158,19 +190,42 @@ func Function() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateStatementRequired(d, "functions")
+		},
+	}
+}
+
+// validateStatementRequired requires the statement, unless the handler of the Java, Scala or Python code is in one
+// of the imports. The statement is not checked while it or the imports are unknown.
+func validateStatementRequired(d *schema.ResourceDiff, objects string) error {
+	if !d.NewValueKnown("statement") || !d.NewValueKnown("imports") || d.Get("statement").(string) != "" {
+		return nil
 	}
+	language := strings.ToUpper(d.Get("language").(string))
+	switch language {
+	case "JAVA", "SCALA", "PYTHON":
+		if len(d.Get("imports").([]interface{})) > 0 {
+			return nil
+		}
+		return fmt.Errorf("statement is required for %s %s without imports", language, objects)
+	case "":
+		language = "SQL"
+	}
+	return fmt.Errorf("statement is required for %s %s", language, objects)
 }
 
 // CreateFunction implements schema.CreateFunc.
 func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
-	schema := d.Get("schema").(string)
+	schemaName := d.Get("schema").(string)
 	database := d.Get("database").(string)
 	s := d.Get("statement").(string)
 	ret := d.Get("return_type").(string)
 
-	builder := snowflake.NewFunctionBuilder(database, schema, name, []string{}).WithStatement(s).WithReturnType(ret)
+	builder := snowflake.NewFunctionBuilder(database, schemaName, name, []string{}).WithStatement(s).WithReturnType(ret)
 
 	// Set optionals, args
 	if _, ok := d.GetOk("arguments"); ok {
@@ -242,6 +297,15 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 		builder.WithTargetPath(v.(string))
 	}
 
+	// external access for Java / Python
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandStringList(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(expandFunctionSecrets(v.(*schema.Set).List()))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -252,7 +316,7 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 
 	functionID := &functionID{
 		DatabaseName: database,
-		SchemaName:   schema,
+		SchemaName:   schemaName,
 		FunctionName: name,
 		ArgTypes:     builder.ArgTypes(),
 	}
@@ -329,18 +393,11 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 				return err
 			}
 		case "returns":
-			// Format in Snowflake DB is returnType(<some number>)
-			re := regexp.MustCompile(`^(.*)\([0-9]*\)$`)
-			match := re.FindStringSubmatch(desc.Value.String)
-			rt := desc.Value.String
-			if match != nil {
-				rt = match[1]
-			}
-			if err := d.Set("return_type", rt); err != nil {
+			if err := d.Set("return_type", readFunctionReturnType(desc.Value.String)); err != nil {
 				return err
 			}
 		case "language":
-			if snowflake.Contains(languages, desc.Value.String) {
+			if snowflake.Contains(languages, strings.ToLower(desc.Value.String)) {
 				if err := d.Set("language", desc.Value.String); err != nil {
 					return err
 				}
@@ -373,6 +430,21 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("runtime_version", desc.Value.String); err != nil {
 				return err
 			}
+		case "external_access_integrations":
+			integrations := parseFunctionExternalAccessIntegrations(desc.Value.String, d.Get("external_access_integrations").(*schema.Set).List())
+			if err := d.Set("external_access_integrations", integrations); err != nil {
+				return err
+			}
+		case "secrets":
+			secrets, err := parseFunctionSecrets(desc.Value.String, d.Get("secrets").(*schema.Set).List())
+			if err != nil {
+				return err
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
+		case "installed_packages":
+			// the resolved versions of all the packages, including the transitive ones, are not managed
 		default:
 			log.Printf("[WARN] unexpected function property %v returned from Snowflake", desc.Property.String)
 		}
@@ -493,9 +565,125 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("external_access_integrations") {
+		q, err := builder.ChangeExternalAccessIntegrations(expandStringList(d.Get("external_access_integrations").(*schema.Set).List()))
+		if err != nil {
+			return err
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating external access integrations for function %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("secrets") {
+		q, err := builder.ChangeSecrets(expandFunctionSecrets(d.Get("secrets").(*schema.Set).List()))
+		if err != nil {
+			return err
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating secrets for function %v err = %w", d.Id(), err)
+		}
+	}
+
 	return ReadFunction(d, meta)
 }
 
+var functionReturnTypeLengthRegexp = regexp.MustCompile(`\([0-9]+(,\s*[0-9]+)?\)`)
+
+// readFunctionReturnType strips the lengths Snowflake adds to the returned types, e.g. VARCHAR(16777216)
+// or TABLE (NAME VARCHAR(16777216), AGE NUMBER(38,0)).
+func readFunctionReturnType(returns string) string {
+	if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(returns)), "TABLE") {
+		return functionReturnTypeLengthRegexp.ReplaceAllString(returns, "")
+	}
+	// Format in Snowflake DB is returnType(<some number>)
+	re := regexp.MustCompile(`^(.*)\([0-9]*\)$`)
+	if match := re.FindStringSubmatch(returns); match != nil {
+		return match[1]
+	}
+	return returns
+}
+
+func normalizeFunctionReturnType(returnType string) string {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(returnType), " "))
+	normalized = strings.ReplaceAll(normalized, "TABLE(", "TABLE (")
+	// the lengths are not read back for the columns of table functions, so the configured ones are ignored too
+	if strings.HasPrefix(normalized, "TABLE") {
+		normalized = functionReturnTypeLengthRegexp.ReplaceAllString(normalized, "")
+	}
+	normalized = strings.ReplaceAll(normalized, "( ", "(")
+	normalized = strings.ReplaceAll(normalized, " )", ")")
+	return strings.ReplaceAll(normalized, " ,", ",")
+}
+
+// diffFunctionReturnType suppresses the differences in case and whitespace of the return types, including the
+// column lists of table functions.
+func diffFunctionReturnType(_, o, n string, _ *schema.ResourceData) bool {
+	return normalizeFunctionReturnType(o) == normalizeFunctionReturnType(n)
+}
+
+func expandFunctionSecrets(secrets []interface{}) []snowflake.FunctionSecret {
+	expanded := make([]snowflake.FunctionSecret, len(secrets))
+	for i, s := range secrets {
+		secret := s.(map[string]interface{})
+		expanded[i] = snowflake.FunctionSecret{
+			VariableName: secret["secret_variable_name"].(string),
+			SecretID:     snowflakeValidation.ParseAndFormatFullyQualifiedObectID(secret["secret_id"].(string)),
+		}
+	}
+	return expanded
+}
+
+// parseFunctionExternalAccessIntegrations parses the [INTEGRATION_A,INTEGRATION_B] list returned by DESCRIBE FUNCTION,
+// keeping the configured spelling of the names that differ only in case.
+func parseFunctionExternalAccessIntegrations(value string, configured []interface{}) []interface{} {
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"))
+	integrations := []interface{}{}
+	if value == "" {
+		return integrations
+	}
+	for _, integration := range strings.Split(value, ",") {
+		integration = strings.Trim(strings.TrimSpace(integration), `"`)
+		for _, c := range configured {
+			if strings.EqualFold(c.(string), integration) {
+				integration = c.(string)
+				break
+			}
+		}
+		integrations = append(integrations, integration)
+	}
+	return integrations
+}
+
+// parseFunctionSecrets parses the {"variable":"\"DB\".\"SCHEMA\".\"SECRET\""} object returned by DESCRIBE FUNCTION,
+// keeping the configured format of the secret identifiers pointing to the same secret.
+func parseFunctionSecrets(value string, configured []interface{}) ([]interface{}, error) {
+	secrets := []interface{}{}
+	if strings.TrimSpace(value) == "" {
+		return secrets, nil
+	}
+	parsed := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, fmt.Errorf("error parsing function secrets %v err = %w", value, err)
+	}
+	for variableName, secretID := range parsed {
+		secret := map[string]interface{}{
+			"secret_variable_name": variableName,
+			"secret_id":            secretID,
+		}
+		for _, c := range configured {
+			configuredSecret := c.(map[string]interface{})
+			configuredID := snowflakeValidation.ParseAndFormatFullyQualifiedObectID(configuredSecret["secret_id"].(string))
+			if configuredSecret["secret_variable_name"] == variableName && strings.EqualFold(configuredID, snowflakeValidation.ParseAndFormatFullyQualifiedObectID(secretID)) {
+				secret["secret_id"] = configuredSecret["secret_id"]
+				break
+			}
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
 // DeleteFunction implements schema.DeleteFunc.
 func DeleteFunction(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
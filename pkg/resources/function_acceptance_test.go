@@ -131,3 +131,60 @@ EOT
 	}
 	`, name, databaseName, schemaName, name, databaseName, schemaName, name, databaseName, schemaName, name, databaseName, schemaName)
 }
+
+func TestAcc_Function_JavaTableFunction(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_FUNCTION_TESTS"); ok {
+		t.Skip("Skipping TestAcc_Function_JavaTableFunction")
+	}
+
+	functName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: functionJavaTableFunctionConfig(functName, acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_function.test_funct_java_udtf", "name", functName),
+					resource.TestCheckResourceAttr("snowflake_function.test_funct_java_udtf", "language", "java"),
+					resource.TestCheckResourceAttr("snowflake_function.test_funct_java_udtf", "return_type", "table (word varchar)"),
+					resource.TestCheckResourceAttr("snowflake_function.test_funct_java_udtf", "handler", "Splitter"),
+				),
+			},
+		},
+	})
+}
+
+func functionJavaTableFunctionConfig(name string, databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_function" "test_funct_java_udtf" {
+		name     = "%s"
+		database = "%s"
+		schema   = "%s"
+		arguments {
+			name = "sentence"
+			type = "varchar"
+		}
+		return_type = "table (word varchar)"
+		language    = "java"
+		handler     = "Splitter"
+		statement   = <<EOT
+import java.util.stream.Stream;
+
+class OutputRow {
+  public String word;
+  public OutputRow(String word) { this.word = word; }
+}
+
+class Splitter {
+  public static Class getOutputClass() { return OutputRow.class; }
+  public Stream<OutputRow> process(String sentence) {
+    return Stream.of(sentence.split(" ")).map(OutputRow::new);
+  }
+}
+EOT
+	}
+	`, name, databaseName, schemaName)
+}
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFunctionReturnType(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("VARCHAR", readFunctionReturnType("VARCHAR(16777216)"))
+	r.Equal("NUMBER(38,0)", readFunctionReturnType("NUMBER(38,0)"))
+	r.Equal("TABLE (WORD VARCHAR, POSITION NUMBER)", readFunctionReturnType("TABLE (WORD VARCHAR(16777216), POSITION NUMBER(38,0))"))
+	r.Equal("TABLE (PRICE NUMBER, RATIO FLOAT)", readFunctionReturnType("TABLE (PRICE NUMBER(10, 2), RATIO FLOAT)"))
+}
+
+func TestDiffFunctionReturnType(t *testing.T) {
+	r := require.New(t)
+
+	r.True(diffFunctionReturnType("", "VARCHAR", "varchar", nil))
+	r.True(diffFunctionReturnType("", "TABLE (WORD VARCHAR, POSITION NUMBER)", "table(word varchar,  position number )", nil))
+	r.True(diffFunctionReturnType("", "TABLE (WORD VARCHAR, POSITION NUMBER)", "TABLE (WORD VARCHAR(100), POSITION NUMBER(38,0))", nil))
+	r.False(diffFunctionReturnType("", "TABLE (WORD VARCHAR)", "TABLE (WORD NUMBER)", nil))
+}
+
+func TestFunctionStatementRequired(t *testing.T) {
+	diff := func(config map[string]interface{}) error {
+		config["name"] = "fn"
+		config["database"] = "db"
+		config["schema"] = "schema"
+		config["return_type"] = "VARCHAR"
+		_, err := Function().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.ErrorContains(t, diff(map[string]interface{}{}), "statement is required for SQL functions")
+	require.ErrorContains(t, diff(map[string]interface{}{"language": "javascript"}), "statement is required for JAVASCRIPT functions")
+	require.ErrorContains(t, diff(map[string]interface{}{"language": "java", "handler": "Handler.run"}), "statement is required for JAVA functions without imports")
+	require.NoError(t, diff(map[string]interface{}{"language": "java", "handler": "Handler.run", "imports": []interface{}{"@stage/handler.jar"}}))
+	require.NoError(t, diff(map[string]interface{}{"language": "sql", "statement": "SELECT 1"}))
+}
@@ -112,6 +112,43 @@ func TestFunctionRead(t *testing.T) {
 	})
 }
 
+func TestFunctionReadExternalAccess(t *testing.T) {
+	r := require.New(t)
+
+	d := prepDummyFunctionResource(t)
+	r.NoError(d.Set("external_access_integrations", []interface{}{"eai_a"}))
+	r.NoError(d.Set("secrets", []interface{}{map[string]interface{}{"secret_variable_name": "cred", "secret_id": "my_db.my_schema.my_secret"}}))
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure"}).
+			AddRow("now", "my_funct", "my_schema", "N", "N", "N", "1", "1", "MY_TEST_FUNCTION(VARCHAR) RETURN VARCHAR", "mock comment", "my_db", "N", "N", "N")
+		mock.ExpectQuery(`SHOW USER FUNCTIONS LIKE 'my_funct' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
+
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "(data VARCHAR, event_dt DATE)").
+			AddRow("returns", "VARCHAR(123456789)").
+			AddRow("language", "PYTHON").
+			AddRow("body", functionBody).
+			AddRow("external_access_integrations", "[EAI_A,EAI_B]").
+			AddRow("secrets", `{"cred":"\"MY_DB\".\"MY_SCHEMA\".\"MY_SECRET\""}`).
+			AddRow("installed_packages", "['numpy==1.24.3','pandas==2.0.3']")
+		mock.ExpectQuery(`DESCRIBE FUNCTION "my_db"."my_schema"."my_funct"\(VARCHAR, DATE\)`).WillReturnRows(describeRows)
+
+		err := resources.ReadFunction(d, db)
+		r.NoError(err)
+
+		integrations := d.Get("external_access_integrations").(*schema.Set)
+		r.Equal(2, integrations.Len())
+		r.True(integrations.Contains("eai_a"))
+		r.True(integrations.Contains("EAI_B"))
+
+		secrets := d.Get("secrets").(*schema.Set).List()
+		r.Len(secrets, 1)
+		r.Equal("cred", secrets[0].(map[string]interface{})["secret_variable_name"])
+		r.Equal("my_db.my_schema.my_secret", secrets[0].(map[string]interface{})["secret_id"])
+	})
+}
+
 func TestFunctionDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -1,6 +1,10 @@
 package resources
 
-import "golang.org/x/exp/slices"
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
 
 // borrowed from https://github.com/terraform-providers/terraform-provider-aws/blob/master/aws/structure.go#L924:6
 
@@ -71,20 +75,22 @@ func reorderStringList(configured []string, actual []string) []string {
 	// This is necessary because the actual list may not be saved in the same order as the configured list
 	// The actual list may not be the same size as the configured list and may contain items not in the configured list
 
-	// Create a map of the actual list
+	// Create a map of the actual list, Snowflake returns the identifiers upper-cased
 	actualMap := make(map[string]bool)
 	for _, v := range actual {
-		actualMap[v] = true
+		actualMap[strings.ToUpper(v)] = true
 	}
+	configuredMap := make(map[string]bool)
 	reorderedList := make([]string, 0)
 	for _, v := range configured {
-		if _, ok := actualMap[v]; ok {
+		configuredMap[strings.ToUpper(v)] = true
+		if _, ok := actualMap[strings.ToUpper(v)]; ok {
 			reorderedList = append(reorderedList, v)
 		}
 	}
 	// add any items in the actual list that are not in the configured list to the end
 	for _, v := range actual {
-		if _, ok := actualMap[v]; !ok {
+		if _, ok := configuredMap[strings.ToUpper(v)]; !ok {
 			reorderedList = append(reorderedList, v)
 		}
 	}
@@ -26,3 +26,13 @@ func TestExpandBlankStringList(t *testing.T) {
 
 	r.Equal(0, len(out))
 }
+
+func TestReorderStringList(t *testing.T) {
+	r := require.New(t)
+
+	out := reorderStringList([]string{"org.b", "org.a"}, []string{"ORG.A", "ORG.B", "ORG.C"})
+	r.Equal([]string{"org.b", "org.a", "ORG.C"}, out)
+
+	out = reorderStringList([]string{"org.a"}, []string{})
+	r.Empty(out)
+}
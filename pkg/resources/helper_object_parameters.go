@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// objectParameterAttributes maps the attributes exposing object parameters on the resources to the object parameters.
+// An attribute holding its zero value ("" or -1) means the parameter is not set on the object itself and is inherited.
+var objectParameterAttributes = map[string]sdk.ObjectParameter{
+	"max_data_extension_time_in_days": sdk.ObjectParameterMaxDataExtensionTimeInDays,
+	"default_ddl_collation":           sdk.ObjectParameterDefaultDDLCollation,
+	"log_level":                       sdk.ObjectParameterLogLevel,
+	"trace_level":                     sdk.ObjectParameterTraceLevel,
+}
+
+var (
+	logLevels = []string{
+		string(sdk.LogLevelTrace), string(sdk.LogLevelDebug), string(sdk.LogLevelInfo), string(sdk.LogLevelWarn),
+		string(sdk.LogLevelError), string(sdk.LogLevelFatal), string(sdk.LogLevelOff),
+	}
+	traceLevels = []string{string(sdk.TraceLevelAlways), string(sdk.TraceLevelOnEvent), string(sdk.TraceLevelOff)}
+)
+
+var maxDataExtensionTimeInDaysSchema = &schema.Schema{
+	Type:         schema.TypeInt,
+	Optional:     true,
+	Default:      -1,
+	Description:  "Maximum number of days for which Snowflake can extend the data retention period to prevent streams from becoming stale. The default value of -1 means the parameter is not set on the object and is inherited.",
+	ValidateFunc: validation.IntBetween(-1, 90),
+}
+
+var defaultDDLCollationSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "Specifies the default collation specification for the columns added to the object. If not set, the parameter is inherited.",
+}
+
+var logLevelSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	Description:  fmt.Sprintf("Specifies the severity level of the messages that are ingested into the active event table. Valid values are (case-insensitive): %s. If not set, the parameter is inherited.", strings.Join(logLevels, " | ")),
+	ValidateFunc: validation.StringInSlice(logLevels, true),
+	DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+		return strings.EqualFold(old, new)
+	},
+}
+
+var traceLevelSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	Description:  fmt.Sprintf("Controls how the trace events are ingested into the active event table. Valid values are (case-insensitive): %s. If not set, the parameter is inherited.", strings.Join(traceLevels, " | ")),
+	ValidateFunc: validation.StringInSlice(traceLevels, true),
+	DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+		return strings.EqualFold(old, new)
+	},
+}
+
+// objectParameterValue returns the value of the attribute as it has to be set in Snowflake, and false when the parameter should be inherited instead.
+func objectParameterValue(d *schema.ResourceData, key string) (string, bool) {
+	switch v := d.Get(key).(type) {
+	case int:
+		if v < 0 {
+			return "", false
+		}
+		return strconv.Itoa(v), true
+	case string:
+		if v == "" {
+			return "", false
+		}
+		if objectParameterAttributes[key] == sdk.ObjectParameterDefaultDDLCollation {
+			return fmt.Sprintf("'%s'", v), true
+		}
+		return strings.ToUpper(v), true
+	default:
+		return "", false
+	}
+}
+
+// createObjectParameters sets the object parameters configured for the newly created object.
+func createObjectParameters(ctx context.Context, client *sdk.Client, d *schema.ResourceData, object sdk.Object, keys ...string) error {
+	for _, key := range keys {
+		if value, ok := objectParameterValue(d, key); ok {
+			if err := client.Parameters.SetObjectParameterOnObject(ctx, object, objectParameterAttributes[key], value); err != nil {
+				return fmt.Errorf("error setting %v on %v %v err = %w", key, object.ObjectType, object.Name.FullyQualifiedName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// updateObjectParameters sets the changed object parameters, or unsets them when they were removed from the configuration.
+func updateObjectParameters(ctx context.Context, client *sdk.Client, d *schema.ResourceData, object sdk.Object, keys ...string) error {
+	for _, key := range keys {
+		if !d.HasChange(key) {
+			continue
+		}
+		parameter := objectParameterAttributes[key]
+		var err error
+		if value, ok := objectParameterValue(d, key); ok {
+			err = client.Parameters.SetObjectParameterOnObject(ctx, object, parameter, value)
+		} else {
+			err = client.Parameters.UnsetObjectParameterOnObject(ctx, object, parameter)
+		}
+		if err != nil {
+			return fmt.Errorf("error updating %v on %v %v err = %w", key, object.ObjectType, object.Name.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// readObjectParameters reads the object parameters with SHOW PARAMETERS. Only the values set on the object itself are stored in the state,
+// so that a parameter set or changed outside of Terraform shows up as a difference.
+func readObjectParameters(ctx context.Context, client *sdk.Client, d *schema.ResourceData, object sdk.Object, keys ...string) error {
+	for _, key := range keys {
+		parameter, err := client.Parameters.ShowObjectParameter(ctx, objectParameterAttributes[key], object)
+		if err != nil {
+			return fmt.Errorf("error reading %v of %v %v err = %w", key, object.ObjectType, object.Name.FullyQualifiedName(), err)
+		}
+		setOnObject := strings.EqualFold(string(parameter.Level), string(object.ObjectType))
+		var value any
+		switch d.Get(key).(type) {
+		case int:
+			value = -1
+			if setOnObject {
+				v, err := strconv.Atoi(parameter.Value)
+				if err != nil {
+					return fmt.Errorf("unable to parse %v value %v err = %w", key, parameter.Value, err)
+				}
+				value = v
+			}
+		default:
+			value = ""
+			if setOnObject {
+				value = parameter.Value
+			}
+		}
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,383 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var icebergTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the Iceberg table; must be unique for the database and schema in which the table is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the Iceberg table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the Iceberg table.",
+	},
+	"external_volume": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Specifies the external volume to use for the Iceberg table. If not specified, the external volume set on the schema, database or account is used.",
+	},
+	"catalog": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     sdk.IcebergTableCatalogSnowflake,
+		ForceNew:    true,
+		Description: "Specifies the catalog for the Iceberg table. Use `SNOWFLAKE` for a Snowflake-managed table, or the name of a catalog integration (e.g. an object store or AWS Glue catalog integration).",
+	},
+	"base_location": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"metadata_file_path", "catalog_table_name"},
+		Description:   "The path to a directory where Snowflake can write data and metadata files for the table, relative to the external volume location. Only valid when `catalog` is `SNOWFLAKE`.",
+	},
+	"metadata_file_path": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"base_location", "catalog_table_name"},
+		Description:   "Specifies the relative path of the Iceberg metadata file to use for column definitions when using an object store catalog integration. Changing this value refreshes the table metadata in place.",
+	},
+	"catalog_table_name": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"base_location", "metadata_file_path"},
+		Description:   "Specifies the table name as recognized by an AWS Glue catalog integration.",
+	},
+	"column": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Definitions of a column to create in a Snowflake-managed Iceberg table. Columns of tables using an external catalog are inferred from the table metadata.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Column name",
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "Column type, e.g. NUMBER",
+					ValidateFunc:     dataTypeValidateFunc,
+					DiffSuppressFunc: dataTypeDiffSuppressFunc,
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					ForceNew:    true,
+					Description: "Whether this column can contain null values.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Column comment",
+				},
+			},
+		},
+	},
+	"replace_invalid_characters": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Specifies whether to replace invalid UTF-8 characters with the Unicode replacement character in query results. Only applies to tables using an external catalog.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, 90),
+		Description:  "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Iceberg table.",
+	},
+	"iceberg_table_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Whether the table is managed by Snowflake (`MANAGED`) or by an external catalog (`UNMANAGED`).",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the Iceberg table.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the Iceberg table.",
+	},
+}
+
+// IcebergTable returns a pointer to the resource representing an Iceberg table.
+func IcebergTable() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateIcebergTable,
+		Read:   ReadIcebergTable,
+		Update: UpdateIcebergTable,
+		Delete: DeleteIcebergTable,
+
+		Schema: icebergTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getIcebergTableColumns(v interface{}) []sdk.IcebergTableColumn {
+	columns := v.([]interface{})
+	result := make([]sdk.IcebergTableColumn, len(columns))
+	for i, c := range columns {
+		column := c.(map[string]interface{})
+		result[i] = sdk.IcebergTableColumn{
+			Name: column["name"].(string),
+			Type: sdk.DataType(column["type"].(string)),
+		}
+		if !column["nullable"].(bool) {
+			result[i].NotNull = sdk.Bool(true)
+		}
+		if comment := column["comment"].(string); comment != "" {
+			result[i].Comment = sdk.String(comment)
+		}
+	}
+	return result
+}
+
+// CreateIcebergTable implements schema.CreateFunc.
+func CreateIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	opts := &sdk.CreateIcebergTableOptions{
+		Catalog: sdk.String(d.Get("catalog").(string)),
+	}
+	if v, ok := d.GetOk("column"); ok {
+		opts.Columns = getIcebergTableColumns(v)
+	}
+	if v, ok := d.GetOk("external_volume"); ok {
+		opts.ExternalVolume = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("base_location"); ok {
+		opts.BaseLocation = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("metadata_file_path"); ok {
+		opts.MetadataFilePath = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("catalog_table_name"); ok {
+		opts.CatalogTableName = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("replace_invalid_characters"); ok {
+		opts.ReplaceInvalidCharacters = sdk.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		opts.DataRetentionTimeInDays = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.IcebergTables.Create(ctx, id, opts); err != nil {
+		return fmt.Errorf("error creating iceberg table %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadIcebergTable(d, meta)
+}
+
+// ReadIcebergTable implements schema.ReadFunc.
+func ReadIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+	if err != nil {
+		log.Printf("[DEBUG] iceberg table (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", icebergTable.Name); err != nil {
+		return err
+	}
+	if err := d.Set("database", icebergTable.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", icebergTable.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("external_volume", icebergTable.ExternalVolumeName); err != nil {
+		return err
+	}
+	if icebergTable.CatalogName != "" {
+		if err := d.Set("catalog", icebergTable.CatalogName); err != nil {
+			return err
+		}
+	}
+	if icebergTable.IsManaged() {
+		if err := d.Set("base_location", icebergTable.BaseLocation); err != nil {
+			return err
+		}
+	}
+	if icebergTable.CatalogTableName != "" {
+		if err := d.Set("catalog_table_name", icebergTable.CatalogTableName); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("comment", icebergTable.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("iceberg_table_type", icebergTable.IcebergTableType); err != nil {
+		return err
+	}
+	if err := d.Set("owner", icebergTable.Owner); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	columns, err := client.IcebergTables.DescribeColumns(ctx, id)
+	if err != nil {
+		return err
+	}
+	columnList := make([]interface{}, len(columns))
+	for i, column := range columns {
+		columnList[i] = map[string]interface{}{
+			"name":     column.Name,
+			"type":     string(column.Type),
+			"nullable": column.Nullable,
+			"comment":  column.Comment,
+		}
+	}
+	if err := d.Set("column", columnList); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("data_retention_time_in_days"); ok {
+		parameter, err := client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameterDataRetentionTimeInDays, sdk.Object{
+			ObjectType: sdk.ObjectTypeTable,
+			Name:       id,
+		})
+		if err != nil {
+			return err
+		}
+		value, err := strconv.Atoi(parameter.Value)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("data_retention_time_in_days", value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateIcebergTable implements schema.UpdateFunc.
+func UpdateIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := &sdk.IcebergTableSet{}, &sdk.IcebergTableUnset{}
+	runSet, runUnset := false, false
+
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.Comment = sdk.String(v.(string))
+			runSet = true
+		} else {
+			unset.Comment = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("data_retention_time_in_days") {
+		if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+			set.DataRetentionTimeInDays = sdk.Int(v.(int))
+			runSet = true
+		} else {
+			unset.DataRetentionTimeInDays = sdk.Bool(true)
+			runUnset = true
+		}
+	}
+	if d.HasChange("replace_invalid_characters") {
+		set.ReplaceInvalidCharacters = sdk.Bool(d.Get("replace_invalid_characters").(bool))
+		runSet = true
+	}
+
+	if runSet {
+		if err := client.IcebergTables.Alter(ctx, id, &sdk.AlterIcebergTableOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating iceberg table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+	if runUnset {
+		if err := client.IcebergTables.Alter(ctx, id, &sdk.AlterIcebergTableOptions{Unset: unset}); err != nil {
+			return fmt.Errorf("error updating iceberg table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("metadata_file_path") {
+		refresh := &sdk.IcebergTableRefresh{}
+		if v, ok := d.GetOk("metadata_file_path"); ok {
+			refresh.MetadataFilePath = sdk.String(v.(string))
+		}
+		if err := client.IcebergTables.Alter(ctx, id, &sdk.AlterIcebergTableOptions{Refresh: refresh}); err != nil {
+			return fmt.Errorf("error refreshing iceberg table %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	return ReadIcebergTable(d, meta)
+}
+
+// DeleteIcebergTable implements schema.DeleteFunc.
+func DeleteIcebergTable(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.IcebergTables.Drop(ctx, id, nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
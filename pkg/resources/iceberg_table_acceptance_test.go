@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_IcebergTable(t *testing.T) {
+	externalVolume, ok := os.LookupEnv("SNOWFLAKE_TEST_EXTERNAL_VOLUME")
+	if !ok {
+		t.Skip("Skipping TestAcc_IcebergTable since SNOWFLAKE_TEST_EXTERNAL_VOLUME is not set")
+	}
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: icebergTableConfig(name, externalVolume, "first comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "external_volume", externalVolume),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "catalog", "SNOWFLAKE"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "base_location", name),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.0.name", "id"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.0.nullable", "false"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "column.1.name", "data"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "comment", "first comment"),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "iceberg_table_type", "MANAGED"),
+				),
+			},
+			{
+				Config: icebergTableConfig(name, externalVolume, "second comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_iceberg_table.test", "comment", "second comment"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_iceberg_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func icebergTableConfig(name string, externalVolume string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_iceberg_table" "test" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	external_volume = "%[4]s"
+	catalog         = "SNOWFLAKE"
+	base_location   = "%[3]s"
+
+	column {
+		name     = "id"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+
+	column {
+		name = "data"
+		type = "VARCHAR"
+	}
+
+	comment = "%[5]s"
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, externalVolume, comment)
+}
@@ -0,0 +1,311 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var jobServiceSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the job service.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the job service.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the job service.",
+	},
+	"compute_pool": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the compute pool in which the job runs.",
+	},
+	"specification": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The YAML specification of the job. See [service specification reference](https://docs.snowflake.com/en/developer-guide/snowpark-container-services/specification-reference).",
+		ExactlyOneOf: []string{"specification", "specification_file"},
+	},
+	"stage": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The stage containing the specification file, e.g. `@db.schema.stage`.",
+		RequiredWith: []string{"specification_file"},
+	},
+	"specification_file": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The path of the specification file relative to the stage.",
+		RequiredWith: []string{"stage"},
+	},
+	"query_warehouse": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the warehouse used by the job containers to run queries when no warehouse is specified by the connection.",
+	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the external access integrations allowing the job to access external sites.",
+	},
+	"replicas": {
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the number of job replicas to run.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies a comment for the job service.",
+	},
+	"async": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether the job is executed asynchronously. A synchronous execution holds the statement until the job finishes, so it is subject to the statement timeout.",
+	},
+	"wait_for_completion": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the provider waits, up to the create timeout, until an asynchronous job finishes and fails when the job fails. It is ignored for synchronous executions.",
+	},
+	"triggers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Arbitrary map of values that, when changed, drops the job service and executes the job again.",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The status of the job: PENDING, READY, DONE or FAILED. When the job has several containers, it is FAILED if any of them failed and DONE when all of them finished.",
+	},
+	"status_message": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The message describing the status of the job, e.g. the reason of the failure.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the job service was created.",
+	},
+}
+
+// JobService returns a pointer to the resource representing a job service.
+func JobService() *schema.Resource {
+	return &schema.Resource{
+		Description: "Executes a one-off containerized job (EXECUTE JOB SERVICE) in a compute pool, e.g. a migration or a loader. The job runs once when the resource is created; change the triggers to run it again.",
+
+		Create: CreateJobService,
+		Read:   ReadJobService,
+		Update: UpdateJobService,
+		Delete: DeleteJobService,
+
+		Schema: jobServiceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+// CreateJobService implements schema.CreateFunc.
+func CreateJobService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	// the job execution and the wait for its completion share the create timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	computePool := sdk.NewAccountObjectIdentifier(d.Get("compute_pool").(string))
+	async := d.Get("async").(bool)
+
+	opts := &sdk.ExecuteJobServiceOptions{
+		Async: sdk.Bool(async),
+	}
+	if v, ok := d.GetOk("specification"); ok {
+		opts.FromSpecification = sdk.String(v.(string))
+	} else {
+		opts.FromStage = &sdk.ServiceFromStage{
+			Stage:             d.Get("stage").(string),
+			SpecificationFile: d.Get("specification_file").(string),
+		}
+	}
+	if v, ok := d.GetOk("query_warehouse"); ok {
+		opts.QueryWarehouse = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		for _, integration := range expandStringList(v.(*schema.Set).List()) {
+			opts.ExternalAccessIntegrations = append(opts.ExternalAccessIntegrations, sdk.NewAccountObjectIdentifier(integration))
+		}
+	}
+	if v, ok := d.GetOk("replicas"); ok {
+		opts.Replicas = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+
+	err := client.Services.ExecuteJob(ctx, id, computePool, opts)
+	if err != nil {
+		// a failed synchronous job leaves the job service behind, so it is stored in the state to be replaced on the next apply
+		if _, showErr := client.Services.ShowByID(ctx, id); showErr == nil {
+			d.SetId(helpers.EncodeSnowflakeID(id))
+		}
+		return fmt.Errorf("error executing job service %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if async && d.Get("wait_for_completion").(bool) {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timeout while waiting for job service %v to finish", id.FullyQualifiedName())
+		}
+		err := retry.RetryContext(ctx, remaining, func() *retry.RetryError {
+			statuses, err := client.Services.GetStatus(ctx, id)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			switch status, message := jobServiceStatus(statuses); status {
+			case sdk.ServiceContainerStatusDone:
+				return nil
+			case sdk.ServiceContainerStatusFailed, sdk.ServiceContainerStatusInternal:
+				return retry.NonRetryableError(fmt.Errorf("job service %v failed: %v", id.FullyQualifiedName(), message))
+			default:
+				return retry.RetryableError(fmt.Errorf("expected job service %v to be finished but it is %v", id.FullyQualifiedName(), status))
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ReadJobService(d, meta)
+}
+
+// jobServiceStatus summarizes the statuses of the job containers: the job failed when any of the containers failed,
+// and it is done when all of them are done.
+func jobServiceStatus(statuses []sdk.ServiceContainerStatus) (sdk.ServiceContainerStatusValue, string) {
+	if len(statuses) == 0 {
+		return sdk.ServiceContainerStatusPending, ""
+	}
+	for _, status := range statuses {
+		if status.Status == sdk.ServiceContainerStatusFailed || status.Status == sdk.ServiceContainerStatusInternal {
+			return status.Status, status.Message
+		}
+	}
+	for _, status := range statuses {
+		if status.Status != sdk.ServiceContainerStatusDone {
+			return status.Status, status.Message
+		}
+	}
+	return sdk.ServiceContainerStatusDone, statuses[0].Message
+}
+
+// ReadJobService implements schema.ReadFunc.
+func ReadJobService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	service, err := client.Services.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] job service (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("database", service.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", service.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", service.Name); err != nil {
+		return err
+	}
+	if err := d.Set("compute_pool", service.ComputePool); err != nil {
+		return err
+	}
+	if err := d.Set("query_warehouse", service.QueryWarehouse); err != nil {
+		return err
+	}
+	if err := d.Set("comment", service.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", service.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	statuses, err := client.Services.GetStatus(ctx, id)
+	if err != nil {
+		return err
+	}
+	status, message := jobServiceStatus(statuses)
+	if err := d.Set("status", string(status)); err != nil {
+		return err
+	}
+	if err := d.Set("status_message", strings.TrimSpace(message)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateJobService implements schema.UpdateFunc. The job cannot be altered, so only the attributes used by the provider are updated.
+func UpdateJobService(d *schema.ResourceData, meta interface{}) error {
+	return ReadJobService(d, meta)
+}
+
+// DeleteJobService implements schema.DeleteFunc.
+func DeleteJobService(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.Services.Drop(ctx, id, &sdk.DropServiceOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting job service %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
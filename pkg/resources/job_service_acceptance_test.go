@@ -0,0 +1,82 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_JobService(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	computePool := os.Getenv("SNOWFLAKE_COMPUTE_POOL")
+	image := os.Getenv("SNOWFLAKE_JOB_IMAGE")
+	if computePool == "" || image == "" {
+		t.Skip("SNOWFLAKE_COMPUTE_POOL and SNOWFLAKE_JOB_IMAGE must be set for JobService acceptance tests")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: jobServiceConfig(name, computePool, image, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_job_service.j", "name", name),
+					resource.TestCheckResourceAttr("snowflake_job_service.j", "status", "DONE"),
+					resource.TestCheckResourceAttrSet("snowflake_job_service.j", "created_on"),
+				),
+			},
+			// RUN AGAIN
+			{
+				Config: jobServiceConfig(name, computePool, image, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_job_service.j", "status", "DONE"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_job_service.j",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specification", "async", "wait_for_completion", "triggers"},
+			},
+		},
+	})
+}
+
+func jobServiceConfig(name string, computePool string, image string, run string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "d" {
+	name = "%[1]s"
+}
+
+resource "snowflake_schema" "s" {
+	database = snowflake_database.d.name
+	name     = "%[1]s"
+}
+
+resource "snowflake_job_service" "j" {
+	database     = snowflake_database.d.name
+	schema       = snowflake_schema.s.name
+	name         = "%[1]s"
+	compute_pool = "%[2]s"
+	async        = true
+	specification = <<-EOT
+	spec:
+	  containers:
+	  - name: main
+	    image: %[3]s
+	    command: ["echo", "done"]
+	EOT
+	triggers = {
+		run = "%[4]s"
+	}
+}
+`, name, computePool, image, run)
+}
@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobService(t *testing.T) {
+	r := require.New(t)
+	err := resources.JobService().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestJobServiceCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":        "db1",
+		"schema":          "schema1",
+		"name":            "job1",
+		"compute_pool":    "pool1",
+		"specification":   "spec: {}",
+		"query_warehouse": "wh1",
+	}
+	d := schema.TestResourceDataRaw(t, resources.JobService().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^EXECUTE JOB SERVICE IN COMPUTE POOL "pool1" FROM SPECIFICATION \$\$spec: {}\$\$ NAME = "db1"."schema1"."job1" ASYNC = false QUERY_WAREHOUSE = "wh1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadJobService(mock, `[{"status":"DONE","message":"Completed successfully","containerName":"main"}]`)
+		err := resources.CreateJobService(d, db)
+		r.NoError(err)
+		r.Equal("db1|schema1|job1", d.Id())
+		r.Equal("DONE", d.Get("status").(string))
+		r.Equal("Completed successfully", d.Get("status_message").(string))
+	})
+}
+
+func TestJobServiceCreateAsyncFailed(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":           "db1",
+		"schema":             "schema1",
+		"name":               "job1",
+		"compute_pool":       "pool1",
+		"stage":              "@db1.schema1.specs",
+		"specification_file": "job.yaml",
+		"async":              true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.JobService().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^EXECUTE JOB SERVICE IN COMPUTE POOL "pool1" FROM @db1.schema1.specs SPECIFICATION_FILE = 'job.yaml' NAME = "db1"."schema1"."job1" ASYNC = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		statusRows := sqlmock.NewRows([]string{"SERVICE_STATUS"}).
+			AddRow(`[{"status":"DONE","containerName":"loader"},{"status":"FAILED","message":"exit code 1","containerName":"main"}]`)
+		mock.ExpectQuery(`^SELECT SYSTEM\$GET_SERVICE_STATUS\('"db1"."schema1"."job1"'\) AS "SERVICE_STATUS"$`).WillReturnRows(statusRows)
+		err := resources.CreateJobService(d, db)
+		r.ErrorContains(err, `job service "db1"."schema1"."job1" failed: exit code 1`)
+		// the failed job service is kept in the state, so that it is replaced on the next apply
+		r.Equal("db1|schema1|job1", d.Id())
+	})
+}
+
+func expectReadJobService(mock sqlmock.Sqlmock, status string) {
+	rows := sqlmock.NewRows([]string{"name", "status", "database_name", "schema_name", "owner", "compute_pool", "created_on", "comment", "query_warehouse", "is_job"}).
+		AddRow("job1", "DONE", "db1", "schema1", "ACCOUNTADMIN", "POOL1", time.Now(), nil, "WH1", true)
+	mock.ExpectQuery(`^SHOW SERVICES LIKE 'job1' IN SCHEMA "db1"."schema1"$`).WillReturnRows(rows)
+	statusRows := sqlmock.NewRows([]string{"SERVICE_STATUS"}).AddRow(status)
+	mock.ExpectQuery(`^SELECT SYSTEM\$GET_SERVICE_STATUS\('"db1"."schema1"."job1"'\) AS "SERVICE_STATUS"$`).WillReturnRows(statusRows)
+}
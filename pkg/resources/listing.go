@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var listingSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the listing.",
+	},
+	"manifest": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The YAML manifest of the listing, including its title, description and the target accounts or regions. See [listing manifest reference](https://other-docs.snowflake.com/en/progaccess/listing-manifest-reference).",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.TrimSpace(old) == strings.TrimSpace(new)
+		},
+	},
+	"share": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		Description:   "Specifies the share attached to the listing.",
+		ConflictsWith: []string{"application_package"},
+	},
+	"application_package": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		Description:   "Specifies the application package attached to the listing.",
+		ConflictsWith: []string{"share"},
+	},
+	"publish": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the listing is published to the target accounts or regions. Setting it to false unpublishes the listing.",
+	},
+	"review": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the listing is submitted for the Marketplace Ops review when it is created or its manifest changes. It is ignored for private listings.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the listing.",
+	},
+	"global_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The global name of the listing, which identifies it in the consumer accounts.",
+	},
+	"state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The state of the listing: DRAFT, PUBLISHED or UNPUBLISHED.",
+	},
+	"review_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The state of the Marketplace Ops review of the listing.",
+	},
+	"target_accounts": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The accounts the listing is offered to.",
+	},
+}
+
+// Listing returns a pointer to the resource representing a listing.
+func Listing() *schema.Resource {
+	return &schema.Resource{
+		Description: "A listing offers a share or an application package to other accounts, either privately or on the Snowflake Marketplace.",
+
+		Create: CreateListing,
+		Read:   ReadListing,
+		Update: UpdateListing,
+		Delete: DeleteListing,
+
+		Schema: listingSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateListing implements schema.CreateFunc.
+func CreateListing(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	opts := &sdk.CreateListingOptions{
+		Publish: sdk.Bool(d.Get("publish").(bool)),
+		Review:  sdk.Bool(d.Get("review").(bool)),
+	}
+	if v, ok := d.GetOk("share"); ok {
+		opts.Share = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("application_package"); ok {
+		opts.ApplicationPackage = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.(string)))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.Listings.Create(ctx, id, d.Get("manifest").(string), opts); err != nil {
+		return fmt.Errorf("error creating listing %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return ReadListing(d, meta)
+}
+
+// ReadListing implements schema.ReadFunc.
+func ReadListing(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	listing, err := client.Listings.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] listing (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", listing.Name); err != nil {
+		return err
+	}
+	if err := d.Set("publish", listing.State == sdk.ListingStatePublished); err != nil {
+		return err
+	}
+	if err := d.Set("comment", listing.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("global_name", listing.GlobalName); err != nil {
+		return err
+	}
+	if err := d.Set("state", string(listing.State)); err != nil {
+		return err
+	}
+	if err := d.Set("review_state", listing.ReviewState); err != nil {
+		return err
+	}
+	if err := d.Set("target_accounts", listing.TargetAccounts); err != nil {
+		return err
+	}
+
+	details, err := client.Listings.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("share", details.Share); err != nil {
+		return err
+	}
+	if err := d.Set("application_package", details.ApplicationPackage); err != nil {
+		return err
+	}
+	// the manifest is only read on import, as Snowflake normalizes the YAML
+	if _, ok := d.GetOk("manifest"); !ok {
+		if err := d.Set("manifest", details.ManifestYaml); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateListing implements schema.UpdateFunc.
+func UpdateListing(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if d.HasChange("manifest") {
+		as := &sdk.ListingAs{
+			Manifest: d.Get("manifest").(string),
+			Publish:  sdk.Bool(d.Get("publish").(bool)),
+			Review:   sdk.Bool(d.Get("review").(bool)),
+		}
+		if err := client.Listings.Alter(ctx, id, &sdk.AlterListingOptions{As: as}); err != nil {
+			return fmt.Errorf("error updating manifest of listing %v err = %w", name, err)
+		}
+	} else if d.HasChange("publish") {
+		opts := &sdk.AlterListingOptions{Unpublish: sdk.Bool(true)}
+		if d.Get("publish").(bool) {
+			opts = &sdk.AlterListingOptions{Publish: sdk.Bool(true)}
+		}
+		if err := client.Listings.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error publishing listing %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		set := &sdk.ListingSet{Comment: sdk.String(d.Get("comment").(string))}
+		if err := client.Listings.Alter(ctx, id, &sdk.AlterListingOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating comment of listing %v err = %w", name, err)
+		}
+	}
+
+	return ReadListing(d, meta)
+}
+
+// DeleteListing implements schema.DeleteFunc.
+func DeleteListing(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	// a published listing has to be unpublished before it can be dropped
+	listing, err := client.Listings.ShowByID(ctx, id)
+	if err == nil && listing.State == sdk.ListingStatePublished {
+		if err := client.Listings.Alter(ctx, id, &sdk.AlterListingOptions{Unpublish: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error unpublishing listing %v err = %w", name, err)
+		}
+	}
+
+	if err := client.Listings.Drop(ctx, id, &sdk.DropListingOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting listing %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,87 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Listing(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	account2 := os.Getenv("SNOWFLAKE_ACCOUNT_SECOND")
+	if account2 == "" {
+		t.Skip("SNOWFLAKE_ACCOUNT_SECOND must be set for Listing acceptance tests")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: listingConfig(name, account2, "first title", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_listing.l", "name", name),
+					resource.TestCheckResourceAttr("snowflake_listing.l", "share", name),
+					resource.TestCheckResourceAttr("snowflake_listing.l", "publish", "false"),
+					resource.TestCheckResourceAttr("snowflake_listing.l", "state", "DRAFT"),
+					resource.TestCheckResourceAttrSet("snowflake_listing.l", "global_name"),
+				),
+			},
+			{
+				Config: listingConfig(name, account2, "second title", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_listing.l", "publish", "true"),
+					resource.TestCheckResourceAttr("snowflake_listing.l", "state", "PUBLISHED"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_listing.l",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest", "review"},
+			},
+		},
+	})
+}
+
+func listingConfig(name string, account string, title string, publish bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "d" {
+	name = "%[1]s"
+}
+
+resource "snowflake_share" "s" {
+	name = "%[1]s"
+}
+
+resource "snowflake_database_grant" "g" {
+	database_name = snowflake_database.d.name
+	privilege     = "USAGE"
+	shares        = [snowflake_share.s.name]
+}
+
+resource "snowflake_listing" "l" {
+	name     = "%[1]s"
+	share    = snowflake_share.s.name
+	publish  = %[4]t
+	manifest = <<-EOT
+		title: "%[3]s"
+		subtitle: "Created by a Terraform acceptance test"
+		description: "Created by a Terraform acceptance test"
+		listing_terms:
+		  type: "OFFLINE"
+		targets:
+		  accounts: ["%[2]s"]
+	EOT
+
+	depends_on = [snowflake_database_grant.g]
+}
+`, name, account, title, publish)
+}
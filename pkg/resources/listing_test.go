@@ -0,0 +1,107 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListing(t *testing.T) {
+	r := require.New(t)
+	err := resources.Listing().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestListingCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "listing1",
+		"manifest": "title: great listing",
+		"share":    "share1",
+		"publish":  false,
+		"comment":  "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Listing().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE EXTERNAL LISTING "listing1" SHARE "share1" AS \$\$title: great listing\$\$ PUBLISH = false REVIEW = true COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadListing(mock, "DRAFT")
+		err := resources.CreateListing(d, db)
+		r.NoError(err)
+		r.Equal("listing1", d.Id())
+		r.Equal(false, d.Get("publish").(bool))
+		r.Equal("GZ1234", d.Get("global_name").(string))
+		r.Equal("share1", d.Get("share").(string))
+	})
+}
+
+func TestListingUpdateManifest(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "listing1",
+		"manifest": "title: great listing",
+		"publish":  true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Listing().Schema, in)
+	d.MarkNewResource()
+	d.SetId("listing1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER LISTING "listing1" AS \$\$title: great listing\$\$ PUBLISH = true REVIEW = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadListing(mock, "PUBLISHED")
+		err := resources.UpdateListing(d, db)
+		r.NoError(err)
+		r.Equal(true, d.Get("publish").(bool))
+	})
+}
+
+func TestListingReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Listing().Schema, map[string]interface{}{"name": "listing1"})
+	d.SetId("listing1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW LISTINGS LIKE 'listing1'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		err := resources.ReadListing(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestListingDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Listing().Schema, map[string]interface{}{"name": "listing1"})
+	d.SetId("listing1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		showRows := sqlmock.NewRows([]string{"global_name", "name", "created_on", "state"}).AddRow("GZ1234", "listing1", time.Now(), "PUBLISHED")
+		mock.ExpectQuery(`^SHOW LISTINGS LIKE 'listing1'$`).WillReturnRows(showRows)
+		mock.ExpectExec(`^ALTER LISTING "listing1" UNPUBLISH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^DROP LISTING IF EXISTS "listing1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteListing(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadListing(mock sqlmock.Sqlmock, state string) {
+	showRows := sqlmock.NewRows([]string{
+		"global_name", "name", "title", "created_on", "state", "review_state", "comment", "target_accounts",
+	}).AddRow("GZ1234", "listing1", "great listing", time.Now(), state, nil, "great comment", "ORG.CONSUMER")
+	mock.ExpectQuery(`^SHOW LISTINGS LIKE 'listing1'$`).WillReturnRows(showRows)
+	descRows := sqlmock.NewRows([]string{
+		"name", "state", "share", "application_package", "manifest_yaml",
+	}).AddRow("listing1", state, "share1", nil, "title: great listing")
+	mock.ExpectQuery(`^DESCRIBE LISTING "listing1"$`).WillReturnRows(descRows)
+}
@@ -1,13 +1,16 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,13 +20,6 @@ const (
 	SnowflakeReaderAccountType = "READER"
 )
 
-var managedAccountProperties = []string{
-	"admin_name",
-	"admin_password",
-	"type",
-	"comment",
-}
-
 var managedAccountSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
@@ -102,36 +98,52 @@ func ManagedAccount() *schema.Resource {
 
 // CreateManagedAccount implements schema.CreateFunc.
 func CreateManagedAccount(d *schema.ResourceData, meta interface{}) error {
-	return CreateResource(
-		"this does not seem to be used",
-		managedAccountProperties,
-		managedAccountSchema,
-		snowflake.NewManagedAccountBuilder,
-		initialReadManagedAccount,
-	)(d, meta)
-}
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	params := &sdk.CreateManagedAccountParams{
+		AdminName:     d.Get("admin_name").(string),
+		AdminPassword: d.Get("admin_password").(string),
+		Type:          sdk.ManagedAccountType(strings.ToUpper(d.Get("type").(string))),
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		params.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.ManagedAccounts.Create(ctx, id, &sdk.CreateManagedAccountOptions{Params: params}); err != nil {
+		return fmt.Errorf("error creating managed account %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	// the locator of the managed account is generated some time after it is created
+	err := helpers.Retry(10, 3*time.Second, func() (error, bool) {
+		managedAccount, err := client.ManagedAccounts.ShowByID(ctx, id)
+		if err != nil {
+			return nil, false
+		}
+		return nil, managedAccount.Locator != ""
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for the locator of managed account %v err = %w", name, err)
+	}
 
-// initialReadManagedAccount is used for the first read, since the locator takes
-// some time to appear. This is currently implemented as a sleep. @TODO actually
-// wait until the locator is generated.
-func initialReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
-	log.Println("[INFO] sleeping to give the locator a chance to be generated")
-	// lintignore:R018
-	time.Sleep(10 * time.Second)
 	return ReadManagedAccount(d, meta)
 }
 
 // ReadManagedAccount implements schema.ReadFunc.
 func ReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NewManagedAccountBuilder(id).Show()
-	row := snowflake.QueryRow(db, stmt)
-	a, err := snowflake.ScanManagedAccount(row)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, remove resource from
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	managedAccount, err := client.ManagedAccounts.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		log.Printf("[DEBUG] managed account (%s) not found", d.Id())
 		d.SetId("")
 		return nil
@@ -140,44 +152,52 @@ func ReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("name", a.Name.String); err != nil {
+	if err := d.Set("name", managedAccount.Name); err != nil {
 		return err
 	}
 
-	if err := d.Set("cloud", a.Cloud.String); err != nil {
+	if err := d.Set("cloud", managedAccount.Cloud); err != nil {
 		return err
 	}
 
-	if err := d.Set("region", a.Region.String); err != nil {
+	if err := d.Set("region", managedAccount.Region); err != nil {
 		return err
 	}
 
-	if err := d.Set("locator", a.Locator.String); err != nil {
+	if err := d.Set("locator", managedAccount.Locator); err != nil {
 		return err
 	}
 
-	if err := d.Set("created_on", a.CreatedOn.String); err != nil {
+	if err := d.Set("created_on", managedAccount.CreatedOn.String()); err != nil {
 		return err
 	}
 
-	if err := d.Set("url", a.URL.String); err != nil {
+	if err := d.Set("url", managedAccount.URL); err != nil {
 		return err
 	}
 
-	if a.IsReader {
-		if err := d.Set("type", "READER"); err != nil {
+	if managedAccount.IsReader {
+		if err := d.Set("type", SnowflakeReaderAccountType); err != nil {
 			return err
 		}
 	} else {
 		return fmt.Errorf("unable to determine the account type")
 	}
 
-	err = d.Set("comment", a.Comment.String)
-
-	return err
+	return d.Set("comment", managedAccount.Comment)
 }
 
 // DeleteManagedAccount implements schema.DeleteFunc.
 func DeleteManagedAccount(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("this does not seem to be used", snowflake.NewManagedAccountBuilder)(d, meta)
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	if err := client.ManagedAccounts.Drop(ctx, sdk.NewAccountObjectIdentifier(name), nil); err != nil {
+		return fmt.Errorf("error deleting managed account %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
 }
@@ -3,6 +3,7 @@ package resources_test
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,7 +11,6 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 )
 
@@ -33,10 +33,13 @@ func TestManagedAccountCreate(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		mock.ExpectExec(`^CREATE MANAGED ACCOUNT "test-account" ADMIN_NAME='bob' ADMIN_PASSWORD='abc123ABC' COMMENT='great comment' TYPE='READER'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^CREATE MANAGED ACCOUNT "test-account" ADMIN_NAME = 'bob', ADMIN_PASSWORD = 'abc123ABC', TYPE = READER, COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// the first read waits for the locator to be generated
+		expectReadManagedAccount(mock)
 		expectReadManagedAccount(mock)
 		err := resources.CreateManagedAccount(d, db)
 		r.NoError(err)
+		r.Equal("locatorstring", d.Get("locator").(string))
 	})
 }
 
@@ -47,8 +50,7 @@ func TestManagedAccountRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
-		q := snowflake.NewManagedAccountBuilder(d.Id()).Show()
-		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(`^SHOW MANAGED ACCOUNTS LIKE 'test-account'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
 		err := resources.ReadManagedAccount(d, db)
 
 		r.Empty(d.State())
@@ -59,6 +61,6 @@ func TestManagedAccountRead(t *testing.T) {
 func expectReadManagedAccount(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"name", "cloud", "region", "locator", "created_on", "url", "is_reader", "comment",
-	}).AddRow("test-account", "aws", "ap-southeast-2", "locatorstring", "2019-01-01", "www.test.com", true, "great comment")
+	}).AddRow("test-account", "aws", "ap-southeast-2", "locatorstring", time.Now(), "www.test.com", true, "great comment")
 	mock.ExpectQuery(`^SHOW MANAGED ACCOUNTS LIKE 'test-account'$`).WillReturnRows(rows)
 }
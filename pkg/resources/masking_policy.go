@@ -51,7 +51,8 @@ var maskingPolicySchema = map[string]*schema.Schema{
 	"signature": {
 		Type:        schema.TypeList,
 		Required:    true,
-		Description: "The signature for the masking policy; specifies the input columns and data types to evaluate at query runtime.",
+		Description: "The signature for the masking policy; specifies the input columns and data types to evaluate at query runtime. The first column is the one being masked; any additional columns make the policy a conditional masking policy.",
+		ForceNew:    true,
 		MinItems:    1,
 		MaxItems:    1,
 		Elem: &schema.Resource{
@@ -66,6 +67,7 @@ var maskingPolicySchema = map[string]*schema.Schema{
 								Type:        schema.TypeString,
 								Required:    true,
 								Description: "Specifies the column name to mask.",
+								ForceNew:    true,
 							},
 							"type": {
 								Type:             schema.TypeString,
@@ -100,6 +102,7 @@ var maskingPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies whether the row access policy or conditional masking policy can reference a column that is already protected by a masking policy.",
 		Default:     false,
+		ForceNew:    true,
 	},
 	"comment": {
 		Type:        schema.TypeString,
@@ -228,16 +231,17 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	signature := []map[string]interface{}{}
-	for _, s := range maskingPolicyDetails.Signature {
-		signature = append(signature, map[string]interface{}{
-			"column": []map[string]interface{}{
-				{
-					"name": s.Name,
-					"type": s.Type,
-				},
-			},
-		})
+	columns := make([]map[string]interface{}, len(maskingPolicyDetails.Signature))
+	for i, s := range maskingPolicyDetails.Signature {
+		columns[i] = map[string]interface{}{
+			"name": s.Name,
+			"type": s.Type,
+		}
+	}
+	signature := []map[string]interface{}{
+		{
+			"column": columns,
+		},
 	}
 	if err := d.Set("signature", signature); err != nil {
 		return err
@@ -113,3 +113,57 @@ func maskingPolicyConfigMultiline(n string, name string, databaseName string, sc
 	}
 	`, name, databaseName, schemaName)
 }
+
+func TestAcc_MaskingPolicyConditional(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: maskingPolicyConfigConditional(name, acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "exempt_other_policies", "true"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "signature.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "signature.0.column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "signature.0.column.0.name", "val"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "signature.0.column.0.type", "VARCHAR"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "signature.0.column.1.name", "visibility"),
+					resource.TestCheckResourceAttr("snowflake_masking_policy.test", "signature.0.column.1.type", "VARCHAR"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_masking_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func maskingPolicyConfigConditional(name string, databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_masking_policy" "test" {
+	name = "%s"
+	database = "%s"
+	schema = "%s"
+	signature {
+		column {
+			name = "val"
+			type = "VARCHAR"
+		}
+		column {
+			name = "visibility"
+			type = "VARCHAR"
+		}
+	}
+	masking_expression = "case when visibility = 'PUBLIC' then val else '***MASKED***' end"
+	return_data_type = "VARCHAR"
+	exempt_other_policies = true
+}
+`, name, databaseName, schemaName)
+}
@@ -47,7 +47,19 @@ var materializedViewSchema = map[string]*schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Specifies that the view is secure.",
+		Description: "Specifies that the view is secure. Changing this value alters the view in place with SET SECURE / UNSET SECURE.",
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more columns/expressions to be used as clustering key(s) for the materialized view.",
+	},
+	"automatic_clustering": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether automatic clustering is resumed (RESUME RECLUSTER) or suspended (SUSPEND RECLUSTER) for the materialized view. Only takes effect when `cluster_by` is set.",
 	},
 	"comment": {
 		Type:        schema.TypeString,
@@ -152,6 +164,11 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		builder.WithComment(v.(string))
 	}
 
+	clusterBy := expandStringList(d.Get("cluster_by").([]interface{}))
+	if len(clusterBy) > 0 {
+		builder.WithClustering(clusterBy)
+	}
+
 	if v, ok := d.GetOk("tag"); ok {
 		tags := getTags(v)
 		builder.WithTags(tags.toSnowflakeTagValues())
@@ -163,6 +180,12 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating view %v err = %w", name, err)
 	}
 
+	if len(clusterBy) > 0 && !d.Get("automatic_clustering").(bool) {
+		if err := snowflake.Exec(db, builder.SuspendRecluster()); err != nil {
+			return fmt.Errorf("error suspending reclustering of materialized view %v err = %w", name, err)
+		}
+	}
+
 	materializedViewID := &materializedViewID{
 		DatabaseName: database,
 		SchemaName:   schema,
@@ -218,16 +241,30 @@ func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
-
-	extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
-	substringOfQuery, err := extractor.ExtractMaterializedView()
-	if err != nil {
+	clusterBy := snowflake.ClusterStatementToList(v.ClusterBy.String)
+	if err := d.Set("cluster_by", clusterBy); err != nil {
 		return err
 	}
 
-	if err := d.Set("statement", substringOfQuery); err != nil {
-		return err
+	// automatic clustering is always reported as OFF for views without a clustering key
+	if len(clusterBy) > 0 && v.AutomaticClustering.Valid {
+		if err := d.Set("automatic_clustering", strings.EqualFold(v.AutomaticClustering.String, "ON")); err != nil {
+			return err
+		}
+	}
+
+	// The text of a secure view is only visible to its owner, so keep the configured statement when it is hidden.
+	if v.Text.String != "" {
+		// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
+		extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
+		substringOfQuery, err := extractor.ExtractMaterializedView()
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("statement", substringOfQuery); err != nil {
+			return err
+		}
 	}
 
 	return d.Set("database", v.DatabaseName.String)
@@ -297,6 +334,31 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	clusterBy := expandStringList(d.Get("cluster_by").([]interface{}))
+	if d.HasChange("cluster_by") {
+		var q string
+		if len(clusterBy) > 0 {
+			q = builder.ChangeClusterBy(clusterBy)
+		} else {
+			q = builder.DropClustering()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating clustering for materialized view %v err = %w", d.Id(), err)
+		}
+	}
+
+	if len(clusterBy) > 0 && (d.HasChange("automatic_clustering") || d.HasChange("cluster_by")) {
+		var q string
+		if d.Get("automatic_clustering").(bool) {
+			q = builder.ResumeRecluster()
+		} else {
+			q = builder.SuspendRecluster()
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating automatic clustering for materialized view %v err = %w", d.Id(), err)
+		}
+	}
+
 	handleErr := handleTagChanges(db, d, builder)
 	if handleErr != nil {
 		return handleErr
@@ -67,6 +67,87 @@ func TestAcc_MaterializedView2(t *testing.T) {
 	})
 }
 
+func TestAcc_MaterializedViewClustering(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_MATERIALIZED_VIEW_TESTS"); ok {
+		t.Skip("Skipping TestAcc_MaterializedViewClustering")
+	}
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	warehouseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	viewName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	q := fmt.Sprintf("SELECT ID, DATA FROM \\\"%s\\\";", tableName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: materializedViewClusteringConfig(warehouseName, tableName, viewName, q, `["ID"]`, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_materialized_view.test", "name", viewName),
+					resource.TestCheckResourceAttr("snowflake_materialized_view.test", "cluster_by.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_materialized_view.test", "cluster_by.0", "ID"),
+					checkBool("snowflake_materialized_view.test", "automatic_clustering", false),
+					checkBool("snowflake_materialized_view.test", "is_secure", true),
+				),
+			},
+			{
+				Config: materializedViewClusteringConfig(warehouseName, tableName, viewName, q, `["ID", "DATA"]`, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_materialized_view.test", "cluster_by.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_materialized_view.test", "cluster_by.1", "DATA"),
+					checkBool("snowflake_materialized_view.test", "automatic_clustering", true),
+					checkBool("snowflake_materialized_view.test", "is_secure", false),
+				),
+			},
+			{
+				Config: materializedViewClusteringConfig(warehouseName, tableName, viewName, q, `[]`, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_materialized_view.test", "cluster_by.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func materializedViewClusteringConfig(warehouseName string, tableName string, viewName string, q string, clusterBy string, automaticClustering bool, isSecure bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_warehouse" "wh" {
+	name = "%s"
+}
+resource "snowflake_table" "test" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+
+	column {
+		name = "ID"
+		type = "NUMBER(38,0)"
+	}
+
+	column {
+		name = "DATA"
+		type = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_materialized_view" "test" {
+	name                 = "%s"
+	database             = "%s"
+	schema               = "%s"
+	warehouse            = snowflake_warehouse.wh.name
+	is_secure            = %t
+	cluster_by           = %s
+	automatic_clustering = %t
+	statement            = "%s"
+
+	depends_on = [
+		snowflake_table.test
+	]
+}
+`, warehouseName, tableName, acc.TestDatabaseName, acc.TestSchemaName, viewName, acc.TestDatabaseName, acc.TestSchemaName, isSecure, clusterBy, automaticClustering, q)
+}
+
 func materializedViewConfig(warehouseName string, tableName string, viewName string, q string, databaseName string, schemaName string) string {
 	// convert the cluster from string slice to string
 	return fmt.Sprintf(`
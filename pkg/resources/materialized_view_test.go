@@ -113,6 +113,48 @@ func TestMaterializedViewCreateAmpersand(t *testing.T) {
 	})
 }
 
+func TestMaterializedViewCreateWithClusterBy(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                 "good_name",
+		"database":             "test_db",
+		"schema":               "test_schema",
+		"warehouse":            "test_wh",
+		"statement":            "SELECT * FROM test_db.PUBLIC.GREAT_TABLE",
+		"cluster_by":           []interface{}{"ID", "TO_DATE(CREATED_AT)"},
+		"automatic_clustering": false,
+	}
+	d := schema.TestResourceDataRaw(t, resources.MaterializedView().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			`^USE WAREHOUSE test_wh;$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^CREATE MATERIALIZED VIEW "test_db"."test_schema"."good_name" CLUSTER BY \(ID, TO_DATE\(CREATED_AT\)\) AS SELECT \* FROM test_db.PUBLIC.GREAT_TABLE$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectExec(
+			`^ALTER MATERIALIZED VIEW "test_db"."test_schema"."good_name" SUSPEND RECLUSTER$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "reserved", "database_name", "schema_name", "cluster_by", "owner", "comment", "text", "is_secure", "automatic_clustering",
+		},
+		).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "", "test_db", "test_schema", "LINEAR(ID, TO_DATE(CREATED_AT))", "admin", "", "CREATE MATERIALIZED VIEW good_name CLUSTER BY (ID, TO_DATE(CREATED_AT)) AS SELECT * FROM test_db.PUBLIC.GREAT_TABLE", false, "OFF")
+		mock.ExpectQuery(`^SHOW MATERIALIZED VIEWS LIKE 'good_name' IN DATABASE "test_db"$`).WillReturnRows(rows)
+
+		err := resources.CreateMaterializedView(d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"ID", "TO_DATE(CREATED_AT)"}, d.Get("cluster_by"))
+		r.False(d.Get("automatic_clustering").(bool))
+		r.Equal("SELECT * FROM test_db.PUBLIC.GREAT_TABLE", d.Get("statement"))
+	})
+}
+
 func expectReadMaterializedView(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized",
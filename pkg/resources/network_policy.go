@@ -22,7 +22,7 @@ var networkPolicySchema = map[string]*schema.Schema{
 	"allowed_ip_list": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
-		Required:    true,
+		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are allowed access to your Snowflake account",
 	},
 	// TODO: Add a ValidationFunc to ensure 0.0.0.0/0 is not in blocked_ip_list
@@ -33,6 +33,18 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are denied access to your Snowflake account<br><br>**Do not** add `0.0.0.0/0` to `blocked_ip_list`",
 	},
+	"allowed_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of fully qualified network rules that contain the network identifiers that are allowed access to Snowflake.",
+	},
+	"blocked_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of fully qualified network rules that contain the network identifiers that are denied access to Snowflake.",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -80,7 +92,15 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		for i, v := range ipList {
 			ipRequests[i] = *sdk.NewIPRequest(v)
 		}
-		req = req.WithAllowedIpList(ipRequests)
+		req = req.WithBlockedIpList(ipRequests)
+	}
+
+	if v, ok := d.GetOk("allowed_network_rule_list"); ok {
+		req = req.WithAllowedNetworkRuleList(networkRuleIdentifiers(v))
+	}
+
+	if v, ok := d.GetOk("blocked_network_rule_list"); ok {
+		req = req.WithBlockedNetworkRuleList(networkRuleIdentifiers(v))
 	}
 
 	db := meta.(*sql.DB)
@@ -133,6 +153,22 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 			if err = d.Set("blocked_ip_list", strings.Split(desc.Value, ",")); err != nil {
 				return err
 			}
+		case "ALLOWED_NETWORK_RULE_LIST":
+			networkRules, err := parseNetworkRuleList(desc.Value)
+			if err != nil {
+				return err
+			}
+			if err = d.Set("allowed_network_rule_list", networkRules); err != nil {
+				return err
+			}
+		case "BLOCKED_NETWORK_RULE_LIST":
+			networkRules, err := parseNetworkRuleList(desc.Value)
+			if err != nil {
+				return err
+			}
+			if err = d.Set("blocked_network_rule_list", networkRules); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -145,19 +181,18 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	ctx := context.Background()
 	client := sdk.NewClientFromDB(db)
-	baseReq := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
 
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 
 		if c := comment.(string); c == "" {
-			err := client.NetworkPolicies.Alter(ctx, baseReq.WithUnsetComment(sdk.Bool(true)))
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithUnsetComment(sdk.Bool(true)))
 			if err != nil {
 				return fmt.Errorf("error unsetting comment for network policy %v err = %w", name, err)
 			}
 		} else {
 			setReq := sdk.NewNetworkPolicySetRequest().WithComment(sdk.String(comment.(string)))
-			err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
+			err := client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name)).WithSet(setReq))
 			if err != nil {
 				return fmt.Errorf("error updating comment for network policy %v err = %w", name, err)
 			}
@@ -166,12 +201,17 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("allowed_ip_list") {
 		newIps := ipChangeParser(d, "allowed_ip_list")
-		ipRequests := make([]sdk.IPRequest, len(newIps))
-		for i, v := range newIps {
-			ipRequests[i] = *sdk.NewIPRequest(v)
+		req := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
+		if len(newIps) > 0 {
+			ipRequests := make([]sdk.IPRequest, len(newIps))
+			for i, v := range newIps {
+				ipRequests[i] = *sdk.NewIPRequest(v)
+			}
+			req = req.WithSet(sdk.NewNetworkPolicySetRequest().WithAllowedIpList(ipRequests))
+		} else {
+			req = req.WithUnset(sdk.NewNetworkPolicyUnsetRequest().WithAllowedIpList(sdk.Bool(true)))
 		}
-		setReq := sdk.NewNetworkPolicySetRequest().WithAllowedIpList(ipRequests)
-		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
+		err := client.NetworkPolicies.Alter(ctx, req)
 		if err != nil {
 			return fmt.Errorf("error updating ALLOWED_IP_LIST for network policy %v err = %w", name, err)
 		}
@@ -179,17 +219,50 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("blocked_ip_list") {
 		newIps := ipChangeParser(d, "blocked_ip_list")
-		ipRequests := make([]sdk.IPRequest, len(newIps))
-		for i, v := range newIps {
-			ipRequests[i] = *sdk.NewIPRequest(v)
+		req := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
+		if len(newIps) > 0 {
+			ipRequests := make([]sdk.IPRequest, len(newIps))
+			for i, v := range newIps {
+				ipRequests[i] = *sdk.NewIPRequest(v)
+			}
+			req = req.WithSet(sdk.NewNetworkPolicySetRequest().WithBlockedIpList(ipRequests))
+		} else {
+			req = req.WithUnset(sdk.NewNetworkPolicyUnsetRequest().WithBlockedIpList(sdk.Bool(true)))
 		}
-		setReq := sdk.NewNetworkPolicySetRequest().WithBlockedIpList(ipRequests)
-		err := client.NetworkPolicies.Alter(ctx, baseReq.WithSet(setReq))
+		err := client.NetworkPolicies.Alter(ctx, req)
 		if err != nil {
 			return fmt.Errorf("error updating BLOCKED_IP_LIST for network policy %v err = %w", name, err)
 		}
 	}
 
+	if d.HasChange("allowed_network_rule_list") {
+		networkRules := networkRuleIdentifiers(d.Get("allowed_network_rule_list"))
+		req := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
+		if len(networkRules) > 0 {
+			req = req.WithSet(sdk.NewNetworkPolicySetRequest().WithAllowedNetworkRuleList(networkRules))
+		} else {
+			req = req.WithUnset(sdk.NewNetworkPolicyUnsetRequest().WithAllowedNetworkRuleList(sdk.Bool(true)))
+		}
+		err := client.NetworkPolicies.Alter(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error updating ALLOWED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("blocked_network_rule_list") {
+		networkRules := networkRuleIdentifiers(d.Get("blocked_network_rule_list"))
+		req := sdk.NewAlterNetworkPolicyRequest(sdk.NewAccountObjectIdentifier(name))
+		if len(networkRules) > 0 {
+			req = req.WithSet(sdk.NewNetworkPolicySetRequest().WithBlockedNetworkRuleList(networkRules))
+		} else {
+			req = req.WithUnset(sdk.NewNetworkPolicyUnsetRequest().WithBlockedNetworkRuleList(sdk.Bool(true)))
+		}
+		err := client.NetworkPolicies.Alter(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error updating BLOCKED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
 	return ReadNetworkPolicy(d, meta)
 }
 
@@ -219,3 +292,26 @@ func ipChangeParser(data *schema.ResourceData, key string) []string {
 	}
 	return newIps
 }
+
+// networkRuleIdentifiers is a helper function to convert a set of fully qualified network rule names into identifiers.
+func networkRuleIdentifiers(v interface{}) []sdk.SchemaObjectIdentifier {
+	networkRules := expandStringList(v.(*schema.Set).List())
+	ids := make([]sdk.SchemaObjectIdentifier, len(networkRules))
+	for i, networkRule := range networkRules {
+		ids[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(networkRule)
+	}
+	return ids
+}
+
+// parseNetworkRuleList is a helper function to parse a network rule list returned by DESCRIBE NETWORK POLICY into fully qualified names.
+func parseNetworkRuleList(value string) ([]string, error) {
+	networkRules, err := sdk.ParseNetworkRulesSnowflakeDto(value)
+	if err != nil {
+		return nil, err
+	}
+	fullyQualifiedNames := make([]string, len(networkRules))
+	for i, networkRule := range networkRules {
+		fullyQualifiedNames[i] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(networkRule.FullyQualifiedRuleName).FullyQualifiedName()
+	}
+	return fullyQualifiedNames, nil
+}
@@ -56,6 +56,46 @@ func TestAcc_NetworkPolicy(t *testing.T) {
 	})
 }
 
+func TestAcc_NetworkPolicy_NetworkRules(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_NETWORK_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAccNetworkPolicy")
+	}
+
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkPolicyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_ip_list.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_network_rule_list.#", "0"),
+				),
+			},
+			// MIGRATE TO NETWORK RULES
+			{
+				Config: networkPolicyNetworkRulesConfig(name, acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_ip_list.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "allowed_network_rule_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_policy.test", "blocked_network_rule_list.#", "1"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_network_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func networkPolicyConfig(name string) string {
 	return fmt.Sprintf(`
 resource "snowflake_network_policy" "test" {
@@ -76,3 +116,32 @@ resource "snowflake_network_policy" "test" {
 }
 `, name, networkPolicyComment)
 }
+
+func networkPolicyNetworkRulesConfig(name string, databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "allowed" {
+	name       = "%[1]v_ALLOWED"
+	database   = "%[3]v"
+	schema     = "%[4]v"
+	type       = "IPV4"
+	mode       = "INGRESS"
+	value_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_network_rule" "blocked" {
+	name       = "%[1]v_BLOCKED"
+	database   = "%[3]v"
+	schema     = "%[4]v"
+	type       = "IPV4"
+	mode       = "INGRESS"
+	value_list = ["192.168.0.101"]
+}
+
+resource "snowflake_network_policy" "test" {
+	name                      = "%[1]v"
+	comment                   = "%[2]v"
+	allowed_network_rule_list = [snowflake_network_rule.allowed.qualified_name]
+	blocked_network_rule_list = [snowflake_network_rule.blocked.qualified_name]
+}
+`, name, networkPolicyComment, databaseName, schemaName)
+}
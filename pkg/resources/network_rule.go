@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the database and schema in which the network rule is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the network rule.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the network rule.",
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.NetworkRuleTypeIpv4),
+			string(sdk.NetworkRuleTypeAwsVpcEid),
+			string(sdk.NetworkRuleTypeAzureLinkId),
+			string(sdk.NetworkRuleTypeHostPort),
+		}, false),
+		Description: "Specifies the type of network identifiers being allowed or blocked. A network rule can have only one type. Allowed values are IPV4, AWSVPCEID, AZURELINKID, HOST_PORT; allowed values are determined by the mode of the network rule.",
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Specifies the network identifiers that will be allowed or blocked. Valid values in the list are determined by the type of network rule, see https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.",
+	},
+	"mode": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.NetworkRuleModeIngress),
+			string(sdk.NetworkRuleModeInternalStage),
+			string(sdk.NetworkRuleModeEgress),
+		}, false),
+		Description: "Specifies what is restricted by the network rule. Valid values are INGRESS, INTERNAL_STAGE and EGRESS.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the network rule.",
+	},
+}
+
+// NetworkRule returns a pointer to the resource representing a network rule.
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateNetworkRule,
+		Read:   ReadNetworkRule,
+		Update: UpdateNetworkRule,
+		Delete: DeleteNetworkRule,
+
+		Schema: networkRuleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func networkRuleValueRequests(v interface{}) []sdk.NetworkRuleValueRequest {
+	values := expandStringList(v.(*schema.Set).List())
+	valueRequests := make([]sdk.NetworkRuleValueRequest, len(values))
+	for i, value := range values {
+		valueRequests[i] = *sdk.NewNetworkRuleValueRequest(value)
+	}
+	return valueRequests
+}
+
+// CreateNetworkRule implements schema.CreateFunc.
+func CreateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	req := sdk.NewCreateNetworkRuleRequest(
+		id,
+		sdk.NetworkRuleType(d.Get("type").(string)),
+		sdk.NetworkRuleMode(d.Get("mode").(string)),
+	).WithValueList(networkRuleValueRequests(d.Get("value_list")))
+
+	if v, ok := d.GetOk("comment"); ok {
+		req = req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.NetworkRules.Create(ctx, req); err != nil {
+		return fmt.Errorf("error creating network rule %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadNetworkRule(d, meta)
+}
+
+// ReadNetworkRule implements schema.ReadFunc.
+func ReadNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+	if networkRule == nil || err != nil {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] network rule (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	networkRuleDetails, err := client.NetworkRules.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", networkRule.Name); err != nil {
+		return err
+	}
+	if err := d.Set("database", networkRule.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", networkRule.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("type", string(networkRule.Type)); err != nil {
+		return err
+	}
+	if err := d.Set("mode", string(networkRule.Mode)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", networkRule.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("value_list", networkRuleDetails.ValueList); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateNetworkRule implements schema.UpdateFunc.
+func UpdateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("value_list") {
+		valueRequests := networkRuleValueRequests(d.Get("value_list"))
+		req := sdk.NewAlterNetworkRuleRequest(id)
+		if len(valueRequests) > 0 {
+			req = req.WithSet(sdk.NewNetworkRuleSetRequest().WithValueList(valueRequests))
+		} else {
+			req = req.WithUnset(sdk.NewNetworkRuleUnsetRequest().WithValueList(sdk.Bool(true)))
+		}
+		if err := client.NetworkRules.Alter(ctx, req); err != nil {
+			return fmt.Errorf("error updating VALUE_LIST for network rule %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		req := sdk.NewAlterNetworkRuleRequest(id)
+		if c := d.Get("comment").(string); c != "" {
+			req = req.WithSet(sdk.NewNetworkRuleSetRequest().WithComment(sdk.String(c)))
+		} else {
+			req = req.WithUnset(sdk.NewNetworkRuleUnsetRequest().WithComment(sdk.Bool(true)))
+		}
+		if err := client.NetworkRules.Alter(ctx, req); err != nil {
+			return fmt.Errorf("error updating comment for network rule %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	return ReadNetworkRule(d, meta)
+}
+
+// DeleteNetworkRule implements schema.DeleteFunc.
+func DeleteNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id)); err != nil {
+		return fmt.Errorf("error deleting network rule %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
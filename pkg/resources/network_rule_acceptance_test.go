@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_NetworkRule(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkRuleConfig(name, `["192.168.0.100/24", "29.254.123.20"]`, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "type", "IPV4"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "mode", "INGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "value_list.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "comment", "test comment"),
+				),
+			},
+			// CHANGE VALUE LIST IN PLACE
+			{
+				Config: networkRuleConfig(name, `["10.0.0.1"]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "value_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_network_rule.test", "value_list.*", "10.0.0.1"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_network_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func networkRuleConfig(name string, valueList string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "test" {
+	name       = "%v"
+	database   = "%s"
+	schema     = "%s"
+	type       = "IPV4"
+	value_list = %s
+	mode       = "INGRESS"
+	comment    = "%s"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, valueList, comment)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -30,12 +31,12 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 	"oauth_redirect_uri": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Specifies the client URI. After a user is authenticated, the web browser is redirected to this URI.",
+		Description: "Specifies the client URI. After a user is authenticated, the web browser is redirected to this URI. Required when `oauth_client` is `CUSTOM`.",
 	},
 	"oauth_client_type": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Specifies the type of client being registered. Snowflake supports both confidential and public clients.",
+		Description: "Specifies the type of client being registered. Snowflake supports both confidential and public clients. Required when `oauth_client` is `CUSTOM`.",
 		ValidateFunc: validation.StringInSlice([]string{
 			"CONFIDENTIAL", "PUBLIC",
 		}, false),
@@ -80,6 +81,23 @@ var oauthIntegrationSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "Date and time when the OAuth integration was created.",
 	},
+	"oauth_client_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The client id of a custom OAuth client.",
+	},
+	"oauth_client_secret": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The client secret of a custom OAuth client, as returned by SYSTEM$SHOW_OAUTH_CLIENT_SECRETS. Only populated when the role used by the provider owns the integration.",
+	},
+	"oauth_client_secret_2": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The second client secret of a custom OAuth client, used for secret rotation.",
+	},
 }
 
 // OAuthIntegration returns a pointer to the resource representing an OAuth integration.
@@ -102,6 +120,15 @@ func CreateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
+	if d.Get("oauth_client").(string) == "CUSTOM" {
+		if _, ok := d.GetOk("oauth_client_type"); !ok {
+			return fmt.Errorf("oauth_client_type is required when oauth_client is CUSTOM")
+		}
+		if _, ok := d.GetOk("oauth_redirect_uri"); !ok {
+			return fmt.Errorf("oauth_redirect_uri is required when oauth_client is CUSTOM")
+		}
+	}
+
 	stmt := snowflake.NewOAuthIntegrationBuilder(name).Create()
 
 	// Set required fields
@@ -153,6 +180,12 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanOAuthIntegration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] security integration (%v) not found", id)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("could not show security integration err = %w", err)
 	}
@@ -248,6 +281,10 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 					return fmt.Errorf("unable to set OAuth client type for security integration err = %w", err)
 				}
 			}
+		case "OAUTH_CLIENT_ID":
+			if err := d.Set("oauth_client_id", v.(string)); err != nil {
+				return fmt.Errorf("unable to set OAuth client id for security integration err = %w", err)
+			}
 		case "OAUTH_ENFORCE_PKCE":
 			// Only used for custom OAuth clients (not supported yet)
 		case "OAUTH_AUTHORIZATION_ENDPOINT":
@@ -266,7 +303,34 @@ func ReadOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return err
+	if strings.HasSuffix(s.IntegrationType.String, "CUSTOM") {
+		if err := readOAuthClientSecrets(d, db, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readOAuthClientSecrets sets the client id and secrets of a custom OAuth client.
+func readOAuthClientSecrets(d *schema.ResourceData, db *sql.DB, id string) error {
+	stmt := snowflake.NewSystemShowOAuthClientSecretsBuilder(id).Select()
+	raw, err := snowflake.ScanOAuthClientSecrets(snowflake.QueryRow(db, stmt))
+	if err != nil {
+		return fmt.Errorf("could not retrieve OAuth client secrets for security integration err = %w", err)
+	}
+	secrets, err := raw.GetStructuredSecrets()
+	if err != nil {
+		return fmt.Errorf("could not parse OAuth client secrets for security integration err = %w", err)
+	}
+
+	if err := d.Set("oauth_client_id", secrets.ClientID); err != nil {
+		return err
+	}
+	if err := d.Set("oauth_client_secret", secrets.ClientSecret); err != nil {
+		return err
+	}
+	return d.Set("oauth_client_secret_2", secrets.ClientSecret2)
 }
 
 // UpdateOAuthIntegration implements schema.UpdateFunc.
@@ -310,7 +374,7 @@ func UpdateOAuthIntegration(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("blocked_roles_list") {
 		runSetStatement = true
-		stmt.SetStringList(`BLOCKED_ROLES_LIST`, expandStringList(d.Get("blocked_roles_list").([]interface{})))
+		stmt.SetStringList(`BLOCKED_ROLES_LIST`, expandStringList(d.Get("blocked_roles_list").(*schema.Set).List()))
 	}
 
 	if d.HasChange("enabled") {
@@ -30,6 +30,9 @@ func TestAcc_OAuthIntegration(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "oauth_refresh_token_validity", "3600"),
 					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "blocked_roles_list.#", "1"),
 					resource.TestCheckResourceAttr("snowflake_oauth_integration.test", "blocked_roles_list.0", "SYSADMIN"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration.test", "oauth_client_id"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration.test", "oauth_client_secret"),
+					resource.TestCheckResourceAttrSet("snowflake_oauth_integration.test", "oauth_client_secret_2"),
 				),
 			},
 			{
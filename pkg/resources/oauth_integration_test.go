@@ -39,6 +39,66 @@ func TestOAuthIntegrationCreate(t *testing.T) {
 	})
 }
 
+func TestOAuthIntegrationCreateCustom(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                         "test_custom_client",
+		"oauth_client":                 "CUSTOM",
+		"oauth_client_type":            "CONFIDENTIAL",
+		"oauth_redirect_uri":           "https://example.com/callback",
+		"oauth_issue_refresh_tokens":   true,
+		"oauth_refresh_token_validity": 86400,
+		"blocked_roles_list":           []interface{}{"SYSADMIN"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.OAuthIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECURITY INTEGRATION "test_custom_client" TYPE=OAUTH OAUTH_CLIENT='CUSTOM' OAUTH_CLIENT_TYPE='CONFIDENTIAL' OAUTH_REDIRECT_URI='https://example.com/callback' OAUTH_USE_SECONDARY_ROLES='NONE' BLOCKED_ROLES_LIST=\('SYSADMIN'\) OAUTH_ISSUE_REFRESH_TOKENS=true OAUTH_REFRESH_TOKEN_VALIDITY=86400$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		showRows := sqlmock.NewRows([]string{
+			"name", "type", "category", "enabled", "comment", "created_on",
+		}).AddRow("test_custom_client", "OAUTH - CUSTOM", "SECURITY", true, nil, "now")
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_custom_client'$`).WillReturnRows(showRows)
+		descRows := sqlmock.NewRows([]string{
+			"property", "property_type", "property_value", "property_default",
+		}).AddRow("OAUTH_CLIENT_TYPE", "String", "CONFIDENTIAL", "").
+			AddRow("OAUTH_REDIRECT_URI", "String", "https://example.com/callback", "").
+			AddRow("OAUTH_CLIENT_ID", "String", "client_id", "").
+			AddRow("BLOCKED_ROLES_LIST", "List", "ACCOUNTADMIN,SECURITYADMIN,SYSADMIN", nil)
+		mock.ExpectQuery(`^DESCRIBE SECURITY INTEGRATION "test_custom_client"$`).WillReturnRows(descRows)
+		secretRows := sqlmock.NewRows([]string{"SECRETS"}).
+			AddRow(`{"OAUTH_CLIENT_SECRET_2":"secret2","OAUTH_CLIENT_SECRET":"secret1","OAUTH_CLIENT_ID":"client_id"}`)
+		mock.ExpectQuery(`^SELECT SYSTEM\$SHOW_OAUTH_CLIENT_SECRETS\('test_custom_client'\) AS "SECRETS"$`).WillReturnRows(secretRows)
+
+		err := resources.CreateOAuthIntegration(d, db)
+		r.NoError(err)
+		r.Equal("client_id", d.Get("oauth_client_id").(string))
+		r.Equal("secret1", d.Get("oauth_client_secret").(string))
+		r.Equal("secret2", d.Get("oauth_client_secret_2").(string))
+		r.Equal([]interface{}{"SYSADMIN"}, d.Get("blocked_roles_list").(*schema.Set).List())
+	})
+}
+
+func TestOAuthIntegrationCreateCustomWithoutRedirectURI(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":              "test_custom_client",
+		"oauth_client":      "CUSTOM",
+		"oauth_client_type": "PUBLIC",
+	}
+	d := schema.TestResourceDataRaw(t, resources.OAuthIntegration().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		err := resources.CreateOAuthIntegration(d, db)
+		r.ErrorContains(err, "oauth_redirect_uri is required")
+	})
+}
+
 func TestOAuthIntegrationRead(t *testing.T) {
 	r := require.New(t)
 
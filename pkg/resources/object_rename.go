@@ -0,0 +1,206 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var objectRenameTypes = []string{
+	string(sdk.ObjectTypeDatabase),
+	string(sdk.ObjectTypeRole),
+	string(sdk.ObjectTypeUser),
+	string(sdk.ObjectTypeWarehouse),
+}
+
+var objectRenameSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  fmt.Sprintf("Type of the renamed object; one of %v.", strings.Join(objectRenameTypes, ", ")),
+		ValidateFunc: validation.StringInSlice(objectRenameTypes, true),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"from_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the object before the rename.",
+	},
+	"to_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the object after the rename. Changing it renames the object again in place.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current name of the object, to be referenced by the configuration that used the previous name.",
+	},
+}
+
+// ObjectRename returns a pointer to the resource renaming an existing object.
+func ObjectRename() *schema.Resource {
+	return &schema.Resource{
+		Description: "Renames an existing object with ALTER ... RENAME TO, e.g. during migrations of roles, warehouses, databases or users that are not managed by their own resource. The rename is idempotent: nothing is executed when the object already has the new name. References to the object in other resources are not rewritten: they should reference the name attribute, so that they pick up the new name. An object renamed back outside of Terraform is renamed again on the next apply, while an object dropped or renamed to another name is removed from the state. Destroying the resource does not rename the object back.",
+
+		Create: CreateObjectRename,
+		Read:   ReadObjectRename,
+		Update: UpdateObjectRename,
+		Delete: DeleteObjectRename,
+
+		Schema: objectRenameSchema,
+	}
+}
+
+// objectExists returns true if the object of the given type exists under the given name.
+func objectExists(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, id sdk.AccountObjectIdentifier) (bool, error) {
+	var err error
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		_, err = client.Databases.ShowByID(ctx, id)
+	case sdk.ObjectTypeUser:
+		_, err = client.Users.ShowByID(ctx, id)
+	case sdk.ObjectTypeWarehouse:
+		_, err = client.Warehouses.ShowByID(ctx, id)
+	case sdk.ObjectTypeRole:
+		roles, showErr := client.Roles.Show(ctx, sdk.NewShowRoleRequest().WithLike(sdk.NewLikeRequest(id.Name())))
+		if showErr != nil {
+			return false, showErr
+		}
+		for _, role := range roles {
+			if role.Name == id.Name() {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("renaming objects of type %v is not supported", objectType)
+	}
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// renameObject renames the object of the given type.
+func renameObject(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, from sdk.AccountObjectIdentifier, to sdk.AccountObjectIdentifier) error {
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		return client.Databases.Alter(ctx, from, &sdk.AlterDatabaseOptions{NewName: to})
+	case sdk.ObjectTypeUser:
+		return client.Users.Alter(ctx, from, &sdk.AlterUserOptions{NewName: to})
+	case sdk.ObjectTypeWarehouse:
+		return client.Warehouses.Alter(ctx, from, &sdk.AlterWarehouseOptions{NewName: &to})
+	case sdk.ObjectTypeRole:
+		return client.Roles.Alter(ctx, sdk.NewAlterRoleRequest(from).WithRenameTo(to))
+	default:
+		return fmt.Errorf("renaming objects of type %v is not supported", objectType)
+	}
+}
+
+// applyObjectRename renames the object unless it already has the new name.
+func applyObjectRename(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, from sdk.AccountObjectIdentifier, to sdk.AccountObjectIdentifier) error {
+	renamed, err := objectExists(ctx, client, objectType, to)
+	if err != nil {
+		return err
+	}
+	if renamed {
+		log.Printf("[DEBUG] %v %v already renamed", objectType, to.Name())
+		return nil
+	}
+	if err := renameObject(ctx, client, objectType, from, to); err != nil {
+		return fmt.Errorf("error renaming %v %v to %v err = %w", objectType, from.Name(), to.Name(), err)
+	}
+	return nil
+}
+
+// CreateObjectRename implements schema.CreateFunc.
+func CreateObjectRename(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	from := sdk.NewAccountObjectIdentifier(d.Get("from_name").(string))
+	to := sdk.NewAccountObjectIdentifier(d.Get("to_name").(string))
+	if err := applyObjectRename(ctx, client, objectType, from, to); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(string(objectType), from.Name()))
+
+	return ReadObjectRename(d, meta)
+}
+
+// ReadObjectRename implements schema.ReadFunc.
+func ReadObjectRename(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	to := sdk.NewAccountObjectIdentifier(d.Get("to_name").(string))
+	exists, err := objectExists(ctx, client, objectType, to)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return d.Set("name", to.Name())
+	}
+
+	// the object renamed back outside of Terraform is reported as drift of to_name, so that it is renamed again in place
+	from := sdk.NewAccountObjectIdentifier(d.Get("from_name").(string))
+	exists, err = objectExists(ctx, client, objectType, from)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Printf("[DEBUG] %v %v still has its previous name %v", objectType, to.Name(), from.Name())
+		if err := d.Set("to_name", from.Name()); err != nil {
+			return err
+		}
+		return d.Set("name", from.Name())
+	}
+
+	// the object dropped or renamed to another name outside of Terraform cannot be tracked anymore
+	log.Printf("[DEBUG] %v %v not found", objectType, to.Name())
+	d.SetId("")
+	return nil
+}
+
+// UpdateObjectRename implements schema.UpdateFunc.
+func UpdateObjectRename(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	if d.HasChange("to_name") {
+		objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+		o, n := d.GetChange("to_name")
+		from := sdk.NewAccountObjectIdentifier(o.(string))
+		to := sdk.NewAccountObjectIdentifier(n.(string))
+		if err := applyObjectRename(ctx, client, objectType, from, to); err != nil {
+			return err
+		}
+	}
+
+	return ReadObjectRename(d, meta)
+}
+
+// DeleteObjectRename implements schema.DeleteFunc.
+func DeleteObjectRename(d *schema.ResourceData, meta interface{}) error {
+	// the object keeps its new name, only the rename is removed from the state
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,102 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectRename(t *testing.T) {
+	r := require.New(t)
+	err := resources.ObjectRename().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestObjectRenameCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type": "role",
+		"from_name":   "old_role",
+		"to_name":     "new_role",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectRename().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW ROLES LIKE 'new_role'$`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}))
+		mock.ExpectExec(`^ALTER ROLE "old_role" RENAME TO "new_role"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectShowObjectRenameRole(mock, "new_role")
+		err := resources.CreateObjectRename(d, db)
+		r.NoError(err)
+		r.Equal("ROLE|old_role", d.Id())
+		r.Equal("new_role", d.Get("name").(string))
+	})
+}
+
+func TestObjectRenameCreateAlreadyRenamed(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type": "ROLE",
+		"from_name":   "old_role",
+		"to_name":     "new_role",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectRename().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowObjectRenameRole(mock, "new_role")
+		expectShowObjectRenameRole(mock, "new_role")
+		err := resources.CreateObjectRename(d, db)
+		r.NoError(err)
+		r.Equal("new_role", d.Get("name").(string))
+	})
+}
+
+func TestObjectRenameRead(t *testing.T) {
+	in := map[string]interface{}{
+		"object_type": "ROLE",
+		"from_name":   "old_role",
+		"to_name":     "new_role",
+	}
+
+	t.Run("reports the object renamed back as drift", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resources.ObjectRename().Schema, in)
+		d.SetId("ROLE|old_role")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^SHOW ROLES LIKE 'new_role'$`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}))
+			expectShowObjectRenameRole(mock, "old_role")
+			err := resources.ReadObjectRename(d, db)
+			require.NoError(t, err)
+			require.Equal(t, "ROLE|old_role", d.Id())
+			require.Equal(t, "old_role", d.Get("to_name").(string))
+			require.Equal(t, "old_role", d.Get("name").(string))
+		})
+	})
+
+	t.Run("removes the missing object from the state", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resources.ObjectRename().Schema, in)
+		d.SetId("ROLE|old_role")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^SHOW ROLES LIKE 'new_role'$`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}))
+			mock.ExpectQuery(`^SHOW ROLES LIKE 'old_role'$`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}))
+			err := resources.ReadObjectRename(d, db)
+			require.NoError(t, err)
+			require.Empty(t, d.Id())
+		})
+	})
+}
+
+func expectShowObjectRenameRole(mock sqlmock.Sqlmock, name string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles", "granted_roles", "owner", "comment"}).
+		AddRow(time.Now(), name, "N", "N", "N", 0, 0, 0, "SECURITYADMIN", "")
+	mock.ExpectQuery(`^SHOW ROLES LIKE '` + name + `'$`).WillReturnRows(rows)
+}
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var packagesPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the packages policy; must be unique for the database and schema in which the packages policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the packages policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the packages policy.",
+	},
+	"language": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      string(sdk.PackagesPolicyLanguagePython),
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.PackagesPolicyLanguagePython)}, false),
+		Description:  "Specifies the language of the packages policy. Currently only PYTHON is supported.",
+	},
+	"allowlist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Computed:    true,
+		Description: "Specifies a list of package specs that are allowed (e.g. `numpy`, `pandas==2.0.*`). If not set, Snowflake allows all packages (`*`).",
+	},
+	"blocklist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of package specs that are explicitly not allowed.",
+	},
+	"additional_creation_blocklist": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies a list of package specs that are blocked at creation time, but can still be used by existing functions and procedures.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the packages policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the packages policy.",
+	},
+}
+
+// PackagesPolicy returns a pointer to the resource representing a packages policy.
+func PackagesPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: CreatePackagesPolicy,
+		Read:   ReadPackagesPolicy,
+		Update: UpdatePackagesPolicy,
+		Delete: DeletePackagesPolicy,
+
+		Schema: packagesPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func packagesPolicyPackageRequests(v interface{}) []sdk.PackagesPolicyPackageRequest {
+	packages := expandStringList(v.(*schema.Set).List())
+	packageRequests := make([]sdk.PackagesPolicyPackageRequest, len(packages))
+	for i, p := range packages {
+		packageRequests[i] = *sdk.NewPackagesPolicyPackageRequest(p)
+	}
+	return packageRequests
+}
+
+// CreatePackagesPolicy implements schema.CreateFunc.
+func CreatePackagesPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	req := sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguage(d.Get("language").(string)))
+
+	if v, ok := d.GetOk("allowlist"); ok {
+		req = req.WithAllowlist(packagesPolicyPackageRequests(v))
+	}
+	if v, ok := d.GetOk("blocklist"); ok {
+		req = req.WithBlocklist(packagesPolicyPackageRequests(v))
+	}
+	if v, ok := d.GetOk("additional_creation_blocklist"); ok {
+		req = req.WithAdditionalCreationBlocklist(packagesPolicyPackageRequests(v))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req = req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.PackagesPolicies.Create(ctx, req); err != nil {
+		return fmt.Errorf("error creating packages policy %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadPackagesPolicy(d, meta)
+}
+
+// ReadPackagesPolicy implements schema.ReadFunc.
+func ReadPackagesPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	packagesPolicy, err := client.PackagesPolicies.ShowByID(ctx, id)
+	if packagesPolicy == nil || err != nil {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] packages policy (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	details, err := client.PackagesPolicies.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", packagesPolicy.Name); err != nil {
+		return err
+	}
+	if err := d.Set("database", packagesPolicy.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", packagesPolicy.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("language", string(details.Language)); err != nil {
+		return err
+	}
+	if err := d.Set("allowlist", details.Allowlist); err != nil {
+		return err
+	}
+	if err := d.Set("blocklist", details.Blocklist); err != nil {
+		return err
+	}
+	if err := d.Set("additional_creation_blocklist", details.AdditionalCreationBlocklist); err != nil {
+		return err
+	}
+	if err := d.Set("comment", packagesPolicy.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePackagesPolicy implements schema.UpdateFunc.
+func UpdatePackagesPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set, unset := sdk.NewPackagesPolicySetRequest(), sdk.NewPackagesPolicyUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("allowlist") {
+		if packages := packagesPolicyPackageRequests(d.Get("allowlist")); len(packages) > 0 {
+			set.WithAllowlist(packages)
+			runSet = true
+		} else {
+			unset.WithAllowlist(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("blocklist") {
+		if packages := packagesPolicyPackageRequests(d.Get("blocklist")); len(packages) > 0 {
+			set.WithBlocklist(packages)
+			runSet = true
+		} else {
+			unset.WithBlocklist(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("additional_creation_blocklist") {
+		if packages := packagesPolicyPackageRequests(d.Get("additional_creation_blocklist")); len(packages) > 0 {
+			set.WithAdditionalCreationBlocklist(packages)
+			runSet = true
+		} else {
+			unset.WithAdditionalCreationBlocklist(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("comment") {
+		if c := d.Get("comment").(string); c != "" {
+			set.WithComment(sdk.String(c))
+			runSet = true
+		} else {
+			unset.WithComment(sdk.Bool(true))
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating packages policy %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	if runUnset {
+		if err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(unset)); err != nil {
+			return fmt.Errorf("error updating packages policy %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	return ReadPackagesPolicy(d, meta)
+}
+
+// DeletePackagesPolicy implements schema.DeleteFunc.
+func DeletePackagesPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id)); err != nil {
+		return fmt.Errorf("error deleting packages policy %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_PackagesPolicy(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: packagesPolicyConfig(name, `["numpy", "pandas"]`, `["requests"]`, "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "language", "PYTHON"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "allowlist.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "blocklist.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "comment", "test comment"),
+				),
+			},
+			// CHANGE LISTS IN PLACE
+			{
+				Config: packagesPolicyConfig(name, `["numpy"]`, `[]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "allowlist.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snowflake_packages_policy.test", "allowlist.*", "numpy"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "blocklist.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_packages_policy.test", "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_packages_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func packagesPolicyConfig(name string, allowlist string, blocklist string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_packages_policy" "test" {
+	name      = "%v"
+	database  = "%s"
+	schema    = "%s"
+	allowlist = %s
+	blocklist = %s
+	comment   = "%s"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, allowlist, blocklist, comment)
+}
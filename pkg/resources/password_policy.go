@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -111,6 +112,20 @@ var passwordPolicySchema = map[string]*schema.Schema{
 		Description:  "Specifies the number of minutes the user account will be locked after exhausting the designated number of password retries (i.e. PASSWORD_MAX_RETRIES). Supported range: 1 to 999, inclusive. Default: 15",
 		ValidateFunc: validation.IntBetween(1, 999),
 	},
+	"min_age_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		Description:  "Specifies the number of days the user must wait before a recently changed password can be changed again. Supported range: 0 to 999, inclusive. Default: 0",
+		ValidateFunc: validation.IntBetween(0, 999),
+	},
+	"history": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		Description:  "Specifies the number of the most recent passwords that Snowflake stores. These stored passwords cannot be repeated when a user updates their password value. The current password value does not count towards the history. When you increase the history value, Snowflake saves the previous values. When you decrease the value, Snowflake saves the stored values up to that value that is set. Supported range: 0 to 24, inclusive. Default: 0",
+		ValidateFunc: validation.IntBetween(0, 24),
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -160,6 +175,8 @@ func CreatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		PasswordMaxAgeDays:        sdk.Int(d.Get("max_age_days").(int)),
 		PasswordMaxRetries:        sdk.Int(d.Get("max_retries").(int)),
 		PasswordLockoutTimeMins:   sdk.Int(d.Get("lockout_time_mins").(int)),
+		PasswordMinAgeDays:        sdk.Int(d.Get("min_age_days").(int)),
+		PasswordHistory:           sdk.Int(d.Get("history").(int)),
 	}
 
 	if v, ok := d.GetOk("comment"); ok {
@@ -187,7 +204,9 @@ func ReadPasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	passwordPolicy, err := client.PasswordPolicies.ShowByID(ctx, objectIdentifier)
 	if err != nil {
-		return err
+		log.Printf("[DEBUG] password policy (%s) not found", d.Id())
+		d.SetId("")
+		return nil
 	}
 
 	if err := d.Set("database", passwordPolicy.DatabaseName); err != nil {
@@ -234,6 +253,12 @@ func ReadPasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 	if err := setIntProperty(d, "lockout_time_mins", passwordPolicyDetails.PasswordLockoutTimeMins); err != nil {
 		return err
 	}
+	if err := setIntProperty(d, "min_age_days", passwordPolicyDetails.PasswordMinAgeDays); err != nil {
+		return err
+	}
+	if err := setIntProperty(d, "history", passwordPolicyDetails.PasswordHistory); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -350,6 +375,29 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("min_age_days") {
+		alterOptions := &sdk.AlterPasswordPolicyOptions{
+			Set: &sdk.PasswordPolicySet{
+				PasswordMinAgeDays: sdk.Int(d.Get("min_age_days").(int)),
+			},
+		}
+		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("history") {
+		alterOptions := &sdk.AlterPasswordPolicyOptions{
+			Set: &sdk.PasswordPolicySet{
+				PasswordHistory: sdk.Int(d.Get("history").(int)),
+			},
+		}
+		err := client.PasswordPolicies.Alter(ctx, objectIdentifier, alterOptions)
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("comment") {
 		alterOptions := &sdk.AlterPasswordPolicyOptions{}
 		if v, ok := d.GetOk("comment"); ok {
@@ -385,7 +433,7 @@ func UpdatePasswordPolicy(d *schema.ResourceData, meta interface{}) error {
 		d.SetId(helpers.EncodeSnowflakeID(newID))
 	}
 
-	return nil
+	return ReadPasswordPolicy(d, meta)
 }
 
 // DeletePasswordPolicy implements schema.DeleteFunc.
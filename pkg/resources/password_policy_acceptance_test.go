@@ -112,3 +112,46 @@ func passwordPolicyDefaultMaxageDaysConfig(s string, databaseName string, schema
 	}
 	`, s, databaseName, schemaName, maxAgeDays)
 }
+
+func TestAcc_PasswordPolicyHistory(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: passwordPolicyHistoryConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, 1, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_age_days", "1"),
+					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "history", "5"),
+				),
+			},
+			{
+				Config: passwordPolicyHistoryConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "min_age_days", "0"),
+					resource.TestCheckResourceAttr("snowflake_password_policy.pa", "history", "0"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_password_policy.pa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func passwordPolicyHistoryConfig(s string, databaseName string, schemaName string, minAgeDays int, history int) string {
+	return fmt.Sprintf(`
+	resource "snowflake_password_policy" "pa" {
+		name         = "%v"
+		database     = "%s"
+		schema       = "%s"
+		min_age_days = %d
+		history      = %d
+	}
+	`, s, databaseName, schemaName, minAgeDays, history)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -78,7 +79,18 @@ var pipeSchema = map[string]*schema.Schema{
 	"error_integration": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Specifies the name of the notification integration used for error notifications.",
+		Description: "Specifies the name of the notification integration used for error notifications. The integration cannot be unset, so removing it recreates the pipe.",
+	},
+	"pipe_execution_paused": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the pipe is paused. Pausing and resuming the pipe does not recreate it.",
+	},
+	"execution_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Current execution state of the pipe as returned by SYSTEM$PIPE_STATUS (e.g. RUNNING, PAUSED, STOPPED_STAGE_DROPPED).",
 	},
 }
 
@@ -93,6 +105,10 @@ func Pipe() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("error_integration", func(ctx context.Context, old, new, meta any) bool {
+			return old.(string) != "" && new.(string) == ""
+		}),
 	}
 }
 
@@ -149,6 +165,13 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
+	if d.Get("pipe_execution_paused").(bool) {
+		err := client.Pipes.Alter(ctx, objectIdentifier, &sdk.AlterPipeOptions{Set: &sdk.PipeSet{PipeExecutionPaused: sdk.Bool(true)}})
+		if err != nil {
+			return fmt.Errorf("error pausing pipe %v: %w", objectIdentifier.Name(), err)
+		}
+	}
+
 	return ReadPipe(d, meta)
 }
 
@@ -200,14 +223,28 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if strings.Contains(pipe.NotificationChannel, "arn:aws:sns:") {
-		err = d.Set("aws_sns_topic_arn", pipe.NotificationChannel)
-		return err
+		if err := d.Set("aws_sns_topic_arn", pipe.NotificationChannel); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("error_integration", pipe.ErrorIntegration); err != nil {
 		return err
 	}
 
+	executionState, err := client.SystemFunctions.PipeStatus(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("execution_state", string(executionState)); err != nil {
+		return err
+	}
+
+	if err := d.Set("pipe_execution_paused", executionState == sdk.PausedPipeExecutionState); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -233,16 +270,19 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// removing the error integration forces a new pipe, so it is only set here
 	if d.HasChange("error_integration") {
 		if errorIntegration, ok := d.GetOk("error_integration"); ok {
 			runSetStatement = true
-			pipeSet.Comment = sdk.String(errorIntegration.(string))
-		} else {
-			runUnsetStatement = true
-			pipeUnset.Comment = sdk.Bool(true)
+			pipeSet.ErrorIntegration = sdk.String(errorIntegration.(string))
 		}
 	}
 
+	if d.HasChange("pipe_execution_paused") {
+		runSetStatement = true
+		pipeSet.PipeExecutionPaused = sdk.Bool(d.Get("pipe_execution_paused").(bool))
+	}
+
 	if runSetStatement {
 		options := &sdk.AlterPipeOptions{Set: pipeSet}
 		err := client.Pipes.Alter(ctx, objectIdentifier, options)
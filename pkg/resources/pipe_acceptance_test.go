@@ -23,7 +23,7 @@ func TestAcc_Pipe(t *testing.T) {
 		CheckDestroy: nil,
 		Steps: []resource.TestStep{
 			{
-				Config: pipeConfig(accName, acc.TestDatabaseName, acc.TestSchemaName),
+				Config: pipeConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "name", accName),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "database", acc.TestDatabaseName),
@@ -31,13 +31,32 @@ func TestAcc_Pipe(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "comment", "Terraform acceptance test"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "auto_ingest", "false"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "notification_channel", ""),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_execution_paused", "false"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "RUNNING"),
+				),
+			},
+			// pause the pipe in place
+			{
+				Config: pipeConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_execution_paused", "true"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "PAUSED"),
+				),
+			},
+			// resume the pipe in place
+			{
+				Config: pipeConfig(accName, acc.TestDatabaseName, acc.TestSchemaName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_execution_paused", "false"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "execution_state", "RUNNING"),
 				),
 			},
 		},
 	})
 }
 
-func pipeConfig(name string, databaseName string, schemaName string) string {
+func pipeConfig(name string, databaseName string, schemaName string, paused bool) string {
 	s := `
 resource "snowflake_table" "test" {
 	database = "%s"
@@ -74,7 +93,9 @@ COPY INTO "${snowflake_table.test.database}"."${snowflake_table.test.schema}"."$
   FILE_FORMAT = (TYPE = CSV)
 CMD
   auto_ingest    = false
+
+  pipe_execution_paused = %t
 }
 `
-	return fmt.Sprintf(s, databaseName, schemaName, name, name, databaseName, schemaName, databaseName, schemaName, name)
+	return fmt.Sprintf(s, databaseName, schemaName, name, name, databaseName, schemaName, databaseName, schemaName, name, paused)
 }
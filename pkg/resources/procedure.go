@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -88,8 +90,8 @@ var procedureSchema = map[string]*schema.Schema{
 	},
 	"statement": {
 		Type:             schema.TypeString,
-		Required:         true,
-		Description:      "Specifies the code used to create the procedure.",
+		Optional:         true,
+		Description:      "Specifies the code used to create the procedure. Can be omitted for Java, Scala and Python procedures whose handler is in a staged file listed in `imports`.",
 		ForceNew:         true,
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
@@ -104,10 +106,14 @@ var procedureSchema = map[string]*schema.Schema{
 		Description:  "Specifies the language of the stored procedure code.",
 	},
 	"execute_as": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "OWNER",
-		Description: "Sets execute context - see caller's rights and owner's rights",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "OWNER",
+		ValidateFunc: validation.StringInSlice([]string{"CALLER", "OWNER"}, true),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+		Description: "Sets execute context - see caller's rights and owner's rights. Valid values are (case-insensitive): CALLER | OWNER. Changed in place.",
 	},
 	"null_input_behavior": {
 		Type:     schema.TypeString,
@@ -136,7 +142,7 @@ var procedureSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
-		Description: "Required for Python procedures. Specifies Python runtime version.",
+		Description: "Required for Python and Scala procedures. Specifies the Java, Scala or Python runtime version, e.g. 11, 2.12 or 3.8.",
 	},
 	"packages": {
 		Type: schema.TypeList,
@@ -145,7 +151,7 @@ var procedureSchema = map[string]*schema.Schema{
 		},
 		Optional:    true,
 		ForceNew:    true,
-		Description: "List of package imports to use for Java / Python procedures. For Java, package imports should be of the form: package_name:version_number, where package_name is snowflake_domain:package. For Python use it should be: ('numpy','pandas','xgboost==1.5.0').",
+		Description: "List of package imports to use for Java / Scala / Python procedures. For Java and Scala, package imports should be of the form: package_name:version_number, where package_name is snowflake_domain:package, e.g. com.snowflake:snowpark:latest. For Python use it should be: ('numpy','pandas','xgboost==1.5.0').",
 	},
 	"imports": {
 		Type: schema.TypeList,
@@ -154,13 +160,39 @@ var procedureSchema = map[string]*schema.Schema{
 		},
 		Optional:    true,
 		ForceNew:    true,
-		Description: "Imports for Java / Python procedures. For Java this a list of jar files, for Python this is a list of Python files.",
+		Description: "Imports for Java / Scala / Python procedures. For Java and Scala this a list of jar files, for Python this is a list of Python files.",
 	},
 	"handler": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
-		Description: "The handler method for Java / Python procedures.",
+		Description: "The handler method for Java / Scala / Python procedures.",
+	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The names of the external access integrations needed in order for the Java / Scala / Python handler code to access external networks.",
+	},
+	"secrets": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The secrets the Java / Scala / Python handler code can read, bound to the variable names used to retrieve them in the code. Requires `external_access_integrations` allowing the secrets.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name used to retrieve the secret in the handler code.",
+				},
+				"secret_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Identifier of the secret. Note: format must follow: \"databaseName\".\"schemaName\".\"secretName\" or \"databaseName.schemaName.secretName\" or \"databaseName|schemaName.secretName\"",
+					ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+				},
+			},
+		},
 	},
 }
 
@@ -180,6 +212,10 @@ func Procedure() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateStatementRequired(d, "procedures")
+		},
 	}
 }
 
@@ -187,12 +223,12 @@ func Procedure() *schema.Resource {
 func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
-	schema := d.Get("schema").(string)
+	schemaName := d.Get("schema").(string)
 	database := d.Get("database").(string)
 	s := d.Get("statement").(string)
 	ret := d.Get("return_type").(string)
 
-	builder := snowflake.NewProcedureBuilder(database, schema, name, []string{}).WithStatement(s).WithReturnType(ret)
+	builder := snowflake.NewProcedureBuilder(database, schemaName, name, []string{}).WithStatement(s).WithReturnType(ret)
 
 	// Set optionals, args
 	if _, ok := d.GetOk("arguments"); ok {
@@ -259,6 +295,15 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 		builder.WithHandler(v.(string))
 	}
 
+	// external access for Java / Scala / Python
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandStringList(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(expandFunctionSecrets(v.(*schema.Set).List()))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -270,7 +315,7 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 
 	procedureID := &procedureID{
 		DatabaseName:  database,
-		SchemaName:    schema,
+		SchemaName:    schemaName,
 		ProcedureName: name,
 		ArgTypes:      builder.ArgTypes(),
 	}
@@ -379,7 +424,21 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("handler", desc.Value.String); err != nil {
 				return err
 			}
-
+		case "external_access_integrations":
+			integrations := parseFunctionExternalAccessIntegrations(desc.Value.String, d.Get("external_access_integrations").(*schema.Set).List())
+			if err := d.Set("external_access_integrations", integrations); err != nil {
+				return err
+			}
+		case "secrets":
+			secrets, err := parseFunctionSecrets(desc.Value.String, d.Get("secrets").(*schema.Set).List())
+			if err != nil {
+				return err
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
+		case "installed_packages":
+			// the resolved versions of all the packages, including the transitive ones, are not managed
 		default:
 			log.Printf("[WARN] unexpected procedure property %v returned from Snowflake", desc.Property.String)
 		}
@@ -494,6 +553,26 @@ func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("external_access_integrations") {
+		q, err := builder.ChangeExternalAccessIntegrations(expandStringList(d.Get("external_access_integrations").(*schema.Set).List()))
+		if err != nil {
+			return err
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating external access integrations for procedure %v err = %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("secrets") {
+		q, err := builder.ChangeSecrets(expandFunctionSecrets(d.Get("secrets").(*schema.Set).List()))
+		if err != nil {
+			return err
+		}
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating secrets for procedure %v err = %w", d.Id(), err)
+		}
+	}
+
 	return ReadProcedure(d, meta)
 }
 
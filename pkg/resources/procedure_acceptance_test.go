@@ -139,3 +139,57 @@ func procedureConfig(name string, databaseName string, schemaName string) string
 	  }
 	`, name, databaseName, schemaName, name, databaseName, schemaName, name, databaseName, schemaName, name, databaseName, schemaName)
 }
+
+func TestAcc_Procedure_PythonExecuteAs(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_PROCEDURE_TESTS"); ok {
+		t.Skip("Skipping TestAcc_Procedure_PythonExecuteAs")
+	}
+
+	procName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: procedurePythonConfig(procName, acc.TestDatabaseName, acc.TestSchemaName, "OWNER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "name", procName),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "language", "PYTHON"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "handler", "run"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "runtime_version", "3.8"),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "execute_as", "OWNER"),
+				),
+			},
+			// execute_as is changed in place
+			{
+				Config: procedurePythonConfig(procName, acc.TestDatabaseName, acc.TestSchemaName, "CALLER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "name", procName),
+					resource.TestCheckResourceAttr("snowflake_procedure.test_proc_python", "execute_as", "CALLER"),
+				),
+			},
+		},
+	})
+}
+
+func procedurePythonConfig(name string, databaseName string, schemaName string, executeAs string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_procedure" "test_proc_python" {
+		name            = "%s"
+		database        = "%s"
+		schema          = "%s"
+		language        = "PYTHON"
+		return_type     = "VARCHAR"
+		runtime_version = "3.8"
+		packages        = ["snowflake-snowpark-python"]
+		handler         = "run"
+		execute_as      = "%s"
+		statement       = <<-EOF
+			def run(session):
+				return "hi"
+		EOF
+	}
+	`, name, databaseName, schemaName, executeAs)
+}
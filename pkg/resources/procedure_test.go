@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/stretchr/testify/require"
@@ -113,6 +115,78 @@ func TestProcedureRead(t *testing.T) {
 	})
 }
 
+func TestProcedureCreatePythonWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	d := procedure(t, "my_db|my_schema|my_proc|", map[string]interface{}{
+		"name":                         "my_proc",
+		"database":                     "my_db",
+		"schema":                       "my_schema",
+		"return_type":                  "varchar",
+		"language":                     "python",
+		"runtime_version":              "3.8",
+		"packages":                     []interface{}{"snowflake-snowpark-python"},
+		"handler":                      "run",
+		"execute_as":                   "caller",
+		"comment":                      "",
+		"statement":                    "def run(session): return 'hi'",
+		"external_access_integrations": []interface{}{"MY_INTEGRATION"},
+		"secrets":                      []interface{}{map[string]interface{}{"secret_variable_name": "cred", "secret_id": "my_db.my_schema.my_secret"}},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE OR REPLACE PROCEDURE "my_db"."my_schema"."my_proc"\(\) RETURNS VARCHAR LANGUAGE PYTHON CALLED ON NULL INPUT VOLATILE RUNTIME_VERSION = '3.8' PACKAGES = \('snowflake-snowpark-python'\) HANDLER = 'run' EXTERNAL_ACCESS_INTEGRATIONS = \("MY_INTEGRATION"\) SECRETS = \('cred' = "my_db"."my_schema"."my_secret"\) EXECUTE AS caller AS \$\$def run\(session\): return 'hi'\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{"name", "schema_name", "arguments", "description", "catalog_name"}).
+			AddRow("MY_PROC", "MY_SCHEMA", "MY_PROC() RETURN VARCHAR", "", "MY_DB")
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "()").
+			AddRow("returns", "VARCHAR(16777216)").
+			AddRow("language", "PYTHON").
+			AddRow("execute as", "CALLER").
+			AddRow("body", "def run(session): return 'hi'").
+			AddRow("handler", "run").
+			AddRow("external_access_integrations", "[MY_INTEGRATION]").
+			AddRow("secrets", `{"cred":"\"MY_DB\".\"MY_SCHEMA\".\"MY_SECRET\""}`).
+			AddRow("installed_packages", "['snowflake-snowpark-python==1.0.0']")
+		mock.ExpectQuery(`DESCRIBE PROCEDURE "my_db"."my_schema"."my_proc"\(\)`).WillReturnRows(describeRows)
+		mock.ExpectQuery(`SHOW PROCEDURES LIKE 'my_proc' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
+
+		err := resources.CreateProcedure(d, db)
+		r.NoError(err)
+		r.Equal("CALLER", d.Get("execute_as").(string))
+		r.Equal([]interface{}{"MY_INTEGRATION"}, d.Get("external_access_integrations").(*schema.Set).List())
+		secrets := d.Get("secrets").(*schema.Set).List()
+		r.Len(secrets, 1)
+		r.Equal("my_db.my_schema.my_secret", secrets[0].(map[string]interface{})["secret_id"])
+	})
+}
+
+func TestProcedureUpdateExecuteAsAndExternalAccess(t *testing.T) {
+	r := require.New(t)
+	d := procedure(t, "my_db|my_schema|my_proc|VARCHAR-DATE", map[string]interface{}{
+		"name":                         "my_proc",
+		"database":                     "my_db",
+		"schema":                       "my_schema",
+		"return_type":                  "varchar",
+		"execute_as":                   "CALLER",
+		"comment":                      "mock comment",
+		"statement":                    procedureBody,
+		"external_access_integrations": []interface{}{"MY_INTEGRATION"},
+	})
+	d.MarkNewResource()
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER PROCEDURE "my_db"."my_schema"."my_proc"\(VARCHAR, DATE\) RENAME TO "my_db"."my_schema"."my_proc"`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER PROCEDURE "my_db"."my_schema"."my_proc"\(VARCHAR, DATE\) SET COMMENT = 'mock comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER PROCEDURE "my_db"."my_schema"."my_proc"\(VARCHAR, DATE\) EXECUTE AS CALLER`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER PROCEDURE "my_db"."my_schema"."my_proc"\(VARCHAR, DATE\) SET EXTERNAL_ACCESS_INTEGRATIONS = \("MY_INTEGRATION"\)`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectProcedureRead(mock, "VARCHAR(123456789)")
+
+		err := resources.UpdateProcedure(d, db)
+		r.NoError(err)
+	})
+}
+
 func TestProcedureDelete(t *testing.T) {
 	t.Helper()
 	r := require.New(t)
@@ -125,3 +199,20 @@ func TestProcedureDelete(t *testing.T) {
 		r.NoError(err)
 	})
 }
+
+func TestProcedureStatementRequired(t *testing.T) {
+	diff := func(config map[string]interface{}) error {
+		config["name"] = "proc"
+		config["database"] = "db"
+		config["schema"] = "schema"
+		config["return_type"] = "VARCHAR"
+		_, err := resources.Procedure().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.ErrorContains(t, diff(map[string]interface{}{}), "statement is required for SQL procedures")
+	require.ErrorContains(t, diff(map[string]interface{}{"language": "javascript"}), "statement is required for JAVASCRIPT procedures")
+	require.ErrorContains(t, diff(map[string]interface{}{"language": "python", "handler": "run"}), "statement is required for PYTHON procedures without imports")
+	require.NoError(t, diff(map[string]interface{}{"language": "python", "handler": "handler.run", "imports": []interface{}{"@stage/handler.py"}}))
+	require.NoError(t, diff(map[string]interface{}{"statement": "BEGIN RETURN 'hi'; END"}))
+}
@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var projectionPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the projection policy; must be unique for the database and schema in which the projection policy is created.",
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the projection policy.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the projection policy.",
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the SQL expression that determines whether the column can be projected; it must evaluate to a `PROJECTION_CONSTRAINT`, e.g. `PROJECTION_CONSTRAINT(ALLOW => false)`.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the projection policy.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Qualified name of the projection policy.",
+	},
+}
+
+// ProjectionPolicy returns a pointer to the resource representing a projection policy.
+func ProjectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateProjectionPolicy,
+		Read:   ReadProjectionPolicy,
+		Update: UpdateProjectionPolicy,
+		Delete: DeleteProjectionPolicy,
+
+		Schema: projectionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateProjectionPolicy implements schema.CreateFunc.
+func CreateProjectionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	req := sdk.NewCreateProjectionPolicyRequest(id, d.Get("body").(string))
+
+	if v, ok := d.GetOk("comment"); ok {
+		req = req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.ProjectionPolicies.Create(ctx, req); err != nil {
+		return fmt.Errorf("error creating projection policy %v err = %w", id.FullyQualifiedName(), err)
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadProjectionPolicy(d, meta)
+}
+
+// ReadProjectionPolicy implements schema.ReadFunc.
+func ReadProjectionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+	if projectionPolicy == nil || err != nil {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] projection policy (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	details, err := client.ProjectionPolicies.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", projectionPolicy.Name); err != nil {
+		return err
+	}
+	if err := d.Set("database", projectionPolicy.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", projectionPolicy.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("body", details.Body); err != nil {
+		return err
+	}
+	if err := d.Set("comment", projectionPolicy.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProjectionPolicy implements schema.UpdateFunc.
+func UpdateProjectionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	set := sdk.NewProjectionPolicySetRequest()
+	var runSet bool
+
+	if d.HasChange("body") {
+		set.WithBody(sdk.String(d.Get("body").(string)))
+		runSet = true
+	}
+
+	if d.HasChange("comment") {
+		if c := d.Get("comment").(string); c != "" {
+			set.WithComment(sdk.String(c))
+			runSet = true
+		} else {
+			unset := sdk.NewProjectionPolicyUnsetRequest().WithComment(sdk.Bool(true))
+			if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnset(unset)); err != nil {
+				return fmt.Errorf("error updating projection policy %v err = %w", id.FullyQualifiedName(), err)
+			}
+		}
+	}
+
+	if runSet {
+		if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSet(set)); err != nil {
+			return fmt.Errorf("error updating projection policy %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+
+	return ReadProjectionPolicy(d, meta)
+}
+
+// DeleteProjectionPolicy implements schema.DeleteFunc.
+func DeleteProjectionPolicy(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id)); err != nil {
+		return fmt.Errorf("error deleting projection policy %v err = %w", id.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,58 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectionPolicy(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: projectionPolicyConfig(name, "PROJECTION_CONSTRAINT(ALLOW => true)", "test comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_projection_policy.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_projection_policy.test", "body", "PROJECTION_CONSTRAINT(ALLOW => true)"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy.test", "comment", "test comment"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy.test", "qualified_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, name)),
+				),
+			},
+			// CHANGE BODY AND UNSET COMMENT
+			{
+				Config: projectionPolicyConfig(name, "PROJECTION_CONSTRAINT(ALLOW => false)", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_projection_policy.test", "body", "PROJECTION_CONSTRAINT(ALLOW => false)"),
+					resource.TestCheckResourceAttr("snowflake_projection_policy.test", "comment", ""),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_projection_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func projectionPolicyConfig(name string, body string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_projection_policy" "test" {
+	name     = "%v"
+	database = "%s"
+	schema   = "%s"
+	body     = "%s"
+	comment  = "%s"
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, body, comment)
+}
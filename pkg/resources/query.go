@@ -0,0 +1,202 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+var querySchema = map[string]*schema.Schema{
+	"execute": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "SQL statements executed when the resource is created. Multiple statements are separated with semicolons.",
+	},
+	"on_update": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "SQL statements executed when the triggers change. When not set, changing the triggers executes the revert and execute statements again.",
+	},
+	"revert": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "SQL statements executed when the resource is destroyed, e.g. to drop the objects created by the execute statements.",
+	},
+	"triggers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Arbitrary map of values that, when changed, executes the on_update statements.",
+	},
+	"query": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "SQL query executed on every read, e.g. SHOW or SELECT. Its result is stored in query_results.",
+	},
+	"query_tag": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Query tag set on the session executing the statements, so that they can be found in the query history.",
+	},
+	"query_results": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Rows returned by the query, with the values keyed by the column names. All the values are strings and NULL values are omitted.",
+		Elem: &schema.Schema{
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+// Query returns a pointer to the resource executing arbitrary SQL.
+func Query() *schema.Resource {
+	return &schema.Resource{
+		Description: "Executes arbitrary SQL statements on create, on update and on destroy, for the features that are not modelled by the other resources yet. The statements are neither validated nor read back, so changes made outside of Terraform are not detected. Use it with care.",
+
+		Create: CreateQuery,
+		Read:   ReadQuery,
+		Update: UpdateQuery,
+		Delete: DeleteQuery,
+
+		Schema: querySchema,
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.HasChange("triggers") && d.Get("on_update").(string) == "" && d.Id() != "" {
+				return d.ForceNew("triggers")
+			}
+			return nil
+		},
+	}
+}
+
+// executeQueryStatements executes the statements on a single connection, so that the query tag applies to all of them.
+func executeQueryStatements(ctx context.Context, db *sql.DB, queryTag string, statements string) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if queryTag != "" {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf(`ALTER SESSION SET QUERY_TAG = '%s'`, strings.ReplaceAll(queryTag, `'`, `\'`))); err != nil {
+			return err
+		}
+		defer func() {
+			if _, err := conn.ExecContext(ctx, `ALTER SESSION UNSET QUERY_TAG`); err != nil {
+				log.Printf("[DEBUG] unable to unset the query tag err = %v", err)
+			}
+		}()
+	}
+
+	// zero allows any number of statements in the request
+	multiStatementCtx, err := gosnowflake.WithMultiStatement(ctx, 0)
+	if err != nil {
+		return err
+	}
+	log.Print("[DEBUG] exec stmt ", statements)
+	_, err = conn.ExecContext(multiStatementCtx, statements)
+	return err
+}
+
+// queryResults runs the query and returns its rows as maps of the column names to the values.
+func queryResults(ctx context.Context, db *sql.DB, query string) ([]map[string]string, error) {
+	log.Print("[DEBUG] query stmt ", query)
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]map[string]string, 0)
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]string, len(columns))
+		for i, column := range columns {
+			if values[i].Valid {
+				row[column] = values[i].String
+			}
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+// CreateQuery implements schema.CreateFunc.
+func CreateQuery(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	ctx := context.Background()
+
+	if err := executeQueryStatements(ctx, db, d.Get("query_tag").(string), d.Get("execute").(string)); err != nil {
+		return fmt.Errorf("error executing the execute statements err = %w", err)
+	}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return ReadQuery(d, meta)
+}
+
+// ReadQuery implements schema.ReadFunc.
+func ReadQuery(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	ctx := context.Background()
+
+	query := d.Get("query").(string)
+	if query == "" {
+		return d.Set("query_results", nil)
+	}
+	results, err := queryResults(ctx, db, query)
+	if err != nil {
+		return fmt.Errorf("error running the query err = %w", err)
+	}
+	return d.Set("query_results", results)
+}
+
+// UpdateQuery implements schema.UpdateFunc.
+func UpdateQuery(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	ctx := context.Background()
+
+	if d.HasChange("triggers") {
+		if err := executeQueryStatements(ctx, db, d.Get("query_tag").(string), d.Get("on_update").(string)); err != nil {
+			return fmt.Errorf("error executing the on_update statements err = %w", err)
+		}
+	}
+
+	return ReadQuery(d, meta)
+}
+
+// DeleteQuery implements schema.DeleteFunc.
+func DeleteQuery(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	ctx := context.Background()
+
+	if revert := d.Get("revert").(string); revert != "" {
+		if err := executeQueryStatements(ctx, db, d.Get("query_tag").(string), revert); err != nil {
+			return fmt.Errorf("error executing the revert statements err = %w", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,65 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) {
+	r := require.New(t)
+	err := resources.Query().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestQueryCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"execute":   "CREATE DATABASE db1; CREATE SCHEMA db1.s1",
+		"query":     "SHOW SCHEMAS IN DATABASE db1",
+		"query_tag": "terraform",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Query().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SESSION SET QUERY_TAG = 'terraform'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^CREATE DATABASE db1; CREATE SCHEMA db1.s1$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SESSION UNSET QUERY_TAG$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"name", "comment"}).
+			AddRow("S1", nil).
+			AddRow("PUBLIC", "default schema")
+		mock.ExpectQuery(`^SHOW SCHEMAS IN DATABASE db1$`).WillReturnRows(rows)
+		err := resources.CreateQuery(d, db)
+		r.NoError(err)
+		r.NotEmpty(d.Id())
+		r.Equal(2, d.Get("query_results.#").(int))
+		r.Equal("S1", d.Get("query_results.0.name").(string))
+		r.Equal(1, len(d.Get("query_results.0").(map[string]interface{})))
+		r.Equal("default schema", d.Get("query_results.1.comment").(string))
+	})
+}
+
+func TestQueryDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"execute": "CREATE DATABASE db1",
+		"revert":  "DROP DATABASE db1",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Query().Schema, in)
+	d.SetId("id")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP DATABASE db1$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteQuery(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
@@ -0,0 +1,465 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+var replicationGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the replication group. The identifier must start with an alphabetic character and cannot contain spaces or special characters unless the identifier string is enclosed in double quotes (e.g. \"My object\"). Identifiers enclosed in double quotes are also case-sensitive.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"object_types": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Type(s) of objects for which you are enabling replication from the source account to the target account. The following object types are supported: \"ACCOUNT PARAMETERS\", \"DATABASES\", \"INTEGRATIONS\", \"NETWORK POLICIES\", \"RESOURCE MONITORS\", \"ROLES\", \"SHARES\", \"USERS\", \"WAREHOUSES\"",
+	},
+	"allowed_databases": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the database or list of databases for which you are enabling replication from the source account to the target account. The OBJECT_TYPES list must include DATABASES to set this parameter.",
+	},
+	"allowed_shares": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the share or list of shares for which you are enabling replication from the source account to the target account. The OBJECT_TYPES list must include SHARES to set this parameter.",
+	},
+	"allowed_integration_types": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Type(s) of integrations for which you are enabling replication from the source account to the target account. This property requires that the OBJECT_TYPES list include INTEGRATIONS to set this parameter. The following integration types are supported: \"SECURITY INTEGRATIONS\", \"API INTEGRATIONS\", \"NOTIFICATION INTEGRATIONS\"",
+	},
+	"allowed_accounts": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		Description: "Specifies the target account or list of target accounts to which replication of specified objects from the source account is enabled. Expected in the form <org_name>.<target_account_name>",
+	},
+	"ignore_edition_check": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows replicating objects to accounts on lower editions.",
+	},
+	"replication_schedule": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the schedule for refreshing secondary replication groups.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cron": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"replication_schedule.0.interval"},
+					Description:   "Specifies the cron expression for the replication schedule.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expression": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the cron expression for the replication schedule. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\".",
+							},
+							"time_zone": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the time zone for secondary group refresh.",
+							},
+						},
+					},
+				},
+				"interval": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ConflictsWith: []string{"replication_schedule.0.cron"},
+					Description:   "Specifies the interval in minutes for the replication schedule.",
+				},
+			},
+		},
+	},
+}
+
+// ReplicationGroup returns a pointer to the resource representing a replication group.
+func ReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "A replication group replicates account objects and databases to other accounts in the organization for read-only access. Use `snowflake_failover_group` if the secondary groups have to be promotable to primary.",
+
+		Create: CreateReplicationGroup,
+		Read:   ReadReplicationGroup,
+		Update: UpdateReplicationGroup,
+		Delete: DeleteReplicationGroup,
+
+		Schema: replicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// expandReplicationSchedule converts the replication_schedule block into the REPLICATION_SCHEDULE value.
+func expandReplicationSchedule(v interface{}) *string {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	replicationSchedule := l[0].(map[string]interface{})
+	if c, ok := replicationSchedule["cron"].([]interface{}); ok && len(c) > 0 {
+		cron := c[0].(map[string]interface{})
+		return sdk.String(fmt.Sprintf("USING CRON %v %v", cron["expression"].(string), cron["time_zone"].(string)))
+	}
+	if interval, ok := replicationSchedule["interval"].(int); ok && interval > 0 {
+		return sdk.String(fmt.Sprintf("%d MINUTE", interval))
+	}
+	return nil
+}
+
+// flattenReplicationSchedule converts the REPLICATION_SCHEDULE value into the replication_schedule block.
+func flattenReplicationSchedule(replicationSchedule string) ([]interface{}, error) {
+	if replicationSchedule == "" {
+		return []interface{}{}, nil
+	}
+	if strings.HasSuffix(replicationSchedule, " MINUTE") {
+		interval, err := strconv.Atoi(strings.TrimSuffix(replicationSchedule, " MINUTE"))
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{
+			map[string]interface{}{
+				"interval": interval,
+			},
+		}, nil
+	}
+	parts := strings.Split(replicationSchedule, " ")
+	timeZone := parts[len(parts)-1]
+	expression := strings.TrimSuffix(strings.TrimPrefix(replicationSchedule, "USING CRON "), " "+timeZone)
+	return []interface{}{
+		map[string]interface{}{
+			"cron": []interface{}{
+				map[string]interface{}{
+					"expression": expression,
+					"time_zone":  timeZone,
+				},
+			},
+		},
+	}, nil
+}
+
+// expandAllowedAccounts converts a set of <org_name>.<account_name> strings into account identifiers.
+func expandAllowedAccounts(v interface{}) ([]sdk.AccountIdentifier, error) {
+	accounts := expandStringList(v.(*schema.Set).List())
+	allowedAccounts := make([]sdk.AccountIdentifier, len(accounts))
+	for i, account := range accounts {
+		parts := strings.Split(account, ".")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("account %s cannot be an account locator and must be of the format <org_name>.<target_account_name>", account)
+		}
+		allowedAccounts[i] = sdk.NewAccountIdentifier(parts[0], parts[1])
+	}
+	return allowedAccounts, nil
+}
+
+func expandAccountObjectIdentifiers(v interface{}) []sdk.AccountObjectIdentifier {
+	names := expandStringList(v.(*schema.Set).List())
+	ids := make([]sdk.AccountObjectIdentifier, len(names))
+	for i, name := range names {
+		ids[i] = sdk.NewAccountObjectIdentifier(name)
+	}
+	return ids
+}
+
+func expandIntegrationTypes(v interface{}) []sdk.IntegrationType {
+	types := expandStringList(v.(*schema.Set).List())
+	integrationTypes := make([]sdk.IntegrationType, len(types))
+	for i, t := range types {
+		integrationTypes[i] = sdk.IntegrationType(t)
+	}
+	return integrationTypes
+}
+
+func expandPluralObjectTypes(v interface{}) []sdk.PluralObjectType {
+	types := expandStringList(v.(*schema.Set).List())
+	objectTypes := make([]sdk.PluralObjectType, len(types))
+	for i, t := range types {
+		objectTypes[i] = sdk.PluralObjectType(t)
+	}
+	return objectTypes
+}
+
+// CreateReplicationGroup implements schema.CreateFunc.
+func CreateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	objectTypes := expandPluralObjectTypes(d.Get("object_types"))
+	allowedAccounts, err := expandAllowedAccounts(d.Get("allowed_accounts"))
+	if err != nil {
+		return err
+	}
+
+	opts := &sdk.CreateReplicationGroupOptions{
+		ReplicationSchedule: expandReplicationSchedule(d.Get("replication_schedule")),
+	}
+	if v, ok := d.GetOk("allowed_databases"); ok {
+		opts.AllowedDatabases = expandAccountObjectIdentifiers(v)
+	}
+	if v, ok := d.GetOk("allowed_shares"); ok {
+		opts.AllowedShares = expandAccountObjectIdentifiers(v)
+	}
+	if v, ok := d.GetOk("allowed_integration_types"); ok {
+		opts.AllowedIntegrationTypes = expandIntegrationTypes(v)
+	}
+	if d.Get("ignore_edition_check").(bool) {
+		opts.IgnoreEditionCheck = sdk.Bool(true)
+	}
+
+	if err := client.ReplicationGroups.Create(ctx, id, objectTypes, allowedAccounts, opts); err != nil {
+		return fmt.Errorf("error creating replication group %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return ReadReplicationGroup(d, meta)
+}
+
+// ReadReplicationGroup implements schema.ReadFunc.
+func ReadReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] replication group (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", replicationGroup.Name); err != nil {
+		return err
+	}
+
+	replicationSchedule, err := flattenReplicationSchedule(replicationGroup.ReplicationSchedule)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("replication_schedule", replicationSchedule); err != nil {
+		return err
+	}
+
+	objectTypes := make([]string, len(replicationGroup.ObjectTypes))
+	for i, v := range replicationGroup.ObjectTypes {
+		objectTypes[i] = string(v)
+	}
+	if err := d.Set("object_types", objectTypes); err != nil {
+		return err
+	}
+
+	allowedIntegrationTypes := make([]string, len(replicationGroup.AllowedIntegrationTypes))
+	for i, v := range replicationGroup.AllowedIntegrationTypes {
+		allowedIntegrationTypes[i] = string(v)
+	}
+	if err := d.Set("allowed_integration_types", allowedIntegrationTypes); err != nil {
+		return err
+	}
+
+	// the current account is implicitly added to the allowed accounts, so only the configured ones are kept
+	configuredAccounts := expandStringList(d.Get("allowed_accounts").(*schema.Set).List())
+	currentAccount := sdk.NewAccountIdentifier(replicationGroup.OrganizationName, replicationGroup.AccountName).Name()
+	allowedAccounts := make([]string, 0, len(replicationGroup.AllowedAccounts))
+	for _, v := range replicationGroup.AllowedAccounts {
+		account := v.Name()
+		if account == currentAccount && !slices.Contains(configuredAccounts, account) {
+			continue
+		}
+		allowedAccounts = append(allowedAccounts, account)
+	}
+	if err := d.Set("allowed_accounts", allowedAccounts); err != nil {
+		return err
+	}
+
+	databases, err := client.ReplicationGroups.ShowDatabases(ctx, id)
+	if err != nil {
+		return err
+	}
+	allowedDatabases := make([]string, len(databases))
+	for i, database := range databases {
+		allowedDatabases[i] = database.Name()
+	}
+	if err := d.Set("allowed_databases", allowedDatabases); err != nil {
+		return err
+	}
+
+	shares, err := client.ReplicationGroups.ShowShares(ctx, id)
+	if err != nil {
+		return err
+	}
+	allowedShares := make([]string, len(shares))
+	for i, share := range shares {
+		allowedShares[i] = share.Name()
+	}
+	return d.Set("allowed_shares", allowedShares)
+}
+
+// UpdateReplicationGroup implements schema.UpdateFunc.
+func UpdateReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	set := &sdk.ReplicationGroupSet{}
+	runSet := false
+
+	if d.HasChange("object_types") || d.HasChange("allowed_integration_types") {
+		set.ObjectTypes = expandPluralObjectTypes(d.Get("object_types"))
+		if slices.Contains(set.ObjectTypes, sdk.PluralObjectTypeIntegrations) {
+			set.AllowedIntegrationTypes = expandIntegrationTypes(d.Get("allowed_integration_types"))
+		}
+		runSet = true
+	}
+
+	if d.HasChange("replication_schedule") {
+		set.ReplicationSchedule = expandReplicationSchedule(d.Get("replication_schedule"))
+		if set.ReplicationSchedule == nil {
+			// there is no UNSET for the schedule, so an empty schedule disables the automatic refreshes
+			set.ReplicationSchedule = sdk.String("")
+		}
+		runSet = true
+	}
+
+	if runSet {
+		if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating replication group %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("allowed_databases") {
+		o, n := d.GetChange("allowed_databases")
+		added, removed := diffAccountObjectIdentifiers(expandAccountObjectIdentifiers(o), expandAccountObjectIdentifiers(n))
+		if len(removed) > 0 {
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Remove: &sdk.ReplicationGroupRemove{AllowedDatabases: removed}}); err != nil {
+				return fmt.Errorf("error removing allowed databases for replication group %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Add: &sdk.ReplicationGroupAdd{AllowedDatabases: added}}); err != nil {
+				return fmt.Errorf("error adding allowed databases for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("allowed_shares") {
+		o, n := d.GetChange("allowed_shares")
+		added, removed := diffAccountObjectIdentifiers(expandAccountObjectIdentifiers(o), expandAccountObjectIdentifiers(n))
+		if len(removed) > 0 {
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Remove: &sdk.ReplicationGroupRemove{AllowedShares: removed}}); err != nil {
+				return fmt.Errorf("error removing allowed shares for replication group %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Add: &sdk.ReplicationGroupAdd{AllowedShares: added}}); err != nil {
+				return fmt.Errorf("error adding allowed shares for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("allowed_accounts") {
+		o, n := d.GetChange("allowed_accounts")
+		oldAccounts, err := expandAllowedAccounts(o)
+		if err != nil {
+			return err
+		}
+		newAccounts, err := expandAllowedAccounts(n)
+		if err != nil {
+			return err
+		}
+		var removed, added []sdk.AccountIdentifier
+		for _, v := range oldAccounts {
+			if !slices.Contains(newAccounts, v) {
+				removed = append(removed, v)
+			}
+		}
+		for _, v := range newAccounts {
+			if !slices.Contains(oldAccounts, v) {
+				added = append(added, v)
+			}
+		}
+		if len(removed) > 0 {
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Remove: &sdk.ReplicationGroupRemove{AllowedAccounts: removed}}); err != nil {
+				return fmt.Errorf("error removing allowed accounts for replication group %v err = %w", name, err)
+			}
+		}
+		if len(added) > 0 {
+			add := &sdk.ReplicationGroupAdd{AllowedAccounts: added}
+			if d.Get("ignore_edition_check").(bool) {
+				add.IgnoreEditionCheck = sdk.Bool(true)
+			}
+			if err := client.ReplicationGroups.AlterSource(ctx, id, &sdk.AlterSourceReplicationGroupOptions{Add: add}); err != nil {
+				return fmt.Errorf("error adding allowed accounts for replication group %v err = %w", name, err)
+			}
+		}
+	}
+
+	return ReadReplicationGroup(d, meta)
+}
+
+// diffAccountObjectIdentifiers returns the identifiers added to and removed from the old list.
+func diffAccountObjectIdentifiers(oldIds []sdk.AccountObjectIdentifier, newIds []sdk.AccountObjectIdentifier) (added []sdk.AccountObjectIdentifier, removed []sdk.AccountObjectIdentifier) {
+	for _, v := range oldIds {
+		if !slices.Contains(newIds, v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range newIds {
+		if !slices.Contains(oldIds, v) {
+			added = append(added, v)
+		}
+	}
+	return added, removed
+}
+
+// DeleteReplicationGroup implements schema.DeleteFunc.
+func DeleteReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+	if err := client.ReplicationGroups.Drop(ctx, id, &sdk.DropReplicationGroupOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting replication group %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
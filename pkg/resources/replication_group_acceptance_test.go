@@ -0,0 +1,66 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ReplicationGroup(t *testing.T) {
+	randomCharacters := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	if _, ok := os.LookupEnv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT"); !ok {
+		t.Skip("Skipping TestAcc_ReplicationGroup since not a business critical account")
+	}
+	accountName := os.Getenv("SNOWFLAKE_BUSINESS_CRITICAL_ACCOUNT")
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: replicationGroupConfig(randomCharacters, accountName, acc.TestDatabaseName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "object_types.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "allowed_databases.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.0.interval", "10"),
+				),
+			},
+			{
+				Config: replicationGroupConfig(randomCharacters, accountName, acc.TestDatabaseName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "name", randomCharacters),
+					resource.TestCheckResourceAttr("snowflake_replication_group.rg", "replication_schedule.0.interval", "20"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_replication_group.rg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+			},
+		},
+	})
+}
+
+func replicationGroupConfig(randomCharacters, accountName, databaseName string, interval int) string {
+	return fmt.Sprintf(`
+resource "snowflake_replication_group" "rg" {
+	name = "%s"
+	object_types = ["DATABASES"]
+	allowed_accounts = ["%s"]
+	allowed_databases = ["%s"]
+	replication_schedule {
+		interval = %d
+	}
+}
+`, randomCharacters, accountName, databaseName, interval)
+}
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandReplicationSchedule(t *testing.T) {
+	r := require.New(t)
+
+	cron := []interface{}{
+		map[string]interface{}{
+			"cron": []interface{}{
+				map[string]interface{}{
+					"expression": "0 0 10-20 * TUE,THU",
+					"time_zone":  "UTC",
+				},
+			},
+			"interval": 0,
+		},
+	}
+	r.Equal("USING CRON 0 0 10-20 * TUE,THU UTC", *expandReplicationSchedule(cron))
+
+	interval := []interface{}{
+		map[string]interface{}{
+			"cron":     []interface{}{},
+			"interval": 10,
+		},
+	}
+	r.Equal("10 MINUTE", *expandReplicationSchedule(interval))
+
+	r.Nil(expandReplicationSchedule([]interface{}{}))
+}
+
+func TestFlattenReplicationSchedule(t *testing.T) {
+	r := require.New(t)
+
+	out, err := flattenReplicationSchedule("USING CRON 0 0 10-20 * TUE,THU UTC")
+	r.NoError(err)
+	cron := out[0].(map[string]interface{})["cron"].([]interface{})[0].(map[string]interface{})
+	r.Equal("0 0 10-20 * TUE,THU", cron["expression"])
+	r.Equal("UTC", cron["time_zone"])
+
+	out, err = flattenReplicationSchedule("10 MINUTE")
+	r.NoError(err)
+	r.Equal(10, out[0].(map[string]interface{})["interval"])
+
+	out, err = flattenReplicationSchedule("")
+	r.NoError(err)
+	r.Empty(out)
+}
+
+func TestExpandAllowedAccounts(t *testing.T) {
+	r := require.New(t)
+
+	_, err := expandAllowedAccounts(allowedAccountsSet("org.account", "locator"))
+	r.ErrorContains(err, "account locator cannot be an account locator")
+
+	accounts, err := expandAllowedAccounts(allowedAccountsSet("org.account"))
+	r.NoError(err)
+	r.Equal("org.account", accounts[0].Name())
+}
+
+func allowedAccountsSet(accounts ...string) interface{} {
+	l := make([]interface{}, len(accounts))
+	for i, v := range accounts {
+		l[i] = v
+	}
+	return schema.NewSet(schema.HashString, l)
+}
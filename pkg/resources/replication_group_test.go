@@ -0,0 +1,37 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationGroup(t *testing.T) {
+	r := require.New(t)
+	err := resources.ReplicationGroup().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestReplicationGroupDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ReplicationGroup().Schema, map[string]interface{}{
+		"name":             "drop_it",
+		"object_types":     []interface{}{"DATABASES"},
+		"allowed_accounts": []interface{}{"org.account"},
+	})
+	d.SetId("drop_it")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP REPLICATION GROUP IF EXISTS "drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteReplicationGroup(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
@@ -13,6 +13,12 @@ import (
 
 var validFrequencies = []string{"MONTHLY", "DAILY", "WEEKLY", "YEARLY", "NEVER"}
 
+var validTriggerActions = []string{
+	string(sdk.TriggerActionSuspend),
+	string(sdk.TriggerActionSuspendImmediate),
+	string(sdk.TriggerActionNotify),
+}
+
 var resourceMonitorSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
@@ -56,35 +62,61 @@ var resourceMonitorSchema = map[string]*schema.Schema{
 		Type:          schema.TypeInt,
 		Optional:      true,
 		Description:   "The number that represents the percentage threshold at which to suspend all warehouses.",
-		ConflictsWith: []string{"suspend_triggers"},
+		ConflictsWith: []string{"suspend_triggers", "trigger"},
+		Deprecated:    "Use trigger instead",
 	},
 	"suspend_triggers": {
 		Type:          schema.TypeSet,
 		Elem:          &schema.Schema{Type: schema.TypeInt},
 		Optional:      true,
 		Description:   "A list of percentage thresholds at which to suspend all warehouses.",
-		ConflictsWith: []string{"suspend_trigger"},
-		Deprecated:    "Use suspend_trigger instead",
+		ConflictsWith: []string{"suspend_trigger", "trigger"},
+		Deprecated:    "Use trigger instead",
 	},
 	"suspend_immediate_trigger": {
 		Type:          schema.TypeInt,
 		Optional:      true,
 		Description:   "The number that represents the percentage threshold at which to immediately suspend all warehouses.",
-		ConflictsWith: []string{"suspend_immediate_triggers"},
+		ConflictsWith: []string{"suspend_immediate_triggers", "trigger"},
+		Deprecated:    "Use trigger instead",
 	},
 	"suspend_immediate_triggers": {
 		Type:          schema.TypeSet,
 		Elem:          &schema.Schema{Type: schema.TypeInt},
 		Optional:      true,
 		Description:   "A list of percentage thresholds at which to suspend all warehouses.",
-		ConflictsWith: []string{"suspend_immediate_trigger"},
-		Deprecated:    "Use suspend_immediate_trigger instead",
+		ConflictsWith: []string{"suspend_immediate_trigger", "trigger"},
+		Deprecated:    "Use trigger instead",
 	},
 	"notify_triggers": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeInt},
+		Optional:      true,
+		Description:   "A list of percentage thresholds at which to send an alert to subscribed users.",
+		ConflictsWith: []string{"trigger"},
+		Deprecated:    "Use trigger instead",
+	},
+	"trigger": {
 		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeInt},
 		Optional:    true,
-		Description: "A list of percentage thresholds at which to send an alert to subscribed users.",
+		Description: "Specifies an action to take when the credit usage reaches a percentage of the credit quota. Removing all triggers recreates the resource monitor, as Snowflake does not allow clearing them in place.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"threshold": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "The percentage of the credit quota at which the action is taken.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"action": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The action to take when the threshold is reached. Valid values are SUSPEND, SUSPEND_IMMEDIATE and NOTIFY.",
+					ValidateFunc: validation.StringInSlice(validTriggerActions, false),
+				},
+			},
+		},
+		ConflictsWith: []string{"suspend_trigger", "suspend_triggers", "suspend_immediate_trigger", "suspend_immediate_triggers", "notify_triggers"},
 	},
 	"set_for_account": {
 		Type:        schema.TypeBool,
@@ -112,7 +144,43 @@ func ResourceMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: forceNewIfAllResourceMonitorTriggersRemoved,
+	}
+}
+
+var resourceMonitorTriggerKeys = []string{"trigger", "suspend_trigger", "suspend_triggers", "suspend_immediate_trigger", "suspend_immediate_triggers", "notify_triggers"}
+
+// forceNewIfAllResourceMonitorTriggersRemoved recreates the resource monitor when all of its triggers, set either
+// with the trigger blocks or with the deprecated attributes, are removed, as Snowflake does not allow clearing them in place.
+func forceNewIfAllResourceMonitorTriggersRemoved(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	isSet := func(v any) bool {
+		switch v := v.(type) {
+		case int:
+			return v > 0
+		case *schema.Set:
+			return v.Len() > 0
+		}
+		return false
+	}
+	var hadTriggers, hasTriggers bool
+	for _, key := range resourceMonitorTriggerKeys {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		o, n := d.GetChange(key)
+		hadTriggers = hadTriggers || isSet(o)
+		hasTriggers = hasTriggers || isSet(n)
+	}
+	if !hadTriggers || hasTriggers {
+		return nil
+	}
+	for _, key := range resourceMonitorTriggerKeys {
+		if d.HasChange(key) {
+			return d.ForceNew(key)
+		}
 	}
+	return nil
 }
 
 func checkAccountAgainstWarehouses(d *schema.ResourceData, name string) error {
@@ -256,10 +324,8 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if len(resourceMonitor.NotifyUsers) > 0 {
-		if err := d.Set("notify_users", resourceMonitor.NotifyUsers); err != nil {
-			return err
-		}
+	if err := d.Set("notify_users", resourceMonitor.NotifyUsers); err != nil {
+		return err
 	}
 
 	// Snowflake returns credit_quota as a float, but only accepts input as an int
@@ -267,7 +333,59 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Triggers
+	// Triggers are reported in the trigger blocks when those are in use, and in the
+	// legacy attributes otherwise (including on import).
+	if d.Get("trigger").(*schema.Set).Len() > 0 {
+		if err := setResourceMonitorTriggerBlocks(d, resourceMonitor); err != nil {
+			return err
+		}
+	} else {
+		if err := setResourceMonitorLegacyTriggers(d, resourceMonitor); err != nil {
+			return err
+		}
+	}
+
+	// Account level
+	if err := d.Set("set_for_account", resourceMonitor.Level == sdk.ResourceMonitorLevelAccount); err != nil {
+		return err
+	}
+
+	return err
+}
+
+func setResourceMonitorTriggerBlocks(d *schema.ResourceData, resourceMonitor *sdk.ResourceMonitor) error {
+	triggers := make([]map[string]any, 0)
+	if resourceMonitor.SuspendAt != nil {
+		triggers = append(triggers, map[string]any{
+			"threshold": *resourceMonitor.SuspendAt,
+			"action":    string(sdk.TriggerActionSuspend),
+		})
+	}
+	if resourceMonitor.SuspendImmediateAt != nil {
+		triggers = append(triggers, map[string]any{
+			"threshold": *resourceMonitor.SuspendImmediateAt,
+			"action":    string(sdk.TriggerActionSuspendImmediate),
+		})
+	}
+	for _, threshold := range resourceMonitor.NotifyTriggers {
+		triggers = append(triggers, map[string]any{
+			"threshold": threshold,
+			"action":    string(sdk.TriggerActionNotify),
+		})
+	}
+	if err := d.Set("trigger", triggers); err != nil {
+		return err
+	}
+
+	for _, key := range []string{"suspend_trigger", "suspend_immediate_trigger", "notify_triggers"} {
+		if err := d.Set(key, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setResourceMonitorLegacyTriggers(d *schema.ResourceData, resourceMonitor *sdk.ResourceMonitor) error {
 	if resourceMonitor.SuspendAt != nil {
 		if err := d.Set("suspend_trigger", *resourceMonitor.SuspendAt); err != nil {
 			return err
@@ -288,16 +406,7 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := d.Set("notify_triggers", resourceMonitor.NotifyTriggers); err != nil {
-		return err
-	}
-
-	// Account level
-	if err := d.Set("set_for_account", resourceMonitor.Level == sdk.ResourceMonitorLevelAccount); err != nil {
-		return err
-	}
-
-	return err
+	return d.Set("notify_triggers", resourceMonitor.NotifyTriggers)
 }
 
 // UpdateResourceMonitor implements schema.UpdateFunc.
@@ -320,14 +429,17 @@ func UpdateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 	opts := sdk.AlterResourceMonitorOptions{Set: &sdk.ResourceMonitorSet{}}
 
 	if d.HasChange("notify_users") {
-		runSetStatement = true
-
 		userNames := expandStringList(d.Get("notify_users").(*schema.Set).List())
-		users := []sdk.NotifiedUser{}
-		for _, name := range userNames {
-			users = append(users, sdk.NotifiedUser{Name: name})
+		runSetStatement = true
+		if len(userNames) > 0 {
+			users := []sdk.NotifiedUser{}
+			for _, name := range userNames {
+				users = append(users, sdk.NotifiedUser{Name: name})
+			}
+			opts.Set.NotifyUsers = &sdk.NotifyUsers{Users: users}
+		} else {
+			opts.Set.RemoveAllNotifyUsers = sdk.Bool(true)
 		}
-		opts.NotifyUsers.Users = users
 	}
 
 	if d.HasChange("credit_quota") {
@@ -359,10 +471,18 @@ func UpdateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		d.HasChange("suspend_triggers") ||
 		d.HasChange("suspend_immediate_trigger") ||
 		d.HasChange("suspend_immediate_triggers") ||
-		d.HasChange("notify_triggers") {
-		runSetStatement = true
+		d.HasChange("notify_triggers") ||
+		d.HasChange("trigger") {
 		triggers := collectResourceMonitorTriggers(d)
-		opts.Triggers = triggers
+		if len(triggers) > 0 {
+			runSetStatement = true
+			opts.Triggers = triggers
+		}
+	}
+
+	// SET without any properties is not valid when only the triggers are altered
+	if *opts.Set == (sdk.ResourceMonitorSet{}) {
+		opts.Set = nil
 	}
 
 	if runSetStatement {
@@ -434,6 +554,17 @@ func UpdateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 
 func collectResourceMonitorTriggers(d *schema.ResourceData) []sdk.TriggerDefinition {
 	triggers := []sdk.TriggerDefinition{}
+	if v, ok := d.GetOk("trigger"); ok {
+		for _, t := range v.(*schema.Set).List() {
+			trigger := t.(map[string]any)
+			triggers = append(triggers, sdk.TriggerDefinition{
+				Threshold:     trigger["threshold"].(int),
+				TriggerAction: sdk.TriggerAction(trigger["action"].(string)),
+			})
+		}
+		return triggers
+	}
+
 	var suspendTrigger *sdk.TriggerDefinition
 	if v, ok := d.GetOk("suspend_trigger"); ok {
 		suspendTrigger = &sdk.TriggerDefinition{
@@ -468,7 +599,7 @@ func collectResourceMonitorTriggers(d *schema.ResourceData) []sdk.TriggerDefinit
 	if v, ok := d.GetOk("suspend_immediate_triggers"); ok {
 		siTrigs := expandIntList(v.(*schema.Set).List())
 		for _, threshold := range siTrigs {
-			if suspendImmediateTrigger == nil || suspendTrigger.Threshold > threshold {
+			if suspendImmediateTrigger == nil || suspendImmediateTrigger.Threshold > threshold {
 				suspendImmediateTrigger = &sdk.TriggerDefinition{
 					Threshold:     threshold,
 					TriggerAction: sdk.TriggerActionSuspendImmediate,
@@ -94,6 +94,69 @@ resource "snowflake_resource_monitor" "test" {
 `, accName)
 }
 
+func TestAcc_ResourceMonitorTriggers(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceMonitorTriggersConfig(name, 80, 90, 40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "trigger.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_resource_monitor.test", "trigger.*", map[string]string{"threshold": "80", "action": "SUSPEND"}),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_resource_monitor.test", "trigger.*", map[string]string{"threshold": "90", "action": "SUSPEND_IMMEDIATE"}),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_resource_monitor.test", "trigger.*", map[string]string{"threshold": "40", "action": "NOTIFY"}),
+				),
+			},
+			// CHANGE THRESHOLDS IN PLACE
+			{
+				Config: resourceMonitorTriggersConfig(name, 75, 95, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_resource_monitor.test", "trigger.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_resource_monitor.test", "trigger.*", map[string]string{"threshold": "75", "action": "SUSPEND"}),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_resource_monitor.test", "trigger.*", map[string]string{"threshold": "95", "action": "SUSPEND_IMMEDIATE"}),
+					resource.TestCheckTypeSetElemNestedAttrs("snowflake_resource_monitor.test", "trigger.*", map[string]string{"threshold": "50", "action": "NOTIFY"}),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_resource_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trigger", "suspend_trigger", "suspend_immediate_trigger", "notify_triggers"},
+			},
+		},
+	})
+}
+
+func resourceMonitorTriggersConfig(accName string, suspend int, suspendImmediate int, notify int) string {
+	return fmt.Sprintf(`
+resource "snowflake_resource_monitor" "test" {
+	name         = "%v"
+	credit_quota = 100
+
+	trigger {
+		threshold = %d
+		action    = "SUSPEND"
+	}
+
+	trigger {
+		threshold = %d
+		action    = "SUSPEND_IMMEDIATE"
+	}
+
+	trigger {
+		threshold = %d
+		action    = "NOTIFY"
+	}
+}
+`, accName, suspend, suspendImmediate, notify)
+}
+
 func TestAcc_ResourceMonitorNotifyUsers(t *testing.T) {
 	userEnv := os.Getenv("RESOURCE_MONITOR_NOTIFY_USERS_TEST")
 	if userEnv == "" {
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForceNewIfAllResourceMonitorTriggersRemoved(t *testing.T) {
+	diff := func(attributes map[string]string, config map[string]interface{}) *terraform.InstanceDiff {
+		attributes["id"] = "RM"
+		attributes["name"] = "RM"
+		config["name"] = "RM"
+		state := &terraform.InstanceState{ID: "RM", Attributes: attributes}
+		d, err := ResourceMonitor().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+		return d
+	}
+	// the state keys set elements by their hash
+	hash := func(key string, v interface{}) string {
+		return strconv.Itoa(resourceMonitorSchema[key].ZeroValue().(*schema.Set).F(v))
+	}
+
+	t.Run("removing the deprecated triggers recreates the resource monitor", func(t *testing.T) {
+		d := diff(map[string]string{"suspend_trigger": "90", "notify_triggers.#": "1", "notify_triggers." + hash("notify_triggers", 80): "80"}, map[string]interface{}{})
+		require.True(t, d.RequiresNew())
+	})
+
+	t.Run("removing the trigger blocks recreates the resource monitor", func(t *testing.T) {
+		triggerHash := hash("trigger", map[string]interface{}{"threshold": 90, "action": "SUSPEND"})
+		d := diff(
+			map[string]string{"trigger.#": "1", "trigger." + triggerHash + ".threshold": "90", "trigger." + triggerHash + ".action": "SUSPEND"},
+			map[string]interface{}{},
+		)
+		require.True(t, d.RequiresNew())
+	})
+
+	t.Run("changing the triggers is done in place", func(t *testing.T) {
+		d := diff(map[string]string{"suspend_trigger": "90"}, map[string]interface{}{"suspend_trigger": 80})
+		require.False(t, d.RequiresNew())
+	})
+
+	t.Run("switching to the trigger blocks is done in place", func(t *testing.T) {
+		d := diff(
+			map[string]string{"suspend_trigger": "90"},
+			map[string]interface{}{"trigger": []interface{}{map[string]interface{}{"threshold": 90, "action": "SUSPEND"}}},
+		)
+		require.False(t, d.RequiresNew())
+	})
+}
@@ -52,6 +52,11 @@ var rowAccessPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the row access policy.",
 	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the row access policy.",
+	},
 }
 
 type rowAccessPolicyID struct {
@@ -191,6 +196,10 @@ func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("qualified_name", builder.QualifiedName()); err != nil {
+		return err
+	}
+
 	descSQL := builder.Describe()
 	rows, err := snowflake.Query(db, descSQL)
 	if err != nil {
@@ -0,0 +1,180 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var rowAccessPolicyAttachmentObjectTypes = map[string]sdk.PolicyEntityDomain{
+	"TABLE": sdk.PolicyEntityDomainTable,
+	"VIEW":  sdk.PolicyEntityDomainView,
+}
+
+var rowAccessPolicyAttachmentSchema = map[string]*schema.Schema{
+	"row_access_policy_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Fully qualified name (`\"db\".\"schema\".\"policy_name\"`) of the row access policy to attach.",
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "TABLE",
+		ValidateFunc: validation.StringInSlice([]string{"TABLE", "VIEW"}, false),
+		Description:  "Type of the object the row access policy is attached to. Valid values are TABLE and VIEW.",
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Fully qualified name (`\"db\".\"schema\".\"object_name\"`) of the table or view to attach the row access policy to.",
+	},
+	"on": {
+		Type:             schema.TypeList,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		Required:         true,
+		ForceNew:         true,
+		MinItems:         1,
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Names of the columns of the object passed as arguments to the row access policy, in the order of the policy signature.",
+	},
+}
+
+// RowAccessPolicyAttachment returns a pointer to the resource representing a row access policy attachment.
+func RowAccessPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a row access policy to a table or a view.",
+
+		Create: CreateRowAccessPolicyAttachment,
+		Read:   ReadRowAccessPolicyAttachment,
+		Delete: DeleteRowAccessPolicyAttachment,
+
+		Schema: rowAccessPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func rowAccessPolicyAttachmentIDFromString(id string) (string, sdk.SchemaObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 7 {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid row access policy attachment id %s, expected format: `objectType|objectDbName|objectSchemaName|objectName|policyDbName|policySchemaName|policyName`", id)
+	}
+	return parts[0], sdk.NewSchemaObjectIdentifier(parts[1], parts[2], parts[3]), sdk.NewSchemaObjectIdentifier(parts[4], parts[5], parts[6]), nil
+}
+
+func rowAccessPolicyAttachmentFromIDs(objectType string, object sdk.SchemaObjectIdentifier, policy sdk.SchemaObjectIdentifier) snowflake.RowAccessPolicyAttachment {
+	return snowflake.RowAccessPolicyAttachment{
+		ObjectType: objectType,
+		Object: &snowflake.SchemaObjectIdentifier{
+			Database:   object.DatabaseName(),
+			Schema:     object.SchemaName(),
+			ObjectName: object.Name(),
+		},
+		RowAccessPolicy: &snowflake.SchemaObjectIdentifier{
+			Database:   policy.DatabaseName(),
+			Schema:     policy.SchemaName(),
+			ObjectName: policy.Name(),
+		},
+	}
+}
+
+// CreateRowAccessPolicyAttachment implements schema.CreateFunc.
+func CreateRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	manager := snowflake.NewRowAccessPolicyAttachmentManager()
+
+	objectType := d.Get("object_type").(string)
+	object, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("object_name").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("object_name %s is not a valid qualified name, expected format: `\"db\".\"schema\".\"object_name\"`", d.Get("object_name"))
+	}
+	policy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("row_access_policy_name").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("row_access_policy_name %s is not a valid row access policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("row_access_policy_name"))
+	}
+
+	attachment := rowAccessPolicyAttachmentFromIDs(objectType, object, policy)
+	attachment.Columns = expandStringList(d.Get("on").([]interface{}))
+
+	stmt := manager.Create(&snowflake.RowAccessPolicyAttachmentCreateInput{RowAccessPolicyAttachment: attachment})
+	if _, err := db.Exec(stmt); err != nil {
+		return fmt.Errorf("error attaching row access policy %v to %v err = %w", policy.FullyQualifiedName(), object.FullyQualifiedName(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(objectType, object.DatabaseName(), object.SchemaName(), object.Name(), policy.DatabaseName(), policy.SchemaName(), policy.Name()))
+
+	return ReadRowAccessPolicyAttachment(d, meta)
+}
+
+// ReadRowAccessPolicyAttachment implements schema.ReadFunc.
+func ReadRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	objectType, object, policy, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, object.FullyQualifiedName(), rowAccessPolicyAttachmentObjectTypes[objectType])
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindRowAccessPolicy && policyReference.PolicyID().FullyQualifiedName() == policy.FullyQualifiedName() {
+			if err := d.Set("object_type", objectType); err != nil {
+				return err
+			}
+			if err := d.Set("object_name", object.FullyQualifiedName()); err != nil {
+				return err
+			}
+			if err := d.Set("row_access_policy_name", policy.FullyQualifiedName()); err != nil {
+				return err
+			}
+			if err := d.Set("on", policyReference.ArgColumnNames()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] row access policy (%s) is not attached to the %s (%s)", policy.FullyQualifiedName(), strings.ToLower(objectType), object.FullyQualifiedName())
+	d.SetId("")
+	return nil
+}
+
+// DeleteRowAccessPolicyAttachment implements schema.DeleteFunc.
+func DeleteRowAccessPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	manager := snowflake.NewRowAccessPolicyAttachmentManager()
+
+	objectType, object, policy, err := rowAccessPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stmt := manager.Delete(&snowflake.RowAccessPolicyAttachmentDeleteInput{RowAccessPolicyAttachment: rowAccessPolicyAttachmentFromIDs(objectType, object, policy)})
+	if _, err := db.Exec(stmt); err != nil {
+		return fmt.Errorf("error detaching row access policy %v from %v err = %w", policy.FullyQualifiedName(), object.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_RowAccessPolicyAttachment(t *testing.T) {
+	policyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: rowAccessPolicyAttachmentConfig(policyName, tableName, "snowflake_row_access_policy.test.qualified_name", "snowflake_table.test.qualified_name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "object_type", "TABLE"),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "object_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, tableName)),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "row_access_policy_name", fmt.Sprintf(`"%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, policyName)),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "on.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "on.0", "REGION"),
+					resource.TestCheckResourceAttr("snowflake_row_access_policy_attachment.test", "on.1", "DEPARTMENT"),
+				),
+			},
+			// unquoted policy and table names do not recreate the attachment
+			{
+				Config:   rowAccessPolicyAttachmentConfig(policyName, tableName, fmt.Sprintf(`"%s.%s.%s"`, acc.TestDatabaseName, acc.TestSchemaName, policyName), fmt.Sprintf(`"%s.%s.%s"`, acc.TestDatabaseName, acc.TestSchemaName, tableName)),
+				PlanOnly: true,
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_row_access_policy_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func rowAccessPolicyAttachmentConfig(policyName string, tableName string, policyReference string, tableReference string) string {
+	return fmt.Sprintf(`
+resource "snowflake_row_access_policy" "test" {
+	name     = "%[1]s"
+	database = "%[3]s"
+	schema   = "%[4]s"
+	signature = {
+		R = "VARCHAR",
+		D = "VARCHAR",
+	}
+	row_access_expression = "case when current_role() in ('ANALYST') then true else R = 'EMEA' end"
+}
+
+resource "snowflake_table" "test" {
+	database = "%[3]s"
+	schema   = "%[4]s"
+	name     = "%[2]s"
+
+	column {
+		name = "REGION"
+		type = "VARCHAR(16777216)"
+	}
+
+	column {
+		name = "DEPARTMENT"
+		type = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_row_access_policy_attachment" "test" {
+	row_access_policy_name = %[5]s
+	object_name            = %[6]s
+	on                     = ["REGION", "DEPARTMENT"]
+}
+`, policyName, tableName, acc.TestDatabaseName, acc.TestSchemaName, policyReference, tableReference)
+}
@@ -2,11 +2,13 @@ package resources
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -130,6 +132,23 @@ var samlIntegrationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"allowed_user_domains": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of email domains that can authenticate with the SAML2 integration, so that several identity providers can be used in the same account.",
+	},
+	"allowed_email_patterns": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of regular expressions that email addresses are matched against to authenticate with the SAML2 integration.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the integration.",
+	},
 	"created_on": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -207,6 +226,18 @@ func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`SAML2_SNOWFLAKE_ACS_URL`, d.Get("saml2_snowflake_acs_url").(string))
 	}
 
+	if v, ok := d.GetOk("allowed_user_domains"); ok {
+		stmt.SetStringList(`ALLOWED_USER_DOMAINS`, expandStringList(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("allowed_email_patterns"); ok {
+		stmt.SetStringList(`ALLOWED_EMAIL_PATTERNS`, expandStringList(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		stmt.SetString(`COMMENT`, v.(string))
+	}
+
 	err := snowflake.Exec(db, stmt.Statement())
 	if err != nil {
 		return fmt.Errorf("error creating security integration err = %w", err)
@@ -228,6 +259,12 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanSamlIntegration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] security integration (%s) not found", id)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("could not show security integration err = %w", err)
 	}
@@ -254,6 +291,10 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return err
+	}
+
 	// Some properties come from the DESCRIBE INTEGRATION call
 	// We need to grab them in a loop
 	var k, pType string
@@ -371,8 +412,16 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("saml2_signature_methods_used", v.(string)); err != nil {
 				return fmt.Errorf("unable to set saml2_signature_methods_used for security integration err = %w", err)
 			}
+		case "ALLOWED_USER_DOMAINS":
+			if err := d.Set("allowed_user_domains", helpers.StringListToList(helpers.ListContentToString(v.(string)))); err != nil {
+				return fmt.Errorf("unable to set allowed_user_domains for security integration err = %w", err)
+			}
+		case "ALLOWED_EMAIL_PATTERNS":
+			if err := d.Set("allowed_email_patterns", helpers.StringListToList(helpers.ListContentToString(v.(string)))); err != nil {
+				return fmt.Errorf("unable to set allowed_email_patterns for security integration err = %w", err)
+			}
 		case "COMMENT":
-			// COMMENT cannot be set according to snowflake docs, so ignoring
+			// set using the SHOW INTEGRATION, ignoring here
 		default:
 			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
@@ -460,6 +509,27 @@ func UpdateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 		stmt.SetString(`saml2_snowflake_acs_url`, d.Get("saml2_snowflake_acs_url").(string))
 	}
 
+	if d.HasChange("allowed_user_domains") {
+		runSetStatement = true
+		stmt.SetStringList(`ALLOWED_USER_DOMAINS`, expandStringList(d.Get("allowed_user_domains").(*schema.Set).List()))
+	}
+
+	if d.HasChange("allowed_email_patterns") {
+		runSetStatement = true
+		stmt.SetStringList(`ALLOWED_EMAIL_PATTERNS`, expandStringList(d.Get("allowed_email_patterns").(*schema.Set).List()))
+	}
+
+	if d.HasChange("comment") {
+		if c := d.Get("comment").(string); c == "" {
+			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION "%v" UNSET COMMENT`, id)); err != nil {
+				return fmt.Errorf("error unsetting comment err = %w", err)
+			}
+		} else {
+			runSetStatement = true
+			stmt.SetString(`COMMENT`, c)
+		}
+	}
+
 	if runSetStatement {
 		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
 			return fmt.Errorf("error updating security integration err = %w", err)
@@ -43,6 +43,63 @@ func TestSAMLIntegrationCreate(t *testing.T) {
 	})
 }
 
+func TestSAMLIntegrationCreateWithAllowedDomains(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                      "test_saml_integration",
+		"saml2_issuer":              "test_issuer",
+		"saml2_sso_url":             "https://testsamlissuer.com",
+		"saml2_provider":            "OKTA",
+		"saml2_x509_cert":           "MIICdummybase64certificate",
+		"saml2_enable_sp_initiated": true,
+		"allowed_user_domains":      []interface{}{"example.com"},
+		"allowed_email_patterns":    []interface{}{"^(.+dev)@example.com$"},
+		"comment":                   "okta sso",
+	}
+	d := schema.TestResourceDataRaw(t, resources.SAMLIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECURITY INTEGRATION "test_saml_integration" TYPE=SAML2 COMMENT='okta sso' SAML2_ISSUER='test_issuer' SAML2_PROVIDER='OKTA' SAML2_SSO_URL='https://testsamlissuer.com' SAML2_X509_CERT='MIICdummybase64certificate' ALLOWED_EMAIL_PATTERNS=\('\^\(\.\+dev\)@example\.com\$'\) ALLOWED_USER_DOMAINS=\('example\.com'\) ENABLED=true SAML2_ENABLE_SP_INITIATED=true$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		showRows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+			AddRow("test_saml_integration", "SAML2", "SECURITY", true, "okta sso", "now")
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_saml_integration'$`).WillReturnRows(showRows)
+		descRows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("SAML2_PROVIDER", "String", "OKTA", nil).
+			AddRow("ALLOWED_USER_DOMAINS", "List", "[example.com]", "[]").
+			AddRow("ALLOWED_EMAIL_PATTERNS", "List", "[^(.+dev)@example.com$]", "[]").
+			AddRow("SAML2_SNOWFLAKE_ACS_URL", "String", "https://myinstance.my-region-1.snowflakecomputing.com/fed/login", nil).
+			AddRow("SAML2_SNOWFLAKE_METADATA", "String", "<md:EntityDescriptor...>", nil)
+		mock.ExpectQuery(`DESCRIBE SECURITY INTEGRATION "test_saml_integration"$`).WillReturnRows(descRows)
+
+		err := resources.CreateSAMLIntegration(d, db)
+		r.NoError(err)
+		r.Equal("okta sso", d.Get("comment").(string))
+		r.Equal([]interface{}{"example.com"}, d.Get("allowed_user_domains").(*schema.Set).List())
+		r.Equal([]interface{}{"^(.+dev)@example.com$"}, d.Get("allowed_email_patterns").(*schema.Set).List())
+		r.Equal("https://myinstance.my-region-1.snowflakecomputing.com/fed/login", d.Get("saml2_snowflake_acs_url").(string))
+		r.Equal("<md:EntityDescriptor...>", d.Get("saml2_snowflake_metadata").(string))
+	})
+}
+
+func TestSAMLIntegrationReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := samlIntegration(t, "test_saml_integration", map[string]interface{}{"name": "test_saml_integration"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_saml_integration'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		err := resources.ReadSAMLIntegration(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
 func TestSAMLIntegrationRead(t *testing.T) {
 	r := require.New(t)
 
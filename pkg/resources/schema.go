@@ -41,14 +41,14 @@ var schemaSchema = map[string]*schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Specifies a schema as transient. Transient schemas do not have a Fail-safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss.",
+		Description: "Specifies a schema as transient. Transient schemas do not have a Fail-safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss. Schemas of a transient database are always transient, whatever the value of this attribute.",
 		ForceNew:    true,
 	},
 	"is_managed": {
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Specifies a managed schema. Managed access schemas centralize privilege management with the schema owner.",
+		Description: "Specifies a managed schema. Managed access schemas centralize privilege management with the schema owner. Managed access is enabled or disabled in place.",
 	},
 	"data_retention_days": {
 		Type:         schema.TypeInt,
@@ -57,9 +57,16 @@ var schemaSchema = map[string]*schema.Schema{
 		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the schema, as well as specifying the default Time Travel retention time for all tables created in the schema.",
 		ValidateFunc: validation.IntBetween(0, 90),
 	},
-	"tag": tagReferenceSchema,
+	"max_data_extension_time_in_days": maxDataExtensionTimeInDaysSchema,
+	"default_ddl_collation":           defaultDDLCollationSchema,
+	"log_level":                       logLevelSchema,
+	"trace_level":                     traceLevelSchema,
+	"tag":                             tagReferenceSchema,
 }
 
+// schemaParameters are the object parameters exposed as attributes of the schema.
+var schemaParameters = []string{"max_data_extension_time_in_days", "default_ddl_collation", "log_level", "trace_level"}
+
 // Schema returns a pointer to the resource representing a schema.
 func Schema() *schema.Resource {
 	return &schema.Resource{
@@ -84,10 +91,11 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
 
-	err := client.Schemas.Create(ctx, sdk.NewDatabaseObjectIdentifier(database, name), &sdk.CreateSchemaOptions{
+	id := sdk.NewDatabaseObjectIdentifier(database, name)
+	err := client.Schemas.Create(ctx, id, &sdk.CreateSchemaOptions{
 		Transient:               GetPropertyAsPointer[bool](d, "is_transient"),
 		WithManagedAccess:       GetPropertyAsPointer[bool](d, "is_managed"),
-		DataRetentionTimeInDays: GetPropertyAsPointer[int](d, "data_retention_days"),
+		DataRetentionTimeInDays: sdk.Int(d.Get("data_retention_days").(int)),
 		Tag:                     getPropertyTags(d, "tag"),
 		Comment:                 GetPropertyAsPointer[string](d, "comment"),
 	})
@@ -97,6 +105,10 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(helpers.EncodeSnowflakeID(database, name))
 
+	if err := createObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeSchema, Name: id}, schemaParameters...); err != nil {
+		return err
+	}
+
 	return ReadSchema(d, meta)
 }
 
@@ -107,9 +119,11 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 	ctx := context.Background()
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.DatabaseObjectIdentifier)
 
-	_, err := client.Databases.ShowByID(ctx, sdk.NewAccountObjectIdentifier(id.DatabaseName()))
+	database, err := client.Databases.ShowByID(ctx, sdk.NewAccountObjectIdentifier(id.DatabaseName()))
 	if err != nil {
+		log.Printf("[DEBUG] database (%s) of schema (%s) not found", id.DatabaseName(), d.Id())
 		d.SetId("")
+		return nil
 	}
 
 	s, err := client.Schemas.ShowByID(ctx, id)
@@ -133,13 +147,28 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	var isTransient, isManaged bool
+	if opts := s.Options; opts != nil && *opts != "" {
+		for _, opt := range strings.Split(*opts, ",") {
+			switch strings.ToUpper(strings.TrimSpace(opt)) {
+			case "TRANSIENT":
+				isTransient = true
+			case "MANAGED ACCESS":
+				isManaged = true
+			}
+		}
+	}
+
 	values := map[string]any{
 		"name":                s.Name,
 		"database":            s.DatabaseName,
 		"data_retention_days": retentionTime,
-		// reset the options before reading back from the DB
-		"is_transient": false,
-		"is_managed":   false,
+		"is_managed":          isManaged,
+	}
+	// schemas of a transient database are reported as transient whether or not they were created as such,
+	// so the configured value is kept to avoid recreating them
+	if !database.Transient {
+		values["is_transient"] = isTransient
 	}
 	if s.Comment != nil {
 		values["comment"] = *s.Comment
@@ -151,22 +180,7 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if opts := s.Options; opts != nil && *opts != "" {
-		for _, opt := range strings.Split(*opts, ", ") {
-			switch opt {
-			case "TRANSIENT":
-				if err := d.Set("is_transient", true); err != nil {
-					return err
-				}
-			case "MANAGED ACCESS":
-				if err := d.Set("is_managed", true); err != nil {
-					return err
-				}
-			}
-		}
-	}
-
-	return nil
+	return readObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeSchema, Name: id}, schemaParameters...)
 }
 
 // UpdateSchema implements schema.UpdateFunc.
@@ -185,6 +199,7 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating schema name on %v err = %w", d.Id(), err)
 		}
 		d.SetId(helpers.EncodeSnowflakeID(id.DatabaseName(), newName))
+		id = sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), newName.(string))
 	}
 
 	if d.HasChange("comment") {
@@ -268,6 +283,10 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := updateObjectParameters(ctx, client, d, sdk.Object{ObjectType: sdk.ObjectTypeSchema, Name: id}, schemaParameters...); err != nil {
+		return err
+	}
+
 	return ReadSchema(d, meta)
 }
 
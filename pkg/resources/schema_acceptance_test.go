@@ -28,6 +28,21 @@ func TestAcc_Schema(t *testing.T) {
 					checkBool("snowflake_schema.test", "is_managed", false),
 				),
 			},
+			// managed access is enabled in place
+			{
+				Config: schemaManagedConfig(schemaName, acc.TestDatabaseName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_schema.test", "name", schemaName),
+					checkBool("snowflake_schema.test", "is_managed", true),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "data_retention_days", "0"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_schema.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -74,3 +89,15 @@ resource "snowflake_schema" "test" {
 }
 `, schemaName, databaseName)
 }
+
+func schemaManagedConfig(schemaName string, databaseName string, managed bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_schema" "test" {
+	name                = "%v"
+	database            = "%s"
+	comment             = "Terraform acceptance test"
+	is_managed          = %t
+	data_retention_days = 0
+}
+`, schemaName, databaseName, managed)
+}
@@ -0,0 +1,103 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	r := require.New(t)
+	err := resources.Schema().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSchemaCreateWithoutRetention(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                "schema1",
+		"database":            "db1",
+		"is_managed":          true,
+		"data_retention_days": 0,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Schema().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE SCHEMA "db1"."schema1" WITH MANAGED ACCESS DATA_RETENTION_TIME_IN_DAYS = 0$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSchema(mock, "", "MANAGED ACCESS", "0")
+		err := resources.CreateSchema(d, db)
+		r.NoError(err)
+		r.True(d.Get("is_managed").(bool))
+		r.False(d.Get("is_transient").(bool))
+		r.Equal(0, d.Get("data_retention_days").(int))
+	})
+}
+
+func TestSchemaUpdateManagedAccess(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "db1|schema1",
+		Attributes: map[string]string{
+			"name":                "schema1",
+			"database":            "db1",
+			"is_managed":          "true",
+			"data_retention_days": "1",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"is_managed": {Old: "true", New: "false"},
+		},
+	}
+	d, err := schema.InternalMap(resources.Schema().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SCHEMA "db1"."schema1" DISABLE MANAGED ACCESS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSchema(mock, "", "", "1")
+		err := resources.UpdateSchema(d, db)
+		r.NoError(err)
+		r.Equal("db1|schema1", d.Id())
+		r.False(d.Get("is_managed").(bool))
+	})
+}
+
+func TestSchemaReadInTransientDatabase(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Schema().Schema, map[string]interface{}{"name": "schema1", "database": "db1"})
+	d.SetId("db1|schema1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the schema inherits the transient option of the database, which must not show up as a difference
+		expectReadSchema(mock, "TRANSIENT", "TRANSIENT, MANAGED ACCESS", "1")
+		err := resources.ReadSchema(d, db)
+		r.NoError(err)
+		r.False(d.Get("is_transient").(bool))
+		r.True(d.Get("is_managed").(bool))
+	})
+}
+
+// expectReadSchema expects the schema to be read, given the options of its database and the options of the schema.
+func expectReadSchema(mock sqlmock.Sqlmock, databaseOptions string, schemaOptions string, retentionTime string) {
+	databaseRows := sqlmock.NewRows([]string{"created_on", "name", "comment", "options", "retention_time"}).
+		AddRow(time.Now(), "db1", "", databaseOptions, "1")
+	mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(databaseRows)
+	schemaRows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "database_name", "owner", "comment", "options", "retention_time"}).
+		AddRow(time.Now(), "schema1", "N", "N", "db1", "ACCOUNTADMIN", nil, schemaOptions, retentionTime)
+	mock.ExpectQuery(`^SHOW SCHEMAS LIKE 'schema1' IN DATABASE "db1"$`).WillReturnRows(schemaRows)
+	for _, key := range []string{"MAX_DATA_EXTENSION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION", "LOG_LEVEL", "TRACE_LEVEL"} {
+		parameterRows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).AddRow(key, "", "", "", "")
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE '` + key + `' IN SCHEMA "db1"."schema1"$`).WillReturnRows(parameterRows)
+	}
+}
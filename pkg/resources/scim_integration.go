@@ -2,6 +2,7 @@ package resources
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -35,7 +36,7 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 	"provisioner_role": {
 		Type:        schema.TypeString,
 		Required:    true,
-		Description: "Specify the SCIM role in Snowflake that owns any users and roles that are imported from the identity provider into Snowflake using SCIM.",
+		Description: "Specify the SCIM role in Snowflake (RUN_AS_ROLE) that owns any users and roles that are imported from the identity provider into Snowflake using SCIM.",
 		ValidateFunc: validation.StringInSlice([]string{
 			"OKTA_PROVISIONER", "AAD_PROVISIONER", "GENERIC_SCIM_PROVISIONER",
 		}, true),
@@ -51,6 +52,23 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies an existing network policy active for your account. The network policy restricts the list of user IP addresses when exchanging an authorization code for an access or refresh token and when using a refresh token to obtain a new access token. If this parameter is not set, the network policy for the account (if any) is used instead.",
 	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether the SCIM integration is enabled.",
+	},
+	"sync_password": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether to enable or disable the synchronization of a user password from an Okta or generic SCIM client to Snowflake. Not supported by the AZURE client, where it is ignored.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the SCIM integration.",
+	},
 	"created_on": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -85,13 +103,23 @@ func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	stmt.SetString(`SCIM_CLIENT`, d.Get("scim_client").(string))
 	stmt.SetString(`RUN_AS_ROLE`, d.Get("provisioner_role").(string))
 
+	stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+
 	// Set optional fields
 	if _, ok := d.GetOk("network_policy"); ok {
 		stmt.SetString(`NETWORK_POLICY`, d.Get("network_policy").(string))
 	}
 
+	if scimClientSupportsSyncPassword(d.Get("scim_client").(string)) {
+		stmt.SetBool(`SYNC_PASSWORD`, d.Get("sync_password").(bool))
+	}
+
+	if _, ok := d.GetOk("comment"); ok {
+		stmt.SetString(`COMMENT`, d.Get("comment").(string))
+	}
+
 	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-		return fmt.Errorf("error creating security integration")
+		return fmt.Errorf("error creating security integration err = %w", err)
 	}
 
 	d.SetId(name)
@@ -110,8 +138,14 @@ func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanScimIntegration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] security integration (%s) not found", id)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("could not show security integration")
+		return fmt.Errorf("could not show security integration err = %w", err)
 	}
 
 	// Note: category must be Security or something is broken
@@ -131,6 +165,14 @@ func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return err
+	}
+
 	// Some properties come from the DESCRIBE INTEGRATION call
 	// We need to grab them in a loop
 	var k, pType string
@@ -154,6 +196,12 @@ func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("provisioner_role", v.(string)); err != nil {
 				return fmt.Errorf("unable to set provisioner role for security integration")
 			}
+		case "SYNC_PASSWORD":
+			if err := d.Set("sync_password", strings.EqualFold(fmt.Sprint(v), "true")); err != nil {
+				return fmt.Errorf("unable to set sync password for security integration")
+			}
+		case "ENABLED", "COMMENT":
+			// We set these using the SHOW INTEGRATION call so let's ignore them here
 		default:
 			log.Printf("[WARN] unexpected security integration property %v returned from Snowflake", k)
 		}
@@ -194,15 +242,42 @@ func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("enabled") {
+		runSetStatement = true
+		stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("sync_password") && scimClientSupportsSyncPassword(d.Get("scim_client").(string)) {
+		runSetStatement = true
+		stmt.SetBool(`SYNC_PASSWORD`, d.Get("sync_password").(bool))
+	}
+
+	if d.HasChange("comment") {
+		v := d.Get("comment").(string)
+		if len(v) == 0 {
+			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION "%v" UNSET COMMENT`, id)); err != nil {
+				return fmt.Errorf("error unsetting comment err = %w", err)
+			}
+		} else {
+			runSetStatement = true
+			stmt.SetString(`COMMENT`, v)
+		}
+	}
+
 	if runSetStatement {
 		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration")
+			return fmt.Errorf("error updating security integration err = %w", err)
 		}
 	}
 
 	return ReadSCIMIntegration(d, meta)
 }
 
+// scimClientSupportsSyncPassword reports whether SYNC_PASSWORD can be set for the given SCIM client.
+func scimClientSupportsSyncPassword(scimClient string) bool {
+	return !strings.EqualFold(scimClient, "AZURE")
+}
+
 // DeleteSCIMIntegration implements schema.DeleteFunc.
 func DeleteSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 	return DeleteResource("", snowflake.NewSCIMIntegrationBuilder)(d, meta)
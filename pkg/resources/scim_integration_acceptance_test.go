@@ -32,6 +32,7 @@ func TestAcc_ScimIntegration(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_scim_integration.test", "scim_client", "AZURE"),
 					resource.TestCheckResourceAttr("snowflake_scim_integration.test", "provisioner_role", scimProvisionerRole),
 					resource.TestCheckResourceAttr("snowflake_scim_integration.test", "network_policy", scimNetworkPolicy),
+					resource.TestCheckResourceAttr("snowflake_scim_integration.test", "enabled", "true"),
 					resource.TestCheckResourceAttrSet("snowflake_scim_integration.test", "created_on"),
 				),
 			},
@@ -32,7 +32,7 @@ func TestSCIMIntegrationCreate(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
-			`^CREATE SECURITY INTEGRATION "test_scim_integration" TYPE=SCIM NETWORK_POLICY='AAD_NETWORK_POLICY' RUN_AS_ROLE='AAD_PROVISIONER' SCIM_CLIENT='AZURE'$`,
+			`^CREATE SECURITY INTEGRATION "test_scim_integration" TYPE=SCIM NETWORK_POLICY='AAD_NETWORK_POLICY' RUN_AS_ROLE='AAD_PROVISIONER' SCIM_CLIENT='AZURE' ENABLED=true$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSCIMIntegration(mock)
 
@@ -41,6 +41,58 @@ func TestSCIMIntegrationCreate(t *testing.T) {
 	})
 }
 
+func TestSCIMIntegrationCreateOkta(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "test_scim_integration",
+		"scim_client":      "OKTA",
+		"provisioner_role": "OKTA_PROVISIONER",
+		"sync_password":    false,
+		"comment":          "okta provisioning",
+	}
+	d := schema.TestResourceDataRaw(t, resources.SCIMIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE SECURITY INTEGRATION "test_scim_integration" TYPE=SCIM COMMENT='okta provisioning' RUN_AS_ROLE='OKTA_PROVISIONER' SCIM_CLIENT='OKTA' ENABLED=true SYNC_PASSWORD=false$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		showRows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+			AddRow("test_scim_integration", "SCIM - OKTA", "SECURITY", true, "okta provisioning", "now")
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_scim_integration'$`).WillReturnRows(showRows)
+		descRows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", "true", "false").
+			AddRow("NETWORK_POLICY", "String", "", nil).
+			AddRow("RUN_AS_ROLE", "String", "OKTA_PROVISIONER", nil).
+			AddRow("SYNC_PASSWORD", "Boolean", "false", "true").
+			AddRow("COMMENT", "String", "okta provisioning", nil)
+		mock.ExpectQuery(`DESCRIBE SECURITY INTEGRATION "test_scim_integration"$`).WillReturnRows(descRows)
+
+		err := resources.CreateSCIMIntegration(d, db)
+		r.NoError(err)
+		r.Equal("OKTA", d.Get("scim_client").(string))
+		r.False(d.Get("sync_password").(bool))
+		r.True(d.Get("enabled").(bool))
+		r.Equal("okta provisioning", d.Get("comment").(string))
+	})
+}
+
+func TestSCIMIntegrationReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := scimIntegration(t, "test_scim_integration", map[string]interface{}{"name": "test_scim_integration"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'test_scim_integration'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		err := resources.ReadSCIMIntegration(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
 func TestSCIMIntegrationRead(t *testing.T) {
 	r := require.New(t)
 
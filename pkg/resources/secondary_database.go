@@ -0,0 +1,332 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secondaryDatabaseSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the secondary database.",
+	},
+	"as_replica_of": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "A fully qualified path to the primary database in another account of the organization, in the <organization_name>.<account_name>.<database_name> format.",
+	},
+	"is_transient": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies the secondary database as transient. Transient databases do not have a Fail-safe period.",
+	},
+	"data_retention_time_in_days": {
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "Number of days for which Snowflake retains historical data for performing Time Travel actions (SELECT, CLONE, UNDROP) on the secondary database. If not set, the account value is used.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secondary database.",
+	},
+	"refresh_triggers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Arbitrary map of values that, when changed, refreshes the secondary database from the primary database. The secondary database is also refreshed when it is created with triggers.",
+	},
+	"refresh_task": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Refreshes the secondary database on a schedule with a task executing ALTER DATABASE ... REFRESH. The task is created in a schema of another database, as the secondary database is read-only.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The database in which to create the refresh task.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The schema in which to create the refresh task.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the identifier for the refresh task.",
+				},
+				"schedule": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The schedule of the refresh, e.g. `60 MINUTE` or `USING CRON 0 * * * * UTC`.",
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The warehouse running the refresh task. If not set, the task is serverless.",
+				},
+			},
+		},
+	},
+	"last_refreshed_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the latest refresh of the secondary database in the last 14 days completed. It is empty when the database was not refreshed in that period. Reading it requires a current warehouse; without one the previous value is kept.",
+	},
+}
+
+// SecondaryDatabase returns a pointer to the resource representing a secondary database.
+func SecondaryDatabase() *schema.Resource {
+	return &schema.Resource{
+		Description: "A secondary database is a read-only replica of a primary database in another account of the organization, refreshed on demand or on a schedule. Reading the latest refresh requires a current warehouse.",
+
+		Create: CreateSecondaryDatabase,
+		Read:   ReadSecondaryDatabase,
+		Update: UpdateSecondaryDatabase,
+		Delete: DeleteSecondaryDatabase,
+
+		Schema: secondaryDatabaseSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// secondaryDatabaseRefreshTask returns the identifier of the refresh task in the given refresh_task value, or false when there is none.
+func secondaryDatabaseRefreshTask(v interface{}) (sdk.SchemaObjectIdentifier, map[string]interface{}, bool) {
+	tasks := v.([]interface{})
+	if len(tasks) == 0 || tasks[0] == nil {
+		return sdk.SchemaObjectIdentifier{}, nil, false
+	}
+	task := tasks[0].(map[string]interface{})
+	return sdk.NewSchemaObjectIdentifier(task["database"].(string), task["schema"].(string), task["name"].(string)), task, true
+}
+
+// createSecondaryDatabaseRefreshTask creates, or replaces, the refresh task and resumes it.
+func createSecondaryDatabaseRefreshTask(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	taskID, task, ok := secondaryDatabaseRefreshTask(d.Get("refresh_task"))
+	if !ok {
+		return nil
+	}
+	request := sdk.NewCreateTaskRequest(taskID, fmt.Sprintf("ALTER DATABASE %s REFRESH", id.FullyQualifiedName())).
+		WithOrReplace(sdk.Bool(true)).
+		WithSchedule(sdk.String(task["schedule"].(string))).
+		WithComment(sdk.String(fmt.Sprintf("Refreshes the secondary database %s", id.Name())))
+	if warehouse := task["warehouse"].(string); warehouse != "" {
+		request = request.WithWarehouse(sdk.NewCreateTaskWarehouseRequest().WithWarehouse(sdk.Pointer(sdk.NewAccountObjectIdentifier(warehouse))))
+	}
+	if err := client.Tasks.Create(ctx, request); err != nil {
+		return fmt.Errorf("error creating refresh task %v of secondary database %v err = %w", taskID.FullyQualifiedName(), id.Name(), err)
+	}
+	if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(taskID).WithResume(sdk.Bool(true))); err != nil {
+		return fmt.Errorf("error resuming refresh task %v of secondary database %v err = %w", taskID.FullyQualifiedName(), id.Name(), err)
+	}
+	return nil
+}
+
+func refreshSecondaryDatabase(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier) error {
+	if err := client.Databases.AlterReplication(ctx, id, &sdk.AlterDatabaseReplicationOptions{Refresh: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error refreshing secondary database %v err = %w", id.Name(), err)
+	}
+	return nil
+}
+
+// CreateSecondaryDatabase implements schema.CreateFunc.
+func CreateSecondaryDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+	primaryID := sdk.NewExternalObjectIdentifierFromFullyQualifiedName(strings.ReplaceAll(d.Get("as_replica_of").(string), `"`, ""))
+
+	opts := &sdk.CreateSecondaryDatabaseOptions{}
+	if d.Get("is_transient").(bool) {
+		opts.Transient = sdk.Bool(true)
+	}
+	if v, ok := d.GetOk("data_retention_time_in_days"); ok {
+		opts.DataRetentionTimeInDays = sdk.Int(v.(int))
+	}
+	if err := client.Databases.CreateSecondary(ctx, id, primaryID, opts); err != nil {
+		return fmt.Errorf("error creating secondary database %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("comment"); ok {
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: &sdk.DatabaseSet{Comment: sdk.String(v.(string))}}); err != nil {
+			return fmt.Errorf("error setting comment of secondary database %v err = %w", name, err)
+		}
+	}
+	if len(d.Get("refresh_triggers").(map[string]interface{})) > 0 {
+		if err := refreshSecondaryDatabase(ctx, client, id); err != nil {
+			return err
+		}
+	}
+	if err := createSecondaryDatabaseRefreshTask(ctx, client, d, id); err != nil {
+		return err
+	}
+
+	return ReadSecondaryDatabase(d, meta)
+}
+
+// ReadSecondaryDatabase implements schema.ReadFunc.
+func ReadSecondaryDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	database, err := client.Databases.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] secondary database (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", database.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", database.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("data_retention_time_in_days", database.RetentionTime); err != nil {
+		return err
+	}
+	if err := d.Set("is_transient", database.Transient); err != nil {
+		return err
+	}
+
+	// the refresh history requires a current warehouse, so the last refresh is kept when it cannot be read
+	refreshes, err := client.Databases.RefreshHistory(ctx, id)
+	if err != nil {
+		log.Printf("[DEBUG] unable to read refresh history of secondary database %v err = %v", d.Id(), err)
+	} else {
+		lastRefreshedOn := ""
+		var latest *sdk.DatabaseRefresh
+		for i, refresh := range refreshes {
+			if refresh.CurrentPhase != "COMPLETED" || refresh.EndTime == nil {
+				continue
+			}
+			if latest == nil || refresh.EndTime.After(*latest.EndTime) {
+				latest = &refreshes[i]
+			}
+		}
+		if latest != nil {
+			lastRefreshedOn = latest.EndTime.String()
+		}
+		if err := d.Set("last_refreshed_on", lastRefreshedOn); err != nil {
+			return err
+		}
+	}
+
+	// the refresh task dropped outside of Terraform is removed from the state, so that it is created again
+	if taskID, _, ok := secondaryDatabaseRefreshTask(d.Get("refresh_task")); ok {
+		task, err := client.Tasks.ShowByID(ctx, taskID)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			return d.Set("refresh_task", nil)
+		}
+		if err != nil {
+			return err
+		}
+		if err := d.Set("refresh_task", []interface{}{map[string]interface{}{
+			"database":  task.DatabaseName,
+			"schema":    task.SchemaName,
+			"name":      task.Name,
+			"schedule":  task.Schedule,
+			"warehouse": task.Warehouse,
+		}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateSecondaryDatabase implements schema.UpdateFunc.
+func UpdateSecondaryDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if d.HasChange("data_retention_time_in_days") {
+		set := &sdk.DatabaseSet{DataRetentionTimeInDays: sdk.Int(d.Get("data_retention_time_in_days").(int))}
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating data retention time of secondary database %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		set := &sdk.DatabaseSet{Comment: sdk.String(d.Get("comment").(string))}
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: set}); err != nil {
+			return fmt.Errorf("error updating comment of secondary database %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("refresh_triggers") {
+		if err := refreshSecondaryDatabase(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("refresh_task") {
+		o, n := d.GetChange("refresh_task")
+		oldTaskID, _, hadTask := secondaryDatabaseRefreshTask(o)
+		newTaskID, _, hasTask := secondaryDatabaseRefreshTask(n)
+		if hadTask && (!hasTask || oldTaskID.FullyQualifiedName() != newTaskID.FullyQualifiedName()) {
+			if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(oldTaskID).WithIfExists(sdk.Bool(true))); err != nil {
+				return fmt.Errorf("error dropping refresh task %v of secondary database %v err = %w", oldTaskID.FullyQualifiedName(), name, err)
+			}
+		}
+		if err := createSecondaryDatabaseRefreshTask(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	return ReadSecondaryDatabase(d, meta)
+}
+
+// DeleteSecondaryDatabase implements schema.DeleteFunc.
+func DeleteSecondaryDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if taskID, _, ok := secondaryDatabaseRefreshTask(d.Get("refresh_task")); ok {
+		if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(taskID).WithIfExists(sdk.Bool(true))); err != nil {
+			return fmt.Errorf("error dropping refresh task %v of secondary database %v err = %w", taskID.FullyQualifiedName(), name, err)
+		}
+	}
+
+	if err := client.Databases.Drop(ctx, id, &sdk.DropDatabaseOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting secondary database %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
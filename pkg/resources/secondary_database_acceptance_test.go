@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SecondaryDatabase(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// a primary database in another account of the organization replicated to the test account, e.g. myorg.account2.db
+	primary := os.Getenv("SNOWFLAKE_PRIMARY_DATABASE")
+	if primary == "" {
+		t.Skip("SNOWFLAKE_PRIMARY_DATABASE must be set for SecondaryDatabase acceptance tests")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secondaryDatabaseConfig(name, primary, "1", "60 MINUTE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_database.s", "name", name),
+					resource.TestCheckResourceAttr("snowflake_secondary_database.s", "as_replica_of", primary),
+					resource.TestCheckResourceAttr("snowflake_secondary_database.s", "refresh_task.0.name", name+"_REFRESH"),
+					resource.TestCheckResourceAttrSet("snowflake_secondary_database.s", "last_refreshed_on"),
+				),
+			},
+			// refresh on trigger and reschedule
+			{
+				Config: secondaryDatabaseConfig(name, primary, "2", "30 MINUTE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secondary_database.s", "refresh_task.0.schedule", "30 MINUTE"),
+					resource.TestCheckResourceAttrSet("snowflake_secondary_database.s", "last_refreshed_on"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_secondary_database.s",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"as_replica_of", "refresh_triggers", "refresh_task"},
+			},
+		},
+	})
+}
+
+func secondaryDatabaseConfig(name string, primary string, trigger string, schedule string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "ops" {
+	name = "%[1]s_OPS"
+}
+
+resource "snowflake_schema" "ops" {
+	database = snowflake_database.ops.name
+	name     = "TASKS"
+}
+
+resource "snowflake_secondary_database" "s" {
+	name          = "%[1]s"
+	as_replica_of = "%[2]s"
+	comment       = "Terraform acceptance test"
+
+	refresh_triggers = {
+		version = "%[3]s"
+	}
+
+	refresh_task {
+		database = snowflake_database.ops.name
+		schema   = snowflake_schema.ops.name
+		name     = "%[1]s_REFRESH"
+		schedule = "%[4]s"
+	}
+}
+`, name, primary, trigger, schedule)
+}
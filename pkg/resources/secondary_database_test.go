@@ -0,0 +1,132 @@
+package resources_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryDatabase(t *testing.T) {
+	r := require.New(t)
+	err := resources.SecondaryDatabase().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSecondaryDatabaseCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "db1",
+		"as_replica_of":    `"myorg"."account1"."db1"`,
+		"is_transient":     true,
+		"comment":          "replica",
+		"refresh_triggers": map[string]interface{}{"version": "1"},
+		"refresh_task": []interface{}{
+			map[string]interface{}{"database": "ops", "schema": "tasks", "name": "refresh_db1", "schedule": "60 MINUTE", "warehouse": "wh1"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.SecondaryDatabase().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TRANSIENT DATABASE "db1" AS REPLICA OF myorg.account1."db1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" SET COMMENT = 'replica'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "db1" REFRESH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^CREATE OR REPLACE TASK "ops"."tasks"."refresh_db1" WAREHOUSE = "wh1" SCHEDULE = '60 MINUTE' COMMENT = 'Refreshes the secondary database db1' AS ALTER DATABASE "db1" REFRESH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TASK "ops"."tasks"."refresh_db1" RESUME$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSecondaryDatabase(mock)
+		err := resources.CreateSecondaryDatabase(d, db)
+		r.NoError(err)
+		r.Equal("db1", d.Id())
+		r.True(d.Get("is_transient").(bool))
+		r.Contains(d.Get("last_refreshed_on").(string), "2024-01-02 03:04:05")
+		r.Equal("60 MINUTE", d.Get("refresh_task.0.schedule").(string))
+	})
+}
+
+func TestSecondaryDatabaseUpdateRefresh(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "db1",
+		Attributes: map[string]string{
+			"name":                     "db1",
+			"as_replica_of":            "myorg.account1.db1",
+			"refresh_triggers.%":       "1",
+			"refresh_triggers.version": "1",
+			"refresh_task.#":           "1",
+			"refresh_task.0.database":  "ops",
+			"refresh_task.0.schema":    "tasks",
+			"refresh_task.0.name":      "refresh_db1",
+			"refresh_task.0.schedule":  "60 MINUTE",
+			"refresh_task.0.warehouse": "",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"refresh_triggers.version": {Old: "1", New: "2"},
+			"refresh_task.#":           {Old: "1", New: "0"},
+			"refresh_task.0.database":  {Old: "ops", New: "", NewRemoved: true},
+			"refresh_task.0.schema":    {Old: "tasks", New: "", NewRemoved: true},
+			"refresh_task.0.name":      {Old: "refresh_db1", New: "", NewRemoved: true},
+			"refresh_task.0.schedule":  {Old: "60 MINUTE", New: "", NewRemoved: true},
+		},
+	}
+	d, err := schema.InternalMap(resources.SecondaryDatabase().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DATABASE "db1" REFRESH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^DROP TASK IF EXISTS "ops"."tasks"."refresh_db1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"created_on", "name", "comment", "options", "retention_time"}).
+			AddRow(time.Now(), "db1", "", "", "1")
+		mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+		refreshRows := sqlmock.NewRows([]string{"CURRENT_PHASE", "START_TIME", "END_TIME", "JOB_UUID"}).
+			AddRow("FAILED", time.Now(), nil, "uuid1")
+		mock.ExpectQuery(`^SELECT CURRENT_PHASE, START_TIME, END_TIME, JOB_UUID FROM TABLE\("db1".INFORMATION_SCHEMA.DATABASE_REFRESH_HISTORY\('db1'\)\)$`).WillReturnRows(refreshRows)
+		err := resources.UpdateSecondaryDatabase(d, db)
+		r.NoError(err)
+		r.Equal("", d.Get("last_refreshed_on").(string))
+		r.Equal(0, d.Get("refresh_task.#").(int))
+	})
+}
+
+func TestSecondaryDatabaseReadWithoutWarehouse(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SecondaryDatabase().Schema, map[string]interface{}{"name": "db1", "as_replica_of": "myorg.account1.db1"})
+	d.SetId("db1")
+	r.NoError(d.Set("last_refreshed_on", "2024-01-02 03:04:05 +0000 UTC"))
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "comment", "options", "retention_time"}).
+			AddRow(time.Now(), "db1", "", "", "1")
+		mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+		mock.ExpectQuery(`^SELECT CURRENT_PHASE, START_TIME, END_TIME, JOB_UUID FROM TABLE\("db1".INFORMATION_SCHEMA.DATABASE_REFRESH_HISTORY\('db1'\)\)$`).
+			WillReturnError(errors.New("No active warehouse selected in the current session"))
+		err := resources.ReadSecondaryDatabase(d, db)
+		r.NoError(err)
+		r.Equal("2024-01-02 03:04:05 +0000 UTC", d.Get("last_refreshed_on").(string))
+	})
+}
+
+func expectReadSecondaryDatabase(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "comment", "options", "retention_time"}).
+		AddRow(time.Now(), "db1", "replica", "TRANSIENT", "1")
+	mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+	refreshRows := sqlmock.NewRows([]string{"CURRENT_PHASE", "START_TIME", "END_TIME", "JOB_UUID"}).
+		AddRow("COMPLETED", time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "uuid1").
+		AddRow("COMPLETED", time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 3, 4, 5, 0, time.UTC), "uuid0")
+	mock.ExpectQuery(`^SELECT CURRENT_PHASE, START_TIME, END_TIME, JOB_UUID FROM TABLE\("db1".INFORMATION_SCHEMA.DATABASE_REFRESH_HISTORY\('db1'\)\)$`).WillReturnRows(refreshRows)
+	taskRows := sqlmock.NewRows([]string{"created_on", "name", "id", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "predecessors", "state", "definition", "condition", "allow_overlapping_execution"}).
+		AddRow("2024-01-01", "refresh_db1", "1", "ops", "tasks", "ACCOUNTADMIN", "", "wh1", "60 MINUTE", "[]", "started", `ALTER DATABASE "db1" REFRESH`, "", "false")
+	mock.ExpectQuery(`^DESCRIBE TASK "ops"."tasks"."refresh_db1"$`).WillReturnRows(taskRows)
+}
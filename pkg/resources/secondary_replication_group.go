@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var secondaryReplicationGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the secondary replication group. It has to be the same as the name of the primary replication group.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"primary": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Specifies the primary replication group in the source account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organization_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the organization of the source account.",
+				},
+				"source_account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the source account containing the primary replication group.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the primary replication group.",
+				},
+			},
+		},
+	},
+	"refresh_on_create": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Refreshes the secondary replication group right after it is created instead of waiting for the replication schedule of the primary group.",
+	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the scheduled refreshes of the secondary replication group are suspended.",
+	},
+	"replication_schedule": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The refresh schedule inherited from the primary replication group.",
+	},
+	"next_scheduled_refresh": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time of the next scheduled refresh.",
+	},
+}
+
+// SecondaryReplicationGroup returns a pointer to the resource representing a secondary replication group.
+func SecondaryReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "A secondary replication group is a read-only replica of a `snowflake_replication_group` in a target account. It is refreshed on the schedule of the primary group.",
+
+		Create: CreateSecondaryReplicationGroup,
+		Read:   ReadSecondaryReplicationGroup,
+		Update: UpdateSecondaryReplicationGroup,
+		Delete: DeleteSecondaryReplicationGroup,
+
+		Schema: secondaryReplicationGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateSecondaryReplicationGroup implements schema.CreateFunc.
+func CreateSecondaryReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	primary := d.Get("primary").([]interface{})[0].(map[string]interface{})
+	primaryID := sdk.NewExternalObjectIdentifier(
+		sdk.NewAccountIdentifier(primary["organization_name"].(string), primary["source_account_name"].(string)),
+		sdk.NewAccountObjectIdentifier(primary["name"].(string)),
+	)
+
+	if err := client.ReplicationGroups.CreateReplica(ctx, id, primaryID, nil); err != nil {
+		return fmt.Errorf("error creating secondary replication group %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if d.Get("refresh_on_create").(bool) {
+		if err := client.ReplicationGroups.AlterTarget(ctx, id, &sdk.AlterTargetReplicationGroupOptions{Refresh: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error refreshing secondary replication group %v err = %w", name, err)
+		}
+	}
+
+	if d.Get("suspended").(bool) {
+		if err := client.ReplicationGroups.AlterTarget(ctx, id, &sdk.AlterTargetReplicationGroupOptions{Suspend: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error suspending secondary replication group %v err = %w", name, err)
+		}
+	}
+
+	return ReadSecondaryReplicationGroup(d, meta)
+}
+
+// ReadSecondaryReplicationGroup implements schema.ReadFunc.
+func ReadSecondaryReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	replicationGroup, err := client.ReplicationGroups.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] secondary replication group (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if replicationGroup.IsPrimary {
+		return fmt.Errorf("replication group %v is a primary group and cannot be managed as a secondary replication group", d.Id())
+	}
+
+	if err := d.Set("name", replicationGroup.Name); err != nil {
+		return err
+	}
+
+	primaryParts := strings.Split(replicationGroup.Primary.FullyQualifiedName(), ".")
+	if len(primaryParts) == 3 {
+		primary := []interface{}{
+			map[string]interface{}{
+				"organization_name":   strings.Trim(primaryParts[0], `"`),
+				"source_account_name": strings.Trim(primaryParts[1], `"`),
+				"name":                strings.Trim(primaryParts[2], `"`),
+			},
+		}
+		if err := d.Set("primary", primary); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("suspended", replicationGroup.SecondaryState == sdk.FailoverGroupSecondaryStateSuspended); err != nil {
+		return err
+	}
+	if err := d.Set("replication_schedule", replicationGroup.ReplicationSchedule); err != nil {
+		return err
+	}
+	return d.Set("next_scheduled_refresh", replicationGroup.NextScheduledRefresh)
+}
+
+// UpdateSecondaryReplicationGroup implements schema.UpdateFunc.
+func UpdateSecondaryReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if d.HasChange("suspended") {
+		opts := &sdk.AlterTargetReplicationGroupOptions{Resume: sdk.Bool(true)}
+		if d.Get("suspended").(bool) {
+			opts = &sdk.AlterTargetReplicationGroupOptions{Suspend: sdk.Bool(true)}
+		}
+		if err := client.ReplicationGroups.AlterTarget(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating secondary replication group %v err = %w", name, err)
+		}
+	}
+
+	return ReadSecondaryReplicationGroup(d, meta)
+}
+
+// DeleteSecondaryReplicationGroup implements schema.DeleteFunc.
+func DeleteSecondaryReplicationGroup(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+	if err := client.ReplicationGroups.Drop(ctx, id, &sdk.DropReplicationGroupOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting secondary replication group %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryReplicationGroup(t *testing.T) {
+	r := require.New(t)
+	err := resources.SecondaryReplicationGroup().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSecondaryReplicationGroupUpdateSuspended(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "rg1",
+		"primary": []interface{}{
+			map[string]interface{}{
+				"organization_name":   "org",
+				"source_account_name": "account",
+				"name":                "rg1",
+			},
+		},
+		"suspended": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.SecondaryReplicationGroup().Schema, in)
+	d.MarkNewResource()
+	d.SetId("rg1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER REPLICATION GROUP "rg1" SUSPEND$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) as CURRENT_ACCOUNT$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_ACCOUNT"}).AddRow("ABC123"))
+		mock.ExpectQuery(`^SHOW REPLICATION GROUPS$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		err := resources.UpdateSecondaryReplicationGroup(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestSecondaryReplicationGroupDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SecondaryReplicationGroup().Schema, map[string]interface{}{"name": "drop_it"})
+	d.SetId("drop_it")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP REPLICATION GROUP IF EXISTS "drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteSecondaryReplicationGroup(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
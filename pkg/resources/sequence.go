@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -11,7 +12,9 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -37,6 +40,16 @@ var sequenceSchema = map[string]*schema.Schema{
 		Default:     1,
 		Description: "The amount the sequence will increase by each time it is used",
 	},
+	"ordering": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies whether the values are generated for the sequence in increasing or decreasing order (ORDER) or not (NOORDER). When not set the account default (NOORDER_SEQUENCE_AS_DEFAULT) is used. Changing NOORDER to ORDER recreates the sequence.",
+		ValidateFunc: validation.StringInSlice([]string{"ORDER", "NOORDER"}, true),
+		StateFunc: func(v interface{}) string {
+			return strings.ToUpper(v.(string))
+		},
+	},
 	"database": {
 		Type:        schema.TypeString,
 		Required:    true,
@@ -93,6 +106,11 @@ func Sequence() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("ordering", func(ctx context.Context, old, new, meta any) bool {
+			// a sequence can only be altered from ORDER to NOORDER
+			return strings.EqualFold(old.(string), "NOORDER") && strings.EqualFold(new.(string), "ORDER")
+		}),
 	}
 }
 
@@ -113,6 +131,10 @@ func CreateSequence(d *schema.ResourceData, meta interface{}) error {
 		sq.WithComment(v.(string))
 	}
 
+	if v, ok := d.GetOk("ordering"); ok {
+		sq.WithOrdering(strings.ToUpper(v.(string)))
+	}
+
 	if err := snowflake.Exec(db, sq.Create()); err != nil {
 		return fmt.Errorf("error creating sequence err = %w", err)
 	}
@@ -184,6 +206,16 @@ func ReadSequence(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if sequence.Ordered.Valid {
+		ordering := "NOORDER"
+		if sequence.Ordered.String == "Y" {
+			ordering = "ORDER"
+		}
+		if err := d.Set("ordering", ordering); err != nil {
+			return err
+		}
+	}
+
 	n, err := strconv.ParseInt(sequence.NextValue.String, 10, 64)
 	if err != nil {
 		return err
@@ -206,44 +238,24 @@ func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	database := sequenceID.DatabaseName
-	schema := sequenceID.SchemaName
-	name := sequenceID.SequenceName
-
-	sq := snowflake.NewSequenceBuilder(name, database, schema)
-	stmt := sq.Show()
-	row := snowflake.QueryRow(db, stmt)
-
-	sequence, err := snowflake.ScanSequence(row)
-	if err != nil {
-		return fmt.Errorf("unable to scan row for SHOW SEQUENCES")
-	}
-	deleteSequenceErr := DeleteSequence(d, meta)
-	if deleteSequenceErr != nil {
-		return deleteSequenceErr
-	}
-
-	if i, ok := d.GetOk("increment"); ok {
-		sq.WithIncrement(i.(int))
-	}
-
-	if v, ok := d.GetOk("comment"); ok {
-		sq.WithComment(v.(string))
-	}
+	sq := snowflake.NewSequenceBuilder(sequenceID.SequenceName, sequenceID.DatabaseName, sequenceID.SchemaName)
 
-	nextValue, err := strconv.Atoi(sequence.NextValue.String)
-	if err != nil {
-		return err
+	if d.HasChange("increment") {
+		if err := snowflake.Exec(db, sq.ChangeIncrement(d.Get("increment").(int))); err != nil {
+			return fmt.Errorf("error changing increment of sequence %v err = %w", sq.QualifiedName(), err)
+		}
 	}
 
-	if err := d.Set("next_value", nextValue); err != nil {
-		return err
+	if d.HasChange("comment") {
+		if err := snowflake.Exec(db, sq.ChangeComment(d.Get("comment").(string))); err != nil {
+			return fmt.Errorf("error changing comment of sequence %v err = %w", sq.QualifiedName(), err)
+		}
 	}
 
-	sq.WithStart(nextValue)
-
-	if err := snowflake.Exec(db, sq.Create()); err != nil {
-		return fmt.Errorf("error creating sequence err = %w", err)
+	if d.HasChange("ordering") && strings.EqualFold(d.Get("ordering").(string), "NOORDER") {
+		if err := snowflake.Exec(db, sq.SetNoOrder()); err != nil {
+			return fmt.Errorf("error changing ordering of sequence %v err = %w", sq.QualifiedName(), err)
+		}
 	}
 
 	return ReadSequence(d, meta)
@@ -93,3 +93,45 @@ resource "snowflake_sequence" "test_sequence" {
 `
 	return fmt.Sprintf(s, sequenceName, databaseName, schemaName, comment)
 }
+
+func TestAcc_SequenceAlterInPlace(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sequenceConfigWithOrdering(accName, acc.TestDatabaseName, acc.TestSchemaName, 1, "first", "ORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "increment", "1"),
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "ordering", "ORDER"),
+				),
+			},
+			// increment, comment and ORDER -> NOORDER are altered in place
+			{
+				Config: sequenceConfigWithOrdering(accName, acc.TestDatabaseName, acc.TestSchemaName, 5, "second", "NOORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "increment", "5"),
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "comment", "second"),
+					resource.TestCheckResourceAttr("snowflake_sequence.test_sequence", "ordering", "NOORDER"),
+				),
+			},
+		},
+	})
+}
+
+func sequenceConfigWithOrdering(sequenceName string, databaseName string, schemaName string, increment int, comment string, ordering string) string {
+	s := `
+resource "snowflake_sequence" "test_sequence" {
+	name      = "%s"
+	database  = "%s"
+	schema    = "%s"
+	increment = %d
+	comment   = "%s"
+	ordering  = "%s"
+}
+`
+	return fmt.Sprintf(s, sequenceName, databaseName, schemaName, increment, comment, ordering)
+}
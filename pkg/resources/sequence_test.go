@@ -102,6 +102,36 @@ func TestSequenceRead(t *testing.T) {
 	})
 }
 
+func TestSequenceUpdate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":      "good_name",
+		"schema":    "schema",
+		"database":  "database",
+		"increment": 5,
+		"ordering":  "noorder",
+	}
+
+	d := sequence(t, "database|schema|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SEQUENCE "database"."schema"."good_name" SET INCREMENT = 5$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SEQUENCE "database"."schema"."good_name" SET NOORDER$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{
+			"name", "database_name", "schema_name", "next_value", "interval", "created_on", "owner", "comment", "ordered",
+		}).AddRow("good_name", "database", "schema", "26", "5", "created_on", "owner", "", "N")
+		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
+
+		err := resources.UpdateSequence(d, db)
+		r.NoError(err)
+		r.Equal(5, d.Get("increment").(int))
+		r.Equal(26, d.Get("next_value").(int))
+		r.Equal("NOORDER", d.Get("ordering").(string))
+		r.Equal("database|schema|good_name", d.Id())
+	})
+}
+
 func TestSequenceDelete(t *testing.T) {
 	r := require.New(t)
 	in := map[string]interface{}{
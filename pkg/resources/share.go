@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -38,6 +39,32 @@ var shareSchema = map[string]*schema.Schema{
 			"in the form of 'organization_name.account_name",
 		DiffSuppressFunc: diffCaseInsensitive,
 	},
+	"share_restrictions": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		Description: "Specifies whether adding non-Business Critical accounts to a share from a Business Critical account is blocked. " +
+			"Set it to false to share data with accounts on lower editions; it is applied whenever accounts are added to the share.",
+	},
+	"shared_objects": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The objects currently granted to the share.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kind": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the shared object, e.g. DATABASE or TABLE.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the shared object.",
+				},
+			},
+		},
+	},
 }
 
 // Share returns a pointer to the resource representing a share.
@@ -76,15 +103,7 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 
 	accounts := expandStringList(d.Get("accounts").([]interface{}))
 	if len(accounts) > 0 {
-		shareID := sdk.NewAccountObjectIdentifier(name)
-		accountIdentifiers := make([]sdk.AccountIdentifier, len(accounts))
-		for i, account := range accounts {
-			parts := strings.Split(account, ".")
-			orgName := parts[0]
-			accountName := parts[1]
-			accountIdentifiers[i] = sdk.NewAccountIdentifier(orgName, accountName)
-		}
-		err := setShareAccounts(ctx, client, shareID, accountIdentifiers)
+		err := addShareAccounts(ctx, client, id, accountIdentifiersFromSlice(accounts), d.Get("share_restrictions").(bool))
 		if err != nil {
 			return err
 		}
@@ -92,7 +111,7 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 	return ReadShare(d, meta)
 }
 
-func setShareAccounts(ctx context.Context, client *sdk.Client, shareID sdk.AccountObjectIdentifier, accounts []sdk.AccountIdentifier) error {
+func addShareAccounts(ctx context.Context, client *sdk.Client, shareID sdk.AccountObjectIdentifier, accounts []sdk.AccountIdentifier, shareRestrictions bool) error {
 	// There is a race condition where error accounts cannot be added to a
 	// share until after a database is added to the share. Since a database
 	// grant is dependent on the share itself, this is a hack to get the
@@ -146,10 +165,14 @@ func setShareAccounts(ctx context.Context, client *sdk.Client, shareID sdk.Accou
 		}
 	}()
 	// 3. Add accounts to the share
+	add := &sdk.ShareAdd{
+		Accounts: accounts,
+	}
+	if !shareRestrictions {
+		add.ShareRestrictions = sdk.Bool(false)
+	}
 	err = client.Shares.Alter(ctx, shareID, &sdk.AlterShareOptions{
-		Add: &sdk.ShareAdd{
-			Accounts: accounts,
-		},
+		Add: add,
 	})
 	return err
 }
@@ -162,6 +185,11 @@ func ReadShare(d *schema.ResourceData, meta interface{}) error {
 	ctx := context.Background()
 
 	share, err := client.Shares.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] share (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("error reading share err = %w", err)
 	}
@@ -187,7 +215,18 @@ func ReadShare(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return err
+	details, err := client.Shares.DescribeProvider(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error describing share err = %w", err)
+	}
+	sharedObjects := make([]interface{}, len(details.SharedObjects))
+	for i, sharedObject := range details.SharedObjects {
+		sharedObjects[i] = map[string]interface{}{
+			"kind": string(sharedObject.Kind),
+			"name": sharedObject.Name.FullyQualifiedName(),
+		}
+	}
+	return d.Set("shared_objects", sharedObjects)
 }
 
 func accountIdentifiersFromSlice(accounts []string) []sdk.AccountIdentifier {
@@ -201,40 +240,79 @@ func accountIdentifiersFromSlice(accounts []string) []sdk.AccountIdentifier {
 	return accountIdentifiers
 }
 
+// diffShareAccounts returns the accounts added to and removed from the old list, ignoring the case of the names.
+func diffShareAccounts(oldAccounts []string, newAccounts []string) (added []string, removed []string) {
+	containsAccount := func(accounts []string, account string) bool {
+		for _, v := range accounts {
+			if strings.EqualFold(v, account) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range oldAccounts {
+		if !containsAccount(newAccounts, v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range newAccounts {
+		if !containsAccount(oldAccounts, v) {
+			added = append(added, v)
+		}
+	}
+	return added, removed
+}
+
 // UpdateShare implements schema.UpdateFunc.
 func UpdateShare(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
 	ctx := context.Background()
+	id := sdk.NewAccountObjectIdentifier(d.Id())
 	if d.HasChange("accounts") {
 		o, n := d.GetChange("accounts")
-		oldAccounts := expandStringList(o.([]interface{}))
-		newAccounts := expandStringList(n.([]interface{}))
-		if len(newAccounts) == 0 {
-			accountIdentifiers := accountIdentifiersFromSlice(oldAccounts)
-			err := client.Shares.Alter(ctx, sdk.NewAccountObjectIdentifier(d.Id()), &sdk.AlterShareOptions{
+		added, removed := diffShareAccounts(expandStringList(o.([]interface{})), expandStringList(n.([]interface{})))
+		if len(removed) > 0 {
+			err := client.Shares.Alter(ctx, id, &sdk.AlterShareOptions{
 				Remove: &sdk.ShareRemove{
-					Accounts: accountIdentifiers,
+					Accounts: accountIdentifiersFromSlice(removed),
 				},
 			})
 			if err != nil {
 				return fmt.Errorf("error removing accounts from share err = %w", err)
 			}
-		} else {
-			accountIdentifiers := accountIdentifiersFromSlice(newAccounts)
-			err := setShareAccounts(ctx, client, sdk.NewAccountObjectIdentifier(d.Id()), accountIdentifiers)
+		}
+		if len(added) > 0 && !d.HasChange("share_restrictions") {
+			err := addShareAccounts(ctx, client, id, accountIdentifiersFromSlice(added), d.Get("share_restrictions").(bool))
+			if err != nil {
+				return fmt.Errorf("error adding accounts to share err = %w", err)
+			}
+		}
+	}
+	if d.HasChange("share_restrictions") {
+		// the restrictions of the accounts already in the share can only be changed by adding them again
+		accounts := expandStringList(d.Get("accounts").([]interface{}))
+		if len(accounts) > 0 {
+			err := addShareAccounts(ctx, client, id, accountIdentifiersFromSlice(accounts), d.Get("share_restrictions").(bool))
 			if err != nil {
-				return err
+				return fmt.Errorf("error updating share restrictions err = %w", err)
 			}
 		}
 	}
 	if d.HasChange("comment") {
-		comment := d.Get("comment").(string)
-		err := client.Shares.Alter(ctx, sdk.NewAccountObjectIdentifier(d.Id()), &sdk.AlterShareOptions{
-			Set: &sdk.ShareSet{
-				Comment: sdk.String(comment),
+		opts := &sdk.AlterShareOptions{
+			Unset: &sdk.ShareUnset{
+				Comment: sdk.Bool(true),
 			},
-		})
+		}
+		if comment := d.Get("comment").(string); comment != "" {
+			opts = &sdk.AlterShareOptions{
+				Set: &sdk.ShareSet{
+					Comment: sdk.String(comment),
+				},
+			}
+		}
+		err := client.Shares.Alter(ctx, id, opts)
 		if err != nil {
 			return fmt.Errorf("error updating share comment err = %w", err)
 		}
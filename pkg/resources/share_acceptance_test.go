@@ -33,6 +33,8 @@ func TestAcc_Share(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_share.test", "name", name),
 					resource.TestCheckResourceAttr("snowflake_share.test", "comment", shareComment),
 					resource.TestCheckResourceAttr("snowflake_share.test", "accounts.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_share.test", "share_restrictions", "true"),
+					resource.TestCheckResourceAttr("snowflake_share.test", "shared_objects.#", "0"),
 				),
 			},
 			{
@@ -50,6 +52,13 @@ func TestAcc_Share(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_share.test", "accounts.0", account2),
 				),
 			},
+			{
+				Config: shareConfigOneAccountWithoutRestrictions(name, shareComment, account2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_share.test", "accounts.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_share.test", "share_restrictions", "false"),
+				),
+			},
 			{
 				Config: shareConfig(name, shareComment),
 				Check: resource.ComposeTestCheckFunc(
@@ -58,9 +67,10 @@ func TestAcc_Share(t *testing.T) {
 			},
 			// IMPORT
 			{
-				ResourceName:      "snowflake_share.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "snowflake_share.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"share_restrictions"},
 			},
 		},
 	})
@@ -94,3 +104,14 @@ resource "snowflake_share" "test" {
 }
 `, name, comment, account2, account3)
 }
+
+func shareConfigOneAccountWithoutRestrictions(name string, comment string, account2 string) string {
+	return fmt.Sprintf(`
+resource "snowflake_share" "test" {
+	name               = "%v"
+	comment            = "%v"
+	accounts           = ["%v"]
+	share_restrictions = false
+}
+`, name, comment, account2)
+}
@@ -0,0 +1,19 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffShareAccounts(t *testing.T) {
+	r := require.New(t)
+
+	added, removed := diffShareAccounts([]string{"org.a", "org.b"}, []string{"ORG.B", "org.c"})
+	r.Equal([]string{"org.c"}, added)
+	r.Equal([]string{"org.a"}, removed)
+
+	added, removed = diffShareAccounts([]string{"org.a"}, []string{"org.a"})
+	r.Empty(added)
+	r.Empty(removed)
+}
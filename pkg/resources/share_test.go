@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShare(t *testing.T) {
+	r := require.New(t)
+	err := resources.Share().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestShareRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Share().Schema, map[string]interface{}{"name": "test_share"})
+	d.SetId("test_share")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		showRows := sqlmock.NewRows([]string{
+			"created_on", "kind", "owner_account", "name", "database_name", "to", "owner", "comment",
+		}).AddRow(time.Now(), "OUTBOUND", "ORG.ACCOUNT", "test_share", "DB", "ORG.CONSUMER", "ACCOUNTADMIN", "great comment")
+		mock.ExpectQuery(`^SHOW SHARES LIKE 'test_share'$`).WillReturnRows(showRows)
+		descRows := sqlmock.NewRows([]string{"kind", "name", "shared_on"}).
+			AddRow("DATABASE", `"DB"`, time.Now()).
+			AddRow("TABLE", `DB.PUBLIC.T`, time.Now())
+		mock.ExpectQuery(`^DESCRIBE SHARE "test_share"$`).WillReturnRows(descRows)
+
+		err := resources.ReadShare(d, db)
+		r.NoError(err)
+		r.Equal("great comment", d.Get("comment").(string))
+		r.Equal([]interface{}{"ORG.CONSUMER"}, d.Get("accounts").([]interface{}))
+		r.Equal(2, d.Get("shared_objects.#").(int))
+		r.Equal("DATABASE", d.Get("shared_objects.0.kind").(string))
+		r.Equal(`"DB"`, d.Get("shared_objects.0.name").(string))
+		r.Equal(`"DB"."PUBLIC"."T"`, d.Get("shared_objects.1.name").(string))
+	})
+}
+
+func TestShareReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Share().Schema, map[string]interface{}{"name": "test_share"})
+	d.SetId("test_share")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW SHARES LIKE 'test_share'$`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+		err := resources.ReadShare(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
@@ -0,0 +1,184 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sharedDatabaseSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the shared database.",
+	},
+	"provider_account": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The account providing the share, either as an account locator or in the <organization_name>.<account_name> format.",
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"share": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The name of the share the database is created from.",
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the shared database.",
+	},
+	"origin": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The share the database was created from, as returned by Snowflake.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the role that owns the shared database.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the shared database was created.",
+	},
+}
+
+// SharedDatabase returns a pointer to the resource representing a shared database.
+func SharedDatabase() *schema.Resource {
+	return &schema.Resource{
+		Description: "A shared database is a read-only database created from a share of a provider account. Its objects and parameters are managed by the provider, so only the comment can be changed in place.",
+
+		Create: CreateSharedDatabase,
+		Read:   ReadSharedDatabase,
+		Update: UpdateSharedDatabase,
+		Delete: DeleteSharedDatabase,
+
+		Schema: sharedDatabaseSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateSharedDatabase implements schema.CreateFunc.
+func CreateSharedDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Get("name").(string)
+	id := sdk.NewAccountObjectIdentifier(name)
+	providerAccount := sdk.NewAccountIdentifierFromFullyQualifiedName(d.Get("provider_account").(string))
+	shareID := sdk.NewExternalObjectIdentifier(providerAccount, sdk.NewAccountObjectIdentifier(d.Get("share").(string)))
+
+	opts := &sdk.CreateSharedDatabaseOptions{}
+	if v, ok := d.GetOk("comment"); ok {
+		opts.Comment = sdk.String(v.(string))
+	}
+	if err := client.Databases.CreateShared(ctx, id, shareID, opts); err != nil {
+		return fmt.Errorf("error creating shared database %v err = %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return ReadSharedDatabase(d, meta)
+}
+
+// ReadSharedDatabase implements schema.ReadFunc.
+func ReadSharedDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+	database, err := client.Databases.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] shared database (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("name", database.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", database.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("origin", database.Origin); err != nil {
+		return err
+	}
+	if err := d.Set("owner", database.Owner); err != nil {
+		return err
+	}
+	if err := d.Set("created_on", database.CreatedOn.String()); err != nil {
+		return err
+	}
+
+	// the origin is only used to fill in the provider account and the share on import, as Snowflake may return
+	// the provider account in a different format than the configured one
+	if d.Get("share").(string) == "" {
+		if i := strings.LastIndex(database.Origin, "."); i > 0 {
+			if err := d.Set("provider_account", database.Origin[:i]); err != nil {
+				return err
+			}
+			if err := d.Set("share", database.Origin[i+1:]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateSharedDatabase implements schema.UpdateFunc.
+func UpdateSharedDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if d.HasChange("comment") {
+		opts := &sdk.AlterDatabaseOptions{Unset: &sdk.DatabaseUnset{Comment: sdk.Bool(true)}}
+		if v, ok := d.GetOk("comment"); ok {
+			opts = &sdk.AlterDatabaseOptions{Set: &sdk.DatabaseSet{Comment: sdk.String(v.(string))}}
+		}
+		if err := client.Databases.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating comment of shared database %v err = %w", name, err)
+		}
+	}
+
+	return ReadSharedDatabase(d, meta)
+}
+
+// DeleteSharedDatabase implements schema.DeleteFunc.
+func DeleteSharedDatabase(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	name := d.Id()
+	id := sdk.NewAccountObjectIdentifier(name)
+
+	if err := client.Databases.Drop(ctx, id, &sdk.DropDatabaseOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return fmt.Errorf("error deleting shared database %v err = %w", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,63 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_SharedDatabase(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	// a share of another account granted to the test account, e.g. myorg.account2.share
+	share := os.Getenv("SNOWFLAKE_INBOUND_SHARE")
+	if share == "" {
+		t.Skip("SNOWFLAKE_INBOUND_SHARE must be set for SharedDatabase acceptance tests")
+	}
+	i := strings.LastIndex(share, ".")
+	providerAccount, shareName := share[:i], share[i+1:]
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sharedDatabaseConfig(name, providerAccount, shareName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_shared_database.s", "name", name),
+					resource.TestCheckResourceAttr("snowflake_shared_database.s", "comment", "first"),
+					resource.TestCheckResourceAttrSet("snowflake_shared_database.s", "origin"),
+				),
+			},
+			{
+				Config: sharedDatabaseConfig(name, providerAccount, shareName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_shared_database.s", "comment", "second"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_shared_database.s",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provider_account", "share"},
+			},
+		},
+	})
+}
+
+func sharedDatabaseConfig(name string, providerAccount string, share string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_shared_database" "s" {
+	name             = "%s"
+	provider_account = "%s"
+	share            = "%s"
+	comment          = "%s"
+}
+`, name, providerAccount, share, comment)
+}
@@ -0,0 +1,63 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedDatabase(t *testing.T) {
+	r := require.New(t)
+	err := resources.SharedDatabase().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSharedDatabaseCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "db1",
+		"provider_account": "myorg.account1",
+		"share":            "share1",
+		"comment":          "shared",
+	}
+	d := schema.TestResourceDataRaw(t, resources.SharedDatabase().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE DATABASE "db1" FROM SHARE myorg.account1."share1" COMMENT = 'shared'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSharedDatabase(mock)
+		err := resources.CreateSharedDatabase(d, db)
+		r.NoError(err)
+		r.Equal("db1", d.Id())
+		r.Equal("myorg.account1", d.Get("provider_account").(string))
+		r.Equal("MYORG.ACCOUNT1.SHARE1", d.Get("origin").(string))
+	})
+}
+
+func TestSharedDatabaseImport(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SharedDatabase().Schema, map[string]interface{}{})
+	d.SetId("db1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadSharedDatabase(mock)
+		err := resources.ReadSharedDatabase(d, db)
+		r.NoError(err)
+		r.Equal("MYORG.ACCOUNT1", d.Get("provider_account").(string))
+		r.Equal("SHARE1", d.Get("share").(string))
+	})
+}
+
+func expectReadSharedDatabase(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "origin", "owner", "comment", "options", "retention_time", "kind"}).
+		AddRow(time.Now(), "db1", "MYORG.ACCOUNT1.SHARE1", "ACCOUNTADMIN", "shared", "", "1", "IMPORTED DATABASE")
+	mock.ExpectQuery(`^SHOW DATABASES LIKE 'db1'$`).WillReturnRows(rows)
+}
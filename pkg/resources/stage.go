@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
@@ -43,10 +45,11 @@ var stageSchema = map[string]*schema.Schema{
 		Description: "Specifies the URL for the stage.",
 	},
 	"credentials": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies the credentials for the stage.",
-		Sensitive:   true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Specifies the credentials for the stage. Changing them, e.g. to rotate AWS keys or SAS tokens, alters the stage in place.",
+		Sensitive:        true,
+		DiffSuppressFunc: diffStageCredentials,
 	},
 	"storage_integration": {
 		Type:        schema.TypeString,
@@ -74,10 +77,51 @@ var stageSchema = map[string]*schema.Schema{
 		Description: "Specifies a comment for the stage.",
 	},
 	"directory": {
-		Type:        schema.TypeString,
-		ForceNew:    true,
-		Optional:    true,
-		Description: "Specifies the directory settings for the stage.",
+		Type:          schema.TypeString,
+		ForceNew:      true,
+		Optional:      true,
+		Description:   "Specifies the directory settings for the stage.",
+		Deprecated:    "Use directory_table instead",
+		ConflictsWith: []string{"directory_table"},
+	},
+	"directory_table": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		Description:   "Specifies the directory table settings for the stage. A directory table is required to create a stream on the stage.",
+		ConflictsWith: []string{"directory"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enable": {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Specifies whether to add a directory table to the stage.",
+				},
+				"auto_refresh": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Specifies whether Snowflake should enable triggering automatic refreshes of the directory table metadata when new or updated data files are available in the external stage.",
+				},
+				"refresh_on_create": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Specifies whether to automatically refresh the directory table metadata once, immediately after the stage is created. Only used on creation of external stages.",
+				},
+				"refresh_trigger": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Arbitrary value; whenever it changes, the directory table metadata is refreshed manually with `ALTER STAGE ... REFRESH`.",
+				},
+				"refresh_subpath": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Relative path limiting the manual refresh triggered by `refresh_trigger` to a subset of the staged files.",
+				},
+			},
+		},
 	},
 	"aws_external_id": {
 		Type:     schema.TypeString,
@@ -92,6 +136,23 @@ var stageSchema = map[string]*schema.Schema{
 	"tag": tagReferenceSchema,
 }
 
+var stageCredentialRegexp = regexp.MustCompile(`(?s)(\w+)\s*=\s*('(?:[^']|'')*'|\S+)`)
+
+// stageCredentials parses the credentials of the stage into a map of the upper-cased keys to their values.
+func stageCredentials(credentials string) map[string]string {
+	values := map[string]string{}
+	for _, match := range stageCredentialRegexp.FindAllStringSubmatch(credentials, -1) {
+		values[strings.ToUpper(match[1])] = match[2]
+	}
+	return values
+}
+
+// diffStageCredentials suppresses the differences in the case of the keys and in the spacing of the credentials.
+// The credentials are never read back from Snowflake, as SHOW and DESCRIBE only return them masked.
+func diffStageCredentials(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return reflect.DeepEqual(stageCredentials(oldValue), stageCredentials(newValue))
+}
+
 type stageID struct {
 	DatabaseName string
 	SchemaName   string
@@ -152,6 +213,19 @@ func Stage() *schema.Resource {
 	}
 }
 
+// stageDirectoryTableFromSchema builds the DIRECTORY options; REFRESH_ON_CREATE is only accepted by external stages.
+func stageDirectoryTableFromSchema(v interface{}, external bool) string {
+	directoryTable := v.([]interface{})[0].(map[string]interface{})
+	options := []string{fmt.Sprintf("ENABLE = %t", directoryTable["enable"].(bool))}
+	if directoryTable["auto_refresh"].(bool) {
+		options = append(options, "AUTO_REFRESH = true")
+	}
+	if external {
+		options = append(options, fmt.Sprintf("REFRESH_ON_CREATE = %t", directoryTable["refresh_on_create"].(bool)))
+	}
+	return strings.Join(options, " ")
+}
+
 // CreateStage implements schema.CreateFunc.
 func CreateStage(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -186,6 +260,10 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 		builder.WithDirectory(v.(string))
 	}
 
+	if v, ok := d.GetOk("directory_table"); ok && v.([]interface{})[0] != nil {
+		builder.WithDirectory(stageDirectoryTableFromSchema(v, d.Get("url").(string) != ""))
+	}
+
 	if v, ok := d.GetOk("encryption"); ok {
 		builder.WithEncryption(v.(string))
 	}
@@ -283,7 +361,18 @@ func ReadStage(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("directory", stageDesc.Directory); err != nil {
+	// the directory_table block is only read back when it is in use, the deprecated directory is read otherwise
+	if v, ok := d.GetOk("directory_table"); ok && v.([]interface{})[0] != nil {
+		directoryTable := v.([]interface{})[0].(map[string]interface{})
+		directoryTable["enable"] = stageDesc.DirectoryEnable
+		directoryTable["auto_refresh"] = stageDesc.DirectoryAutoRefresh
+		if err := d.Set("directory_table", []interface{}{directoryTable}); err != nil {
+			return err
+		}
+		if err := d.Set("directory", nil); err != nil {
+			return err
+		}
+	} else if err := d.Set("directory", stageDesc.Directory); err != nil {
 		return err
 	}
 
@@ -320,22 +409,23 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 
 	db := meta.(*sql.DB)
 
-	if d.HasChange("credentials") {
-		credentials := d.Get("credentials")
-		q := builder.ChangeCredentials(credentials.(string))
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating stage credentials on %v", d.Id())
-		}
-	}
-
-	if d.HasChange("storage_integration") && d.HasChange("url") {
+	// credentials are sent along with a new url, as the url of an external stage cannot be changed without them
+	switch {
+	case d.HasChange("storage_integration") && d.HasChange("url"):
 		si := d.Get("storage_integration")
 		url := d.Get("url")
 		q := builder.ChangeStorageIntegrationAndUrl(si.(string), url.(string))
 		if err := snowflake.Exec(db, q); err != nil {
 			return fmt.Errorf("error updating stage storage integration and url on %v", d.Id())
 		}
-	} else {
+	case d.HasChange("url") && d.Get("credentials").(string) != "":
+		url := d.Get("url")
+		credentials := d.Get("credentials")
+		q := builder.ChangeURLAndCredentials(url.(string), credentials.(string))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating stage url and credentials on %v err = %w", d.Id(), err)
+		}
+	default:
 		if d.HasChange("storage_integration") {
 			si := d.Get("storage_integration")
 			q := builder.ChangeStorageIntegration(si.(string))
@@ -351,6 +441,14 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("error updating stage url on %v", d.Id())
 			}
 		}
+
+		if d.HasChange("credentials") {
+			credentials := d.Get("credentials")
+			q := builder.ChangeCredentials(credentials.(string))
+			if err := snowflake.Exec(db, q); err != nil {
+				return fmt.Errorf("error updating stage credentials on %v err = %w", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("encryption") {
@@ -374,6 +472,18 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating stage copy options on %v", d.Id())
 		}
 	}
+	if d.HasChange("directory_table.0.enable") {
+		q := builder.ChangeDirectoryEnable(d.Get("directory_table.0.enable").(bool))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating stage directory table on %v err = %w", d.Id(), err)
+		}
+	}
+	if d.HasChange("directory_table.0.refresh_trigger") {
+		q := builder.RefreshDirectory(d.Get("directory_table.0.refresh_subpath").(string))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error refreshing stage directory table on %v err = %w", d.Id(), err)
+		}
+	}
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
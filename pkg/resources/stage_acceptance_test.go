@@ -57,3 +57,55 @@ resource "snowflake_stage" "test" {
 
 	return fmt.Sprintf(resources, name, siNameSuffix, url, name, url, databaseName, schemaName)
 }
+
+func TestAcc_StageDirectoryTable(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: stageDirectoryTableConfig(name, true, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_stage.test", "name", name),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory_table.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory_table.0.enable", "true"),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory_table.0.auto_refresh", "false"),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory", ""),
+				),
+			},
+			// trigger a manual refresh
+			{
+				Config: stageDirectoryTableConfig(name, true, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory_table.0.enable", "true"),
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory_table.0.refresh_trigger", "2"),
+				),
+			},
+			// disable the directory table in place
+			{
+				Config: stageDirectoryTableConfig(name, false, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_stage.test", "directory_table.0.enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func stageDirectoryTableConfig(name string, enable bool, refreshTrigger string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage" "test" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+
+	directory_table {
+		enable          = %t
+		refresh_trigger = "%s"
+	}
+}
+`, name, acc.TestDatabaseName, acc.TestSchemaName, enable, refreshTrigger)
+}
@@ -0,0 +1,231 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageFileSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database of the stage.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema of the stage.",
+	},
+	"stage": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the internal named stage to which the file is uploaded.",
+	},
+	"path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "",
+		Description: "Directory in the stage to which the file is uploaded, e.g. `jars/v1`. By default, the file is uploaded to the root of the stage.",
+		StateFunc: func(v interface{}) string {
+			return strings.Trim(v.(string), "/")
+		},
+	},
+	"source": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Path of the local file to upload.",
+	},
+	"auto_compress": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Specifies whether the file is compressed with gzip during the upload, which adds the .gz extension to its name in the stage. Artifacts used by functions, procedures and Streamlit apps must not be compressed.",
+	},
+	"source_hash": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The SHA256 hash of the uploaded local file. The file is uploaded again when the local file changes.",
+	},
+	"file_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Path of the uploaded file in the stage, e.g. `jars/v1/udf.jar`.",
+	},
+	"stage_location": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Location of the uploaded file, to be used e.g. in the imports of functions and procedures, e.g. `@\"db\".\"schema\".\"stage\"/jars/v1/udf.jar`.",
+	},
+	"size": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Size of the file in the stage in bytes.",
+	},
+}
+
+// StageFile returns a pointer to the resource representing a file uploaded to a stage.
+func StageFile() *schema.Resource {
+	return &schema.Resource{
+		Description: "Uploads a local file, e.g. a UDF jar, a Python zip or a Streamlit file, to an internal named stage with PUT. The file is uploaded again when the hash of the local file changes, and removed from the stage on destroy.",
+
+		Create: CreateStageFile,
+		Read:   ReadStageFile,
+		Delete: DeleteStageFile,
+
+		Schema: stageFileSchema,
+
+		CustomizeDiff: customizeStageFileDiff,
+	}
+}
+
+// customizeStageFileDiff uploads the file again when the hash of the local file differs from the uploaded one.
+func customizeStageFileDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	source := d.Get("source").(string)
+	if source == "" {
+		return nil
+	}
+	hash, err := fileSHA256(source)
+	if err != nil {
+		return err
+	}
+	if d.Get("source_hash").(string) == hash {
+		return nil
+	}
+	if err := d.SetNew("source_hash", hash); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return d.ForceNew("source_hash")
+}
+
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", fmt.Errorf("unable to open the file %v err = %w", name, err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("unable to read the file %v err = %w", name, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// stageFilePath returns the path of the uploaded file in the stage.
+func stageFilePath(d *schema.ResourceData) string {
+	name := filepath.Base(d.Get("source").(string))
+	if d.Get("auto_compress").(bool) && !strings.HasSuffix(name, ".gz") {
+		name += ".gz"
+	}
+	if dir := strings.Trim(d.Get("path").(string), "/"); dir != "" {
+		return dir + "/" + name
+	}
+	return name
+}
+
+// CreateStageFile implements schema.CreateFunc.
+func CreateStageFile(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	stageID := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+	source, err := filepath.Abs(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+	hash, err := fileSHA256(source)
+	if err != nil {
+		return err
+	}
+	location := sdk.NewStageLocation(stageID, d.Get("path").(string))
+	opts := &sdk.PutStageFileOptions{
+		AutoCompress: sdk.Bool(d.Get("auto_compress").(bool)),
+		Overwrite:    sdk.Bool(true),
+	}
+	if err := client.StageFiles.Put(ctx, filepath.ToSlash(source), location, opts); err != nil {
+		return fmt.Errorf("error uploading %v to %v err = %w", source, location, err)
+	}
+
+	filePath := stageFilePath(d)
+	d.SetId(helpers.EncodeSnowflakeID(stageID.DatabaseName(), stageID.SchemaName(), stageID.Name(), filePath))
+	if err := d.Set("source_hash", hash); err != nil {
+		return err
+	}
+
+	return ReadStageFile(d, meta)
+}
+
+// ReadStageFile implements schema.ReadFunc.
+func ReadStageFile(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	stageID := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+	filePath := stageFilePath(d)
+	location := sdk.NewStageLocation(stageID, filePath)
+	files, err := client.StageFiles.List(ctx, location)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] stage of %v not found", location)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing %v err = %w", location, err)
+	}
+	for _, file := range files {
+		// LIST matches the files by prefix, so e.g. udf.jar.old is listed together with udf.jar
+		if file.PathInStage() != filePath {
+			continue
+		}
+		if err := d.Set("file_path", filePath); err != nil {
+			return err
+		}
+		if err := d.Set("stage_location", location.String()); err != nil {
+			return err
+		}
+		return d.Set("size", file.Size)
+	}
+
+	log.Printf("[DEBUG] file %v not found", location)
+	d.SetId("")
+	return nil
+}
+
+// DeleteStageFile implements schema.DeleteFunc.
+func DeleteStageFile(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	stageID := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("stage").(string))
+	location := sdk.NewStageLocation(stageID, stageFilePath(d))
+	if err := client.StageFiles.Remove(ctx, location); err != nil {
+		return fmt.Errorf("error removing %v err = %w", location, err)
+	}
+	d.SetId("")
+	return nil
+}
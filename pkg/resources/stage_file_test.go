@@ -0,0 +1,123 @@
+package resources_test
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageFile(t *testing.T) {
+	r := require.New(t)
+	err := resources.StageFile().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestStageFileCreate(t *testing.T) {
+	r := require.New(t)
+
+	source := filepath.Join(t.TempDir(), "udf.jar")
+	r.NoError(os.WriteFile(source, []byte("test"), 0o600))
+
+	in := map[string]interface{}{
+		"database": "db",
+		"schema":   "schema",
+		"stage":    "stage",
+		"path":     "jars/v1/",
+		"source":   source,
+	}
+	d := schema.TestResourceDataRaw(t, resources.StageFile().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^PUT 'file://.*/udf.jar' @"db"."schema"."stage"/jars/v1 AUTO_COMPRESS = false OVERWRITE = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"name", "size", "md5", "last_modified"}).
+			AddRow("stage/jars/v1/udf.jar", 16, "abc", "Mon, 1 Jan 2024 00:00:00 GMT").
+			AddRow("stage/jars/v1/udf.jar.old", 16, "def", "Mon, 1 Jan 2024 00:00:00 GMT")
+		mock.ExpectQuery(`^LIST @"db"."schema"."stage"/jars/v1/udf.jar$`).WillReturnRows(rows)
+		err := resources.CreateStageFile(d, db)
+		r.NoError(err)
+		r.Equal("db|schema|stage|jars/v1/udf.jar", d.Id())
+		r.Equal("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", d.Get("source_hash").(string))
+		r.Equal(`@"db"."schema"."stage"/jars/v1/udf.jar`, d.Get("stage_location").(string))
+		r.Equal(16, d.Get("size").(int))
+	})
+}
+
+func TestStageFileDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":      "db",
+		"schema":        "schema",
+		"stage":         "stage",
+		"source":        "app/streamlit_app.py",
+		"auto_compress": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.StageFile().Schema, in)
+	d.SetId("db|schema|stage|streamlit_app.py.gz")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^REMOVE @"db"."schema"."stage"/streamlit_app.py.gz$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteStageFile(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func TestStageFileRead(t *testing.T) {
+	in := map[string]interface{}{
+		"database": "db",
+		"schema":   "schema",
+		"stage":    "stage",
+		"source":   "udf.jar",
+	}
+
+	t.Run("returns the listing errors", func(t *testing.T) {
+		r := require.New(t)
+		d := schema.TestResourceDataRaw(t, resources.StageFile().Schema, in)
+		d.SetId("db|schema|stage|udf.jar")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^LIST @"db"."schema"."stage"/udf.jar$`).WillReturnError(errors.New("No active warehouse selected in the current session"))
+			err := resources.ReadStageFile(d, db)
+			r.ErrorContains(err, "No active warehouse")
+			r.Equal("db|schema|stage|udf.jar", d.Id())
+		})
+	})
+
+	t.Run("removes the file of a dropped stage", func(t *testing.T) {
+		r := require.New(t)
+		d := schema.TestResourceDataRaw(t, resources.StageFile().Schema, in)
+		d.SetId("db|schema|stage|udf.jar")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^LIST @"db"."schema"."stage"/udf.jar$`).WillReturnError(errors.New("Stage 'DB.SCHEMA.STAGE' does not exist or not authorized."))
+			err := resources.ReadStageFile(d, db)
+			r.NoError(err)
+			r.Equal("", d.Id())
+		})
+	})
+
+	t.Run("removes the file not listed", func(t *testing.T) {
+		r := require.New(t)
+		d := schema.TestResourceDataRaw(t, resources.StageFile().Schema, in)
+		d.SetId("db|schema|stage|udf.jar")
+
+		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			rows := sqlmock.NewRows([]string{"name", "size", "md5", "last_modified"}).
+				AddRow("stage/udf.jar.old", 16, "def", "Mon, 1 Jan 2024 00:00:00 GMT")
+			mock.ExpectQuery(`^LIST @"db"."schema"."stage"/udf.jar$`).WillReturnRows(rows)
+			err := resources.ReadStageFile(d, db)
+			r.NoError(err)
+			r.Equal("", d.Id())
+		})
+	})
+}
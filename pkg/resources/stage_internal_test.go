@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffStageCredentials(t *testing.T) {
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		"same":            {old: "AWS_KEY_ID='1a2b3c' AWS_SECRET_KEY='4x5y6z'", new: "AWS_KEY_ID='1a2b3c' AWS_SECRET_KEY='4x5y6z'", expected: true},
+		"case of keys":    {old: "AWS_KEY_ID='1a2b3c' AWS_SECRET_KEY='4x5y6z'", new: "aws_key_id='1a2b3c' aws_secret_key='4x5y6z'", expected: true},
+		"spacing":         {old: "AWS_KEY_ID='1a2b3c' AWS_SECRET_KEY='4x5y6z'", new: "AWS_KEY_ID = '1a2b3c'\n  AWS_SECRET_KEY = '4x5y6z'", expected: true},
+		"rotated secret":  {old: "AWS_KEY_ID='1a2b3c' AWS_SECRET_KEY='4x5y6z'", new: "AWS_KEY_ID='1a2b3c' AWS_SECRET_KEY='7u8v9w'", expected: false},
+		"case of values":  {old: "AZURE_SAS_TOKEN='abc'", new: "AZURE_SAS_TOKEN='ABC'", expected: false},
+		"added":           {old: "", new: "AZURE_SAS_TOKEN='abc'", expected: false},
+		"removed":         {old: "AZURE_SAS_TOKEN='abc'", new: "", expected: false},
+		"unquoted values": {old: "AWS_ROLE=arn:aws:iam::001234567890:role/role", new: "aws_role = arn:aws:iam::001234567890:role/role", expected: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffStageCredentials("credentials", tc.old, tc.new, nil))
+		})
+	}
+}
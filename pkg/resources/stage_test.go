@@ -171,3 +171,46 @@ func TestStageUpdateWithJustSI(t *testing.T) {
 		r.NoError(err)
 	})
 }
+
+func TestStageUpdateWithJustCredentials(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":        "test_stage",
+		"database":    "test_db",
+		"schema":      "test_schema",
+		"credentials": "aws_key_id='1a2b3c' aws_secret_key='4x5y6z'",
+	}
+
+	d := stage(t, "test_db|test_schema|test_stage", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER STAGE "test_db"."test_schema"."test_stage" SET CREDENTIALS = \(aws_key_id='1a2b3c' aws_secret_key='4x5y6z'\)`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadStage(mock)
+		expectReadStageShow(mock)
+		err := resources.UpdateStage(d, db)
+		r.NoError(err)
+	})
+}
+
+func TestStageUpdateWithURLAndCredentials(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":        "test_stage",
+		"database":    "test_db",
+		"schema":      "test_schema",
+		"url":         "s3://changed_url",
+		"credentials": "aws_key_id='1a2b3c' aws_secret_key='4x5y6z'",
+	}
+
+	d := stage(t, "test_db|test_schema|test_stage", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER STAGE "test_db"."test_schema"."test_stage" SET URL = 's3://changed_url' CREDENTIALS = \(aws_key_id='1a2b3c' aws_secret_key='4x5y6z'\)`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadStage(mock)
+		expectReadStageShow(mock)
+		err := resources.UpdateStage(d, db)
+		r.NoError(err)
+	})
+}
@@ -43,21 +43,28 @@ var streamSchema = map[string]*schema.Schema{
 		Optional:     true,
 		ForceNew:     true,
 		Description:  "Specifies an identifier for the table the stream will monitor.",
-		ExactlyOneOf: []string{"on_table", "on_view", "on_stage"},
+		ExactlyOneOf: []string{"on_table", "on_view", "on_stage", "on_dynamic_table"},
 	},
 	"on_view": {
 		Type:         schema.TypeString,
 		Optional:     true,
 		ForceNew:     true,
 		Description:  "Specifies an identifier for the view the stream will monitor.",
-		ExactlyOneOf: []string{"on_table", "on_view", "on_stage"},
+		ExactlyOneOf: []string{"on_table", "on_view", "on_stage", "on_dynamic_table"},
+	},
+	"on_dynamic_table": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies an identifier for the dynamic table the stream will monitor.",
+		ExactlyOneOf: []string{"on_table", "on_view", "on_stage", "on_dynamic_table"},
 	},
 	"on_stage": {
 		Type:         schema.TypeString,
 		Optional:     true,
 		ForceNew:     true,
 		Description:  "Specifies an identifier for the stage the stream will monitor.",
-		ExactlyOneOf: []string{"on_table", "on_view", "on_stage"},
+		ExactlyOneOf: []string{"on_table", "on_view", "on_stage", "on_dynamic_table"},
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			// Suppress diff if the stage name is the same, even if database and schema are not specified
 			return strings.Trim(strings.Split(old, ".")[len(strings.Split(old, "."))-1], "\"") == strings.Trim(strings.Split(new, ".")[len(strings.Split(new, "."))-1], "\"")
@@ -84,6 +91,29 @@ var streamSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies whether to return all existing rows in the source table as row inserts the first time the stream is consumed.",
 	},
+	"at": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		Description:   "Creates the stream at the specified point in time of the source object (Time Travel). Only one of the fields can be set. Not supported for streams on stages.",
+		Elem:          &schema.Resource{Schema: streamOffsetSchema},
+		ConflictsWith: []string{"before", "on_stage"},
+	},
+	"before": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		Description:   "Creates the stream immediately preceding the specified point in time of the source object (Time Travel). Only one of the fields can be set. Not supported for streams on stages.",
+		Elem:          &schema.Resource{Schema: streamOffsetSchema},
+		ConflictsWith: []string{"at", "on_stage"},
+	},
+	"source_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the object the stream monitors (e.g. Table, External Table, View, Dynamic Table, Stage).",
+	},
 	"owner": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -91,6 +121,71 @@ var streamSchema = map[string]*schema.Schema{
 	},
 }
 
+var streamOffsetSchema = map[string]*schema.Schema{
+	"timestamp": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies an exact date and time to use for Time Travel (e.g. `TO_TIMESTAMP_TZ('2024-01-01 00:00:00 +0000')`).",
+	},
+	"offset": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the difference in seconds from the current time to use for Time Travel (e.g. `-60`).",
+	},
+	"statement": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the query ID of a statement to use as the reference point for Time Travel.",
+	},
+	"stream": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of an existing stream whose current offset is used as the reference point.",
+	},
+}
+
+// streamOnRequest builds the AT | BEFORE clause of the stream from the at and before blocks.
+func streamOnRequest(d *schema.ResourceData) (*sdk.OnStreamRequest, error) {
+	var req *sdk.OnStreamRequest
+	var offset map[string]interface{}
+	if v, ok := d.GetOk("at"); ok && v.([]interface{})[0] != nil {
+		req = sdk.NewOnStreamRequest().WithAt(sdk.Bool(true))
+		offset = v.([]interface{})[0].(map[string]interface{})
+	} else if v, ok := d.GetOk("before"); ok && v.([]interface{})[0] != nil {
+		req = sdk.NewOnStreamRequest().WithBefore(sdk.Bool(true))
+		offset = v.([]interface{})[0].(map[string]interface{})
+	} else {
+		return nil, nil
+	}
+
+	statement := sdk.NewOnStreamStatementRequest()
+	set := 0
+	if v := offset["timestamp"].(string); v != "" {
+		statement.WithTimestamp(sdk.String(v))
+		set++
+	}
+	if v := offset["offset"].(string); v != "" {
+		statement.WithOffset(sdk.String(v))
+		set++
+	}
+	if v := offset["statement"].(string); v != "" {
+		statement.WithStatement(sdk.String(v))
+		set++
+	}
+	if v := offset["stream"].(string); v != "" {
+		statement.WithStream(sdk.String(v))
+		set++
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of timestamp, offset, statement or stream has to be set in the at/before block")
+	}
+	return req.WithStatement(*statement), nil
+}
+
 func Stream() *schema.Resource {
 	return &schema.Resource{
 		Create: CreateStream,
@@ -122,6 +217,12 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	onTable, onTableSet := d.GetOk("on_table")
 	onView, onViewSet := d.GetOk("on_view")
 	onStage, onStageSet := d.GetOk("on_stage")
+	onDynamicTable, onDynamicTableSet := d.GetOk("on_dynamic_table")
+
+	on, err := streamOnRequest(d)
+	if err != nil {
+		return err
+	}
 
 	switch {
 	case onTableSet:
@@ -140,6 +241,9 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 
 		if t.IsExternal.String == "Y" {
 			req := sdk.NewCreateStreamOnExternalTableRequest(id, tableId)
+			if on != nil {
+				req.WithOn(on)
+			}
 			if insertOnly {
 				req.WithInsertOnly(sdk.Bool(true))
 			}
@@ -152,6 +256,9 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 			}
 		} else {
 			req := sdk.NewCreateStreamOnTableRequest(id, tableId)
+			if on != nil {
+				req.WithOn(on)
+			}
 			if appendOnly {
 				req.WithAppendOnly(sdk.Bool(true))
 			}
@@ -168,10 +275,10 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		}
 	case onViewSet:
 		viewObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onView.(string))
-		viewId := viewObjectIdentifier.(sdk.SchemaObjectIdentifier)
 		if err != nil {
 			return err
 		}
+		viewId := viewObjectIdentifier.(sdk.SchemaObjectIdentifier)
 
 		tq := snowflake.NewViewBuilder(viewId.Name()).WithDB(viewId.DatabaseName()).WithSchema(viewId.SchemaName()).Show()
 		viewRow := snowflake.QueryRow(db, tq)
@@ -181,6 +288,9 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		req := sdk.NewCreateStreamOnViewRequest(id, viewId)
+		if on != nil {
+			req.WithOn(on)
+		}
 		if appendOnly {
 			req.WithAppendOnly(sdk.Bool(true))
 		}
@@ -194,12 +304,37 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("error creating stream %v err = %w", name, err)
 		}
+	case onDynamicTableSet:
+		dynamicTableObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onDynamicTable.(string))
+		if err != nil {
+			return err
+		}
+		dynamicTableId := dynamicTableObjectIdentifier.(sdk.SchemaObjectIdentifier)
+
+		if _, err := client.DynamicTables.ShowByID(ctx, dynamicTableId); err != nil {
+			return err
+		}
+
+		req := sdk.NewCreateStreamOnDynamicTableRequest(id, dynamicTableId)
+		if on != nil {
+			req.WithOn(on)
+		}
+		if showInitialRows {
+			req.WithShowInitialRows(sdk.Bool(true))
+		}
+		if v, ok := d.GetOk("comment"); ok {
+			req.WithComment(sdk.String(v.(string)))
+		}
+		err = client.Streams.CreateOnDynamicTable(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error creating stream %v err = %w", name, err)
+		}
 	case onStageSet:
 		stageObjectIdentifier, err := helpers.DecodeSnowflakeParameterID(onStage.(string))
-		stageId := stageObjectIdentifier.(sdk.SchemaObjectIdentifier)
 		if err != nil {
 			return err
 		}
+		stageId := stageObjectIdentifier.(sdk.SchemaObjectIdentifier)
 		stageBuilder := snowflake.NewStageBuilder(stageId.Name(), stageId.DatabaseName(), stageId.SchemaName())
 		sq := stageBuilder.Describe()
 		stageDesc, err := snowflake.DescStage(db, sq)
@@ -245,7 +380,18 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("schema", stream.SchemaName); err != nil {
 		return err
 	}
-	switch *stream.SourceType {
+	sourceType := ""
+	if stream.SourceType != nil {
+		sourceType = *stream.SourceType
+	}
+	if err := d.Set("source_type", sourceType); err != nil {
+		return err
+	}
+	switch sourceType {
+	case "Dynamic Table":
+		if err := d.Set("on_dynamic_table", *stream.TableName); err != nil {
+			return err
+		}
 	case "Stage":
 		if err := d.Set("on_stage", *stream.TableName); err != nil {
 			return err
@@ -155,6 +155,74 @@ func TestAcc_Stream(t *testing.T) {
 	})
 }
 
+func TestAcc_StreamOnDynamicTable(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTableStreamConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream", "name", name),
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream", "on_dynamic_table", fmt.Sprintf("%s.%s.%s_DT", acc.TestDatabaseName, acc.TestSchemaName, name)),
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream", "source_type", "Dynamic Table"),
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream", "before.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_stream.test_stream", "before.0.offset", "-1"),
+					checkBool("snowflake_stream.test_stream", "show_initial_rows", false),
+				),
+			},
+			{
+				ResourceName:            "snowflake_stream.test_stream",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"before"},
+			},
+		},
+	})
+}
+
+func dynamicTableStreamConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "t" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s_SOURCE"
+	change_tracking = true
+
+	column {
+		name = "id"
+		type = "NUMBER(38,0)"
+	}
+}
+
+resource "snowflake_dynamic_table" "dt" {
+	database  = "%[1]s"
+	schema    = "%[2]s"
+	name      = "%[3]s_DT"
+	warehouse = "%[4]s"
+	query     = "select id from \"%[1]s\".\"%[2]s\".\"${snowflake_table.t.name}\""
+
+	target_lag {
+		maximum_duration = "2 minutes"
+	}
+}
+
+resource "snowflake_stream" "test_stream" {
+	database         = "%[1]s"
+	schema           = "%[2]s"
+	name             = "%[3]s"
+	on_dynamic_table = "%[1]s.%[2]s.${snowflake_dynamic_table.dt.name}"
+
+	before {
+		offset = "-1"
+	}
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, name, acc.TestWarehouseName)
+}
+
 func streamConfig(name string, appendOnly bool) string {
 	appendOnlyConfig := ""
 	if appendOnly {
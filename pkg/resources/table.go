@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -9,11 +10,12 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
-	"golang.org/x/exp/slices"
 )
 
 const (
@@ -62,11 +64,23 @@ var tableSchema = map[string]*schema.Schema{
 					Required:    true,
 					Description: "Column type, e.g. VARIANT",
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// these are all equivalent as per https://docs.snowflake.com/en/sql-reference/data-types-text.html
-						varcharType := []string{"VARCHAR(16777216)", "VARCHAR", "text", "string", "NVARCHAR", "NVARCHAR2", "CHAR VARYING", "NCHAR VARYING"}
-						return slices.Contains(varcharType, new) && slices.Contains(varcharType, old)
+						return normalizeColumnType(old) == normalizeColumnType(new)
 					},
 				},
+				"collate": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Column collation, e.g. utf8. Changing the collation of an existing column recreates the table.",
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return strings.EqualFold(old, new)
+					},
+				},
+				"unique": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether the column has an inline UNIQUE constraint. Note: Snowflake does not enforce UNIQUE constraints.",
+				},
 				"nullable": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -88,15 +102,17 @@ var tableSchema = map[string]*schema.Schema{
 								// ConflictsWith: []string{".expression", ".sequence"}, - can't use, nor ExactlyOneOf due to column type being TypeList
 							},
 							"expression": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								Description: "The default expression value for the column",
+								Type:             schema.TypeString,
+								Optional:         true,
+								DiffSuppressFunc: DiffSuppressStatement,
+								Description:      "The default expression value for the column",
 								// ConflictsWith: []string{".constant", ".sequence"}, - can't use, nor ExactlyOneOf due to column type being TypeList
 							},
 							"sequence": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								Description: "The default sequence to use for the column",
+								Type:             schema.TypeString,
+								Optional:         true,
+								DiffSuppressFunc: suppressQuotedIdentifierDiff,
+								Description:      "The default sequence to use for the column",
 								// ConflictsWith: []string{".constant", ".expression"}, - can't use, nor ExactlyOneOf due to column type being TypeList
 							},
 						},
@@ -125,6 +141,16 @@ var tableSchema = map[string]*schema.Schema{
 								Description: "Step size to increment by.",
 								Default:     1,
 							},
+							"ordering": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								Description:  "Specifies whether the generated values are guaranteed to be in increasing order (ORDER) or not (NOORDER). When not set the account default (NOORDER_SEQUENCE_AS_DEFAULT) is used. Only the change from ORDER to NOORDER is applied in place; other changes of the identity recreate the table.",
+								ValidateFunc: validation.StringInSlice([]string{"ORDER", "NOORDER"}, true),
+								StateFunc: func(v interface{}) string {
+									return strings.ToUpper(v.(string))
+								},
+							},
 						},
 					},
 				},
@@ -135,10 +161,31 @@ var tableSchema = map[string]*schema.Schema{
 					Description: "Column comment",
 				},
 				"masking_policy": {
-					Type:        schema.TypeString,
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "",
+					Description:      "Masking policy to apply on column",
+					DiffSuppressFunc: suppressQuotedIdentifierDiff,
+				},
+				"tag": {
+					Type:        schema.TypeList,
 					Optional:    true,
-					Default:     "",
-					Description: "Masking policy to apply on column",
+					Description: "Tags to set on the column. Tags set on the column outside of this block are ignored.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tag_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Specifies the identifier for the tag. Note: format must follow: \"databaseName\".\"schemaName\".\"tagName\" or \"databaseName.schemaName.tagName\" or \"databaseName|schemaName.tagName\" (snowflake_tag.tag.id)",
+								ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+							},
+							"tag_value": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Specifies the value of the tag.",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -190,9 +237,10 @@ var tableSchema = map[string]*schema.Schema{
 		Optional:      true,
 		Description:   "Specifies the retention period for the table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the table. Default value is 1, if you wish to inherit the parent schema setting then pass in the schema attribute to this argument.",
 		ValidateFunc:  validation.IntBetween(0, 90),
-		Deprecated:    "Use snowflake_object_parameter instead",
 		ConflictsWith: []string{"data_retention_days"},
 	},
+	"max_data_extension_time_in_days": maxDataExtensionTimeInDaysSchema,
+	"default_ddl_collation":           defaultDDLCollationSchema,
 	"change_tracking": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -207,6 +255,9 @@ var tableSchema = map[string]*schema.Schema{
 	"tag": tagReferenceSchema,
 }
 
+// tableParameters are the object parameters exposed as attributes of the table.
+var tableParameters = []string{"max_data_extension_time_in_days", "default_ddl_collation"}
+
 func Table() *schema.Resource {
 	return &schema.Resource{
 		Create: CreateTable,
@@ -218,6 +269,11 @@ func Table() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("column", tableColumnIdentityForceNew),
+			customdiff.ForceNewIfChange("column", tableColumnCollationForceNew),
+		),
 	}
 }
 
@@ -307,11 +363,91 @@ func (cd *columnDefault) _type() string {
 type columnIdentity struct {
 	startNum int
 	stepNum  int
+	ordering string
 }
 
 func (identity *columnIdentity) toSnowflakeColumnIdentity() *snowflake.ColumnIdentity {
 	snowIdentity := snowflake.ColumnIdentity{}
-	return snowIdentity.WithStartNum(identity.startNum).WithStep(identity.stepNum)
+	return snowIdentity.WithStartNum(identity.startNum).WithStep(identity.stepNum).WithOrdering(strings.ToUpper(identity.ordering))
+}
+
+// requiresRecreation reports whether the change of the identity of an existing column from old to new
+// cannot be applied in place. Snowflake only allows switching an identity from ORDER to NOORDER.
+func (identity *columnIdentity) requiresRecreation(new *columnIdentity) bool {
+	if identity == nil || new == nil {
+		return identity != new
+	}
+	if identity.startNum != new.startNum || identity.stepNum != new.stepNum {
+		return true
+	}
+	return strings.EqualFold(new.ordering, "ORDER") && !strings.EqualFold(identity.ordering, "ORDER")
+}
+
+// tableColumnIdentityForceNew forces the recreation of the table when the identity of an existing column
+// changes in a way that cannot be applied with ALTER TABLE.
+func tableColumnIdentityForceNew(_ context.Context, old, new, _ any) bool {
+	for _, cO := range getColumns(old) {
+		for _, cN := range getColumns(new) {
+			if cO.name == cN.name && cO.identity.requiresRecreation(cN.identity) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type columnTag struct {
+	tagID string
+	value string
+}
+
+func (ct columnTag) toSnowflakeTagValue() snowflake.TagValue {
+	database, schema, name := snowflakeValidation.ParseFullyQualifiedObjectID(ct.tagID)
+	return snowflake.TagValue{
+		Database: database,
+		Schema:   schema,
+		Name:     name,
+		Value:    ct.value,
+	}
+}
+
+type columnTags []columnTag
+
+func (ct columnTags) toSnowflakeTagValues() []snowflake.TagValue {
+	tags := make([]snowflake.TagValue, len(ct))
+	for i, t := range ct {
+		tags[i] = t.toSnowflakeTagValue()
+	}
+	return tags
+}
+
+// diffs returns the tags removed from and the tags added or changed in new, compared by tag_id.
+func (ct columnTags) diffs(new columnTags) (removed columnTags, set columnTags) {
+	for _, tO := range ct {
+		found := false
+		for _, tN := range new {
+			if tO.tagID == tN.tagID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, tO)
+		}
+	}
+	for _, tN := range new {
+		changed := true
+		for _, tO := range ct {
+			if tO.tagID == tN.tagID && tO.value == tN.value {
+				changed = false
+				break
+			}
+		}
+		if changed {
+			set = append(set, tN)
+		}
+	}
+	return removed, set
 }
 
 type column struct {
@@ -322,6 +458,9 @@ type column struct {
 	identity      *columnIdentity
 	comment       string
 	maskingPolicy string
+	tags          columnTags
+	collate       string
+	unique        bool
 }
 
 func (c column) toSnowflakeColumn() snowflake.Column {
@@ -339,7 +478,9 @@ func (c column) toSnowflakeColumn() snowflake.Column {
 		WithType(c.dataType).
 		WithNullable(c.nullable).
 		WithComment(c.comment).
-		WithMaskingPolicy(c.maskingPolicy)
+		WithMaskingPolicy(c.maskingPolicy).
+		WithCollate(c.collate).
+		WithUnique(c.unique)
 }
 
 type columns []column
@@ -361,19 +502,29 @@ type changedColumn struct {
 	dropedDefault         bool
 	changedComment        bool
 	changedMaskingPolicy  bool
+	changedToNoOrder      bool
+	changedUnique         bool
+	removedTags           columnTags
+	setTags               columnTags
 }
 
 func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = changedColumns{}
 	for _, cO := range c {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false}
+			changeColumn := changedColumn{newColumn: cN}
 			if cO.name == cN.name && cO.dataType != cN.dataType {
 				changeColumn.changedDataType = true
 			}
 			if cO.name == cN.name && cO.nullable != cN.nullable {
 				changeColumn.changedNullConstraint = true
 			}
+			if cO.name == cN.name && cO.identity != nil && cN.identity != nil && strings.EqualFold(cN.identity.ordering, "NOORDER") && !strings.EqualFold(cO.identity.ordering, "NOORDER") {
+				changeColumn.changedToNoOrder = true
+			}
+			if cO.name == cN.name && cO.unique != cN.unique {
+				changeColumn.changedUnique = true
+			}
 			if cO.name == cN.name && cO._default != nil && cN._default == nil {
 				changeColumn.dropedDefault = true
 			}
@@ -382,10 +533,14 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 				changeColumn.changedComment = true
 			}
 
-			if cO.name == cN.name && cO.maskingPolicy != cN.maskingPolicy {
+			if cO.name == cN.name && !suppressQuotedIdentifierDiff("", cO.maskingPolicy, cN.maskingPolicy, nil) {
 				changeColumn.changedMaskingPolicy = true
 			}
 
+			if cO.name == cN.name {
+				changeColumn.removedTags, changeColumn.setTags = cO.tags.diffs(cN.tags)
+			}
+
 			changed = append(changed, changeColumn)
 		}
 	}
@@ -428,7 +583,8 @@ func getColumnIdentity(identity map[string]interface{}) *columnIdentity {
 	if len(identity) > 0 {
 		startNum := identity["start_num"].(int)
 		stepNum := identity["step_num"].(int)
-		return &columnIdentity{startNum, stepNum}
+		ordering, _ := identity["ordering"].(string)
+		return &columnIdentity{startNum, stepNum, ordering}
 	}
 
 	return nil
@@ -457,9 +613,148 @@ func getColumn(from interface{}) (to column) {
 		identity:      id,
 		comment:       c["comment"].(string),
 		maskingPolicy: c["masking_policy"].(string),
+		tags:          getColumnTags(c["tag"]),
+		collate:       c["collate"].(string),
+		unique:        c["unique"].(bool),
 	}
 }
 
+var columnTypeSynonyms = map[string]string{
+	// https://docs.snowflake.com/en/sql-reference/data-types-text
+	"VARCHAR":       "VARCHAR(16777216)",
+	"TEXT":          "VARCHAR(16777216)",
+	"STRING":        "VARCHAR(16777216)",
+	"NVARCHAR":      "VARCHAR(16777216)",
+	"NVARCHAR2":     "VARCHAR(16777216)",
+	"CHAR VARYING":  "VARCHAR(16777216)",
+	"NCHAR VARYING": "VARCHAR(16777216)",
+	"CHAR":          "VARCHAR(1)",
+	"CHARACTER":     "VARCHAR(1)",
+	"NCHAR":         "VARCHAR(1)",
+	"BINARY":        "BINARY(8388608)",
+	"VARBINARY":     "BINARY(8388608)",
+	// https://docs.snowflake.com/en/sql-reference/data-types-numeric
+	"NUMBER":           "NUMBER(38,0)",
+	"DECIMAL":          "NUMBER(38,0)",
+	"NUMERIC":          "NUMBER(38,0)",
+	"INT":              "NUMBER(38,0)",
+	"INTEGER":          "NUMBER(38,0)",
+	"BIGINT":           "NUMBER(38,0)",
+	"SMALLINT":         "NUMBER(38,0)",
+	"TINYINT":          "NUMBER(38,0)",
+	"BYTEINT":          "NUMBER(38,0)",
+	"DOUBLE":           "FLOAT",
+	"DOUBLE PRECISION": "FLOAT",
+	"REAL":             "FLOAT",
+	"FLOAT4":           "FLOAT",
+	"FLOAT8":           "FLOAT",
+	// https://docs.snowflake.com/en/sql-reference/data-types-datetime
+	"DATETIME":      "TIMESTAMP_NTZ(9)",
+	"TIME":          "TIME(9)",
+	"TIMESTAMP_NTZ": "TIMESTAMP_NTZ(9)",
+	"TIMESTAMP_LTZ": "TIMESTAMP_LTZ(9)",
+	"TIMESTAMP_TZ":  "TIMESTAMP_TZ(9)",
+}
+
+var columnTypeAliasesWithPrecision = map[string]string{
+	"VARCHAR":       "VARCHAR",
+	"TEXT":          "VARCHAR",
+	"STRING":        "VARCHAR",
+	"NVARCHAR":      "VARCHAR",
+	"NVARCHAR2":     "VARCHAR",
+	"CHAR VARYING":  "VARCHAR",
+	"NCHAR VARYING": "VARCHAR",
+	"CHAR":          "VARCHAR",
+	"CHARACTER":     "VARCHAR",
+	"NCHAR":         "VARCHAR",
+	"VARBINARY":     "BINARY",
+	"DECIMAL":       "NUMBER",
+	"NUMERIC":       "NUMBER",
+	"DATETIME":      "TIMESTAMP_NTZ",
+}
+
+// normalizeColumnType returns the canonical form in which Snowflake reports the given column type in DESC TABLE,
+// e.g. INT is reported as NUMBER(38,0) and DECIMAL(10) as NUMBER(10,0).
+func normalizeColumnType(t string) string {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(t), " "))
+	if canonical, ok := columnTypeSynonyms[normalized]; ok {
+		return canonical
+	}
+	name, arguments, found := strings.Cut(normalized, "(")
+	if !found {
+		return normalized
+	}
+	name = strings.TrimSpace(name)
+	if alias, ok := columnTypeAliasesWithPrecision[name]; ok {
+		name = alias
+	}
+	arguments = strings.ReplaceAll(strings.TrimSuffix(arguments, ")"), " ", "")
+	if name == "NUMBER" && !strings.Contains(arguments, ",") {
+		arguments += ",0"
+	}
+	return fmt.Sprintf("%s(%s)", name, arguments)
+}
+
+// tableColumnCollationForceNew forces the recreation of the table when the collation of an existing column changes,
+// as Snowflake does not allow altering the collation of a column.
+func tableColumnCollationForceNew(_ context.Context, old, new, _ any) bool {
+	for _, cO := range getColumns(old) {
+		for _, cN := range getColumns(new) {
+			if cO.name == cN.name && !strings.EqualFold(cO.collate, cN.collate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getColumnTags(from interface{}) columnTags {
+	tags, _ := from.([]interface{})
+	to := make(columnTags, 0, len(tags))
+	for _, t := range tags {
+		tag := t.(map[string]interface{})
+		to = append(to, columnTag{
+			tagID: tag["tag_id"].(string),
+			value: tag["tag_value"].(string),
+		})
+	}
+	return to
+}
+
+// suppressQuotedIdentifierDiff suppresses differences between identifiers that differ only in quoting or case,
+// e.g. the masking policy name returned by DESC TABLE and the one from the configuration.
+func suppressQuotedIdentifierDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(strings.ReplaceAll(old, `"`, ""), strings.ReplaceAll(new, `"`, ""))
+}
+
+// flattenColumnsWithTags sets on the flattened columns the tags set on them in Snowflake, limited to
+// the tags managed in the configuration for each column, so that only drift of the managed tags is detected.
+func flattenColumnsWithTags(flattened []interface{}, configured columns, references []snowflake.ColumnTagReference) []interface{} {
+	for _, f := range flattened {
+		flat := f.(map[string]interface{})
+		tags := []interface{}{}
+		for _, c := range configured {
+			if c.name != flat["name"] {
+				continue
+			}
+			for _, t := range c.tags {
+				tv := t.toSnowflakeTagValue()
+				for _, r := range references {
+					if r.ColumnName.String == c.name && strings.EqualFold(r.TagDatabase.String, tv.Database) && strings.EqualFold(r.TagSchema.String, tv.Schema) && strings.EqualFold(r.TagName.String, tv.Name) {
+						tags = append(tags, map[string]interface{}{
+							"tag_id":    t.tagID,
+							"tag_value": r.TagValue.String,
+						})
+						break
+					}
+				}
+			}
+		}
+		flat["tag"] = tags
+	}
+	return flattened
+}
+
 func getColumns(from interface{}) (to columns) {
 	cols := from.([]interface{})
 	to = make(columns, len(cols))
@@ -536,6 +831,16 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating table %v", name)
 	}
 
+	for _, c := range columns {
+		if len(c.tags) == 0 {
+			continue
+		}
+		q := builder.SetColumnTags(c.name, c.tags.toSnowflakeTagValues())
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error setting tags on column %v of table %v err = %w", c.name, name, err)
+		}
+	}
+
 	tableID := &tableID{
 		DatabaseName: database,
 		SchemaName:   schema,
@@ -547,6 +852,12 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(dataIDInput)
 
+	client := sdk.NewClientFromDB(db)
+	tableObject := sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: sdk.NewSchemaObjectIdentifier(database, schema, name)}
+	if err := createObjectParameters(context.Background(), client, d, tableObject, tableParameters...); err != nil {
+		return err
+	}
+
 	return ReadTable(d, meta)
 }
 
@@ -594,6 +905,27 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}*/
 
+	configuredColumns := getColumns(d.Get("column").([]interface{}))
+	configuredUnique := map[string]bool{}
+	for _, c := range configuredColumns {
+		configuredUnique[c.name] = c.unique
+	}
+	columns, err := readColumnsWithUniqueKeys(db, builder, tableDescription, configuredUnique)
+	if err != nil {
+		return err
+	}
+	flattenedColumns := columns.Flatten()
+	for _, c := range configuredColumns {
+		if len(c.tags) > 0 {
+			references, err := snowflake.ListColumnTagReferences(builder, db)
+			if err != nil {
+				return err
+			}
+			flattenedColumns = flattenColumnsWithTags(flattenedColumns, configuredColumns, references)
+			break
+		}
+	}
+
 	// Set the relevant data in the state
 	toSet := map[string]interface{}{
 		"name":       table.TableName.String,
@@ -601,7 +933,7 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 		"database":   tableID.DatabaseName,
 		"schema":     tableID.SchemaName,
 		"comment":    table.Comment.String,
-		"column":     snowflake.NewColumns(tableDescription).Flatten(),
+		"column":     flattenedColumns,
 		"cluster_by": snowflake.ClusterStatementToList(table.ClusterBy.String),
 		// "primary_key":         snowflake.FlattenTablePrimaryKey(pkDescription),
 		"change_tracking": (table.ChangeTracking.String == "ON"),
@@ -622,7 +954,29 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
-	return nil
+
+	client := sdk.NewClientFromDB(db)
+	tableObject := sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: sdk.NewSchemaObjectIdentifier(tableID.DatabaseName, tableID.SchemaName, tableID.TableName)}
+	return readObjectParameters(context.Background(), client, d, tableObject, tableParameters...)
+}
+
+// readColumnsWithUniqueKeys returns the described columns with their inline UNIQUE constraints, which are only
+// looked up when DESC TABLE reports a column as part of a UNIQUE constraint.
+func readColumnsWithUniqueKeys(db *sql.DB, builder *snowflake.TableBuilder, tds []snowflake.TableDescription, configured map[string]bool) (snowflake.Columns, error) {
+	columns := snowflake.NewColumns(tds)
+	if !snowflake.HasUniqueKeys(tds) {
+		return columns, nil
+	}
+	rows, err := snowflake.Query(db, builder.ShowUniqueKeys())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ukds, err := snowflake.ScanUniqueKeyDescription(rows)
+	if err != nil {
+		return nil, err
+	}
+	return columns.WithUniqueKeys(ukds, configured), nil
 }
 
 // UpdateTable implements schema.UpdateFunc.
@@ -673,23 +1027,21 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 		for _, cA := range added {
-			var q string
-
-			if cA.identity == nil && cA._default == nil { //nolint:gocritic  // todo: please fix this to pass gocritic
-				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, nil, nil, cA.comment, cA.maskingPolicy)
-			} else if cA.identity != nil {
-				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, nil, cA.identity.toSnowflakeColumnIdentity(), cA.comment, cA.maskingPolicy)
-			} else {
-				if cA._default._type() != "constant" {
-					return fmt.Errorf("failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name)
-				}
-
-				q = builder.AddColumn(cA.name, cA.dataType, cA.nullable, cA._default.toSnowflakeColumnDefault(), nil, cA.comment, cA.maskingPolicy)
+			if cA.identity == nil && cA._default != nil && cA._default._type() != "constant" {
+				return fmt.Errorf("failed to add column %v => Only adding a column as a constant is supported by Snowflake", cA.name)
 			}
+			q := builder.AddColumnDefinition(cA.toSnowflakeColumn())
 
 			if err := snowflake.Exec(db, q); err != nil {
 				return fmt.Errorf("error adding column on %v", d.Id())
 			}
+
+			if len(cA.tags) > 0 {
+				q := builder.SetColumnTags(cA.name, cA.tags.toSnowflakeTagValues())
+				if err := snowflake.Exec(db, q); err != nil {
+					return fmt.Errorf("error setting tags on column %v of %v err = %w", cA.name, d.Id(), err)
+				}
+			}
 		}
 		for _, cA := range changed {
 			if cA.changedDataType {
@@ -704,6 +1056,18 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 					return fmt.Errorf("error changing property on %v", d.Id())
 				}
 			}
+			if cA.changedUnique {
+				q := builder.ChangeColumnUnique(cA.newColumn.name, cA.newColumn.unique)
+				if err := snowflake.Exec(db, q); err != nil {
+					return fmt.Errorf("error changing unique constraint of column %v on %v err = %w", cA.newColumn.name, d.Id(), err)
+				}
+			}
+			if cA.changedToNoOrder {
+				q := builder.ChangeColumnNoOrder(cA.newColumn.name)
+				if err := snowflake.Exec(db, q); err != nil {
+					return fmt.Errorf("error changing identity ordering of column %v on %v err = %w", cA.newColumn.name, d.Id(), err)
+				}
+			}
 			if cA.dropedDefault {
 				q := builder.DropColumnDefault(cA.newColumn.name)
 				if err := snowflake.Exec(db, q); err != nil {
@@ -722,6 +1086,18 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 					return fmt.Errorf("error changing property on %v", d.Id())
 				}
 			}
+			if len(cA.removedTags) > 0 {
+				q := builder.UnsetColumnTags(cA.newColumn.name, cA.removedTags.toSnowflakeTagValues())
+				if err := snowflake.Exec(db, q); err != nil {
+					return fmt.Errorf("error unsetting tags on column %v of %v err = %w", cA.newColumn.name, d.Id(), err)
+				}
+			}
+			if len(cA.setTags) > 0 {
+				q := builder.SetColumnTags(cA.newColumn.name, cA.setTags.toSnowflakeTagValues())
+				if err := snowflake.Exec(db, q); err != nil {
+					return fmt.Errorf("error setting tags on column %v of %v err = %w", cA.newColumn.name, d.Id(), err)
+				}
+			}
 		}
 	}
 	if d.HasChange("cluster_by") {
@@ -792,6 +1168,12 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 		return tagChangeErr
 	}
 
+	client := sdk.NewClientFromDB(db)
+	tableObject := sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: sdk.NewSchemaObjectIdentifier(dbName, schema, d.Get("name").(string))}
+	if err := updateObjectParameters(context.Background(), client, d, tableObject, tableParameters...); err != nil {
+		return err
+	}
+
 	return ReadTable(d, meta)
 }
 
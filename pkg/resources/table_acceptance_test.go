@@ -1077,6 +1077,89 @@ resource "snowflake_table" "test_table" {
 	return fmt.Sprintf(s, name, tagName, tag2Name, databaseName, schemaName)
 }
 
+func TestAcc_TableColumnTagsAndMaskingPolicy(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	policyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableWithColumnTags(accName, tagName, policyName, "pii", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.tag.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.tag.0.tag_value", "pii"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy", fmt.Sprintf("%s.%s.%s", acc.TestDatabaseName, acc.TestSchemaName, policyName)),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.tag.#", "0"),
+				),
+			},
+			{
+				Config: tableWithColumnTags(accName, tagName, policyName, "public", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.tag.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.tag.0.tag_value", "public"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.masking_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func tableWithColumnTags(name string, tagName string, policyName string, tagValue string, withPolicy bool) string {
+	maskingPolicy := ""
+	if withPolicy {
+		maskingPolicy = "snowflake_masking_policy.test.qualified_name"
+	} else {
+		maskingPolicy = `""`
+	}
+	s := `
+resource "snowflake_tag" "test_tag" {
+	name     = "%[2]s"
+	database = "%[4]s"
+	schema   = "%[5]s"
+}
+
+resource "snowflake_masking_policy" "test" {
+	name               = "%[3]s"
+	database           = "%[4]s"
+	schema             = "%[5]s"
+	signature {
+		column {
+			name = "val"
+			type = "VARCHAR"
+		}
+	}
+	masking_expression = "case when current_role() in ('ANALYST') then val else sha2(val, 512) end"
+	return_data_type   = "VARCHAR"
+}
+
+resource "snowflake_table" "test_table" {
+	database = "%[4]s"
+	schema   = "%[5]s"
+	name     = "%[1]s"
+
+	column {
+		name           = "column1"
+		type           = "VARCHAR(16)"
+		masking_policy = %[7]s
+
+		tag {
+			tag_id    = snowflake_tag.test_tag.id
+			tag_value = "%[6]s"
+		}
+	}
+
+	column {
+		name = "column2"
+		type = "VARCHAR(16)"
+	}
+}
+`
+	return fmt.Sprintf(s, name, tagName, policyName, acc.TestDatabaseName, acc.TestSchemaName, tagValue, maskingPolicy)
+}
+
 func TestAcc_TableIdentity(t *testing.T) {
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
@@ -1211,6 +1294,120 @@ resource "snowflake_table" "test_table" {
 	return fmt.Sprintf(s, name, databaseName, schemaName, name, databaseName, schemaName)
 }
 
+func TestAcc_TableIdentityOrdering(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableColumnWithIdentityOrdering(accName, 1, "ORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.identity.0.start_num", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.identity.0.step_num", "2"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.identity.0.ordering", "ORDER"),
+				),
+			},
+			// ORDER -> NOORDER is applied in place
+			{
+				Config: tableColumnWithIdentityOrdering(accName, 1, "NOORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.identity.0.ordering", "NOORDER"),
+				),
+			},
+			// changing the start recreates the table
+			{
+				Config: tableColumnWithIdentityOrdering(accName, 10, "NOORDER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.identity.0.start_num", "10"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.identity.0.ordering", "NOORDER"),
+				),
+			},
+		},
+	})
+}
+
+func tableColumnWithIdentityOrdering(name string, startNum int, ordering string) string {
+	s := `
+resource "snowflake_table" "test_table" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+
+	column {
+		name = "id"
+		type = "NUMBER(38,0)"
+		identity {
+			start_num = %d
+			step_num  = 2
+			ordering  = "%s"
+		}
+	}
+}
+`
+	return fmt.Sprintf(s, name, acc.TestDatabaseName, acc.TestSchemaName, startNum, ordering)
+}
+
+func TestAcc_TableColumnCollationAndUnique(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableColumnWithCollationAndUnique(accName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.type", "int"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.unique", "true"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.collate", "en-ci"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.2.default.0.expression", "CURRENT_TIMESTAMP()"),
+				),
+			},
+			// the unique constraint is dropped in place
+			{
+				Config: tableColumnWithCollationAndUnique(accName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.unique", "false"),
+				),
+			},
+		},
+	})
+}
+
+func tableColumnWithCollationAndUnique(name string, unique bool) string {
+	s := `
+resource "snowflake_table" "test_table" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+
+	column {
+		name     = "id"
+		type     = "int"
+		nullable = false
+		unique   = %t
+	}
+
+	column {
+		name    = "name"
+		type    = "VARCHAR(100)"
+		collate = "en-ci"
+	}
+
+	column {
+		name = "created_at"
+		type = "TIMESTAMP_NTZ(9)"
+		default {
+			expression = "CURRENT_TIMESTAMP()"
+		}
+	}
+}
+`
+	return fmt.Sprintf(s, name, acc.TestDatabaseName, acc.TestSchemaName, unique)
+}
+
 func TestAcc_TableRename(t *testing.T) {
 	oldTableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 	newTableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
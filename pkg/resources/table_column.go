@@ -0,0 +1,286 @@
+package resources
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tableColumnSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which the table is located.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which the table is located.",
+	},
+	"table": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the table to add the column to.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Column name.",
+	},
+	"type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Column type, e.g. VARIANT. Only the changes of the type allowed by Snowflake's ALTER TABLE ... MODIFY COLUMN (e.g. increasing the length of a VARCHAR) are supported.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return normalizeColumnType(old) == normalizeColumnType(new)
+		},
+	},
+	"collate": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Column collation, e.g. utf8.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"nullable": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Whether this column can contain null values.",
+	},
+	"unique": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether the column has an inline UNIQUE constraint. Note: Snowflake does not enforce UNIQUE constraints.",
+	},
+	"default": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Defines the column default value. Only constant defaults can be used when adding a column to an existing table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"constant": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The default constant value for the column",
+				},
+				"expression": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: DiffSuppressStatement,
+					Description:      "The default expression value for the column",
+				},
+				"sequence": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: suppressQuotedIdentifierDiff,
+					Description:      "The default sequence to use for the column",
+				},
+			},
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "Column comment.",
+	},
+	"masking_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "",
+		Description:      "Masking policy to apply on the column.",
+		DiffSuppressFunc: suppressQuotedIdentifierDiff,
+	},
+}
+
+// TableColumn returns a pointer to the resource representing a single column of a table.
+func TableColumn() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single column of a table with targeted ALTER TABLE statements, so that columns can be added to tables which are not managed by Terraform. " +
+			"Do not use it for the columns of a `snowflake_table`, as both resources would try to manage the same column.",
+
+		Create: CreateTableColumn,
+		Read:   ReadTableColumn,
+		Update: UpdateTableColumn,
+		Delete: DeleteTableColumn,
+
+		Schema: tableColumnSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func tableColumnIDFromString(id string) (*snowflake.TableBuilder, string, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return nil, "", fmt.Errorf("invalid table column id %s, expected format: `databaseName|schemaName|tableName|columnName`", id)
+	}
+	return snowflake.NewTableBuilder(parts[2], parts[0], parts[1]), parts[3], nil
+}
+
+// CreateTableColumn implements schema.CreateFunc.
+func CreateTableColumn(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	tableName := d.Get("table").(string)
+	builder := snowflake.NewTableBuilder(tableName, databaseName, schemaName)
+
+	col := column{
+		name:          d.Get("name").(string),
+		dataType:      d.Get("type").(string),
+		nullable:      d.Get("nullable").(bool),
+		comment:       d.Get("comment").(string),
+		maskingPolicy: d.Get("masking_policy").(string),
+		collate:       d.Get("collate").(string),
+		unique:        d.Get("unique").(bool),
+	}
+	if v, ok := d.GetOk("default"); ok {
+		col._default = getColumnDefault(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if err := snowflake.Exec(db, builder.AddColumnDefinition(col.toSnowflakeColumn())); err != nil {
+		return fmt.Errorf("error adding column %v to table %v err = %w", col.name, builder.QualifiedName(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName, tableName, col.name))
+
+	return ReadTableColumn(d, meta)
+}
+
+// ReadTableColumn implements schema.ReadFunc.
+func ReadTableColumn(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	builder, columnName, err := tableColumnIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rows, err := snowflake.Query(db, builder.ShowColumns())
+	if err != nil {
+		log.Printf("[DEBUG] table (%s) not found", builder.QualifiedName())
+		d.SetId("")
+		return nil
+	}
+	tableDescription, err := snowflake.ScanTableDescription(rows)
+	if err != nil {
+		return err
+	}
+
+	var columnDescription []snowflake.TableDescription
+	for _, td := range tableDescription {
+		if td.Name.String == columnName {
+			columnDescription = append(columnDescription, td)
+		}
+	}
+	columns, err := readColumnsWithUniqueKeys(db, builder, columnDescription, map[string]bool{columnName: d.Get("unique").(bool)})
+	if err != nil {
+		return err
+	}
+	flattened := columns.Flatten()
+	if len(flattened) == 0 {
+		log.Printf("[DEBUG] column (%s) not found in table (%s)", columnName, builder.QualifiedName())
+		d.SetId("")
+		return nil
+	}
+	col := flattened[0].(map[string]interface{})
+
+	parts := strings.Split(d.Id(), helpers.IDDelimiter)
+	toSet := map[string]interface{}{
+		"database":       parts[0],
+		"schema":         parts[1],
+		"table":          parts[2],
+		"name":           col["name"],
+		"type":           col["type"],
+		"collate":        col["collate"],
+		"nullable":       col["nullable"],
+		"unique":         col["unique"],
+		"comment":        col["comment"],
+		"masking_policy": col["masking_policy"],
+	}
+	if _default, ok := col["default"]; ok {
+		toSet["default"] = _default
+	} else {
+		toSet["default"] = []interface{}{}
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateTableColumn implements schema.UpdateFunc.
+func UpdateTableColumn(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	builder, columnName, err := tableColumnIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("type") {
+		q := builder.ChangeColumnType(columnName, d.Get("type").(string))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error changing type of column %v on %v err = %w", columnName, builder.QualifiedName(), err)
+		}
+	}
+	if d.HasChange("nullable") {
+		q := builder.ChangeNullConstraint(columnName, d.Get("nullable").(bool))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error changing null constraint of column %v on %v err = %w", columnName, builder.QualifiedName(), err)
+		}
+	}
+	if d.HasChange("unique") {
+		q := builder.ChangeColumnUnique(columnName, d.Get("unique").(bool))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error changing unique constraint of column %v on %v err = %w", columnName, builder.QualifiedName(), err)
+		}
+	}
+	if d.HasChange("comment") {
+		q := builder.ChangeColumnComment(columnName, d.Get("comment").(string))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error changing comment of column %v on %v err = %w", columnName, builder.QualifiedName(), err)
+		}
+	}
+	if d.HasChange("masking_policy") {
+		q := builder.ChangeColumnMaskingPolicy(columnName, d.Get("masking_policy").(string))
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error changing masking policy of column %v on %v err = %w", columnName, builder.QualifiedName(), err)
+		}
+	}
+
+	return ReadTableColumn(d, meta)
+}
+
+// DeleteTableColumn implements schema.DeleteFunc.
+func DeleteTableColumn(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	builder, columnName, err := tableColumnIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := snowflake.Exec(db, builder.DropColumn(columnName)); err != nil {
+		return fmt.Errorf("error dropping column %v from table %v err = %w", columnName, builder.QualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
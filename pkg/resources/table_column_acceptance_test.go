@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TableColumn(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableColumnConfig(accName, "VARCHAR(16)", "first comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table_column.test", "id", fmt.Sprintf("%s|%s|%s|code", acc.TestDatabaseName, acc.TestSchemaName, accName)),
+					resource.TestCheckResourceAttr("snowflake_table_column.test", "type", "VARCHAR(16)"),
+					resource.TestCheckResourceAttr("snowflake_table_column.test", "nullable", "true"),
+					resource.TestCheckResourceAttr("snowflake_table_column.test", "comment", "first comment"),
+				),
+			},
+			// the type and the comment are changed in place
+			{
+				Config: tableColumnConfig(accName, "VARCHAR(32)", "second comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table_column.test", "type", "VARCHAR(32)"),
+					resource.TestCheckResourceAttr("snowflake_table_column.test", "comment", "second comment"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_table_column.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func tableColumnConfig(tableName string, columnType string, comment string) string {
+	s := `
+resource "snowflake_table" "test" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+
+	column {
+		name = "id"
+		type = "NUMBER(38,0)"
+	}
+
+	lifecycle {
+		ignore_changes = [column]
+	}
+}
+
+resource "snowflake_table_column" "test" {
+	database = snowflake_table.test.database
+	schema   = snowflake_table.test.schema
+	table    = snowflake_table.test.name
+	name     = "code"
+	type     = "%[4]s"
+	comment  = "%[5]s"
+}
+`
+	return fmt.Sprintf(s, acc.TestDatabaseName, acc.TestSchemaName, tableName, columnType, comment)
+}
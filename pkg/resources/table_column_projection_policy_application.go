@@ -0,0 +1,135 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tableColumnProjectionPolicyApplicationSchema = map[string]*schema.Schema{
+	"table": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The fully qualified name (`database.schema.table`) of the table to apply the projection policy to.",
+	},
+	"column": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The column to apply the projection policy to.",
+	},
+	"projection_policy": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Fully qualified name (`database.schema.policyname`) of the policy to apply.",
+	},
+}
+
+func TableColumnProjectionPolicyApplication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Applies a projection policy to a table column.",
+		Create:      CreateTableColumnProjectionPolicyApplication,
+		Read:        ReadTableColumnProjectionPolicyApplication,
+		Delete:      DeleteTableColumnProjectionPolicyApplication,
+
+		Schema: tableColumnProjectionPolicyApplicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateTableColumnProjectionPolicyApplication implements schema.CreateFunc.
+func CreateTableColumnProjectionPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+	manager := snowflake.NewTableColumnProjectionPolicyApplicationManager()
+
+	input := &snowflake.TableColumnProjectionPolicyApplicationCreateInput{
+		TableColumnProjectionPolicyApplication: snowflake.TableColumnProjectionPolicyApplication{
+			Table:            snowflake.SchemaObjectIdentifierFromQualifiedName(d.Get("table").(string)),
+			Column:           d.Get("column").(string),
+			ProjectionPolicy: snowflake.SchemaObjectIdentifierFromQualifiedName(d.Get("projection_policy").(string)),
+		},
+	}
+
+	stmt := manager.Create(input)
+
+	db := meta.(*sql.DB)
+	_, err := db.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error applying projection policy: %w", err)
+	}
+
+	identifier := snowflake.ColumnIdentifier{
+		Database:   input.Table.Database,
+		Schema:     input.Table.Schema,
+		ObjectName: input.Table.ObjectName,
+		Column:     input.Column,
+	}
+	d.SetId(identifier.QualifiedName())
+
+	return ReadTableColumnProjectionPolicyApplication(d, meta)
+}
+
+// ReadTableColumnProjectionPolicyApplication implements schema.ReadFunc.
+func ReadTableColumnProjectionPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	table, column := TableColumnMaskingPolicyApplicationIdentifier(d.Id())
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, table.QualifiedName(), sdk.PolicyEntityDomainTable)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindProjectionPolicy && strings.EqualFold(policyReference.RefColumnName, column) {
+			if err := d.Set("table", table.QualifiedName()); err != nil {
+				return err
+			}
+			if err := d.Set("column", column); err != nil {
+				return err
+			}
+			if err := d.Set("projection_policy", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] projection policy is not attached to the column (%s)", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// DeleteTableColumnProjectionPolicyApplication implements schema.DeleteFunc.
+func DeleteTableColumnProjectionPolicyApplication(d *schema.ResourceData, meta interface{}) error {
+	manager := snowflake.NewTableColumnProjectionPolicyApplicationManager()
+
+	input := &snowflake.TableColumnProjectionPolicyApplicationDeleteInput{
+		TableColumn: snowflake.TableColumn{
+			Table:  snowflake.SchemaObjectIdentifierFromQualifiedName(d.Get("table").(string)),
+			Column: d.Get("column").(string),
+		},
+	}
+
+	stmt := manager.Delete(input)
+
+	db := meta.(*sql.DB)
+	_, err := db.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error executing drop statement: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TableColumnProjectionPolicyApplication(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: projectionPolicyApplicationTestConfig(acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table_column_projection_policy_application.ppa", "table", fmt.Sprintf(`"%s"."%s"."projection_table"`, acc.TestDatabaseName, acc.TestSchemaName)),
+					resource.TestCheckResourceAttr("snowflake_table_column_projection_policy_application.ppa", "column", "secret"),
+					resource.TestCheckResourceAttr("snowflake_table_column_projection_policy_application.ppa", "projection_policy", fmt.Sprintf(`"%s"."%s"."projection_policy"`, acc.TestDatabaseName, acc.TestSchemaName)),
+				),
+			},
+			{
+				ResourceName:      "snowflake_table_column_projection_policy_application.ppa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func projectionPolicyApplicationTestConfig(databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_projection_policy" "test" {
+	name     = "projection_policy"
+	database = "%s"
+	schema   = "%s"
+	body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+resource "snowflake_table" "table" {
+	database = "%s"
+	schema   = "%s"
+	name     = "projection_table"
+
+	column {
+	  name     = "secret"
+	  type     = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_table_column_projection_policy_application" "ppa" {
+	table             = snowflake_table.table.qualified_name
+	column            = "secret"
+	projection_policy = snowflake_projection_policy.test.qualified_name
+}`, databaseName, schemaName, databaseName, schemaName)
+}
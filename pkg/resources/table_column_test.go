@@ -0,0 +1,117 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableColumn(t *testing.T) {
+	r := require.New(t)
+	err := resources.TableColumn().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestTableColumnCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"table":    "test_table",
+		"name":     "code",
+		"type":     "VARCHAR(16)",
+		"collate":  "en-ci",
+		"nullable": false,
+		"comment":  "a code",
+		"default":  []interface{}{map[string]interface{}{"constant": "none"}},
+	}
+	d := schema.TestResourceDataRaw(t, resources.TableColumn().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER TABLE "test_db"."test_schema"."test_table" ADD COLUMN "code" VARCHAR\(16\) COLLATE 'en-ci' NOT NULL DEFAULT 'none' COMMENT 'a code'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadTableColumn(mock)
+
+		err := resources.CreateTableColumn(d, db)
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_table|code", d.Id())
+		r.Equal("VARCHAR(16)", d.Get("type"))
+		r.Equal("en-ci", d.Get("collate"))
+		r.False(d.Get("nullable").(bool))
+		r.Equal("none", d.Get("default.0.constant"))
+	})
+}
+
+func TestTableColumnRead(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableColumn().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_table|code")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadTableColumn(mock)
+
+		err := resources.ReadTableColumn(d, db)
+		r.NoError(err)
+		r.Equal("test_table", d.Get("table"))
+		r.Equal("code", d.Get("name"))
+		r.Equal("a code", d.Get("comment"))
+	})
+}
+
+func TestTableColumnReadCompositeUniqueKey(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableColumn().Schema, map[string]interface{}{"unique": false})
+	d.SetId("test_db|test_schema|test_table|code")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"name", "type", "kind", "null?", "default", "primary key", "unique key", "check", "expression", "comment", "policy name",
+		}).
+			AddRow("id", "NUMBER(38,0)", "COLUMN", "N", nil, "N", "Y", nil, nil, nil, nil).
+			AddRow("code", "VARCHAR(16)", "COLUMN", "N", nil, "N", "Y", nil, nil, nil, nil)
+		mock.ExpectQuery(`^DESC TABLE "test_db"."test_schema"."test_table"$`).WillReturnRows(rows)
+		keys := sqlmock.NewRows([]string{"column_name", "key_sequence", "constraint_name"}).
+			AddRow("id", "1", "ID_CODE_UNIQUE").
+			AddRow("code", "2", "ID_CODE_UNIQUE")
+		mock.ExpectQuery(`^SHOW UNIQUE KEYS IN TABLE "test_db"."test_schema"."test_table"$`).WillReturnRows(keys)
+
+		err := resources.ReadTableColumn(d, db)
+		r.NoError(err)
+		r.False(d.Get("unique").(bool))
+	})
+}
+
+func TestTableColumnDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableColumn().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_table|code")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "test_db"."test_schema"."test_table" DROP COLUMN "code"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := resources.DeleteTableColumn(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadTableColumn(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"name", "type", "kind", "null?", "default", "primary key", "unique key", "check", "expression", "comment", "policy name",
+	}).
+		AddRow("id", "NUMBER(38,0)", "COLUMN", "N", nil, "N", "N", nil, nil, nil, nil).
+		AddRow("code", "VARCHAR(16) COLLATE 'en-ci'", "COLUMN", "N", "'none'", "N", "N", nil, nil, "a code", nil)
+	mock.ExpectQuery(`^DESC TABLE "test_db"."test_schema"."test_table"$`).WillReturnRows(rows)
+}
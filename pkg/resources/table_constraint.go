@@ -51,7 +51,6 @@ var tableConstraintSchema = map[string]*schema.Schema{
 	"enforced": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		ForceNew:    true,
 		Default:     false,
 		Description: "Whether the constraint is enforced",
 	},
@@ -80,14 +79,12 @@ var tableConstraintSchema = map[string]*schema.Schema{
 	"validate": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		ForceNew:    true,
 		Default:     false,
 		Description: "Specifies whether to validate existing data on the table when a constraint is created. Only used in conjunction with the ENABLE property.",
 	},
 	"rely": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		ForceNew:    true,
 		Default:     true,
 		Description: "Specifies whether a constraint in NOVALIDATE mode is taken into account during query rewrite.",
 	},
@@ -286,22 +283,77 @@ func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 }
 
 // ReadTableConstraint implements schema.ReadFunc.
-func ReadTableConstraint(_ *schema.ResourceData, _ interface{}) error {
-	// commenting this out since it requires an active warehouse to be set which may not be intuitive.
-	// also it takes a while for the database to reflect changes. Would likely need to add a validation
-	// step like in tag association. People don't like waiting 40 minutes for Terraform to run.
-
-	/*db := meta.(*sql.DB)
+func ReadTableConstraint(d *schema.ResourceData, meta interface{}) error {
+	// SHOW ... KEYS is used instead of INFORMATION_SCHEMA.TABLE_CONSTRAINTS, as it does not require an active warehouse
+	// and reflects the changes immediately.
+	db := meta.(*sql.DB)
 	tc := tableConstraintID{}
 	tc.parse(d.Id())
-	databaseName, schemaName, tableName := snowflakeValidation.ParseFullyQualifiedObjectID(tc.tableID)
+	formattedTableID := snowflakeValidation.ParseAndFormatFullyQualifiedObectID(tc.tableID)
+	builder := snowflake.NewTableConstraintBuilder(tc.name, tc.constraintType, formattedTableID)
 
-	// just need to check to make sure it exists
-	_, err := snowflake.ShowTableConstraint(tc.name, databaseName, schemaName, tableName, db)
+	keys, err := snowflake.ListTableConstraintKeys(builder, db)
 	if err != nil {
-		return fmt.Errorf(fmt.Sprintf("error reading table constraint %v", tc.String()))
-	}*/
+		return fmt.Errorf("error reading table constraint %v err = %w", tc.name, err)
+	}
+	// NOT NULL constraints are not listed by Snowflake
+	if keys == nil {
+		return nil
+	}
+	if len(keys) == 0 {
+		log.Printf("[DEBUG] table constraint (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	columns := make([]string, len(keys))
+	referenceColumns := make([]string, len(keys))
+	for i, key := range keys {
+		columns[i] = key.Column()
+		referenceColumns[i] = key.PkColumnName.String
+	}
 
+	toSet := map[string]interface{}{
+		"name":     tc.name,
+		"type":     tc.constraintType,
+		"table_id": tc.tableID,
+		"columns":  columns,
+		"rely":     strings.EqualFold(keys[0].Rely.String, "true"),
+		"comment":  keys[0].Comment.String,
+	}
+	if tc.constraintType == "FOREIGN KEY" {
+		referenceTableID := snowflakeValidation.FormatFullyQualifiedObjectID(keys[0].PkDatabaseName.String, keys[0].PkSchemaName.String, keys[0].PkTableName.String)
+		match := "FULL"
+		if v, ok := d.GetOk("foreign_key_properties"); ok {
+			foreignKeyProperties := v.([]interface{})[0].(map[string]interface{})
+			match = foreignKeyProperties["match"].(string)
+			if references := foreignKeyProperties["references"].([]interface{}); len(references) == 1 {
+				configuredTableID := references[0].(map[string]interface{})["table_id"].(string)
+				// keep the configured format of the identifier as long as it points to the same table
+				if strings.EqualFold(snowflakeValidation.ParseAndFormatFullyQualifiedObectID(configuredTableID), referenceTableID) {
+					referenceTableID = configuredTableID
+				}
+			}
+		}
+		toSet["foreign_key_properties"] = []interface{}{
+			map[string]interface{}{
+				"references": []interface{}{
+					map[string]interface{}{
+						"table_id": referenceTableID,
+						"columns":  referenceColumns,
+					},
+				},
+				"match":     match,
+				"on_update": keys[0].UpdateRule.String,
+				"on_delete": keys[0].DeleteRule.String,
+			},
+		}
+	}
+	for key, val := range toSet {
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -329,6 +381,19 @@ func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("error renaming table constraint %v err = %w", tc.name, err)
 		}
+		tc.name = n.(string)
+		d.SetId(tc.String())
+		builder = snowflake.NewTableConstraintBuilder(tc.name, tc.constraintType, formattedTableID)
+	}
+
+	if d.HasChanges("enforced", "validate", "rely") {
+		builder.WithEnforced(d.Get("enforced").(bool))
+		builder.WithValidate(d.Get("validate").(bool))
+		builder.WithRely(d.Get("rely").(bool))
+		_, err := db.Exec(builder.Alter())
+		if err != nil {
+			return fmt.Errorf("error altering table constraint %v err = %w", tc.name, err)
+		}
 	}
 
 	return ReadTableConstraint(d, meta)
@@ -135,3 +135,60 @@ resource "snowflake_table_constraint" "unique" {
 
 `, n, databaseName, schemaName, n, databaseName, schemaName, n)
 }
+
+func TestAcc_TableConstraint_pkRely(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tableConstraintPKRelyConfig(name, acc.TestDatabaseName, acc.TestSchemaName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table_constraint.pk", "type", "PRIMARY KEY"),
+					resource.TestCheckResourceAttr("snowflake_table_constraint.pk", "columns.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_table_constraint.pk", "rely", "true"),
+				),
+			},
+			// rely is altered in place
+			{
+				Config: tableConstraintPKRelyConfig(name, acc.TestDatabaseName, acc.TestSchemaName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table_constraint.pk", "rely", "false"),
+				),
+			},
+		},
+	})
+}
+
+func tableConstraintPKRelyConfig(n string, databaseName string, schemaName string, rely bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "t" {
+	name     = "%s"
+	database = "%s"
+	schema   = "%s"
+
+	column {
+		name     = "col1"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+
+	column {
+		name     = "col2"
+		type     = "NUMBER(38,0)"
+		nullable = false
+	}
+}
+
+resource "snowflake_table_constraint" "pk" {
+	name     = "%s"
+	type     = "PRIMARY KEY"
+	table_id = snowflake_table.t.id
+	columns  = ["col1", "col2"]
+	rely     = %t
+}
+`, n, databaseName, schemaName, n, rely)
+}
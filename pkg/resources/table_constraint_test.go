@@ -0,0 +1,63 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableConstraint(t *testing.T) {
+	r := require.New(t)
+	err := resources.TableConstraint().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestTableConstraintReadForeignKey(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableConstraint().Schema, map[string]interface{}{})
+	d.SetId("fk❄️FOREIGN KEY❄️db|schema|table")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "pk_database_name", "pk_schema_name", "pk_table_name", "pk_column_name", "fk_database_name", "fk_schema_name", "fk_table_name", "fk_column_name", "key_sequence", "update_rule", "delete_rule", "fk_name", "pk_name", "deferrability", "rely", "comment",
+		}).
+			AddRow("2023-01-01", "db", "schema", "other", "y", "db", "schema", "table", "b", "2", "CASCADE", "NO ACTION", "FK", "PK", "NOT DEFERRABLE", "true", "a comment").
+			AddRow("2023-01-01", "db", "schema", "other", "x", "db", "schema", "table", "a", "1", "CASCADE", "NO ACTION", "FK", "PK", "NOT DEFERRABLE", "true", "a comment").
+			AddRow("2023-01-01", "db", "schema", "another", "z", "db", "schema", "table", "c", "1", "NO ACTION", "NO ACTION", "OTHER_FK", "PK", "NOT DEFERRABLE", "false", nil)
+		mock.ExpectQuery(`^SHOW IMPORTED KEYS IN TABLE "db"."schema"."table"$`).WillReturnRows(rows)
+
+		err := resources.ReadTableConstraint(d, db)
+		r.NoError(err)
+		r.Equal("fk", d.Get("name"))
+		r.Equal([]interface{}{"a", "b"}, d.Get("columns"))
+		r.True(d.Get("rely").(bool))
+		r.Equal("a comment", d.Get("comment"))
+		r.Equal(`"db"."schema"."other"`, d.Get("foreign_key_properties.0.references.0.table_id"))
+		r.Equal([]interface{}{"x", "y"}, d.Get("foreign_key_properties.0.references.0.columns"))
+		r.Equal("CASCADE", d.Get("foreign_key_properties.0.on_update"))
+		r.Equal("NO ACTION", d.Get("foreign_key_properties.0.on_delete"))
+	})
+}
+
+func TestTableConstraintReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.TableConstraint().Schema, map[string]interface{}{})
+	d.SetId("pk❄️PRIMARY KEY❄️db|schema|table")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "database_name", "schema_name", "table_name", "column_name", "key_sequence", "constraint_name", "rely", "comment"})
+		mock.ExpectQuery(`^SHOW PRIMARY KEYS IN TABLE "db"."schema"."table"$`).WillReturnRows(rows)
+
+		err := resources.ReadTableConstraint(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -70,3 +71,81 @@ func TestTableStruct(t *testing.T) {
 	r.Equal("database|name", newTable.DatabaseName)
 	r.Equal("table|name", newTable.TableName)
 }
+
+func TestColumnTagsDiffs(t *testing.T) {
+	r := require.New(t)
+	old := columnTags{{tagID: "db.schema.a", value: "1"}, {tagID: "db.schema.b", value: "2"}}
+	new := columnTags{{tagID: "db.schema.b", value: "3"}, {tagID: "db.schema.c", value: "4"}}
+
+	removed, set := old.diffs(new)
+	r.Equal(columnTags{{tagID: "db.schema.a", value: "1"}}, removed)
+	r.Equal(columnTags{{tagID: "db.schema.b", value: "3"}, {tagID: "db.schema.c", value: "4"}}, set)
+
+	removed, set = old.diffs(old)
+	r.Empty(removed)
+	r.Empty(set)
+}
+
+func TestSuppressQuotedIdentifierDiff(t *testing.T) {
+	r := require.New(t)
+	r.True(suppressQuotedIdentifierDiff("", "DB.SCHEMA.POLICY", `"DB"."SCHEMA"."POLICY"`, nil))
+	r.True(suppressQuotedIdentifierDiff("", "DB.SCHEMA.POLICY", "db.schema.policy", nil))
+	r.False(suppressQuotedIdentifierDiff("", "DB.SCHEMA.POLICY", "", nil))
+}
+
+func TestColumnIdentityRequiresRecreation(t *testing.T) {
+	r := require.New(t)
+	identity := &columnIdentity{startNum: 1, stepNum: 1, ordering: "ORDER"}
+
+	r.False(identity.requiresRecreation(&columnIdentity{startNum: 1, stepNum: 1, ordering: "ORDER"}))
+	r.False(identity.requiresRecreation(&columnIdentity{startNum: 1, stepNum: 1, ordering: "NOORDER"}))
+	r.True(identity.requiresRecreation(&columnIdentity{startNum: 2, stepNum: 1, ordering: "ORDER"}))
+	r.True(identity.requiresRecreation(&columnIdentity{startNum: 1, stepNum: 5, ordering: "ORDER"}))
+	r.True(identity.requiresRecreation(nil))
+	r.True((&columnIdentity{startNum: 1, stepNum: 1, ordering: "NOORDER"}).requiresRecreation(identity))
+
+	var none *columnIdentity
+	r.False(none.requiresRecreation(nil))
+	r.True(none.requiresRecreation(identity))
+}
+
+func TestNormalizeColumnType(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("NUMBER(38,0)", normalizeColumnType("int"))
+	r.Equal("NUMBER(38,0)", normalizeColumnType("NUMBER"))
+	r.Equal("NUMBER(10,0)", normalizeColumnType("decimal(10)"))
+	r.Equal("NUMBER(10,2)", normalizeColumnType("NUMERIC(10, 2)"))
+	r.Equal("FLOAT", normalizeColumnType("double precision"))
+	r.Equal("VARCHAR(16777216)", normalizeColumnType("string"))
+	r.Equal("VARCHAR(10)", normalizeColumnType("text(10)"))
+	r.Equal("VARCHAR(1)", normalizeColumnType("CHAR"))
+	r.Equal("BINARY(8388608)", normalizeColumnType("VARBINARY"))
+	r.Equal("TIMESTAMP_NTZ(9)", normalizeColumnType("DATETIME"))
+	r.Equal("TIMESTAMP_NTZ(3)", normalizeColumnType("datetime(3)"))
+	r.Equal("VARIANT", normalizeColumnType("variant"))
+}
+
+func TestTableColumnCollationForceNew(t *testing.T) {
+	r := require.New(t)
+	col := func(name, collate string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":           name,
+			"type":           "VARCHAR",
+			"nullable":       true,
+			"comment":        "",
+			"masking_policy": "",
+			"default":        []interface{}{},
+			"identity":       []interface{}{},
+			"tag":            []interface{}{},
+			"collate":        collate,
+			"unique":         false,
+		}
+	}
+
+	old := []interface{}{col("a", "en-ci"), col("b", "")}
+	r.False(tableColumnCollationForceNew(context.Background(), old, []interface{}{col("a", "EN-CI"), col("b", "")}, nil))
+	r.False(tableColumnCollationForceNew(context.Background(), old, []interface{}{col("a", "en-ci")}, nil))
+	r.True(tableColumnCollationForceNew(context.Background(), old, []interface{}{col("a", "en-cs"), col("b", "")}, nil))
+	r.True(tableColumnCollationForceNew(context.Background(), old, []interface{}{col("a", "en-ci"), col("b", "utf8")}, nil))
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -12,7 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -47,7 +50,13 @@ var tagSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Description: "List of allowed values for the tag.",
+		Description: "List of allowed values for the tag. Values added or removed from the list are added to or dropped from the tag in place.",
+	},
+	"masking_policies": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Set of the resource ids of the masking policies attached to the tag (snowflake_masking_policy.policy.id), at most one per data type. Reading the attached policies requires a current warehouse. Do not use together with the snowflake_tag_masking_policy_association resource for the same tag.",
 	},
 }
 
@@ -182,9 +191,9 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
-	schema := d.Get("schema").(string)
+	schemaName := d.Get("schema").(string)
 
-	builder := snowflake.NewTagBuilder(name).WithDB(database).WithSchema(schema)
+	builder := snowflake.NewTagBuilder(name).WithDB(database).WithSchema(schemaName)
 
 	// Set optionals
 	if v, ok := d.GetOk("comment"); ok {
@@ -203,7 +212,7 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 
 	tagID := &TagID{
 		DatabaseName: database,
-		SchemaName:   schema,
+		SchemaName:   schemaName,
 		TagName:      name,
 	}
 	dataIDInput, err := tagID.String()
@@ -212,9 +221,24 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(dataIDInput)
 
+	for _, policy := range expandStringList(d.Get("masking_policies").(*schema.Set).List()) {
+		if err := snowflake.Exec(db, tagMaskingPolicyBuilder(builder, policy).AddMaskingPolicy()); err != nil {
+			return fmt.Errorf("error attaching masking policy %v to tag %v err = %w", policy, name, err)
+		}
+	}
+
 	return ReadTag(d, meta)
 }
 
+// tagMaskingPolicyBuilder returns the builder of the tag with the masking policy given by its resource id.
+func tagMaskingPolicyBuilder(builder *snowflake.TagBuilder, policy string) *snowflake.TagBuilder {
+	id, ok := helpers.DecodeSnowflakeID(policy).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		id = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(policy)
+	}
+	return builder.WithMaskingPolicy(snowflake.MaskingPolicy(id.Name(), id.DatabaseName(), id.SchemaName()))
+}
+
 // ReadSchema implements schema.ReadFunc.
 func ReadTag(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -260,8 +284,28 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 	av := strings.ReplaceAll(t.AllowedValues.String, "\"", "")
 	av = strings.TrimPrefix(av, "[")
 	av = strings.TrimSuffix(av, "]")
-	err = d.Set("allowed_values", helpers.StringListToList(av))
-	return err
+	if err := d.Set("allowed_values", helpers.StringListToList(av)); err != nil {
+		return err
+	}
+
+	// the attached policies are only read when they are managed by Terraform, as reading them requires a warehouse
+	if d.Get("masking_policies").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+	id := sdk.NewSchemaObjectIdentifier(dbName, schemaName, tag)
+	references, err := client.PolicyReferences.GetForEntity(ctx, id.FullyQualifiedName(), sdk.PolicyEntityDomainTag)
+	if err != nil {
+		return fmt.Errorf("error reading masking policies of tag %v err = %w", d.Id(), err)
+	}
+	policies := make([]string, 0, len(references))
+	for _, reference := range references {
+		if reference.PolicyKind == sdk.PolicyKindMaskingPolicy {
+			policies = append(policies, helpers.EncodeSnowflakeID(reference.PolicyID()))
+		}
+	}
+	return d.Set("masking_policies", policies)
 }
 
 // UpdateTag implements schema.UpdateFunc.
@@ -291,27 +335,39 @@ func UpdateTag(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	// If there is change in allowed_values field
 	if d.HasChange("allowed_values") {
-		if _, ok := d.GetOk("allowed_values"); ok {
-			v := d.Get("allowed_values")
-
-			ns := expandAllowedValues(v)
-
-			q := builder.RemoveAllowedValues()
-
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag)
+		o, n := d.GetChange("allowed_values")
+		oldValues, newValues := expandAllowedValues(o), expandAllowedValues(n)
+		if len(newValues) == 0 {
+			if err := snowflake.Exec(db, builder.RemoveAllowedValues()); err != nil {
+				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v err = %w", tag, err)
 			}
+		} else {
+			if removed := missingStrings(oldValues, newValues); len(removed) > 0 {
+				if err := snowflake.Exec(db, builder.DropAllowedValues(removed)); err != nil {
+					return fmt.Errorf("error dropping ALLOWED_VALUES for tag %v err = %w", tag, err)
+				}
+			}
+			if added := missingStrings(newValues, oldValues); len(added) > 0 {
+				if err := snowflake.Exec(db, builder.AddAllowedValues(added)); err != nil {
+					return fmt.Errorf("error adding ALLOWED_VALUES for tag %v err = %w", tag, err)
+				}
+			}
+		}
+	}
 
-			addQuery := builder.AddAllowedValues(ns)
-			if err := snowflake.Exec(db, addQuery); err != nil {
-				return fmt.Errorf("error adding ALLOWED_VALUES for tag %v", tag)
+	// the policies are detached first, as a tag can have only one masking policy per data type
+	if d.HasChange("masking_policies") {
+		o, n := d.GetChange("masking_policies")
+		oldPolicies, newPolicies := o.(*schema.Set), n.(*schema.Set)
+		for _, policy := range expandStringList(oldPolicies.Difference(newPolicies).List()) {
+			if err := snowflake.Exec(db, tagMaskingPolicyBuilder(builder, policy).RemoveMaskingPolicy()); err != nil {
+				return fmt.Errorf("error detaching masking policy %v from tag %v err = %w", policy, tag, err)
 			}
-		} else {
-			q := builder.RemoveAllowedValues()
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag)
+		}
+		for _, policy := range expandStringList(newPolicies.Difference(oldPolicies).List()) {
+			if err := snowflake.Exec(db, tagMaskingPolicyBuilder(builder, policy).AddMaskingPolicy()); err != nil {
+				return fmt.Errorf("error attaching masking policy %v to tag %v err = %w", policy, tag, err)
 			}
 		}
 	}
@@ -330,6 +386,17 @@ func expandAllowedValues(avChangeSet interface{}) []string {
 	return newAvs
 }
 
+// missingStrings returns the values of from that are not in in.
+func missingStrings(from []string, in []string) []string {
+	missing := make([]string, 0)
+	for _, value := range from {
+		if !slices.Contains(in, value) {
+			missing = append(missing, value)
+		}
+	}
+	return missing
+}
+
 // DeleteTag implements schema.DeleteFunc.
 func DeleteTag(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
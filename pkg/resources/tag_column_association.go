@@ -0,0 +1,216 @@
+package resources
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tagColumnAssociationSchema = map[string]*schema.Schema{
+	"tag_id": {
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Specifies the identifier for the tag. Note: format must follow: \"databaseName\".\"schemaName\".\"tagName\" or \"databaseName.schemaName.tagName\" or \"databaseName|schemaName.tagName\" (snowflake_tag.tag.id)",
+		ValidateFunc: snowflakeValidation.ValidateFullyQualifiedObjectID,
+		ForceNew:     true,
+	},
+	"tag_value": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the value of the tag, (e.g. 'finance' or 'engineering')",
+	},
+	"column_identifiers": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateColumnIdentifier},
+		Required:    true,
+		MinItems:    1,
+		Description: "Set of fully qualified column names (`\"database\".\"schema\".\"table\".\"column\"`) to associate the tag with. Columns of the same table are tagged with a single ALTER TABLE statement.",
+	},
+}
+
+// TagColumnAssociation returns a pointer to the resource representing a tag associated with many table columns.
+func TagColumnAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Associates a tag with a set of table columns in bulk.",
+
+		Create: CreateTagColumnAssociation,
+		Read:   ReadTagColumnAssociation,
+		Update: UpdateTagColumnAssociation,
+		Delete: DeleteTagColumnAssociation,
+
+		Schema: tagColumnAssociationSchema,
+	}
+}
+
+func validateColumnIdentifier(i interface{}, _ string) (s []string, errors []error) {
+	v, _ := i.(string)
+	if _, err := parseColumnIdentifier(v); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+// parseColumnIdentifier parses a fully qualified column name. The parts may be quoted, e.g. when they contain dots.
+func parseColumnIdentifier(columnIdentifier string) (*snowflake.ColumnIdentifier, error) {
+	id, err := helpers.DecodeSnowflakeParameterID(columnIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a valid column identifier, expected format: `\"database\".\"schema\".\"table\".\"column\"`", columnIdentifier)
+	}
+	columnID, ok := id.(sdk.TableColumnIdentifier)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a valid column identifier, expected format: `\"database\".\"schema\".\"table\".\"column\"`", columnIdentifier)
+	}
+	return &snowflake.ColumnIdentifier{
+		Database:   columnID.DatabaseName(),
+		Schema:     columnID.SchemaName(),
+		ObjectName: columnID.TableName(),
+		Column:     columnID.Name(),
+	}, nil
+}
+
+// groupColumnsByTable groups fully qualified column names by the fully qualified name of their table.
+func groupColumnsByTable(columnIdentifiers []string) (tables []*snowflake.SchemaObjectIdentifier, columns map[string][]string, err error) {
+	columns = make(map[string][]string)
+	for _, columnIdentifier := range columnIdentifiers {
+		c, err := parseColumnIdentifier(columnIdentifier)
+		if err != nil {
+			return nil, nil, err
+		}
+		table := &snowflake.SchemaObjectIdentifier{Database: c.Database, Schema: c.Schema, ObjectName: c.ObjectName}
+		if _, ok := columns[table.QualifiedName()]; !ok {
+			tables = append(tables, table)
+		}
+		columns[table.QualifiedName()] = append(columns[table.QualifiedName()], c.Column)
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].QualifiedName() < tables[j].QualifiedName() })
+	return tables, columns, nil
+}
+
+func execForColumns(db *sql.DB, columnIdentifiers []string, stmt func(table string, columns []string) string) error {
+	tables, columns, err := groupColumnsByTable(columnIdentifiers)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		q := stmt(table.QualifiedName(), columns[table.QualifiedName()])
+		if err := snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error executing [%v]: %w", q, err)
+		}
+	}
+	return nil
+}
+
+// CreateTagColumnAssociation implements schema.CreateFunc.
+func CreateTagColumnAssociation(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	tagID := d.Get("tag_id").(string)
+	builder := snowflake.NewTagAssociationBuilder(tagID).WithTagValue(d.Get("tag_value").(string))
+	columnIdentifiers := expandStringList(d.Get("column_identifiers").(*schema.Set).List())
+
+	if err := execForColumns(db, columnIdentifiers, builder.CreateForColumns); err != nil {
+		return fmt.Errorf("error associating tag [%v] to columns: %w", tagID, err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(builder.GetTagDatabase(), builder.GetTagSchema(), builder.GetTagName()))
+
+	return ReadTagColumnAssociation(d, meta)
+}
+
+// ReadTagColumnAssociation implements schema.ReadFunc.
+func ReadTagColumnAssociation(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	tagValue := d.Get("tag_value").(string)
+	builder := snowflake.NewTagAssociationBuilder(d.Get("tag_id").(string))
+	columnIdentifiers := expandStringList(d.Get("column_identifiers").(*schema.Set).List())
+
+	// Only the columns that still have the tag with the expected value are kept in the state,
+	// so that the missing ones are re-tagged during the next apply. The columns are kept in their
+	// configured format, so that e.g. unquoted identifiers do not show a diff.
+	references := make(map[string][]snowflake.TagColumnReference)
+	associated := make([]string, 0)
+	for _, columnIdentifier := range columnIdentifiers {
+		c, err := parseColumnIdentifier(columnIdentifier)
+		if err != nil {
+			return err
+		}
+		table := &snowflake.SchemaObjectIdentifier{Database: c.Database, Schema: c.Schema, ObjectName: c.ObjectName}
+		tableReferences, ok := references[table.QualifiedName()]
+		if !ok {
+			tableReferences, err = snowflake.ListTagColumnReferences(builder, table, db)
+			if err != nil {
+				return fmt.Errorf("error listing tag references for table %v err = %w", table.QualifiedName(), err)
+			}
+			references[table.QualifiedName()] = tableReferences
+		}
+		for _, reference := range tableReferences {
+			if strings.EqualFold(reference.ColumnName.String, c.Column) && reference.TagValue.String == tagValue {
+				associated = append(associated, columnIdentifier)
+				break
+			}
+		}
+	}
+
+	if len(associated) == 0 {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] tag column association (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return d.Set("column_identifiers", associated)
+}
+
+// UpdateTagColumnAssociation implements schema.UpdateFunc.
+func UpdateTagColumnAssociation(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	tagID := d.Get("tag_id").(string)
+	builder := snowflake.NewTagAssociationBuilder(tagID).WithTagValue(d.Get("tag_value").(string))
+
+	if d.HasChange("column_identifiers") {
+		o, n := d.GetChange("column_identifiers")
+		removed := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		if len(removed) > 0 {
+			if err := execForColumns(db, removed, builder.DropForColumns); err != nil {
+				return fmt.Errorf("error removing tag [%v] from columns: %w", tagID, err)
+			}
+		}
+	}
+
+	// Setting a tag that is already associated with the column overrides its value,
+	// so on a value change all the columns are re-tagged and otherwise only the new ones.
+	added := expandStringList(d.Get("column_identifiers").(*schema.Set).List())
+	if !d.HasChange("tag_value") {
+		o, n := d.GetChange("column_identifiers")
+		added = expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+	}
+	if len(added) > 0 {
+		if err := execForColumns(db, added, builder.CreateForColumns); err != nil {
+			return fmt.Errorf("error associating tag [%v] to columns: %w", tagID, err)
+		}
+	}
+
+	return ReadTagColumnAssociation(d, meta)
+}
+
+// DeleteTagColumnAssociation implements schema.DeleteFunc.
+func DeleteTagColumnAssociation(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	tagID := d.Get("tag_id").(string)
+	builder := snowflake.NewTagAssociationBuilder(tagID)
+	columnIdentifiers := expandStringList(d.Get("column_identifiers").(*schema.Set).List())
+
+	if err := execForColumns(db, columnIdentifiers, builder.DropForColumns); err != nil {
+		return fmt.Errorf("error deleting tag association for tag [%s]: %w", tagID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TagColumnAssociation(t *testing.T) {
+	tagName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	tableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	column := func(name string) string {
+		return fmt.Sprintf(`"%s"."%s"."%s"."%s"`, acc.TestDatabaseName, acc.TestSchemaName, tableName, name)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: tagColumnAssociationConfig(tagName, tableName, "finance", `["FIRST", "SECOND"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_tag_column_association.test", "tag_value", "finance"),
+					resource.TestCheckResourceAttr("snowflake_tag_column_association.test", "column_identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_tag_column_association.test", "column_identifiers.*", column("FIRST")),
+					resource.TestCheckTypeSetElemAttr("snowflake_tag_column_association.test", "column_identifiers.*", column("SECOND")),
+				),
+			},
+			// CHANGE VALUE AND COLUMNS
+			{
+				Config: tagColumnAssociationConfig(tagName, tableName, "engineering", `["SECOND", "THIRD"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_tag_column_association.test", "tag_value", "engineering"),
+					resource.TestCheckResourceAttr("snowflake_tag_column_association.test", "column_identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_tag_column_association.test", "column_identifiers.*", column("SECOND")),
+					resource.TestCheckTypeSetElemAttr("snowflake_tag_column_association.test", "column_identifiers.*", column("THIRD")),
+				),
+			},
+		},
+	})
+}
+
+func tagColumnAssociationConfig(tagName string, tableName string, tagValue string, columns string) string {
+	return fmt.Sprintf(`
+resource "snowflake_tag" "test" {
+	name     = "%[1]s"
+	database = "%[3]s"
+	schema   = "%[4]s"
+}
+
+resource "snowflake_table" "test" {
+	database = "%[3]s"
+	schema   = "%[4]s"
+	name     = "%[2]s"
+
+	column {
+		name = "FIRST"
+		type = "VARCHAR(16777216)"
+	}
+
+	column {
+		name = "SECOND"
+		type = "VARCHAR(16777216)"
+	}
+
+	column {
+		name = "THIRD"
+		type = "VARCHAR(16777216)"
+	}
+}
+
+resource "snowflake_tag_column_association" "test" {
+	tag_id             = snowflake_tag.test.id
+	tag_value          = "%[5]s"
+	column_identifiers = [for c in %[6]s : "${snowflake_table.test.qualified_name}.\"${c}\""]
+}
+`, tagName, tableName, acc.TestDatabaseName, acc.TestSchemaName, tagValue, columns)
+}
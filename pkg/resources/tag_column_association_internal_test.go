@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseColumnIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	c, err := parseColumnIdentifier(`"db"."schema"."table"."column"`)
+	r.NoError(err)
+	r.Equal(&snowflake.ColumnIdentifier{Database: "db", Schema: "schema", ObjectName: "table", Column: "column"}, c)
+
+	c, err = parseColumnIdentifier(`DB.SCHEMA.TABLE.COLUMN`)
+	r.NoError(err)
+	r.Equal(&snowflake.ColumnIdentifier{Database: "DB", Schema: "SCHEMA", ObjectName: "TABLE", Column: "COLUMN"}, c)
+
+	c, err = parseColumnIdentifier(`"db"."schema"."table.with.dots"."column.v1"`)
+	r.NoError(err)
+	r.Equal(&snowflake.ColumnIdentifier{Database: "db", Schema: "schema", ObjectName: "table.with.dots", Column: "column.v1"}, c)
+
+	_, err = parseColumnIdentifier(`"db"."schema"."table"`)
+	r.ErrorContains(err, "is not a valid column identifier")
+}
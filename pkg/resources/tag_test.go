@@ -10,6 +10,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,7 +60,6 @@ func TestTagUpdate(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" SET COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" UNSET ALLOWED_VALUES$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" ADD ALLOWED_VALUES 'marketing', 'finance'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
@@ -68,6 +68,48 @@ func TestTagUpdate(t *testing.T) {
 	})
 }
 
+func TestTagUpdateAllowedValuesAndMaskingPolicies(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "test_db|test_schema|good_name",
+		Attributes: map[string]string{
+			"name":               "good_name",
+			"database":           "test_db",
+			"schema":             "test_schema",
+			"comment":            "great comment",
+			"allowed_values.#":   "2",
+			"allowed_values.0":   "al1",
+			"allowed_values.1":   "al2",
+			"masking_policies.#": "1",
+			"masking_policies.0": "test_db|test_schema|mp_old",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"allowed_values.1":   {Old: "al2", New: "al3"},
+			"masking_policies.0": {Old: "test_db|test_schema|mp_old", New: "test_db|test_schema|mp_new"},
+		},
+	}
+	d, err := schema.InternalMap(resources.Tag().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" DROP ALLOWED_VALUES 'al2'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" ADD ALLOWED_VALUES 'al3'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" UNSET MASKING POLICY "test_db"."test_schema"."mp_old"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" SET MASKING POLICY "test_db"."test_schema"."mp_new"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		expectReadTag(mock)
+		rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_DATABASE_NAME", "REF_SCHEMA_NAME", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN", "REF_COLUMN_NAME", "REF_ARG_COLUMN_NAMES", "TAG_DATABASE", "TAG_SCHEMA", "TAG_NAME", "POLICY_STATUS"}).
+			AddRow("test_db", "test_schema", "mp_new", "MASKING_POLICY", "test_db", "test_schema", "good_name", "TAG", nil, nil, nil, nil, nil, "ACTIVE")
+		mock.ExpectQuery(`POLICY_REFERENCES.*REF_ENTITY_DOMAIN => 'TAG'`).WillReturnRows(rows)
+		err := resources.UpdateTag(d, db)
+		r.NoError(err)
+		r.Equal([]interface{}{"test_db|test_schema|mp_new"}, d.Get("masking_policies").(*schema.Set).List())
+	})
+}
+
 func TestTagDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -16,7 +17,6 @@ import (
 	"golang.org/x/exp/slices"
 )
 
-// TODO [SNOW-884987]: add missing SUSPEND_TASK_AFTER_NUM_FAILURES attribute.
 var taskSchema = map[string]*schema.Schema{
 	"enabled": {
 		Type:        schema.TypeBool,
@@ -52,8 +52,8 @@ var taskSchema = map[string]*schema.Schema{
 	"schedule": {
 		Type:          schema.TypeString,
 		Optional:      true,
-		Description:   "The schedule for periodically running the task. This can be a cron or interval in minutes. (Conflict with after)",
-		ConflictsWith: []string{"after"},
+		Description:   "The schedule for periodically running the task. This can be a cron or interval in minutes. (Conflict with after and finalize)",
+		ConflictsWith: []string{"after", "finalize"},
 	},
 	"session_parameters": {
 		Type:        schema.TypeMap,
@@ -76,8 +76,14 @@ var taskSchema = map[string]*schema.Schema{
 		Type:          schema.TypeList,
 		Elem:          &schema.Schema{Type: schema.TypeString},
 		Optional:      true,
-		Description:   "Specifies one or more predecessor tasks for the current task. Use this option to create a DAG of tasks or add this task to an existing DAG. A DAG is a series of tasks that starts with a scheduled root task and is linked together by dependencies.",
-		ConflictsWith: []string{"schedule"},
+		Description:   "Specifies one or more predecessor tasks for the current task. Use this option to create a DAG of tasks or add this task to an existing DAG. A DAG is a series of tasks that starts with a scheduled root task and is linked together by dependencies. Alternatively, dependencies can be managed with the `snowflake_task_dependency` resource.",
+		ConflictsWith: []string{"schedule", "finalize"},
+	},
+	"finalize": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Specifies the name of a root task in the same schema that this finalizer task is associated with. A finalizer task runs after all other tasks in the DAG complete, regardless of their result. (Conflicts with schedule and after)",
+		ConflictsWith: []string{"schedule", "after"},
 	},
 	"when": {
 		Type:        schema.TypeString,
@@ -100,6 +106,18 @@ var taskSchema = map[string]*schema.Schema{
 		Description:   "Specifies the size of the compute resources to provision for the first run of the task, before a task history is available for Snowflake to determine an ideal size. Once a task has successfully completed a few runs, Snowflake ignores this parameter setting. (Conflicts with warehouse)",
 		ConflictsWith: []string{"warehouse"},
 	},
+	"suspend_task_after_num_failures": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of consecutive failed task runs after which the current task is suspended automatically. The default is 0 (no automatic suspension).",
+	},
+	"task_auto_retry_attempts": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, 30),
+		Description:  "Specifies the number of automatic task graph retry attempts. If any task graphs complete in a FAILED state, Snowflake can automatically retry the task graphs from the last task in the graph that failed. Can only be set on a root task.",
+	},
 	"error_integration": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -199,6 +217,14 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	finalize := ""
+	if task.TaskRelations.FinalizedRootTask != nil {
+		finalize = task.TaskRelations.FinalizedRootTask.Name()
+	}
+	if err := d.Set("finalize", finalize); err != nil {
+		return err
+	}
+
 	if err := d.Set("when", task.Condition); err != nil {
 		return err
 	}
@@ -217,6 +243,8 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		sessionParameters := map[string]interface{}{}
 		fieldParameters := map[string]interface{}{
 			"user_task_managed_initial_warehouse_size": "",
+			"suspend_task_after_num_failures":          0,
+			"task_auto_retry_attempts":                 0,
 		}
 
 		for _, param := range params {
@@ -233,6 +261,18 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				fieldParameters["user_task_timeout_ms"] = timeout
+			case "SUSPEND_TASK_AFTER_NUM_FAILURES":
+				num, err := strconv.Atoi(param.Value)
+				if err != nil {
+					return err
+				}
+				fieldParameters["suspend_task_after_num_failures"] = num
+			case "TASK_AUTO_RETRY_ATTEMPTS":
+				num, err := strconv.Atoi(param.Value)
+				if err != nil {
+					return err
+				}
+				fieldParameters["task_auto_retry_attempts"] = num
 			default:
 				sessionParameters[param.Key] = param.Value
 			}
@@ -299,6 +339,14 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 		createRequest.WithUserTaskTimeoutMs(sdk.Int(v.(int)))
 	}
 
+	if v, ok := d.GetOk("suspend_task_after_num_failures"); ok {
+		createRequest.WithSuspendTaskAfterNumFailures(sdk.Int(v.(int)))
+	}
+
+	if v, ok := d.GetOk("task_auto_retry_attempts"); ok {
+		createRequest.WithTaskAutoRetryAttempts(sdk.Int(v.(int)))
+	}
+
 	if v, ok := d.GetOk("comment"); ok {
 		createRequest.WithComment(sdk.String(v.(string)))
 	}
@@ -339,6 +387,22 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 		createRequest.WithAfter(precedingTasks)
 	}
 
+	if v, ok := d.GetOk("finalize"); ok {
+		rootTaskId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, v.(string))
+		rootTask, err := client.Tasks.ShowByID(ctx, rootTaskId)
+		if err != nil {
+			return err
+		}
+		// the root task needs to be suspended before a finalizer can be attached to it
+		if rootTask.IsStarted() {
+			if err := suspendTask(ctx, client, rootTaskId); err != nil {
+				return err
+			}
+			defer func() { _ = resumeTask(ctx, client, rootTaskId) }()
+		}
+		createRequest.WithFinalize(&rootTaskId)
+	}
+
 	if v, ok := d.GetOk("when"); ok {
 		createRequest.WithWhen(sdk.String(v.(string)))
 	}
@@ -445,8 +509,73 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 			alterRequest := sdk.NewAlterTaskRequest(taskId).WithSet(sdk.NewTaskSetRequest().WithUserTaskManagedInitialWarehouseSize(&size))
 			err = client.Tasks.Alter(ctx, alterRequest)
 			if err != nil {
-				return fmt.Errorf("error updating user_task_managed_initial_warehouse_size on task %s", taskId.FullyQualifiedName())
+				return fmt.Errorf("error updating user_task_managed_initial_warehouse_size on task %s err = %w", taskId.FullyQualifiedName(), err)
 			}
+		} else if newSize == "" {
+			alterRequest := sdk.NewAlterTaskRequest(taskId).WithUnset(sdk.NewTaskUnsetRequest().WithUserTaskManagedInitialWarehouseSize(sdk.Bool(true)))
+			if err := client.Tasks.Alter(ctx, alterRequest); err != nil {
+				return fmt.Errorf("error unsetting user_task_managed_initial_warehouse_size on task %s err = %w", taskId.FullyQualifiedName(), err)
+			}
+		}
+	}
+
+	if d.HasChange("suspend_task_after_num_failures") {
+		o, n := d.GetChange("suspend_task_after_num_failures")
+		alterRequest := sdk.NewAlterTaskRequest(taskId)
+		if o.(int) > 0 && n.(int) == 0 {
+			alterRequest.WithUnset(sdk.NewTaskUnsetRequest().WithSuspendTaskAfterNumFailures(sdk.Bool(true)))
+		} else {
+			alterRequest.WithSet(sdk.NewTaskSetRequest().WithSuspendTaskAfterNumFailures(sdk.Int(n.(int))))
+		}
+		if err := client.Tasks.Alter(ctx, alterRequest); err != nil {
+			return fmt.Errorf("error updating suspend_task_after_num_failures on task %s err = %w", taskId.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("task_auto_retry_attempts") {
+		o, n := d.GetChange("task_auto_retry_attempts")
+		alterRequest := sdk.NewAlterTaskRequest(taskId)
+		if o.(int) > 0 && n.(int) == 0 {
+			alterRequest.WithUnset(sdk.NewTaskUnsetRequest().WithTaskAutoRetryAttempts(sdk.Bool(true)))
+		} else {
+			alterRequest.WithSet(sdk.NewTaskSetRequest().WithTaskAutoRetryAttempts(sdk.Int(n.(int))))
+		}
+		if err := client.Tasks.Alter(ctx, alterRequest); err != nil {
+			return fmt.Errorf("error updating task_auto_retry_attempts on task %s err = %w", taskId.FullyQualifiedName(), err)
+		}
+	}
+
+	if d.HasChange("finalize") {
+		// both the old and the new root task need to be suspended before the finalizer can be detached from or attached to them
+		o, n := d.GetChange("finalize")
+		for _, rootTaskName := range []string{o.(string), n.(string)} {
+			if rootTaskName == "" {
+				continue
+			}
+			rootTaskId := sdk.NewSchemaObjectIdentifier(taskId.DatabaseName(), taskId.SchemaName(), rootTaskName)
+			rootTask, err := client.Tasks.ShowByID(ctx, rootTaskId)
+			// the old root task may have been dropped already, leaving nothing to suspend
+			if rootTaskName == o.(string) && errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if rootTask.IsStarted() {
+				if err := suspendTask(ctx, client, rootTaskId); err != nil {
+					return err
+				}
+				defer func() { _ = resumeTask(ctx, client, rootTaskId) }()
+			}
+		}
+		alterRequest := sdk.NewAlterTaskRequest(taskId)
+		if n.(string) != "" {
+			alterRequest.WithSetFinalize(sdk.Pointer(sdk.NewSchemaObjectIdentifier(taskId.DatabaseName(), taskId.SchemaName(), n.(string))))
+		} else {
+			alterRequest.WithUnsetFinalize(sdk.Bool(true))
+		}
+		if err := client.Tasks.Alter(ctx, alterRequest); err != nil {
+			return fmt.Errorf("error updating finalize on task %s err = %w", taskId.FullyQualifiedName(), err)
 		}
 	}
 
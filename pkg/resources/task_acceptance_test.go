@@ -624,6 +624,85 @@ resource "snowflake_task" "test_task" {
 	return fmt.Sprintf(s, taskRootName, databaseName, schemaName, name, databaseName, schemaName)
 }
 
+func TestAcc_Task_ServerlessWithFinalizer(t *testing.T) {
+	rootName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	finalizerName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: taskConfigServerlessWithFinalizer(rootName, finalizerName, acc.TestDatabaseName, acc.TestSchemaName, 2, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task.root", "user_task_managed_initial_warehouse_size", "XSMALL"),
+					resource.TestCheckResourceAttr("snowflake_task.root", "warehouse", ""),
+					resource.TestCheckResourceAttr("snowflake_task.root", "task_auto_retry_attempts", "2"),
+					resource.TestCheckResourceAttr("snowflake_task.root", "suspend_task_after_num_failures", "3"),
+					resource.TestCheckResourceAttr("snowflake_task.finalizer", "finalize", rootName),
+					resource.TestCheckResourceAttr("snowflake_task.finalizer", "after.#", "0"),
+				),
+			},
+			// update retry settings in place
+			{
+				Config: taskConfigServerlessWithFinalizer(rootName, finalizerName, acc.TestDatabaseName, acc.TestSchemaName, 0, 5, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task.root", "task_auto_retry_attempts", "0"),
+					resource.TestCheckResourceAttr("snowflake_task.root", "suspend_task_after_num_failures", "5"),
+					resource.TestCheckResourceAttr("snowflake_task.finalizer", "finalize", rootName),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_task.finalizer",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"session_parameters"},
+			},
+			// detach the finalizer from the started root task
+			{
+				Config: taskConfigServerlessWithFinalizer(rootName, finalizerName, acc.TestDatabaseName, acc.TestSchemaName, 0, 5, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task.finalizer", "finalize", ""),
+					resource.TestCheckResourceAttr("snowflake_task.root", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func taskConfigServerlessWithFinalizer(rootName string, finalizerName string, databaseName string, schemaName string, retryAttempts int, suspendAfterFailures int, finalize bool) string {
+	finalizeAttribute := ""
+	if finalize {
+		finalizeAttribute = "finalize                                 = snowflake_task.root.name"
+	}
+	s := `
+resource "snowflake_task" "root" {
+	name                                     = "%s"
+	database                                 = "%s"
+	schema                                   = "%s"
+	sql_statement                            = "SELECT 1"
+	enabled                                  = true
+	schedule                                 = "5 MINUTE"
+	user_task_managed_initial_warehouse_size = "XSMALL"
+	task_auto_retry_attempts                 = %d
+	suspend_task_after_num_failures          = %d
+}
+
+resource "snowflake_task" "finalizer" {
+	name                                     = "%s"
+	database                                 = "%s"
+	schema                                   = "%s"
+	sql_statement                            = "SELECT 1"
+	enabled                                  = true
+	user_task_managed_initial_warehouse_size = "XSMALL"
+	%s
+}
+`
+	return fmt.Sprintf(s, rootName, databaseName, schemaName, retryAttempts, suspendAfterFailures, finalizerName, databaseName, schemaName, finalizeAttribute)
+}
+
 func checkInt64(name, key string, value int64) func(*terraform.State) error {
 	return func(state *terraform.State) error {
 		return resource.TestCheckResourceAttr(name, key, fmt.Sprintf("%v", value))(state)
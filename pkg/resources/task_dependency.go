@@ -0,0 +1,176 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var taskDependencySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which the tasks are located.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which the tasks are located.",
+	},
+	"task": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the task that runs after the predecessor task.",
+	},
+	"predecessor": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the predecessor task that has to complete before the task runs.",
+	},
+}
+
+// TaskDependency returns a pointer to the resource representing a single predecessor edge of a task DAG.
+func TaskDependency() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single predecessor (AFTER) dependency between two tasks, so that the edges of a task DAG can be managed independently of the tasks themselves. " +
+			"Do not set `after` on a `snowflake_task` whose dependencies are managed by this resource; use `lifecycle { ignore_changes = [after] }` on it instead.",
+
+		Create: CreateTaskDependency,
+		Read:   ReadTaskDependency,
+		Delete: DeleteTaskDependency,
+
+		Schema: taskDependencySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func taskDependencyIDFromString(id string) (sdk.SchemaObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid task dependency id %s, expected format: `databaseName|schemaName|taskName|predecessorName`", id)
+	}
+	return sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[2]), sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[3]), nil
+}
+
+// alterTaskDependency suspends the task and the root tasks of the predecessor for the time of the DAG modification.
+func alterTaskDependency(ctx context.Context, client *sdk.Client, taskId sdk.SchemaObjectIdentifier, predecessorId sdk.SchemaObjectIdentifier, request *sdk.AlterTaskRequest) error {
+	task, err := client.Tasks.ShowByID(ctx, taskId)
+	if err != nil {
+		return err
+	}
+	if task.IsStarted() {
+		if err := suspendTask(ctx, client, taskId); err != nil {
+			return err
+		}
+		defer func() { _ = resumeTask(ctx, client, taskId) }()
+	}
+
+	rootTasks, err := sdk.GetRootTasks(client.Tasks, ctx, predecessorId)
+	if err != nil {
+		return err
+	}
+	for _, rootTask := range rootTasks {
+		if rootTask.IsStarted() {
+			if err := suspendTask(ctx, client, rootTask.ID()); err != nil {
+				return err
+			}
+			defer func(identifier sdk.SchemaObjectIdentifier) { _ = resumeTask(ctx, client, identifier) }(rootTask.ID())
+		}
+	}
+
+	return client.Tasks.Alter(ctx, request)
+}
+
+// CreateTaskDependency implements schema.CreateFunc.
+func CreateTaskDependency(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	taskId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, d.Get("task").(string))
+	predecessorId := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, d.Get("predecessor").(string))
+
+	request := sdk.NewAlterTaskRequest(taskId).WithAddAfter([]sdk.SchemaObjectIdentifier{predecessorId})
+	if err := alterTaskDependency(ctx, client, taskId, predecessorId, request); err != nil {
+		return fmt.Errorf("error adding predecessor %v to task %v err = %w", predecessorId.FullyQualifiedName(), taskId.FullyQualifiedName(), err)
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName, taskId.Name(), predecessorId.Name()))
+
+	return ReadTaskDependency(d, meta)
+}
+
+// ReadTaskDependency implements schema.ReadFunc.
+func ReadTaskDependency(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	taskId, predecessorId, err := taskDependencyIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	task, err := client.Tasks.ShowByID(ctx, taskId)
+	if err != nil {
+		log.Printf("[DEBUG] task (%s) not found", taskId.FullyQualifiedName())
+		d.SetId("")
+		return nil
+	}
+
+	for _, predecessor := range task.Predecessors {
+		if predecessor.Name() == predecessorId.Name() {
+			if err := d.Set("database", taskId.DatabaseName()); err != nil {
+				return err
+			}
+			if err := d.Set("schema", taskId.SchemaName()); err != nil {
+				return err
+			}
+			if err := d.Set("task", taskId.Name()); err != nil {
+				return err
+			}
+			if err := d.Set("predecessor", predecessorId.Name()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] task (%s) does not run after (%s)", taskId.FullyQualifiedName(), predecessorId.FullyQualifiedName())
+	d.SetId("")
+	return nil
+}
+
+// DeleteTaskDependency implements schema.DeleteFunc.
+func DeleteTaskDependency(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	taskId, predecessorId, err := taskDependencyIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := sdk.NewAlterTaskRequest(taskId).WithRemoveAfter([]sdk.SchemaObjectIdentifier{predecessorId})
+	if err := alterTaskDependency(ctx, client, taskId, predecessorId, request); err != nil {
+		return fmt.Errorf("error removing predecessor %v from task %v err = %w", predecessorId.FullyQualifiedName(), taskId.FullyQualifiedName(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_TaskDependency(t *testing.T) {
+	rootName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	childName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: taskDependencyConfig(rootName, childName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_task_dependency.test", "database", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr("snowflake_task_dependency.test", "schema", acc.TestSchemaName),
+					resource.TestCheckResourceAttr("snowflake_task_dependency.test", "task", childName),
+					resource.TestCheckResourceAttr("snowflake_task_dependency.test", "predecessor", rootName),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_task_dependency.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func taskDependencyConfig(rootName string, childName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_task" "root" {
+	database                                 = "%[1]s"
+	schema                                   = "%[2]s"
+	name                                     = "%[3]s"
+	sql_statement                            = "SELECT 1"
+	schedule                                 = "5 MINUTE"
+	user_task_managed_initial_warehouse_size = "XSMALL"
+	enabled                                  = true
+}
+
+resource "snowflake_task" "child" {
+	database                                 = "%[1]s"
+	schema                                   = "%[2]s"
+	name                                     = "%[4]s"
+	sql_statement                            = "SELECT 1"
+	user_task_managed_initial_warehouse_size = "XSMALL"
+	enabled                                  = false
+
+	lifecycle {
+		ignore_changes = [after]
+	}
+}
+
+resource "snowflake_task_dependency" "test" {
+	database    = "%[1]s"
+	schema      = "%[2]s"
+	task        = snowflake_task.child.name
+	predecessor = snowflake_task.root.name
+}
+`, acc.TestDatabaseName, acc.TestSchemaName, rootName, childName)
+}
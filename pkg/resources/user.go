@@ -4,16 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
 var userProperties = []string{
+	"user_type",
 	"comment",
 	"login_name",
 	"password",
@@ -22,6 +26,8 @@ var userProperties = []string{
 	"default_role",
 	"default_secondary_roles",
 	"default_warehouse",
+	"network_policy",
+	"authentication_policy",
 	"rsa_public_key",
 	"rsa_public_key_2",
 	"must_change_password",
@@ -31,6 +37,8 @@ var userProperties = []string{
 	"last_name",
 }
 
+var userTypes = []string{string(sdk.UserTypePerson), string(sdk.UserTypeService), string(sdk.UserTypeLegacyService)}
+
 var diffCaseInsensitive = func(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(old, new)
 }
@@ -42,6 +50,13 @@ var userSchema = map[string]*schema.Schema{
 		Sensitive:   true,
 		Description: "Name of the user. Note that if you do not supply login_name this will be used as login_name. [doc](https://docs.snowflake.net/manuals/sql-reference/sql/create-user.html#required-parameters)",
 	},
+	"user_type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      fmt.Sprintf("Specifies the type of the user. Service users represent workload identities and cannot have a password, must_change_password, first_name or last_name. Valid values are (case-insensitive): %s. If not set, the user behaves as a PERSON.", strings.Join(userTypes, " | ")),
+		ValidateFunc:     validation.StringInSlice(userTypes, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
 	"login_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -86,10 +101,25 @@ var userSchema = map[string]*schema.Schema{
 		Description: "Specifies the role that is active by default for the user’s session upon login.",
 	},
 	"default_secondary_roles": {
-		Type:        schema.TypeSet,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"ALL"}, false),
+		},
 		Optional:    true,
-		Description: "Specifies the set of secondary roles that are active for the user’s session upon login. Currently only [\"ALL\"] value is supported - more information can be found in [doc](https://docs.snowflake.com/en/sql-reference/sql/create-user#optional-object-properties-objectproperties)",
+		Description: "Specifies the set of secondary roles that are active for the user’s session upon login. Currently only [\"ALL\"] value is supported - more information can be found in [doc](https://docs.snowflake.com/en/sql-reference/sql/create-user#optional-object-properties-objectproperties). Removing the attribute unsets the default secondary roles.",
+	},
+	"network_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: diffCaseInsensitive,
+		Description:      "Specifies the network policy enforced for the user, overriding the network policy of the account.",
+	},
+	"authentication_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Fully qualified name (`\"db\".\"schema\".\"policy_name\"`) of the authentication policy attached to the user. Do not use it together with the snowflake_user_authentication_policy_attachment resource for the same user.",
 	},
 	"rsa_public_key": {
 		Type:        schema.TypeString,
@@ -106,6 +136,16 @@ var userSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "Will be true if user as an RSA key set.",
 	},
+	"rsa_public_key_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The SHA256 fingerprint of the user’s RSA public key, which can be compared with the fingerprint of the private key used by the clients.",
+	},
+	"rsa_public_key_2_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The SHA256 fingerprint of the user’s second RSA public key.",
+	},
 	"must_change_password": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -159,9 +199,32 @@ func User() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customdiff.All(
+			validateServiceUser,
+			customdiff.ComputedIf("rsa_public_key_fp", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange("rsa_public_key")
+			}),
+			customdiff.ComputedIf("rsa_public_key_2_fp", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange("rsa_public_key_2")
+			}),
+		),
 	}
 }
 
+// validateServiceUser rejects the attributes that cannot be set on the users of type SERVICE.
+func validateServiceUser(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !strings.EqualFold(d.Get("user_type").(string), string(sdk.UserTypeService)) {
+		return nil
+	}
+	for _, key := range []string{"password", "must_change_password", "first_name", "last_name"} {
+		if _, ok := d.GetOk(key); ok {
+			return fmt.Errorf("%s cannot be set for a user of type %s", key, sdk.UserTypeService)
+		}
+	}
+	return nil
+}
+
 func CreateUser(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
@@ -175,6 +238,9 @@ func CreateUser(d *schema.ResourceData, meta interface{}) error {
 	ctx := context.Background()
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
 
+	if userType, ok := d.GetOk("user_type"); ok {
+		opts.ObjectProperties.Type = sdk.Pointer(sdk.UserType(strings.ToUpper(userType.(string))))
+	}
 	if loginName, ok := d.GetOk("login_name"); ok {
 		opts.ObjectProperties.LoginName = sdk.String(loginName.(string))
 	}
@@ -228,11 +294,21 @@ func CreateUser(d *schema.ResourceData, meta interface{}) error {
 	if lastName, ok := d.GetOk("last_name"); ok {
 		opts.ObjectProperties.LastName = sdk.String(lastName.(string))
 	}
+	if networkPolicy, ok := d.GetOk("network_policy"); ok {
+		opts.ObjectParameters.NetworkPolicy = sdk.String(networkPolicy.(string))
+	}
 	err := client.Users.Create(ctx, objectIdentifier, opts)
 	if err != nil {
 		return err
 	}
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+
+	if authenticationPolicy, ok := d.GetOk("authentication_policy"); ok {
+		err := client.Users.Alter(ctx, objectIdentifier, &sdk.AlterUserOptions{Set: &sdk.UserSet{AuthenticationPolicy: sdk.String(authenticationPolicy.(string))}})
+		if err != nil {
+			return fmt.Errorf("error attaching authentication policy to user %v err = %w", name, err)
+		}
+	}
 	return ReadUser(d, meta)
 }
 
@@ -257,6 +333,9 @@ func ReadUser(d *schema.ResourceData, meta interface{}) error {
 	if err := setStringProperty(d, "name", user.Name); err != nil {
 		return err
 	}
+	if err := setStringProperty(d, "user_type", user.Type); err != nil {
+		return err
+	}
 	if err := setStringProperty(d, "comment", user.Comment); err != nil {
 		return err
 	}
@@ -290,6 +369,12 @@ func ReadUser(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if err := setStringProperty(d, "rsa_public_key_fp", user.RsaPublicKeyFp); err != nil {
+		return err
+	}
+	if err := setStringProperty(d, "rsa_public_key_2_fp", user.RsaPublicKey2Fp); err != nil {
+		return err
+	}
 	if err := setStringProperty(d, "email", user.Email); err != nil {
 		return err
 	}
@@ -302,7 +387,38 @@ func ReadUser(d *schema.ResourceData, meta interface{}) error {
 	if err := setStringProperty(d, "last_name", user.LastName); err != nil {
 		return err
 	}
-	return nil
+	return readUserPolicies(ctx, client, d, objectIdentifier)
+}
+
+// readUserPolicies reads the network policy set on the user itself. The authentication policy is only read when it is
+// managed by the resource, as reading the policy references requires a warehouse and is not needed by most users.
+func readUserPolicies(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	parameter, err := client.Parameters.ShowObjectParameter(ctx, sdk.ObjectParameterNetworkPolicy, sdk.Object{ObjectType: sdk.ObjectTypeUser, Name: id})
+	if err != nil {
+		return err
+	}
+	networkPolicy := ""
+	if strings.EqualFold(string(parameter.Level), string(sdk.ObjectTypeUser)) {
+		networkPolicy = parameter.Value
+	}
+	if err := d.Set("network_policy", networkPolicy); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("authentication_policy"); !ok {
+		return nil
+	}
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, id.FullyQualifiedName(), sdk.PolicyEntityDomainUser)
+	if err != nil {
+		return err
+	}
+	authenticationPolicy := ""
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindAuthenticationPolicy {
+			authenticationPolicy = policyReference.PolicyID().FullyQualifiedName()
+		}
+	}
+	return d.Set("authentication_policy", authenticationPolicy)
 }
 
 func UpdateUser(d *schema.ResourceData, meta interface{}) error {
@@ -326,6 +442,24 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 		d.SetId(helpers.EncodeSnowflakeID(newID))
 		id = newID
 	}
+	passwordUnset := false
+	if d.HasChange("user_type") {
+		alterOptions := &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: &sdk.UserObjectPropertiesUnset{Type: sdk.Bool(true)}}}
+		if userType := d.Get("user_type").(string); userType != "" {
+			alterOptions = &sdk.AlterUserOptions{Set: &sdk.UserSet{ObjectProperties: &sdk.UserObjectProperties{Type: sdk.Pointer(sdk.UserType(strings.ToUpper(userType)))}}}
+		}
+		// a user cannot become a service user while it still has a password
+		if _, ok := d.GetOk("password"); !ok && d.HasChange("password") {
+			err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: &sdk.UserObjectPropertiesUnset{Password: sdk.Bool(true)}}})
+			if err != nil {
+				return err
+			}
+			passwordUnset = true
+		}
+		if err := client.Users.Alter(ctx, id, alterOptions); err != nil {
+			return fmt.Errorf("error updating type of user %v err = %w", id.Name(), err)
+		}
+	}
 	runSet := false
 	alterOptions := &sdk.AlterUserOptions{
 		Set: &sdk.UserSet{
@@ -342,7 +476,7 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 		_, n := d.GetChange("comment")
 		alterOptions.Set.ObjectProperties.Comment = sdk.String(n.(string))
 	}
-	if d.HasChange("password") {
+	if d.HasChange("password") && !passwordUnset {
 		runSet = true
 		_, n := d.GetChange("password")
 		alterOptions.Set.ObjectProperties.Password = sdk.String(n.(string))
@@ -370,24 +504,21 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 		alterOptions.Set.ObjectProperties.DefaultRole = sdk.String(n.(string))
 	}
 	if d.HasChange("default_secondary_roles") {
-		runSet = true
 		_, n := d.GetChange("default_secondary_roles")
 		roles := expandStringList(n.(*schema.Set).List())
-		secondaryRoles := []sdk.SecondaryRole{}
-		for _, role := range roles {
-			secondaryRoles = append(secondaryRoles, sdk.SecondaryRole{Value: role})
+		if len(roles) > 0 {
+			runSet = true
+			secondaryRoles := []sdk.SecondaryRole{}
+			for _, role := range roles {
+				secondaryRoles = append(secondaryRoles, sdk.SecondaryRole{Value: role})
+			}
+			alterOptions.Set.ObjectProperties.DefaultSeconaryRoles = &sdk.SecondaryRoles{Roles: secondaryRoles}
+		} else {
+			err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: &sdk.UserObjectPropertiesUnset{DefaultSeconaryRoles: sdk.Bool(true)}}})
+			if err != nil {
+				return err
+			}
 		}
-		alterOptions.Set.ObjectProperties.DefaultSeconaryRoles = &sdk.SecondaryRoles{Roles: secondaryRoles}
-	}
-	if d.HasChange("rsa_public_key") {
-		runSet = true
-		_, n := d.GetChange("rsa_public_key")
-		alterOptions.Set.ObjectProperties.RSAPublicKey = sdk.String(n.(string))
-	}
-	if d.HasChange("rsa_public_key_2") {
-		runSet = true
-		_, n := d.GetChange("rsa_public_key_2")
-		alterOptions.Set.ObjectProperties.RSAPublicKey2 = sdk.String(n.(string))
 	}
 	if d.HasChange("must_change_password") {
 		runSet = true
@@ -421,10 +552,76 @@ func UpdateUser(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if err := updateUserRSAPublicKeys(ctx, client, d, id); err != nil {
+		return err
+	}
+	if err := updateUserPolicies(ctx, client, d, id); err != nil {
+		return err
+	}
 
 	return ReadUser(d, meta)
 }
 
+// updateUserRSAPublicKeys sets the new RSA public keys before unsetting the removed ones, so that during a rotation
+// the user can always authenticate with at least one of the keys.
+func updateUserRSAPublicKeys(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	set := &sdk.UserObjectProperties{}
+	unset := &sdk.UserObjectPropertiesUnset{}
+	if d.HasChange("rsa_public_key") {
+		if v, ok := d.GetOk("rsa_public_key"); ok {
+			set.RSAPublicKey = sdk.String(v.(string))
+		} else {
+			unset.RSAPublicKey = sdk.Bool(true)
+		}
+	}
+	if d.HasChange("rsa_public_key_2") {
+		if v, ok := d.GetOk("rsa_public_key_2"); ok {
+			set.RSAPublicKey2 = sdk.String(v.(string))
+		} else {
+			unset.RSAPublicKey2 = sdk.Bool(true)
+		}
+	}
+	if set.RSAPublicKey != nil || set.RSAPublicKey2 != nil {
+		if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Set: &sdk.UserSet{ObjectProperties: set}}); err != nil {
+			return fmt.Errorf("error setting RSA public keys of user %v err = %w", id.Name(), err)
+		}
+	}
+	if unset.RSAPublicKey != nil || unset.RSAPublicKey2 != nil {
+		if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: unset}}); err != nil {
+			return fmt.Errorf("error unsetting RSA public keys of user %v err = %w", id.Name(), err)
+		}
+	}
+	return nil
+}
+
+// updateUserPolicies sets or unsets the network policy and the authentication policy of the user. A user can have
+// only one authentication policy, so the previous one is detached before the new one is attached.
+func updateUserPolicies(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	if d.HasChange("network_policy") {
+		opts := &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectParameters: &sdk.UserObjectParametersUnset{NetworkPolicy: sdk.Bool(true)}}}
+		if networkPolicy := d.Get("network_policy").(string); networkPolicy != "" {
+			opts = &sdk.AlterUserOptions{Set: &sdk.UserSet{ObjectParameters: &sdk.UserObjectParameters{NetworkPolicy: sdk.String(networkPolicy)}}}
+		}
+		if err := client.Users.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating network policy of user %v err = %w", id.Name(), err)
+		}
+	}
+	if d.HasChange("authentication_policy") {
+		o, n := d.GetChange("authentication_policy")
+		if o.(string) != "" {
+			if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{AuthenticationPolicy: sdk.Bool(true)}}); err != nil {
+				return fmt.Errorf("error detaching authentication policy from user %v err = %w", id.Name(), err)
+			}
+		}
+		if n.(string) != "" {
+			if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Set: &sdk.UserSet{AuthenticationPolicy: sdk.String(n.(string))}}); err != nil {
+				return fmt.Errorf("error attaching authentication policy to user %v err = %w", id.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
 func DeleteUser(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	client := sdk.NewClientFromDB(db)
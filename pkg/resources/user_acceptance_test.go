@@ -3,6 +3,7 @@ package resources_test
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -60,6 +61,8 @@ func TestAcc_User(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_user.w", "default_secondary_roles.0", "ALL"),
 					resource.TestCheckResourceAttr("snowflake_user.w", "default_namespace", "FOO"),
 					checkBool("snowflake_user.w", "has_rsa_public_key", true),
+					resource.TestCheckResourceAttrSet("snowflake_user.w", "rsa_public_key_fp"),
+					resource.TestCheckResourceAttrSet("snowflake_user.w", "rsa_public_key_2_fp"),
 					checkBool("snowflake_user.w", "must_change_password", true),
 				),
 			},
@@ -99,6 +102,8 @@ func TestAcc_User(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_user.w", "default_secondary_roles.#", "0"),
 					resource.TestCheckResourceAttr("snowflake_user.w", "default_namespace", "BAR"),
 					checkBool("snowflake_user.w", "has_rsa_public_key", false),
+					resource.TestCheckResourceAttr("snowflake_user.w", "rsa_public_key_fp", ""),
+					resource.TestCheckResourceAttr("snowflake_user.w", "rsa_public_key_2_fp", ""),
 				),
 			},
 			// IMPORT
@@ -162,3 +167,100 @@ resource "snowflake_user" "w" {
 	log.Printf("[DEBUG] s2 %s", s)
 	return fmt.Sprintf(s, prefix, prefix)
 }
+
+func TestAcc_User_Type(t *testing.T) {
+	r := require.New(t)
+	name := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	sshkey1, err := testhelpers.Fixture("userkey1")
+	r.NoError(err)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      userTypeConfig(name, "SERVICE", `password = "best password"`),
+				ExpectError: regexp.MustCompile("password cannot be set for a user of type SERVICE"),
+			},
+			{
+				Config: userTypeConfig(name, "SERVICE", fmt.Sprintf("rsa_public_key = <<KEY\n%s\nKEY", sshkey1)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user.w", "name", name),
+					resource.TestCheckResourceAttr("snowflake_user.w", "user_type", "SERVICE"),
+					checkBool("snowflake_user.w", "has_rsa_public_key", true),
+				),
+			},
+			// CHANGE TYPE
+			{
+				Config: userTypeConfig(name, "LEGACY_SERVICE", `password = "best password"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user.w", "user_type", "LEGACY_SERVICE"),
+					resource.TestCheckResourceAttr("snowflake_user.w", "password", "best password"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "snowflake_user.w",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "rsa_public_key"},
+			},
+		},
+	})
+}
+
+func userTypeConfig(name, userType, authentication string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "w" {
+	name = "%s"
+	user_type = "%s"
+	%s
+}
+`, name, userType, authentication)
+}
+
+func TestAcc_User_NetworkPolicy(t *testing.T) {
+	name := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: userNetworkPolicyConfig(name, "network_policy = snowflake_network_policy.p.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user.w", "network_policy", name),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_user.w",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// UNSET
+			{
+				Config: userNetworkPolicyConfig(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user.w", "network_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func userNetworkPolicyConfig(name, networkPolicy string) string {
+	return fmt.Sprintf(`
+resource "snowflake_network_policy" "p" {
+	name            = "%[1]s"
+	allowed_ip_list = ["192.168.0.100/24"]
+}
+
+resource "snowflake_user" "w" {
+	name = "%[1]s"
+	%[2]s
+}
+`, name, networkPolicy)
+}
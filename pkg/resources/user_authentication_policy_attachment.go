@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userAuthenticationPolicyAttachmentSchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "User name of the user you want to attach the authentication policy to.",
+	},
+	"authentication_policy_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Fully qualified name (`\"db\".\"schema\".\"policy_name\"`) of the authentication policy to attach to the user.",
+	},
+}
+
+// UserAuthenticationPolicyAttachment returns a pointer to the resource representing a user authentication policy attachment.
+func UserAuthenticationPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the authentication policy to use for a certain user.",
+
+		Create: CreateUserAuthenticationPolicyAttachment,
+		Read:   ReadUserAuthenticationPolicyAttachment,
+		Delete: DeleteUserAuthenticationPolicyAttachment,
+
+		Schema: userAuthenticationPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func userAuthenticationPolicyAttachmentIDFromString(id string) (sdk.AccountObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return sdk.AccountObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid user authentication policy attachment id %s, expected format: `userName|dbName|schemaName|policyName`", id)
+	}
+	return sdk.NewAccountObjectIdentifier(parts[0]), sdk.NewSchemaObjectIdentifier(parts[1], parts[2], parts[3]), nil
+}
+
+// CreateUserAuthenticationPolicyAttachment implements schema.CreateFunc.
+func CreateUserAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifier(d.Get("user_name").(string))
+	authenticationPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("authentication_policy_name").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("authentication_policy_name %s is not a valid authentication policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("authentication_policy_name"))
+	}
+
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			AuthenticationPolicy: sdk.String(authenticationPolicy.FullyQualifiedName()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(userName.Name(), authenticationPolicy.DatabaseName(), authenticationPolicy.SchemaName(), authenticationPolicy.Name()))
+
+	return ReadUserAuthenticationPolicyAttachment(d, meta)
+}
+
+// ReadUserAuthenticationPolicyAttachment implements schema.ReadFunc.
+func ReadUserAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName, _, err := userAuthenticationPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, userName.FullyQualifiedName(), sdk.PolicyEntityDomainUser)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindAuthenticationPolicy {
+			if err := d.Set("user_name", userName.Name()); err != nil {
+				return err
+			}
+			if err := d.Set("authentication_policy_name", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] authentication policy is not attached to the user (%s)", userName.Name())
+	d.SetId("")
+	return nil
+}
+
+// DeleteUserAuthenticationPolicyAttachment implements schema.DeleteFunc.
+func DeleteUserAuthenticationPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifier(d.Get("user_name").(string))
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			AuthenticationPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
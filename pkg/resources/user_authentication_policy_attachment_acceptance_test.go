@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_UserAuthenticationPolicyAttachment(t *testing.T) {
+	userName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	policyName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	authenticationPolicy := sdk.NewSchemaObjectIdentifier(acc.TestDatabaseName, acc.TestSchemaName, policyName)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: userAuthenticationPolicyAttachmentConfig(userName, authenticationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.spa", "user_name", userName),
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.spa", "authentication_policy_name", authenticationPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_user_authentication_policy_attachment.spa", "id", fmt.Sprintf("%s|%s|%s|%s", userName, authenticationPolicy.DatabaseName(), authenticationPolicy.SchemaName(), authenticationPolicy.Name())),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_user_authentication_policy_attachment.spa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func userAuthenticationPolicyAttachmentConfig(userName string, authenticationPolicy sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%s"
+}
+
+resource "snowflake_authentication_policy" "pa" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%s"
+}
+
+resource "snowflake_user_authentication_policy_attachment" "spa" {
+	authentication_policy_name = snowflake_authentication_policy.pa.qualified_name
+	user_name                  = snowflake_user.user.name
+}
+`, userName, authenticationPolicy.DatabaseName(), authenticationPolicy.SchemaName(), authenticationPolicy.Name())
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userPasswordPolicyAttachmentSchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "User name of the user you want to attach the password policy to.",
+	},
+	"password_policy_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Fully qualified name (`\"db\".\"schema\".\"policy_name\"`) of the password policy to attach to the user.",
+	},
+}
+
+// UserPasswordPolicyAttachment returns a pointer to the resource representing a user password policy attachment.
+func UserPasswordPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the password policy to use for a certain user.",
+
+		Create: CreateUserPasswordPolicyAttachment,
+		Read:   ReadUserPasswordPolicyAttachment,
+		Delete: DeleteUserPasswordPolicyAttachment,
+
+		Schema: userPasswordPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func userPasswordPolicyAttachmentIDFromString(id string) (sdk.AccountObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return sdk.AccountObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid user password policy attachment id %s, expected format: `userName|dbName|schemaName|policyName`", id)
+	}
+	return sdk.NewAccountObjectIdentifier(parts[0]), sdk.NewSchemaObjectIdentifier(parts[1], parts[2], parts[3]), nil
+}
+
+// CreateUserPasswordPolicyAttachment implements schema.CreateFunc.
+func CreateUserPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifier(d.Get("user_name").(string))
+	passwordPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("password_policy_name").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("password_policy_name %s is not a valid password policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("password_policy_name"))
+	}
+
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			PasswordPolicy: sdk.String(passwordPolicy.FullyQualifiedName()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(userName.Name(), passwordPolicy.DatabaseName(), passwordPolicy.SchemaName(), passwordPolicy.Name()))
+
+	return ReadUserPasswordPolicyAttachment(d, meta)
+}
+
+// ReadUserPasswordPolicyAttachment implements schema.ReadFunc.
+func ReadUserPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName, _, err := userPasswordPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, userName.FullyQualifiedName(), sdk.PolicyEntityDomainUser)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindPasswordPolicy {
+			if err := d.Set("user_name", userName.Name()); err != nil {
+				return err
+			}
+			if err := d.Set("password_policy_name", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] password policy is not attached to the user (%s)", userName.Name())
+	d.SetId("")
+	return nil
+}
+
+// DeleteUserPasswordPolicyAttachment implements schema.DeleteFunc.
+func DeleteUserPasswordPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifier(d.Get("user_name").(string))
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			PasswordPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
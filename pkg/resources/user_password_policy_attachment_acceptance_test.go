@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_UserPasswordPolicyAttachment(t *testing.T) {
+	userName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	policyName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: userPasswordPolicyAttachmentConfig(userName, acc.TestDatabaseName, acc.TestSchemaName, policyName, "snowflake_password_policy.pp.qualified_name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_password_policy_attachment.ppa", "user_name", userName),
+					resource.TestCheckResourceAttrPair("snowflake_user_password_policy_attachment.ppa", "password_policy_name", "snowflake_password_policy.pp", "qualified_name"),
+					resource.TestCheckResourceAttr("snowflake_user_password_policy_attachment.ppa", "id", fmt.Sprintf("%s|%s|%s|%s", userName, acc.TestDatabaseName, acc.TestSchemaName, policyName)),
+				),
+			},
+			// unquoted policy name does not recreate the attachment
+			{
+				Config:   userPasswordPolicyAttachmentConfig(userName, acc.TestDatabaseName, acc.TestSchemaName, policyName, `"${snowflake_password_policy.pp.database}.${snowflake_password_policy.pp.schema}.${snowflake_password_policy.pp.name}"`),
+				PlanOnly: true,
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_user_password_policy_attachment.ppa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func userPasswordPolicyAttachmentConfig(userName, databaseName, schemaName, policyName, policyReference string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%s"
+}
+
+resource "snowflake_password_policy" "pp" {
+	database = "%s"
+	schema   = "%s"
+	name     = "%s"
+}
+
+resource "snowflake_user_password_policy_attachment" "ppa" {
+	password_policy_name = %s
+	user_name            = snowflake_user.user.name
+}
+`, userName, databaseName, schemaName, policyName, policyReference)
+}
@@ -1,21 +1,21 @@
 package resources
 
 import (
+	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var userPublicKeyProperties = []string{
-	"rsa_public_key",
-	"rsa_public_key_2",
-}
-
 // sanitize input to suppress diffs, etc.
 func publicKeyStateFunc(v interface{}) string {
 	value := v.(string)
@@ -27,10 +27,9 @@ var userPublicKeysSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
 		Required:    true,
-		Description: "Name of the user.",
 		ForceNew:    true,
+		Description: "Name of the existing user whose RSA public keys are managed.",
 	},
-
 	"rsa_public_key": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -40,13 +39,26 @@ var userPublicKeysSchema = map[string]*schema.Schema{
 	"rsa_public_key_2": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "Specifies the user’s second RSA public key; used to rotate the public and Public keys for key-pair authentication based on an expiration schedule set by your organization. Must be on 1 line without header and trailer.",
+		Description: "Specifies the user’s second RSA public key; used to rotate the public and private keys for key-pair authentication. Must be on 1 line without header and trailer.",
 		StateFunc:   publicKeyStateFunc,
 	},
+	"rsa_public_key_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The SHA256 fingerprint of the user’s RSA public key.",
+	},
+	"rsa_public_key_2_fp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The SHA256 fingerprint of the user’s second RSA public key.",
+	},
 }
 
+// UserPublicKeys returns a pointer to the resource representing the RSA public keys of a user.
 func UserPublicKeys() *schema.Resource {
 	return &schema.Resource{
+		Description: "Manages only the RSA public keys of an existing user, e.g. to rotate the keys of an application without owning the user. Do not set the keys on the snowflake_user resource of the same user. The keys are compared with Snowflake through their fingerprints, so changes to any other property of the user are not detected.",
+
 		Create: CreateUserPublicKeys,
 		Read:   ReadUserPublicKeys,
 		Update: UpdateUserPublicKeys,
@@ -56,121 +68,119 @@ func UserPublicKeys() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-	}
-}
 
-func checkUserExists(db *sql.DB, name string) (bool, error) {
-	// First check if user exists
-	stmt := snowflake.NewUserBuilder(name).Describe()
-	_, err := snowflake.Query(db, stmt)
-	if errors.Is(err, sql.ErrNoRows) {
-		log.Printf("[DEBUG] user (%s) not found", name)
-		return false, nil
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("rsa_public_key_fp", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange("rsa_public_key")
+			}),
+			customdiff.ComputedIf("rsa_public_key_2_fp", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange("rsa_public_key_2")
+			}),
+		),
 	}
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
 }
 
-func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	exists, err := checkUserExists(db, id)
+// rsaPublicKeyFingerprint returns the fingerprint of the public key in the format reported by DESCRIBE USER,
+// or an empty string when the key is not valid base64.
+func rsaPublicKeyFingerprint(key string) string {
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(key), ""))
 	if err != nil {
-		return err
+		return ""
 	}
-	// If not found, mark resource to be removed from state file during apply or refresh
-	if !exists {
-		d.SetId("")
-		return nil
-	}
-	// we can't really read the public keys back from Snowflake so assume they haven't changed
-	return nil
+	sum := sha256.Sum256(der)
+	return "SHA256:" + base64.StdEncoding.EncodeToString(sum[:])
 }
 
+// CreateUserPublicKeys implements schema.CreateFunc.
 func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	name := d.Get("name").(string)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
-	for _, prop := range userPublicKeyProperties {
-		publicKey, publicKeyOK := d.GetOk(prop)
-		if !publicKeyOK {
-			continue
-		}
-		err := updateUserPublicKeys(db, name, prop, publicKey.(string))
-		if err != nil {
-			return err
-		}
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+	if err := updateUserRSAPublicKeys(ctx, client, d, id); err != nil {
+		return err
 	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	d.SetId(name)
 	return ReadUserPublicKeys(d, meta)
 }
 
-func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+// ReadUserPublicKeys implements schema.ReadFunc.
+func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	name := d.Id()
-
-	propsToSet := map[string]string{}
-	propsToUnset := map[string]string{}
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
-	for _, prop := range userPublicKeyProperties {
-		// if key hasn't changed, continue
-		if !d.HasChange(prop) {
-			continue
-		}
-		// if it has changed then we should do something about it
-		publicKey, publicKeyOK := d.GetOk(prop)
-		if publicKeyOK { // if set, then we should update the value
-			propsToSet[prop] = publicKey.(string)
-		} else { // if now unset, we should unset the key from the user
-			propsToUnset[prop] = publicKey.(string)
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	user, err := client.Users.Describe(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("[DEBUG] user (%s) not found or we are not authorized.Err:\n%s", d.Id(), err.Error())
+			d.SetId("")
+			return nil
 		}
+		return err
 	}
 
-	// set the keys we decided should be set
-	for prop, value := range propsToSet {
-		err := updateUserPublicKeys(db, name, prop, value)
-		if err != nil {
+	if err := d.Set("name", id.Name()); err != nil {
+		return err
+	}
+	fingerprints := map[string]*sdk.StringProperty{
+		"rsa_public_key":   user.RsaPublicKeyFp,
+		"rsa_public_key_2": user.RsaPublicKey2Fp,
+	}
+	for key, fingerprint := range fingerprints {
+		remote := ""
+		if fingerprint != nil {
+			remote = fingerprint.Value
+		}
+		if err := d.Set(key+"_fp", remote); err != nil {
 			return err
 		}
+		// the key is only reported as drifted when its fingerprint differs, as DESCRIBE USER does not return the key itself
+		if local := d.Get(key).(string); local != "" && rsaPublicKeyFingerprint(local) != remote {
+			if err := d.Set(key, ""); err != nil {
+				return err
+			}
+		}
 	}
+	return nil
+}
 
-	// unset the keys we decided should be unset
-	for k := range propsToUnset {
-		err := unsetUserPublicKeys(db, name, k)
-		if err != nil {
-			return err
-		}
+// UpdateUserPublicKeys implements schema.UpdateFunc.
+func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	if err := updateUserRSAPublicKeys(ctx, client, d, id); err != nil {
+		return err
 	}
-	// re-sync
+
 	return ReadUserPublicKeys(d, meta)
 }
 
+// DeleteUserPublicKeys implements schema.DeleteFunc.
 func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
-	name := d.Id()
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
 
-	for _, prop := range userPublicKeyProperties {
-		err := unsetUserPublicKeys(db, name, prop)
-		if err != nil {
-			return err
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
+	unset := &sdk.UserObjectPropertiesUnset{}
+	if _, ok := d.GetOk("rsa_public_key"); ok {
+		unset.RSAPublicKey = sdk.Bool(true)
+	}
+	if _, ok := d.GetOk("rsa_public_key_2"); ok {
+		unset.RSAPublicKey2 = sdk.Bool(true)
+	}
+	if unset.RSAPublicKey != nil || unset.RSAPublicKey2 != nil {
+		if err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{Unset: &sdk.UserUnset{ObjectProperties: unset}}); err != nil {
+			return fmt.Errorf("error unsetting RSA public keys of user %v err = %w", id.Name(), err)
 		}
 	}
-
 	d.SetId("")
 	return nil
 }
-
-func updateUserPublicKeys(db *sql.DB, name string, prop string, value string) error {
-	stmt := fmt.Sprintf(`ALTER USER "%s" SET %s = '%s'`, name, prop, value)
-	return snowflake.Exec(db, stmt)
-}
-
-func unsetUserPublicKeys(db *sql.DB, name string, prop string) error {
-	stmt := fmt.Sprintf(`ALTER USER "%s" UNSET %s`, name, prop)
-	return snowflake.Exec(db, stmt)
-}
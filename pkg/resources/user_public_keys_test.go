@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPublicKeys(t *testing.T) {
+	r := require.New(t)
+	err := resources.UserPublicKeys().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestUserPublicKeysCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":           "user1",
+		"rsa_public_key": "dGVzdA==",
+	}
+	d := schema.TestResourceDataRaw(t, resources.UserPublicKeys().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "user1" SET RSA_PUBLIC_KEY = 'dGVzdA=='$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadUserPublicKeys(mock, "SHA256:n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=")
+		err := resources.CreateUserPublicKeys(d, db)
+		r.NoError(err)
+		r.Equal("user1", d.Id())
+		r.Equal("dGVzdA==", d.Get("rsa_public_key").(string))
+		r.Equal("SHA256:n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=", d.Get("rsa_public_key_fp").(string))
+	})
+}
+
+func TestUserPublicKeysReadDrift(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.UserPublicKeys().Schema, map[string]interface{}{"name": "user1", "rsa_public_key": "dGVzdA=="})
+	d.SetId("user1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadUserPublicKeys(mock, "SHA256:other")
+		err := resources.ReadUserPublicKeys(d, db)
+		r.NoError(err)
+		r.Equal("", d.Get("rsa_public_key").(string))
+		r.Equal("SHA256:other", d.Get("rsa_public_key_fp").(string))
+	})
+}
+
+func TestUserPublicKeysDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.UserPublicKeys().Schema, map[string]interface{}{"name": "user1", "rsa_public_key_2": "dGVzdA=="})
+	d.SetId("user1")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "user1" UNSET RSA_PUBLIC_KEY_2$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteUserPublicKeys(d, db)
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadUserPublicKeys(mock sqlmock.Sqlmock, fingerprint string) {
+	rows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+		AddRow("NAME", "user1", "null", "").
+		AddRow("RSA_PUBLIC_KEY_FP", fingerprint, "null", "").
+		AddRow("RSA_PUBLIC_KEY_2_FP", "null", "null", "")
+	mock.ExpectQuery(`^DESCRIBE USER "user1"$`).WillReturnRows(rows)
+}
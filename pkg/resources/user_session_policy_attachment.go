@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userSessionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"user_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "User name of the user you want to attach the session policy to.",
+	},
+	"session_policy_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressQualifiedObjectIDDiff,
+		Description:      "Fully qualified name (`\"db\".\"schema\".\"policy_name\"`) of the session policy to attach to the user.",
+	},
+}
+
+// UserSessionPolicyAttachment returns a pointer to the resource representing a user session policy attachment.
+func UserSessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Specifies the session policy to use for a certain user.",
+
+		Create: CreateUserSessionPolicyAttachment,
+		Read:   ReadUserSessionPolicyAttachment,
+		Delete: DeleteUserSessionPolicyAttachment,
+
+		Schema: userSessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func userSessionPolicyAttachmentIDFromString(id string) (sdk.AccountObjectIdentifier, sdk.SchemaObjectIdentifier, error) {
+	parts := strings.Split(id, helpers.IDDelimiter)
+	if len(parts) != 4 {
+		return sdk.AccountObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, fmt.Errorf("invalid user session policy attachment id %s, expected format: `userName|dbName|schemaName|policyName`", id)
+	}
+	return sdk.NewAccountObjectIdentifier(parts[0]), sdk.NewSchemaObjectIdentifier(parts[1], parts[2], parts[3]), nil
+}
+
+// CreateUserSessionPolicyAttachment implements schema.CreateFunc.
+func CreateUserSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifier(d.Get("user_name").(string))
+	sessionPolicy, ok := sdk.NewObjectIdentifierFromFullyQualifiedName(d.Get("session_policy_name").(string)).(sdk.SchemaObjectIdentifier)
+	if !ok {
+		return fmt.Errorf("session_policy_name %s is not a valid session policy qualified name, expected format: `\"db\".\"schema\".\"policy\"`", d.Get("session_policy_name"))
+	}
+
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Set: &sdk.UserSet{
+			SessionPolicy: sdk.String(sessionPolicy.FullyQualifiedName()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(helpers.EncodeSnowflakeID(userName.Name(), sessionPolicy.DatabaseName(), sessionPolicy.SchemaName(), sessionPolicy.Name()))
+
+	return ReadUserSessionPolicyAttachment(d, meta)
+}
+
+// ReadUserSessionPolicyAttachment implements schema.ReadFunc.
+func ReadUserSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName, _, err := userSessionPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, userName.FullyQualifiedName(), sdk.PolicyEntityDomainUser)
+	if err != nil {
+		return err
+	}
+
+	for _, policyReference := range policyReferences {
+		if policyReference.PolicyKind == sdk.PolicyKindSessionPolicy {
+			if err := d.Set("user_name", userName.Name()); err != nil {
+				return err
+			}
+			if err := d.Set("session_policy_name", policyReference.PolicyID().FullyQualifiedName()); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] session policy is not attached to the user (%s)", userName.Name())
+	d.SetId("")
+	return nil
+}
+
+// DeleteUserSessionPolicyAttachment implements schema.DeleteFunc.
+func DeleteUserSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+	client := sdk.NewClientFromDB(db)
+	ctx := context.Background()
+
+	userName := sdk.NewAccountObjectIdentifier(d.Get("user_name").(string))
+	err := client.Users.Alter(ctx, userName, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			SessionPolicy: sdk.Bool(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
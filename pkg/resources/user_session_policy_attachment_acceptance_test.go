@@ -0,0 +1,57 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_UserSessionPolicyAttachment(t *testing.T) {
+	userName := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	sessionPolicy, createSessionPolicy := sessionPolicyForAttachment(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: createSessionPolicy,
+				Config:    userSessionPolicyAttachmentConfig(userName, sessionPolicy.FullyQualifiedName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "user_name", userName),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "session_policy_name", sessionPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_user_session_policy_attachment.spa", "id", fmt.Sprintf("%s|%s|%s|%s", userName, sessionPolicy.DatabaseName(), sessionPolicy.SchemaName(), sessionPolicy.Name())),
+				),
+			},
+			// unquoted policy name does not recreate the attachment
+			{
+				Config:   userSessionPolicyAttachmentConfig(userName, fmt.Sprintf("%s.%s.%s", sessionPolicy.DatabaseName(), sessionPolicy.SchemaName(), sessionPolicy.Name())),
+				PlanOnly: true,
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_user_session_policy_attachment.spa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func userSessionPolicyAttachmentConfig(userName string, sessionPolicyName string) string {
+	return fmt.Sprintf(`
+resource "snowflake_user" "user" {
+	name = "%s"
+}
+
+resource "snowflake_user_session_policy_attachment" "spa" {
+	session_policy_name = %q
+	user_name           = snowflake_user.user.name
+}
+`, userName, sessionPolicyName)
+}
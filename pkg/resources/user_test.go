@@ -0,0 +1,107 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser(t *testing.T) {
+	r := require.New(t)
+	err := resources.User().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestUserRotateRSAPublicKeys(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "user1",
+		Attributes: map[string]string{
+			"name":           "user1",
+			"rsa_public_key": "key1",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"rsa_public_key":   {Old: "key1", New: ""},
+			"rsa_public_key_2": {Old: "", New: "key2"},
+		},
+	}
+	d, err := schema.InternalMap(resources.User().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the new key is set before the old one is removed
+		mock.ExpectExec(`^ALTER USER "user1" SET RSA_PUBLIC_KEY_2 = 'key2'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER USER "user1" UNSET RSA_PUBLIC_KEY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+			AddRow("NAME", "user1", "null", "").
+			AddRow("RSA_PUBLIC_KEY_FP", "null", "null", "").
+			AddRow("RSA_PUBLIC_KEY_2_FP", "SHA256:abc", "null", "")
+		mock.ExpectQuery(`^DESCRIBE USER "user1"$`).WillReturnRows(rows)
+		expectReadUserNetworkPolicy(mock, "", "")
+		err := resources.UpdateUser(d, db)
+		r.NoError(err)
+		r.False(d.Get("has_rsa_public_key").(bool))
+		r.Equal("", d.Get("rsa_public_key_fp").(string))
+		r.Equal("SHA256:abc", d.Get("rsa_public_key_2_fp").(string))
+	})
+}
+
+func TestUserUpdatePolicies(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "user1",
+		Attributes: map[string]string{
+			"name":                      "user1",
+			"default_secondary_roles.#": "1",
+			"default_secondary_roles.0": "ALL",
+			"network_policy":            "",
+			"authentication_policy":     "",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"default_secondary_roles.#": {Old: "1", New: "0"},
+			"default_secondary_roles.0": {Old: "ALL", New: "", NewRemoved: true},
+			"network_policy":            {Old: "", New: "POLICY1"},
+			"authentication_policy":     {Old: "", New: `"db"."schema"."policy2"`},
+		},
+	}
+	d, err := schema.InternalMap(resources.User().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "user1" UNSET DEFAULT_SECONDARY_ROLES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER USER "user1" SET NETWORK_POLICY = 'POLICY1'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER USER "user1" SET AUTHENTICATION POLICY "db"."schema"."policy2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"property", "value", "default", "description"}).
+			AddRow("NAME", "user1", "null", "").
+			AddRow("DEFAULT_SECONDARY_ROLES", "null", "null", "")
+		mock.ExpectQuery(`^DESCRIBE USER "user1"$`).WillReturnRows(rows)
+		expectReadUserNetworkPolicy(mock, "POLICY1", "USER")
+		policyRows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN", "POLICY_STATUS"}).
+			AddRow("db", "schema", "policy2", "AUTHENTICATION_POLICY", "user1", "USER", "ACTIVE")
+		mock.ExpectQuery(`POLICY_REFERENCES`).WillReturnRows(policyRows)
+		err := resources.UpdateUser(d, db)
+		r.NoError(err)
+		r.Equal(0, d.Get("default_secondary_roles").(*schema.Set).Len())
+		r.Equal("POLICY1", d.Get("network_policy").(string))
+		r.Equal(`"db"."schema"."policy2"`, d.Get("authentication_policy").(string))
+	})
+}
+
+func expectReadUserNetworkPolicy(mock sqlmock.Sqlmock, value string, level string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+		AddRow("NETWORK_POLICY", value, "", level, "")
+	mock.ExpectQuery(`^SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN USER "user1"$`).WillReturnRows(rows)
+}
@@ -44,11 +44,36 @@ var viewSchema = map[string]*schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Retains the access permissions from the original view when a new view is created using the OR REPLACE clause.",
+		Description: "Retains the access permissions from the original view when a new view is created using the OR REPLACE clause. This also applies when the view is replaced in place because of changes to `statement` or `column`.",
 		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 			return oldValue != "" && oldValue != newValue
 		},
 	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the view.",
+	},
+	"column": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Specifies the column list of the view, allowing a comment per column. Columns are matched by position with the columns of the `statement`; changing the column names replaces the view in place.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the name of the column.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the column.",
+				},
+			},
+		},
+	},
 	"is_secure": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -63,8 +88,7 @@ var viewSchema = map[string]*schema.Schema{
 	"statement": {
 		Type:             schema.TypeString,
 		Required:         true,
-		Description:      "Specifies the query used to create the view.",
-		ForceNew:         true,
+		Description:      "Specifies the query used to create the view. Changing the statement replaces the view in place with CREATE OR REPLACE (honoring `copy_grants`).",
 		DiffSuppressFunc: DiffSuppressStatement,
 	},
 	"created_on": {
@@ -185,6 +209,12 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 		builder.WithComment(v.(string))
 	}
 
+	if v, ok := d.GetOk("change_tracking"); ok && v.(bool) {
+		builder.WithChangeTracking()
+	}
+
+	builder.WithColumns(expandViewColumns(d.Get("column")))
+
 	if v, ok := d.GetOk("tag"); ok {
 		tags := getTags(v)
 		builder.WithTags(tags.toSnowflakeTagValues())
@@ -245,6 +275,11 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("copy_grants", v.HasCopyGrants()); err != nil {
 		return err
 	}
+	if v.ChangeTracking.Valid {
+		if err = d.Set("change_tracking", v.HasChangeTracking()); err != nil {
+			return err
+		}
+	}
 	if err = d.Set("comment", v.Comment.String); err != nil {
 		return err
 	}
@@ -265,10 +300,61 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("statement", substringOfQuery); err != nil {
 		return err
 	}
+
+	// the column list is only tracked when it is managed by the configuration
+	if len(d.Get("column").([]interface{})) > 0 {
+		q, err := snowflake.NewViewBuilder(view).WithDB(dbName).WithSchema(schema).Describe()
+		if err != nil {
+			return err
+		}
+		rows, err := snowflake.Query(db, q)
+		if err != nil {
+			return err
+		}
+		viewDescription, err := snowflake.ScanTableDescription(rows)
+		if err != nil {
+			return err
+		}
+		columns := make([]map[string]interface{}, 0, len(viewDescription))
+		for _, c := range viewDescription {
+			columns = append(columns, map[string]interface{}{
+				"column_name": c.Name.String,
+				"comment":     c.Comment.String,
+			})
+		}
+		if err = d.Set("column", columns); err != nil {
+			return err
+		}
+	}
+
 	err = d.Set("database", v.DatabaseName.String)
 	return err
 }
 
+func expandViewColumns(v interface{}) []snowflake.ViewColumn {
+	columns := make([]snowflake.ViewColumn, 0, len(v.([]interface{})))
+	for _, c := range v.([]interface{}) {
+		column := c.(map[string]interface{})
+		columns = append(columns, snowflake.ViewColumn{
+			Name:    column["column_name"].(string),
+			Comment: column["comment"].(string),
+		})
+	}
+	return columns
+}
+
+func viewColumnNamesChanged(o []snowflake.ViewColumn, n []snowflake.ViewColumn) bool {
+	if len(o) != len(n) {
+		return true
+	}
+	for i := range o {
+		if o[i].Name != n[i].Name {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateView implements schema.UpdateFunc.
 func UpdateView(d *schema.ResourceData, meta interface{}) error {
 	viewID, err := viewIDFromString(d.Id())
@@ -302,6 +388,72 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 		d.SetId(dataIDInput)
+		// the statements below target the renamed view
+		builder = snowflake.NewViewBuilder(name.(string)).WithDB(dbName).WithSchema(schema)
+	}
+
+	oldColumns, newColumns := d.GetChange("column")
+	if d.HasChange("statement") || viewColumnNamesChanged(expandViewColumns(oldColumns), expandViewColumns(newColumns)) {
+		// The view definition can only be changed by replacing the view, which resets all of its properties.
+		builder.WithReplace().WithStatement(d.Get("statement").(string)).WithColumns(expandViewColumns(newColumns))
+		if d.Get("is_secure").(bool) {
+			builder.WithSecure()
+		}
+		// the changes of copy_grants are suppressed, so the configured value is used
+		if copyGrants := d.GetRawConfig().GetAttr("copy_grants"); !copyGrants.IsNull() && copyGrants.True() {
+			builder.WithCopyGrants()
+		}
+		if d.Get("change_tracking").(bool) {
+			builder.WithChangeTracking()
+		}
+		if c := d.Get("comment").(string); c != "" {
+			builder.WithComment(c)
+		}
+		q, err := builder.Create()
+		if err != nil {
+			return err
+		}
+		if err = snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error replacing view %v err = %w", d.Id(), err)
+		}
+		for _, tA := range getTags(d.Get("tag")) {
+			q := builder.AddTag(tA.toSnowflakeTagValue())
+			if err := snowflake.Exec(db, q); err != nil {
+				return fmt.Errorf("error setting tag on %v err = %w", d.Id(), err)
+			}
+		}
+		return ReadView(d, meta)
+	}
+
+	if d.HasChange("column") {
+		for i, c := range expandViewColumns(newColumns) {
+			if !d.HasChange(fmt.Sprintf("column.%d.comment", i)) {
+				continue
+			}
+			var q string
+			var err error
+			if c.Comment == "" {
+				q, err = builder.RemoveColumnComment(c.Name)
+			} else {
+				q, err = builder.ChangeColumnComment(c.Name, c.Comment)
+			}
+			if err != nil {
+				return err
+			}
+			if err = snowflake.Exec(db, q); err != nil {
+				return fmt.Errorf("error updating comment of column %v for view %v err = %w", c.Name, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("change_tracking") {
+		q, err := builder.ChangeChangeTracking(d.Get("change_tracking").(bool))
+		if err != nil {
+			return err
+		}
+		if err = snowflake.Exec(db, q); err != nil {
+			return fmt.Errorf("error updating change tracking for view %v err = %w", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("comment") {
@@ -8,6 +8,7 @@ import (
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAcc_View(t *testing.T) {
@@ -76,6 +77,60 @@ func TestAcc_ViewWithCopyGrants(t *testing.T) {
 	})
 }
 
+// Checks that renaming the view and changing its statement in one apply replaces the renamed view.
+func TestAcc_ViewRenameAndChangeStatement(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	newName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: viewConfig(accName, false, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "name", accName),
+				),
+			},
+			{
+				Config: viewConfig(newName, false, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.ENABLED_ROLES", acc.TestDatabaseName, acc.TestSchemaName) + viewsInSchemaConfig(acc.TestDatabaseName, acc.TestSchemaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "name", newName),
+					resource.TestCheckResourceAttr("snowflake_view.test", "statement", "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.ENABLED_ROLES"),
+					checkViewNotListed("data.snowflake_views.all", accName),
+				),
+			},
+		},
+	})
+}
+
+func viewsInSchemaConfig(databaseName string, schemaName string) string {
+	return fmt.Sprintf(`
+data "snowflake_views" "all" {
+	database   = "%s"
+	schema     = "%s"
+	depends_on = [snowflake_view.test]
+}
+`, databaseName, schemaName)
+}
+
+// checkViewNotListed checks that the views data source does not list the view, e.g. left behind under its old name.
+func checkViewNotListed(dataSourceName string, viewName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("%s not found", dataSourceName)
+		}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "views.") && strings.HasSuffix(key, ".name") && value == viewName {
+				return fmt.Errorf("view %s should not exist", viewName)
+			}
+		}
+		return nil
+	}
+}
+
 // Checks that copy_grants changes don't trigger a drop
 func TestAcc_ViewChangeCopyGrants(t *testing.T) {
 	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
@@ -165,3 +220,70 @@ resource "snowflake_view" "test" {
 }
 `, n, databaseName, schemaName, copyGrants, copyGrants, q)
 }
+
+// Checks that statement and column changes replace the view in place, keeping its grants.
+func TestAcc_ViewReplaceWithColumns(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	roleName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: viewWithColumnsConfig(accName, roleName, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", "role name", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.0.column_name", "NAME"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.0.comment", "role name"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "change_tracking", "false"),
+				),
+			},
+			{
+				Config: viewWithColumnsConfig(accName, roleName, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.ENABLED_ROLES", "the role name", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_view.test", "statement", "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.ENABLED_ROLES"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "column.0.comment", "the role name"),
+					resource.TestCheckResourceAttr("snowflake_view.test", "change_tracking", "true"),
+					resource.TestCheckResourceAttr("snowflake_view_grant.test", "roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func viewWithColumnsConfig(n string, roleName string, q string, columnComment string, changeTracking bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_role" "test" {
+	name = "%[2]s"
+}
+
+resource "snowflake_view" "test" {
+	name            = "%[1]s"
+	database        = "%[3]s"
+	schema          = "%[4]s"
+	copy_grants     = true
+	change_tracking = %[7]t
+	statement       = "%[5]s"
+
+	column {
+		column_name = "NAME"
+		comment     = "%[6]s"
+	}
+
+	column {
+		column_name = "OWNER"
+	}
+}
+
+resource "snowflake_view_grant" "test" {
+	database_name = "%[3]s"
+	schema_name   = "%[4]s"
+	view_name     = snowflake_view.test.name
+	privilege     = "SELECT"
+	roles         = [snowflake_role.test.name]
+}
+`, n, roleName, acc.TestDatabaseName, acc.TestSchemaName, q, columnComment, changeTracking)
+}
@@ -97,6 +97,50 @@ func TestViewCreateAmpersand(t *testing.T) {
 	})
 }
 
+func TestViewCreateWithColumns(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":            "good_name",
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"statement":       "SELECT ID, DATA FROM test_db.PUBLIC.GREAT_TABLE",
+		"copy_grants":     true,
+		"or_replace":      true,
+		"change_tracking": true,
+		"column": []interface{}{
+			map[string]interface{}{"column_name": "ID", "comment": "the id"},
+			map[string]interface{}{"column_name": "DATA", "comment": ""},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resources.View().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE OR REPLACE VIEW "test_db"."test_schema"."good_name" \("ID" COMMENT 'the id', "DATA"\) COPY GRANTS CHANGE_TRACKING = TRUE AS SELECT ID, DATA FROM test_db.PUBLIC.GREAT_TABLE$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized", "change_tracking",
+		},
+		).AddRow(time.Now(), "good_name", "", "test_db", "test_schema", "admin", "", `CREATE OR REPLACE VIEW "test_db"."test_schema"."good_name" ("ID" COMMENT 'the id', "DATA") COPY GRANTS CHANGE_TRACKING = TRUE AS SELECT ID, DATA FROM test_db.PUBLIC.GREAT_TABLE`, false, false, "ON")
+		mock.ExpectQuery(`^SHOW VIEWS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+		describeRows := sqlmock.NewRows([]string{"name", "type", "kind", "null?", "default", "comment"}).
+			AddRow("ID", "NUMBER(38,0)", "COLUMN", "Y", nil, "the id").
+			AddRow("DATA", "VARCHAR(16777216)", "COLUMN", "Y", nil, nil)
+		mock.ExpectQuery(`^DESC VIEW "test_db"."test_schema"."good_name"$`).WillReturnRows(describeRows)
+
+		err := resources.CreateView(d, db)
+		r.NoError(err)
+		r.Equal("SELECT ID, DATA FROM test_db.PUBLIC.GREAT_TABLE", d.Get("statement"))
+		r.True(d.Get("change_tracking").(bool))
+		r.Equal("the id", d.Get("column.0.comment"))
+		r.Equal("DATA", d.Get("column.1.column_name"))
+	})
+}
+
 func expectReadView(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "name", "reserved", "database_name", "schema_name", "owner", "comment", "text", "is_secure", "is_materialized",
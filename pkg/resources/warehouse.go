@@ -3,6 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -97,22 +100,22 @@ var warehouseSchema = map[string]*schema.Schema{
 		Deprecated:  "This field is deprecated and will be removed in the next major version of the provider. It doesn't do anything and should be removed from your configuration.",
 	},
 	"statement_timeout_in_seconds": {
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     172800,
-		Description: "Specifies the time, in seconds, after which a running SQL statement (query, DDL, DML, etc.) is canceled by the system",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the time, in seconds, after which a running SQL statement (query, DDL, DML, etc.) is canceled by the system. When not set, the value is inherited from the account and differences are not reported.",
 	},
 	"statement_queued_timeout_in_seconds": {
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     0,
-		Description: "Object parameter that specifies the time, in seconds, a SQL statement (query, DDL, DML, etc.) can be queued on a warehouse before it is canceled by the system.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Object parameter that specifies the time, in seconds, a SQL statement (query, DDL, DML, etc.) can be queued on a warehouse before it is canceled by the system. When not set, the value is inherited from the account and differences are not reported.",
 	},
 	"max_concurrency_level": {
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     8,
-		Description: "Object parameter that specifies the concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Object parameter that specifies the concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse. When not set, the value is inherited from the account and differences are not reported.",
 	},
 	"enable_query_acceleration": {
 		Type:        schema.TypeBool,
@@ -135,8 +138,34 @@ var warehouseSchema = map[string]*schema.Schema{
 			string(sdk.WarehouseTypeStandard),
 			string(sdk.WarehouseTypeSnowparkOptimized),
 		}, true),
-		Description: "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse",
+		DiffSuppressFunc: ignoreCaseSuppressFunc,
+		Description:      "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse. Changing the type of a running warehouse suspends it for the duration of the change.",
 	},
+	"resource_constraint": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(warehouseResourceConstraints(), false),
+		Description:  "Specifies the memory and CPU architecture for Snowpark-optimized warehouses (e.g. MEMORY_16X), or the generation for standard warehouses (STANDARD_GEN_1 or STANDARD_GEN_2). When not set, Snowflake picks the default of the warehouse type; removing it from the configuration keeps the current value.",
+	},
+	"generation": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(sdk.WarehouseGeneration1),
+			string(sdk.WarehouseGeneration2),
+		}, false),
+		Description: "Specifies the generation of a standard warehouse (1 or 2). When not set, Snowflake picks the default; removing it from the configuration keeps the current value.",
+	},
+}
+
+func warehouseResourceConstraints() []string {
+	constraints := make([]string, len(sdk.AllWarehouseResourceConstraints))
+	for i, constraint := range sdk.AllWarehouseResourceConstraints {
+		constraints[i] = string(constraint)
+	}
+	return constraints
 }
 
 // Warehouse returns a pointer to the resource representing a warehouse.
@@ -151,9 +180,31 @@ func Warehouse() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: computeWarehouseTypeDefaults,
 	}
 }
 
+// computeWarehouseTypeDefaults marks the resource constraint and the generation that are not configured as computed when
+// the warehouse type changes, as Snowflake picks their defaults for the new type.
+func computeWarehouseTypeDefaults(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.HasChange("warehouse_type") || d.Id() == "" {
+		return nil
+	}
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().IsObjectType() {
+		return nil
+	}
+	for _, key := range []string{"resource_constraint", "generation"} {
+		if rawConfig.GetAttr(key).IsNull() {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // CreateWarehouse implements schema.CreateFunc.
 func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
@@ -162,23 +213,14 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
-	whType := sdk.WarehouseType(d.Get("warehouse_type").(string))
+	whType := sdk.WarehouseType(strings.ToUpper(d.Get("warehouse_type").(string)))
 	createOptions := &sdk.CreateWarehouseOptions{
 		Comment:                         sdk.String(d.Get("comment").(string)),
-		StatementTimeoutInSeconds:       sdk.Int(d.Get("statement_timeout_in_seconds").(int)),
-		StatementQueuedTimeoutInSeconds: sdk.Int(d.Get("statement_queued_timeout_in_seconds").(int)),
-		MaxConcurrencyLevel:             sdk.Int(d.Get("max_concurrency_level").(int)),
 		EnableQueryAcceleration:         sdk.Bool(d.Get("enable_query_acceleration").(bool)),
+		QueryAccelerationMaxScaleFactor: sdk.Int(d.Get("query_acceleration_max_scale_factor").(int)),
 		WarehouseType:                   &whType,
 	}
 
-	if enable := *sdk.Bool(d.Get("enable_query_acceleration").(bool)); enable {
-		if v, ok := d.GetOk("query_acceleration_max_scale_factor"); ok {
-			queryAccelerationMaxScaleFactor := sdk.Int(v.(int))
-			createOptions.QueryAccelerationMaxScaleFactor = queryAccelerationMaxScaleFactor
-		}
-	}
-
 	if v, ok := d.GetOk("warehouse_size"); ok {
 		size, err := sdk.ToWarehouseSize(v.(string))
 		if err != nil {
@@ -186,6 +228,14 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		}
 		createOptions.WarehouseSize = &size
 	}
+	if v, ok := d.GetOk("resource_constraint"); ok {
+		resourceConstraint := sdk.WarehouseResourceConstraint(v.(string))
+		createOptions.ResourceConstraint = &resourceConstraint
+	}
+	if v, ok := d.GetOk("generation"); ok {
+		generation := sdk.WarehouseGeneration(v.(string))
+		createOptions.Generation = &generation
+	}
 	if v, ok := d.GetOk("max_cluster_count"); ok {
 		createOptions.MaxClusterCount = sdk.Int(v.(int))
 	}
@@ -208,6 +258,15 @@ func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("resource_monitor"); ok {
 		createOptions.ResourceMonitor = sdk.String(v.(string))
 	}
+	if v, ok := d.GetOk("statement_timeout_in_seconds"); ok {
+		createOptions.StatementTimeoutInSeconds = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("statement_queued_timeout_in_seconds"); ok {
+		createOptions.StatementQueuedTimeoutInSeconds = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("max_concurrency_level"); ok {
+		createOptions.MaxConcurrencyLevel = sdk.Int(v.(int))
+	}
 
 	err := client.Warehouses.Create(ctx, objectIdentifier, createOptions)
 	if err != nil {
@@ -243,6 +302,12 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("warehouse_size", w.Size); err != nil {
 		return err
 	}
+	if err = d.Set("resource_constraint", w.ResourceConstraint); err != nil {
+		return err
+	}
+	if err = d.Set("generation", w.Generation); err != nil {
+		return err
+	}
 	if err = d.Set("max_cluster_count", w.MaxClusterCount); err != nil {
 		return err
 	}
@@ -264,12 +329,54 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("enable_query_acceleration", w.EnableQueryAcceleration); err != nil {
 		return err
 	}
-	if w.EnableQueryAcceleration {
-		if err = d.Set("query_acceleration_max_scale_factor", w.QueryAccelerationMaxScaleFactor); err != nil {
+	if err = d.Set("query_acceleration_max_scale_factor", w.QueryAccelerationMaxScaleFactor); err != nil {
+		return err
+	}
+
+	return readWarehouseParameters(ctx, client, d, id)
+}
+
+// readWarehouseParameters sets the parameters set on the warehouse itself. The inherited
+// parameters are read as unset, so that they are not reported as differences.
+func readWarehouseParameters(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.AccountObjectIdentifier) error {
+	params, err := client.Parameters.ShowParameters(ctx, &sdk.ShowParametersOptions{In: &sdk.ParametersIn{Warehouse: id}})
+	if err != nil {
+		return err
+	}
+
+	fieldParameters := map[string]interface{}{
+		"statement_timeout_in_seconds":        0,
+		"statement_queued_timeout_in_seconds": 0,
+		"max_concurrency_level":               0,
+	}
+	for _, param := range params {
+		if param.Level != sdk.ParameterTypeWarehouse {
+			continue
+		}
+		var key string
+		switch param.Key {
+		case string(sdk.SessionParameterStatementTimeoutInSeconds):
+			key = "statement_timeout_in_seconds"
+		case string(sdk.ObjectParameterStatementQueuedTimeoutInSeconds):
+			key = "statement_queued_timeout_in_seconds"
+		case string(sdk.ObjectParameterMaxConcurrencyLevel):
+			key = "max_concurrency_level"
+		default:
+			continue
+		}
+		value, err := strconv.Atoi(param.Value)
+		if err != nil {
 			return err
 		}
+		fieldParameters[key] = value
 	}
 
+	for key, value := range fieldParameters {
+		// lintignore:R001
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -315,6 +422,22 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		}
 		set.WarehouseSize = &size
 	}
+	// resource constraint is applied together with the type when both change; as both the resource constraint and
+	// the generation are computed, they only change when set in the configuration
+	if d.HasChange("resource_constraint") && !d.HasChange("warehouse_type") {
+		if v, ok := d.GetOk("resource_constraint"); ok {
+			runSet = true
+			resourceConstraint := sdk.WarehouseResourceConstraint(v.(string))
+			set.ResourceConstraint = &resourceConstraint
+		}
+	}
+	if d.HasChange("generation") {
+		if v, ok := d.GetOk("generation"); ok {
+			runSet = true
+			generation := sdk.WarehouseGeneration(v.(string))
+			set.Generation = &generation
+		}
+	}
 	if d.HasChange("max_cluster_count") {
 		if v, ok := d.GetOk("max_cluster_count"); ok {
 			runSet = true
@@ -371,16 +494,31 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	if d.HasChange("statement_timeout_in_seconds") {
-		runSet = true
-		set.StatementTimeoutInSeconds = sdk.Int(d.Get("statement_timeout_in_seconds").(int))
+		if v, ok := d.GetOk("statement_timeout_in_seconds"); ok {
+			runSet = true
+			set.StatementTimeoutInSeconds = sdk.Int(v.(int))
+		} else {
+			runUnset = true
+			unset.StatementTimeoutInSeconds = sdk.Bool(true)
+		}
 	}
 	if d.HasChange("statement_queued_timeout_in_seconds") {
-		runSet = true
-		set.StatementQueuedTimeoutInSeconds = sdk.Int(d.Get("statement_queued_timeout_in_seconds").(int))
+		if v, ok := d.GetOk("statement_queued_timeout_in_seconds"); ok {
+			runSet = true
+			set.StatementQueuedTimeoutInSeconds = sdk.Int(v.(int))
+		} else {
+			runUnset = true
+			unset.StatementQueuedTimeoutInSeconds = sdk.Bool(true)
+		}
 	}
 	if d.HasChange("max_concurrency_level") {
-		runSet = true
-		set.MaxConcurrencyLevel = sdk.Int(d.Get("max_concurrency_level").(int))
+		if v, ok := d.GetOk("max_concurrency_level"); ok {
+			runSet = true
+			set.MaxConcurrencyLevel = sdk.Int(v.(int))
+		} else {
+			runUnset = true
+			unset.MaxConcurrencyLevel = sdk.Bool(true)
+		}
 	}
 	if d.HasChange("enable_query_acceleration") {
 		runSet = true
@@ -391,13 +529,14 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		set.QueryAccelerationMaxScaleFactor = sdk.Int(d.Get("query_acceleration_max_scale_factor").(int))
 	}
 	if d.HasChange("warehouse_type") {
-		if v, ok := d.GetOk("warehouse_type"); ok {
-			runSet = true
-			whType := sdk.WarehouseType(v.(string))
-			set.WarehouseType = &whType
-		} else {
-			runUnset = true
-			unset.WarehouseType = sdk.Bool(true)
+		var resourceConstraint *sdk.WarehouseResourceConstraint
+		// the configured resource constraint is sent with the new type even if it did not change, as otherwise
+		// Snowflake falls back to the default of the new type
+		if v, ok := d.GetOk("resource_constraint"); ok {
+			resourceConstraint = sdk.Pointer(sdk.WarehouseResourceConstraint(v.(string)))
+		}
+		if err := updateWarehouseType(ctx, client, id, d.Get("warehouse_type").(string), resourceConstraint); err != nil {
+			return err
 		}
 	}
 
@@ -419,6 +558,39 @@ func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	return ReadWarehouse(d, meta)
+}
+
+// updateWarehouseType changes the type of the warehouse in place. Snowflake only allows
+// the type to be changed while the warehouse is suspended, so a running warehouse is
+// suspended for the change and resumed afterwards. The resource constraint depends on the
+// type, so a new one is set in the same statement.
+func updateWarehouseType(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, warehouseType string, resourceConstraint *sdk.WarehouseResourceConstraint) error {
+	w, err := client.Warehouses.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	whType := sdk.WarehouseType(strings.ToUpper(warehouseType))
+	if w.Type == whType && resourceConstraint == nil {
+		return nil
+	}
+
+	running := w.State == sdk.WarehouseStateStarted || w.State == sdk.WarehouseStateResuming || w.State == sdk.WarehouseStateResizing
+	if running {
+		if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Suspend: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error suspending warehouse %v to change its type err = %w", id.Name(), err)
+		}
+	}
+
+	if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Set: &sdk.WarehouseSet{WarehouseType: &whType, ResourceConstraint: resourceConstraint}}); err != nil {
+		return fmt.Errorf("error changing type of warehouse %v err = %w", id.Name(), err)
+	}
+
+	if running {
+		if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Resume: sdk.Bool(true)}); err != nil {
+			return fmt.Errorf("error resuming warehouse %v after changing its type err = %w", id.Name(), err)
+		}
+	}
 	return nil
 }
 
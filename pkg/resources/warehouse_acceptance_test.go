@@ -62,9 +62,53 @@ func TestAcc_Warehouse(t *testing.T) {
 					"initially_suspended",
 					"wait_for_provisioning",
 					"query_acceleration_max_scale_factor",
-					"max_concurrency_level",
-					"statement_queued_timeout_in_seconds",
-					"statement_timeout_in_seconds",
+				},
+			},
+		},
+	})
+}
+
+func TestAcc_WarehouseSnowparkAndQueryAcceleration(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_WAREHOUSE_TESTS"); ok {
+		t.Skip("Skipping TestAccWarehouse")
+	}
+
+	prefix := "tst-terraform" + strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    acc.TestAccProviders(),
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: wConfigTypeAndQueryAcceleration(prefix, "STANDARD", "STANDARD_GEN_1", true, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "name", prefix),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "warehouse_type", "STANDARD"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "resource_constraint", "STANDARD_GEN_1"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "enable_query_acceleration", "true"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "query_acceleration_max_scale_factor", "4"),
+				),
+			},
+			// CHANGE TYPE AND QUERY ACCELERATION IN PLACE
+			{
+				Config: wConfigTypeAndQueryAcceleration(prefix, "SNOWPARK-OPTIMIZED", "MEMORY_16X", false, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "name", prefix),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "warehouse_type", "SNOWPARK-OPTIMIZED"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "resource_constraint", "MEMORY_16X"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "enable_query_acceleration", "false"),
+					resource.TestCheckResourceAttr("snowflake_warehouse.w", "query_acceleration_max_scale_factor", "10"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_warehouse.w",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"initially_suspended",
+					"wait_for_provisioning",
 				},
 			},
 		},
@@ -131,6 +175,25 @@ resource "snowflake_warehouse" "w" {
 	return fmt.Sprintf(s, prefix, size)
 }
 
+func wConfigTypeAndQueryAcceleration(prefix string, warehouseType string, resourceConstraint string, enableQueryAcceleration bool, scaleFactor int) string {
+	s := `
+resource "snowflake_warehouse" "w" {
+	name                = "%s"
+	warehouse_size      = "MEDIUM"
+	warehouse_type      = "%s"
+	resource_constraint = "%s"
+
+	enable_query_acceleration           = %t
+	query_acceleration_max_scale_factor = %d
+
+	auto_suspend          = 60
+	initially_suspended   = true
+	wait_for_provisioning = false
+}
+`
+	return fmt.Sprintf(s, prefix, warehouseType, resourceConstraint, enableQueryAcceleration, scaleFactor)
+}
+
 func wConfigPattern(prefix string) string {
 	s := `
 resource "snowflake_warehouse" "w1" {
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeWarehouseTypeDefaults(t *testing.T) {
+	diff := func(config map[string]string) *terraform.InstanceDiff {
+		config["name"] = "WH"
+		block := Warehouse().CoreConfigSchema()
+		values := map[string]cty.Value{}
+		for name, attributeType := range block.ImpliedType().AttributeTypes() {
+			values[name] = cty.NullVal(attributeType)
+		}
+		for name, value := range config {
+			values[name] = cty.StringVal(value)
+		}
+		state := &terraform.InstanceState{ID: "WH", Attributes: map[string]string{
+			"name":                "WH",
+			"warehouse_type":      "SNOWPARK-OPTIMIZED",
+			"resource_constraint": "MEMORY_16X",
+			"generation":          "",
+		}}
+		// the raw config is passed to the diff with the prior state, as done by the plugin server
+		state.RawConfig = cty.ObjectVal(values)
+		d, err := Warehouse().Diff(context.Background(), state, terraform.NewResourceConfigShimmed(state.RawConfig, block), nil)
+		require.NoError(t, err)
+		return d
+	}
+
+	t.Run("unset resource constraint is computed when the type changes", func(t *testing.T) {
+		d := diff(map[string]string{"warehouse_type": "STANDARD"})
+		require.NotNil(t, d.Attributes["resource_constraint"])
+		require.True(t, d.Attributes["resource_constraint"].NewComputed)
+		require.NotNil(t, d.Attributes["generation"])
+		require.True(t, d.Attributes["generation"].NewComputed)
+	})
+
+	t.Run("configured resource constraint is kept when the type changes", func(t *testing.T) {
+		d := diff(map[string]string{"warehouse_type": "STANDARD", "resource_constraint": "STANDARD_GEN_2"})
+		require.NotNil(t, d.Attributes["resource_constraint"])
+		require.False(t, d.Attributes["resource_constraint"].NewComputed)
+		require.Equal(t, "STANDARD_GEN_2", d.Attributes["resource_constraint"].New)
+	})
+
+	t.Run("unset resource constraint keeps its value when the type does not change", func(t *testing.T) {
+		d := diff(map[string]string{"warehouse_type": "SNOWPARK-OPTIMIZED"})
+		require.Nil(t, d.Attributes["resource_constraint"])
+	})
+}
@@ -0,0 +1,103 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouse(t *testing.T) {
+	r := require.New(t)
+	err := resources.Warehouse().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestWarehouseCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                         "wh1",
+		"comment":                      "great comment",
+		"statement_timeout_in_seconds": 3600,
+		"max_concurrency_level":        4,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Warehouse().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE WAREHOUSE "wh1" WAREHOUSE_TYPE = 'STANDARD' COMMENT = 'great comment' ENABLE_QUERY_ACCELERATION = false QUERY_ACCELERATION_MAX_SCALE_FACTOR = 8 MAX_CONCURRENCY_LEVEL = 4 STATEMENT_TIMEOUT_IN_SECONDS = 3600$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadWarehouse(mock)
+		err := resources.CreateWarehouse(d, db)
+		r.NoError(err)
+		r.Equal("wh1", d.Id())
+		r.Equal(3600, d.Get("statement_timeout_in_seconds").(int))
+		r.Equal(4, d.Get("max_concurrency_level").(int))
+	})
+}
+
+func TestWarehouseReadInheritedParameters(t *testing.T) {
+	r := require.New(t)
+
+	d := warehouse(t, "wh1", map[string]interface{}{
+		"name":                                "wh1",
+		"statement_timeout_in_seconds":        3600,
+		"statement_queued_timeout_in_seconds": 60,
+		"max_concurrency_level":               4,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadWarehouse(mock)
+		err := resources.ReadWarehouse(d, db)
+		r.NoError(err)
+		// set on the warehouse
+		r.Equal(3600, d.Get("statement_timeout_in_seconds").(int))
+		r.Equal(4, d.Get("max_concurrency_level").(int))
+		// inherited from the account, so it is read as unset
+		r.Equal(0, d.Get("statement_queued_timeout_in_seconds").(int))
+	})
+}
+
+func TestWarehouseUpdateUnsetParameters(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "wh1",
+		Attributes: map[string]string{
+			"name":                         "wh1",
+			"statement_timeout_in_seconds": "3600",
+		},
+	}
+	// the parameter was set on the warehouse and removed from the configuration
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"statement_timeout_in_seconds": {Old: "3600", New: "", NewRemoved: true},
+		},
+	}
+	d, err := schema.InternalMap(resources.Warehouse().Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER WAREHOUSE "wh1" UNSET STATEMENT_TIMEOUT_IN_SECONDS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadWarehouse(mock)
+		err := resources.UpdateWarehouse(d, db)
+		r.NoError(err)
+	})
+}
+
+func expectReadWarehouse(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"name", "state", "type", "size", "min_cluster_count", "max_cluster_count", "auto_suspend", "auto_resume", "comment", "scaling_policy"}).
+		AddRow("wh1", "SUSPENDED", "STANDARD", "X-Small", 1, 1, 600, true, "great comment", "STANDARD")
+	mock.ExpectQuery(`^SHOW WAREHOUSES LIKE 'wh1'$`).WillReturnRows(rows)
+
+	parameterRows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+		AddRow("STATEMENT_TIMEOUT_IN_SECONDS", "3600", "172800", "WAREHOUSE", "").
+		AddRow("STATEMENT_QUEUED_TIMEOUT_IN_SECONDS", "60", "0", "ACCOUNT", "").
+		AddRow("MAX_CONCURRENCY_LEVEL", "4", "8", "WAREHOUSE", "")
+	mock.ExpectQuery(`^SHOW PARAMETERS IN WAREHOUSE "wh1"$`).WillReturnRows(parameterRows)
+}
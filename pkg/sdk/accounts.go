@@ -146,38 +146,52 @@ func (opts *AccountLevelParameters) validate() error {
 }
 
 type AccountSet struct {
-	Parameters      *AccountLevelParameters `ddl:"list,no_parentheses"`
-	ResourceMonitor AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
-	PasswordPolicy  SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
-	SessionPolicy   SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
-	Tag             []TagAssociation        `ddl:"keyword" sql:"TAG"`
+	Parameters           *AccountLevelParameters `ddl:"list,no_parentheses"`
+	ResourceMonitor      AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
+	PasswordPolicy       SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
+	SessionPolicy        SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
+	AuthenticationPolicy SchemaObjectIdentifier  `ddl:"identifier" sql:"AUTHENTICATION POLICY"`
+	PackagesPolicy       SchemaObjectIdentifier  `ddl:"identifier" sql:"PACKAGES POLICY"`
+	Tag                  []TagAssociation        `ddl:"keyword" sql:"TAG"`
 }
 
 func (opts *AccountSet) validate() error {
-	if !anyValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-		return fmt.Errorf("at least one of parameters, resource monitor, password policy, session policy, or tag must be set")
+	if !anyValueSet(opts.Parameters, opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+		return fmt.Errorf("at least one of parameters, resource monitor, password policy, session policy, authentication policy, packages policy, or tag must be set")
 	}
 	if valueSet(opts.Parameters) {
-		if !everyValueNil(opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-			return fmt.Errorf("cannot set both parameters and resource monitor, password policy, session policy, or tag")
+		if !everyValueNil(opts.ResourceMonitor, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot set both parameters and resource monitor, password policy, session policy, authentication policy, packages policy, or tag")
 		}
 		return opts.Parameters.validate()
 	}
 	if valueSet(opts.ResourceMonitor) {
-		if !everyValueNil(opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-			return fmt.Errorf("cannot set both resource monitor and password policy, session policy, or tag")
+		if !everyValueNil(opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot set both resource monitor and password policy, session policy, authentication policy, packages policy, or tag")
 		}
 		return nil
 	}
 	if valueSet(opts.PasswordPolicy) {
-		if !everyValueNil(opts.SessionPolicy, opts.Tag) {
-			return fmt.Errorf("cannot set both password policy and session policy or tag")
+		if !everyValueNil(opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot set both password policy and session policy, authentication policy, packages policy, or tag")
 		}
 		return nil
 	}
 	if valueSet(opts.SessionPolicy) {
+		if !everyValueNil(opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot set both session policy and authentication policy, packages policy, or tag")
+		}
+		return nil
+	}
+	if valueSet(opts.AuthenticationPolicy) {
+		if !everyValueNil(opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot set both authentication policy and packages policy or tag")
+		}
+		return nil
+	}
+	if valueSet(opts.PackagesPolicy) {
 		if !everyValueNil(opts.Tag) {
-			return fmt.Errorf("cannot set both session policy and tag")
+			return fmt.Errorf("cannot set both packages policy and tag")
 		}
 		return nil
 	}
@@ -199,31 +213,45 @@ func (opts *AccountLevelParametersUnset) validate() error {
 }
 
 type AccountUnset struct {
-	Parameters     *AccountLevelParametersUnset `ddl:"list,no_parentheses"`
-	PasswordPolicy *bool                        `ddl:"keyword" sql:"PASSWORD POLICY"`
-	SessionPolicy  *bool                        `ddl:"keyword" sql:"SESSION POLICY"`
-	Tag            []ObjectIdentifier           `ddl:"keyword" sql:"TAG"`
+	Parameters           *AccountLevelParametersUnset `ddl:"list,no_parentheses"`
+	PasswordPolicy       *bool                        `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy        *bool                        `ddl:"keyword" sql:"SESSION POLICY"`
+	AuthenticationPolicy *bool                        `ddl:"keyword" sql:"AUTHENTICATION POLICY"`
+	PackagesPolicy       *bool                        `ddl:"keyword" sql:"PACKAGES POLICY"`
+	Tag                  []ObjectIdentifier           `ddl:"keyword" sql:"TAG"`
 }
 
 func (opts *AccountUnset) validate() error {
-	if !anyValueSet(opts.Parameters, opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-		return fmt.Errorf("at least one of parameters, password policy, session policy, or tag must be set")
+	if !anyValueSet(opts.Parameters, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+		return fmt.Errorf("at least one of parameters, password policy, session policy, authentication policy, packages policy, or tag must be set")
 	}
 	if valueSet(opts.Parameters) {
-		if !everyValueNil(opts.PasswordPolicy, opts.SessionPolicy, opts.Tag) {
-			return fmt.Errorf("cannot unset both parameters and password policy, session policy, or tag")
+		if !everyValueNil(opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot unset both parameters and password policy, session policy, authentication policy, packages policy, or tag")
 		}
 		return opts.Parameters.validate()
 	}
 	if valueSet(opts.PasswordPolicy) {
-		if !everyValueNil(opts.SessionPolicy, opts.Tag) {
-			return fmt.Errorf("cannot unset both password policy and session policy or tag")
+		if !everyValueNil(opts.SessionPolicy, opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot unset both password policy and session policy, authentication policy, packages policy, or tag")
 		}
 		return nil
 	}
 	if valueSet(opts.SessionPolicy) {
+		if !everyValueNil(opts.AuthenticationPolicy, opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot unset both session policy and authentication policy, packages policy, or tag")
+		}
+		return nil
+	}
+	if valueSet(opts.AuthenticationPolicy) {
+		if !everyValueNil(opts.PackagesPolicy, opts.Tag) {
+			return fmt.Errorf("cannot unset both authentication policy and packages policy or tag")
+		}
+		return nil
+	}
+	if valueSet(opts.PackagesPolicy) {
 		if !everyValueNil(opts.Tag) {
-			return fmt.Errorf("cannot unset both session policy and tag")
+			return fmt.Errorf("cannot unset both packages policy and tag")
 		}
 		return nil
 	}
@@ -356,7 +384,7 @@ func (row accountDBRow) convert() *Account {
 		acc.MarketplaceProviderBillingEntityName = row.MarketplaceProviderBillingEntityName.String
 	}
 	if row.RegionGroup.Valid {
-		acc.SnowflakeRegion = row.RegionGroup.String
+		acc.RegionGroup = row.RegionGroup.String
 	}
 	return acc
 }
@@ -386,6 +414,17 @@ func (c *accounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*A
 			return &account, nil
 		}
 	}
+
+	// LIKE matches only the account names, so the accounts have to be listed to find one by its locator
+	accounts, err = c.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.AccountLocator == id.Name() {
+			return &account, nil
+		}
+	}
 	return nil, ErrObjectNotExistOrAuthorized
 }
 
@@ -138,6 +138,42 @@ func TestAccountAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET SESSION POLICY`)
 	})
 
+	t.Run("with set authentication policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				AuthenticationPolicy: NewSchemaObjectIdentifier("db", "schema", "authpol"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET AUTHENTICATION POLICY "db"."schema"."authpol"`)
+	})
+
+	t.Run("with unset authentication policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Unset: &AccountUnset{
+				AuthenticationPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET AUTHENTICATION POLICY`)
+	})
+
+	t.Run("with set packages policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				PackagesPolicy: NewSchemaObjectIdentifier("db", "schema", "pkgpol"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET PACKAGES POLICY "db"."schema"."pkgpol"`)
+	})
+
+	t.Run("with unset packages policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Unset: &AccountUnset{
+				PackagesPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET PACKAGES POLICY`)
+	})
+
 	t.Run("with set tag", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Set: &AccountSet{
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -38,9 +39,11 @@ type CreateAlertOptions struct {
 	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
 	name        SchemaObjectIdentifier `ddl:"identifier"`
 
+	// optional, the alert is serverless when no warehouse is specified
+	warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+
 	// required
-	warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	schedule  string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+	schedule string `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
 
 	// optional
 	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
@@ -58,18 +61,23 @@ func (opts *CreateAlertOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		return errors.New("invalid object identifier")
 	}
+	if opts.warehouse != nil && !ValidObjectIdentifier(opts.warehouse) {
+		return errors.New("invalid warehouse identifier")
+	}
 
 	return nil
 }
 
+// Create creates an alert. Passing an empty warehouse identifier creates a serverless alert.
 func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error {
 	if opts == nil {
 		opts = &CreateAlertOptions{}
 	}
 	opts.name = id
-	opts.warehouse = warehouse
+	if warehouse.Name() != "" {
+		opts.warehouse = &warehouse
+	}
 	opts.schedule = schedule
-	opts.name = id
 	opts.condition = []AlertCondition{{Condition: []string{condition}}}
 	opts.action = action
 	if err := opts.validate(); err != nil {
@@ -223,18 +231,23 @@ type Alert struct {
 	Action       string
 }
 
+// IsServerless returns true if the alert runs on Snowflake-managed compute resources.
+func (v *Alert) IsServerless() bool {
+	return v.Warehouse == ""
+}
+
 type alertDBRow struct {
-	CreatedOn    time.Time `db:"created_on"`
-	Name         string    `db:"name"`
-	DatabaseName string    `db:"database_name"`
-	SchemaName   string    `db:"schema_name"`
-	Owner        string    `db:"owner"`
-	Comment      *string   `db:"comment"`
-	Warehouse    string    `db:"warehouse"`
-	Schedule     string    `db:"schedule"`
-	State        string    `db:"state"` // suspended, started
-	Condition    string    `db:"condition"`
-	Action       string    `db:"action"`
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        string         `db:"owner"`
+	Comment      *string        `db:"comment"`
+	Warehouse    sql.NullString `db:"warehouse"`
+	Schedule     string         `db:"schedule"`
+	State        string         `db:"state"` // suspended, started
+	Condition    string         `db:"condition"`
+	Action       string         `db:"action"`
 }
 
 func (row alertDBRow) convert() *Alert {
@@ -245,7 +258,7 @@ func (row alertDBRow) convert() *Alert {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
+		Warehouse:    row.Warehouse.String,
 		Schedule:     row.Schedule,
 		State:        AlertState(row.State),
 		Condition:    row.Condition,
@@ -324,7 +337,7 @@ func (row alertDBRow) toAlertDetails() (*AlertDetails, error) {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
+		Warehouse:    row.Warehouse.String,
 		Schedule:     row.Schedule,
 		State:        row.State,
 		Condition:    row.Condition,
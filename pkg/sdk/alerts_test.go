@@ -19,7 +19,7 @@ func TestAlertCreate(t *testing.T) {
 
 		opts := &CreateAlertOptions{
 			name:      id,
-			warehouse: warehouse,
+			warehouse: &warehouse,
 			schedule:  schedule,
 			condition: []AlertCondition{condition},
 			action:    action,
@@ -28,6 +28,18 @@ func TestAlertCreate(t *testing.T) {
 
 		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s WAREHOUSE = "%s" SCHEDULE = '%s' COMMENT = '%s' IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), warehouse.name, schedule, newComment, existsCondition, action)
 	})
+
+	t.Run("serverless", func(t *testing.T) {
+		schedule := "USING CRON 0 * * * * UTC"
+		opts := &CreateAlertOptions{
+			name:      id,
+			schedule:  schedule,
+			condition: []AlertCondition{{[]string{"SELECT 1"}}},
+			action:    "SELECT 1",
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s SCHEDULE = '%s' IF (EXISTS (SELECT 1)) THEN SELECT 1`, id.FullyQualifiedName(), schedule)
+	})
 }
 
 func TestAlertAlter(t *testing.T) {
@@ -0,0 +1,410 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var _ ApplicationPackages = (*applicationPackages)(nil)
+
+var (
+	_ validatable = new(CreateApplicationPackageOptions)
+	_ validatable = new(AlterApplicationPackageOptions)
+	_ validatable = new(DropApplicationPackageOptions)
+	_ validatable = new(ShowApplicationPackageOptions)
+	_ validatable = new(showApplicationPackageVersionsOptions)
+	_ validatable = new(showApplicationPackageReleaseDirectivesOptions)
+)
+
+// ApplicationPackages manages the application packages containing the versions of the Native Apps offered by the provider.
+type ApplicationPackages interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateApplicationPackageOptions) error
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationPackageOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationPackageOptions) error
+	Show(ctx context.Context, opts *ShowApplicationPackageOptions) ([]ApplicationPackage, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
+	ShowVersions(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageVersion, error)
+	ShowReleaseDirectives(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageReleaseDirective, error)
+}
+
+// applicationPackages implements ApplicationPackages.
+type applicationPackages struct {
+	client *Client
+}
+
+type Distribution string
+
+const (
+	DistributionInternal Distribution = "INTERNAL"
+	DistributionExternal Distribution = "EXTERNAL"
+)
+
+// CreateApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application-package.
+type CreateApplicationPackageOptions struct {
+	create                     bool                    `ddl:"static" sql:"CREATE"`
+	applicationPackage         bool                    `ddl:"static" sql:"APPLICATION PACKAGE"`
+	IfNotExists                *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       AccountObjectIdentifier `ddl:"identifier"`
+	DataRetentionTimeInDays    *int                    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string                 `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Distribution               *Distribution           `ddl:"parameter" sql:"DISTRIBUTION"`
+	Tag                        []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+func (opts *CreateApplicationPackageOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applicationPackages) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateApplicationPackageOptions) error {
+	if opts == nil {
+		opts = &CreateApplicationPackageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-application-package,
+// https://docs.snowflake.com/en/sql-reference/sql/alter-application-package-version and
+// https://docs.snowflake.com/en/sql-reference/sql/alter-application-package-release-directive.
+type AlterApplicationPackageOptions struct {
+	alter                      bool                                   `ddl:"static" sql:"ALTER"`
+	applicationPackage         bool                                   `ddl:"static" sql:"APPLICATION PACKAGE"`
+	IfExists                   *bool                                  `ddl:"keyword" sql:"IF EXISTS"`
+	name                       AccountObjectIdentifier                `ddl:"identifier"`
+	Set                        *ApplicationPackageSet                 `ddl:"keyword" sql:"SET"`
+	Unset                      *ApplicationPackageUnset               `ddl:"list,no_parentheses" sql:"UNSET"`
+	AddVersion                 *AddApplicationPackageVersion          `ddl:"keyword" sql:"ADD VERSION"`
+	DropVersion                *string                                `ddl:"parameter,no_equals" sql:"DROP VERSION"`
+	AddPatch                   *AddApplicationPackagePatch            `ddl:"keyword" sql:"ADD PATCH"`
+	SetDefaultReleaseDirective *ApplicationPackageReleaseDirectiveSet `ddl:"keyword" sql:"SET DEFAULT RELEASE DIRECTIVE"`
+}
+
+type ApplicationPackageSet struct {
+	DataRetentionTimeInDays    *int          `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int          `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string       `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *string       `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Distribution               *Distribution `ddl:"parameter" sql:"DISTRIBUTION"`
+}
+
+type ApplicationPackageUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+	Distribution               *bool `ddl:"keyword" sql:"DISTRIBUTION"`
+}
+
+// AddApplicationPackageVersion adds a version from the files in the given stage location, e.g. @db.schema.stage/v1.
+type AddApplicationPackageVersion struct {
+	VersionIdentifier string  `ddl:"keyword"`
+	Using             string  `ddl:"parameter,single_quotes,no_equals" sql:"USING"`
+	Label             *string `ddl:"parameter,single_quotes" sql:"LABEL"`
+}
+
+// AddApplicationPackagePatch adds the next patch of the version from the files in the given stage location.
+type AddApplicationPackagePatch struct {
+	VersionIdentifier string  `ddl:"parameter,no_equals" sql:"FOR VERSION"`
+	Using             string  `ddl:"parameter,single_quotes,no_equals" sql:"USING"`
+	Label             *string `ddl:"parameter,single_quotes" sql:"LABEL"`
+}
+
+type ApplicationPackageReleaseDirectiveSet struct {
+	Version string `ddl:"parameter" sql:"VERSION"`
+	Patch   int    `ddl:"parameter" sql:"PATCH"`
+}
+
+func (opts *AlterApplicationPackageOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.AddVersion, opts.DropVersion, opts.AddPatch, opts.SetDefaultReleaseDirective) {
+		return errors.New("exactly one of SET, UNSET, ADD VERSION, DROP VERSION, ADD PATCH or SET DEFAULT RELEASE DIRECTIVE must be specified")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.DefaultDDLCollation, opts.Set.Comment, opts.Set.Distribution) {
+		return errors.New("at least one property must be specified for SET")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.DefaultDDLCollation, opts.Unset.Comment, opts.Unset.Distribution) {
+		return errors.New("at least one property must be specified for UNSET")
+	}
+	if valueSet(opts.AddVersion) && (opts.AddVersion.VersionIdentifier == "" || opts.AddVersion.Using == "") {
+		return errors.New("the version identifier and the stage location must be specified for ADD VERSION")
+	}
+	if valueSet(opts.AddPatch) && (opts.AddPatch.VersionIdentifier == "" || opts.AddPatch.Using == "") {
+		return errors.New("the version identifier and the stage location must be specified for ADD PATCH")
+	}
+	if valueSet(opts.SetDefaultReleaseDirective) && opts.SetDefaultReleaseDirective.Version == "" {
+		return errors.New("the version must be specified for SET DEFAULT RELEASE DIRECTIVE")
+	}
+	return nil
+}
+
+func (v *applicationPackages) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationPackageOptions) error {
+	if opts == nil {
+		opts = &AlterApplicationPackageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-application-package.
+type DropApplicationPackageOptions struct {
+	drop               bool                    `ddl:"static" sql:"DROP"`
+	applicationPackage bool                    `ddl:"static" sql:"APPLICATION PACKAGE"`
+	IfExists           *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name               AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropApplicationPackageOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applicationPackages) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationPackageOptions) error {
+	if opts == nil {
+		opts = &DropApplicationPackageOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-application-packages.
+type ShowApplicationPackageOptions struct {
+	show                bool  `ddl:"static" sql:"SHOW"`
+	applicationPackages bool  `ddl:"static" sql:"APPLICATION PACKAGES"`
+	Like                *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowApplicationPackageOptions) validate() error {
+	return nil
+}
+
+// ApplicationPackage is a user friendly result for a SHOW APPLICATION PACKAGES query.
+type ApplicationPackage struct {
+	CreatedOn        time.Time
+	Name             string
+	IsDefault        bool
+	IsCurrent        bool
+	Distribution     Distribution
+	Owner            string
+	Comment          string
+	RetentionTime    int
+	Options          string
+	DroppedOn        string
+	ApplicationClass string
+}
+
+func (v *ApplicationPackage) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ApplicationPackage) ObjectType() ObjectType {
+	return ObjectTypeApplicationPackage
+}
+
+// applicationPackageDBRow is used to decode the result of a SHOW APPLICATION PACKAGES query.
+type applicationPackageDBRow struct {
+	CreatedOn        time.Time      `db:"created_on"`
+	Name             string         `db:"name"`
+	IsDefault        sql.NullString `db:"is_default"`
+	IsCurrent        sql.NullString `db:"is_current"`
+	Distribution     string         `db:"distribution"`
+	Owner            sql.NullString `db:"owner"`
+	Comment          sql.NullString `db:"comment"`
+	RetentionTime    sql.NullInt64  `db:"retention_time"`
+	Options          sql.NullString `db:"options"`
+	DroppedOn        sql.NullString `db:"dropped_on"`
+	ApplicationClass sql.NullString `db:"application_class"`
+}
+
+func (row applicationPackageDBRow) convert() *ApplicationPackage {
+	return &ApplicationPackage{
+		CreatedOn:        row.CreatedOn,
+		Name:             row.Name,
+		IsDefault:        row.IsDefault.String == "Y",
+		IsCurrent:        row.IsCurrent.String == "Y",
+		Distribution:     Distribution(row.Distribution),
+		Owner:            row.Owner.String,
+		Comment:          row.Comment.String,
+		RetentionTime:    int(row.RetentionTime.Int64),
+		Options:          row.Options.String,
+		DroppedOn:        row.DroppedOn.String,
+		ApplicationClass: row.ApplicationClass.String,
+	}
+}
+
+func (v *applicationPackages) Show(ctx context.Context, opts *ShowApplicationPackageOptions) ([]ApplicationPackage, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[applicationPackageDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationPackageDBRow, ApplicationPackage](dbRows)
+	return resultList, nil
+}
+
+func (v *applicationPackages) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error) {
+	applicationPackages, err := v.Show(ctx, &ShowApplicationPackageOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, applicationPackage := range applicationPackages {
+		if applicationPackage.ID().name == id.Name() {
+			return &applicationPackage, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// showApplicationPackageVersionsOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-versions.
+type showApplicationPackageVersionsOptions struct {
+	show bool                    `ddl:"static" sql:"SHOW VERSIONS IN APPLICATION PACKAGE"`
+	name AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *showApplicationPackageVersionsOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ApplicationPackageVersion is a single patch of a version, as returned by a SHOW VERSIONS query.
+type ApplicationPackageVersion struct {
+	Version      string
+	Patch        int
+	Label        string
+	Comment      string
+	CreatedOn    time.Time
+	State        string
+	ReviewStatus string
+}
+
+type applicationPackageVersionDBRow struct {
+	Version      string         `db:"version"`
+	Patch        int            `db:"patch"`
+	Label        sql.NullString `db:"label"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedOn    time.Time      `db:"created_on"`
+	DroppedOn    sql.NullString `db:"dropped_on"`
+	State        sql.NullString `db:"state"`
+	ReviewStatus sql.NullString `db:"review_status"`
+}
+
+func (row applicationPackageVersionDBRow) convert() *ApplicationPackageVersion {
+	return &ApplicationPackageVersion{
+		Version:      row.Version,
+		Patch:        row.Patch,
+		Label:        row.Label.String,
+		Comment:      row.Comment.String,
+		CreatedOn:    row.CreatedOn,
+		State:        row.State.String,
+		ReviewStatus: row.ReviewStatus.String,
+	}
+}
+
+// ShowVersions returns the patches of the versions of the application package, skipping the dropped versions.
+func (v *applicationPackages) ShowVersions(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageVersion, error) {
+	opts := &showApplicationPackageVersionsOptions{name: id}
+	dbRows, err := validateAndQuery[applicationPackageVersionDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]ApplicationPackageVersion, 0, len(dbRows))
+	for _, row := range dbRows {
+		if row.DroppedOn.Valid && row.DroppedOn.String != "" {
+			continue
+		}
+		versions = append(versions, *row.convert())
+	}
+	return versions, nil
+}
+
+// showApplicationPackageReleaseDirectivesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-release-directives.
+type showApplicationPackageReleaseDirectivesOptions struct {
+	show bool                    `ddl:"static" sql:"SHOW RELEASE DIRECTIVES IN APPLICATION PACKAGE"`
+	name AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *showApplicationPackageReleaseDirectivesOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ApplicationPackageReleaseDirective is a user friendly result for a SHOW RELEASE DIRECTIVES query. The default release directive is named DEFAULT.
+type ApplicationPackageReleaseDirective struct {
+	Name       string
+	TargetType string
+	TargetName string
+	CreatedOn  time.Time
+	Version    string
+	Patch      int
+}
+
+type applicationPackageReleaseDirectiveDBRow struct {
+	Name       string         `db:"name"`
+	TargetType sql.NullString `db:"target_type"`
+	TargetName sql.NullString `db:"target_name"`
+	CreatedOn  time.Time      `db:"created_on"`
+	Version    string         `db:"version"`
+	Patch      int            `db:"patch"`
+}
+
+func (row applicationPackageReleaseDirectiveDBRow) convert() *ApplicationPackageReleaseDirective {
+	return &ApplicationPackageReleaseDirective{
+		Name:       row.Name,
+		TargetType: row.TargetType.String,
+		TargetName: row.TargetName.String,
+		CreatedOn:  row.CreatedOn,
+		Version:    row.Version,
+		Patch:      row.Patch,
+	}
+}
+
+func (v *applicationPackages) ShowReleaseDirectives(ctx context.Context, id AccountObjectIdentifier) ([]ApplicationPackageReleaseDirective, error) {
+	opts := &showApplicationPackageReleaseDirectivesOptions{name: id}
+	dbRows, err := validateAndQuery[applicationPackageReleaseDirectiveDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[applicationPackageReleaseDirectiveDBRow, ApplicationPackageReleaseDirective](dbRows), nil
+}
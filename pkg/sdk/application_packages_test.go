@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplicationPackagesCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("pkg1")
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateApplicationPackageOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateApplicationPackageOptions{name: id}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION PACKAGE "pkg1"`)
+	})
+
+	t.Run("with all options", func(t *testing.T) {
+		opts := &CreateApplicationPackageOptions{
+			name:                       id,
+			IfNotExists:                Bool(true),
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(14),
+			DefaultDDLCollation:        String("en_US"),
+			Comment:                    String("native app"),
+			Distribution:               Pointer(DistributionExternal),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION PACKAGE IF NOT EXISTS "pkg1" DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 DEFAULT_DDL_COLLATION = 'en_US' COMMENT = 'native app' DISTRIBUTION = EXTERNAL`)
+	})
+}
+
+func TestApplicationPackagesAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("pkg1")
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{name: id}
+		assertOptsInvalid(t, opts, errors.New("exactly one of SET, UNSET, ADD VERSION, DROP VERSION, ADD PATCH or SET DEFAULT RELEASE DIRECTIVE must be specified"))
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{name: id, Set: &ApplicationPackageSet{}}
+		assertOptsInvalid(t, opts, errors.New("at least one property must be specified for SET"))
+	})
+
+	t.Run("validation: version without stage location", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{name: id, AddVersion: &AddApplicationPackageVersion{VersionIdentifier: "v1"}}
+		assertOptsInvalid(t, opts, errors.New("the version identifier and the stage location must be specified for ADD VERSION"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			Set: &ApplicationPackageSet{
+				Comment:      String("native app"),
+				Distribution: Pointer(DistributionInternal),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE "pkg1" SET COMMENT = 'native app' DISTRIBUTION = INTERNAL`)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			Unset: &ApplicationPackageUnset{
+				DataRetentionTimeInDays: Bool(true),
+				Comment:                 Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE "pkg1" UNSET DATA_RETENTION_TIME_IN_DAYS, COMMENT`)
+	})
+
+	t.Run("add version", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			AddVersion: &AddApplicationPackageVersion{
+				VersionIdentifier: "v1_0",
+				Using:             "@db.schema.stage/v1",
+				Label:             String("first version"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE "pkg1" ADD VERSION v1_0 USING '@db.schema.stage/v1' LABEL = 'first version'`)
+	})
+
+	t.Run("drop version", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{name: id, DropVersion: String("v1_0")}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE "pkg1" DROP VERSION v1_0`)
+	})
+
+	t.Run("add patch", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name: id,
+			AddPatch: &AddApplicationPackagePatch{
+				VersionIdentifier: "v1_0",
+				Using:             "@db.schema.stage/v1_1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE "pkg1" ADD PATCH FOR VERSION v1_0 USING '@db.schema.stage/v1_1'`)
+	})
+
+	t.Run("set default release directive", func(t *testing.T) {
+		opts := &AlterApplicationPackageOptions{
+			name:                       id,
+			SetDefaultReleaseDirective: &ApplicationPackageReleaseDirectiveSet{Version: "v1_0", Patch: 0},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION PACKAGE "pkg1" SET DEFAULT RELEASE DIRECTIVE VERSION = v1_0 PATCH = 0`)
+	})
+}
+
+func TestApplicationPackagesDrop(t *testing.T) {
+	t.Run("if exists", func(t *testing.T) {
+		opts := &DropApplicationPackageOptions{name: NewAccountObjectIdentifier("pkg1"), IfExists: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, `DROP APPLICATION PACKAGE IF EXISTS "pkg1"`)
+	})
+}
+
+func TestApplicationPackagesShow(t *testing.T) {
+	t.Run("like", func(t *testing.T) {
+		opts := &ShowApplicationPackageOptions{Like: &Like{Pattern: String("pkg1")}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION PACKAGES LIKE 'pkg1'`)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		opts := &showApplicationPackageVersionsOptions{name: NewAccountObjectIdentifier("pkg1")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW VERSIONS IN APPLICATION PACKAGE "pkg1"`)
+	})
+
+	t.Run("release directives", func(t *testing.T) {
+		opts := &showApplicationPackageReleaseDirectivesOptions{name: NewAccountObjectIdentifier("pkg1")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW RELEASE DIRECTIVES IN APPLICATION PACKAGE "pkg1"`)
+	})
+}
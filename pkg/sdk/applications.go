@@ -0,0 +1,350 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+var _ Applications = (*applications)(nil)
+
+var (
+	_ validatable = new(CreateApplicationOptions)
+	_ validatable = new(AlterApplicationOptions)
+	_ validatable = new(DropApplicationOptions)
+	_ validatable = new(ShowApplicationOptions)
+	_ validatable = new(describeApplicationOptions)
+)
+
+// Applications manages the Native Apps installed in the account, either from an application package or from a listing.
+type Applications interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateApplicationOptions) error
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationOptions) error
+	Show(ctx context.Context, opts *ShowApplicationOptions) ([]Application, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Application, error)
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ApplicationDetails, error)
+}
+
+// applications implements Applications.
+type applications struct {
+	client *Client
+}
+
+// CreateApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application.
+type CreateApplicationOptions struct {
+	create                 bool                     `ddl:"static" sql:"CREATE"`
+	application            bool                     `ddl:"static" sql:"APPLICATION"`
+	name                   AccountObjectIdentifier  `ddl:"identifier"`
+	FromApplicationPackage *AccountObjectIdentifier `ddl:"identifier" sql:"FROM APPLICATION PACKAGE"`
+	Using                  *ApplicationVersion      `ddl:"keyword" sql:"USING"`
+	FromListing            *AccountObjectIdentifier `ddl:"identifier" sql:"FROM LISTING"`
+	Comment                *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	DebugMode              *bool                    `ddl:"parameter" sql:"DEBUG_MODE"`
+	Tag                    []TagAssociation         `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+// ApplicationVersion selects the version, and optionally the patch, of the application package to install.
+// When the patch is not specified, the latest patch of the version is installed.
+type ApplicationVersion struct {
+	Version string `ddl:"parameter,no_equals" sql:"VERSION"`
+	Patch   *int   `ddl:"parameter,no_equals" sql:"PATCH"`
+}
+
+func (opts *CreateApplicationOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.FromApplicationPackage, opts.FromListing) {
+		return errors.New("exactly one of FromApplicationPackage or FromListing must be specified")
+	}
+	if valueSet(opts.Using) && !valueSet(opts.FromApplicationPackage) {
+		return errors.New("the version can only be specified when installing from an application package")
+	}
+	if valueSet(opts.Using) && opts.Using.Version == "" {
+		return errors.New("the version must not be empty")
+	}
+	return nil
+}
+
+func (v *applications) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateApplicationOptions) error {
+	if opts == nil {
+		opts = &CreateApplicationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-application.
+type AlterApplicationOptions struct {
+	alter       bool                    `ddl:"static" sql:"ALTER"`
+	application bool                    `ddl:"static" sql:"APPLICATION"`
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	Set         *ApplicationSet         `ddl:"keyword" sql:"SET"`
+	Unset       *ApplicationUnset       `ddl:"list,no_parentheses" sql:"UNSET"`
+	Upgrade     *ApplicationUpgrade     `ddl:"keyword" sql:"UPGRADE"`
+}
+
+type ApplicationSet struct {
+	Comment                 *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	DebugMode               *bool   `ddl:"parameter" sql:"DEBUG_MODE"`
+	ShareEventsWithProvider *bool   `ddl:"parameter" sql:"SHARE_EVENTS_WITH_PROVIDER"`
+}
+
+type ApplicationUnset struct {
+	Comment                 *bool `ddl:"keyword" sql:"COMMENT"`
+	DebugMode               *bool `ddl:"keyword" sql:"DEBUG_MODE"`
+	ShareEventsWithProvider *bool `ddl:"keyword" sql:"SHARE_EVENTS_WITH_PROVIDER"`
+}
+
+// ApplicationUpgrade upgrades the application to the version of the release directive, or to the given version of the application package.
+type ApplicationUpgrade struct {
+	Using *ApplicationVersion `ddl:"keyword" sql:"USING"`
+}
+
+func (opts *AlterApplicationOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.Upgrade) {
+		return errors.New("exactly one of SET, UNSET or UPGRADE must be specified")
+	}
+	if valueSet(opts.Set) && !anyValueSet(opts.Set.Comment, opts.Set.DebugMode, opts.Set.ShareEventsWithProvider) {
+		return errors.New("at least one property must be specified for SET")
+	}
+	if valueSet(opts.Unset) && !anyValueSet(opts.Unset.Comment, opts.Unset.DebugMode, opts.Unset.ShareEventsWithProvider) {
+		return errors.New("at least one property must be specified for UNSET")
+	}
+	if valueSet(opts.Upgrade) && valueSet(opts.Upgrade.Using) && opts.Upgrade.Using.Version == "" {
+		return errors.New("the version must not be empty")
+	}
+	return nil
+}
+
+func (v *applications) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterApplicationOptions) error {
+	if opts == nil {
+		opts = &AlterApplicationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-application.
+type DropApplicationOptions struct {
+	drop        bool                    `ddl:"static" sql:"DROP"`
+	application bool                    `ddl:"static" sql:"APPLICATION"`
+	IfExists    *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	Cascade     *bool                   `ddl:"keyword" sql:"CASCADE"`
+}
+
+func (opts *DropApplicationOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *applications) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropApplicationOptions) error {
+	if opts == nil {
+		opts = &DropApplicationOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-applications.
+type ShowApplicationOptions struct {
+	show         bool  `ddl:"static" sql:"SHOW"`
+	applications bool  `ddl:"static" sql:"APPLICATIONS"`
+	Like         *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowApplicationOptions) validate() error {
+	return nil
+}
+
+// Application is a user friendly result for a SHOW APPLICATIONS query.
+type Application struct {
+	CreatedOn     time.Time
+	Name          string
+	IsDefault     bool
+	IsCurrent     bool
+	SourceType    string
+	Source        string
+	Owner         string
+	Comment       string
+	Version       string
+	Label         string
+	Patch         int
+	Options       string
+	RetentionTime int
+}
+
+func (v *Application) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Application) ObjectType() ObjectType {
+	return ObjectTypeApplication
+}
+
+// applicationDBRow is used to decode the result of a SHOW APPLICATIONS query.
+type applicationDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	IsDefault     sql.NullString `db:"is_default"`
+	IsCurrent     sql.NullString `db:"is_current"`
+	SourceType    sql.NullString `db:"source_type"`
+	Source        sql.NullString `db:"source"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	Version       sql.NullString `db:"version"`
+	Label         sql.NullString `db:"label"`
+	Patch         sql.NullInt64  `db:"patch"`
+	Options       sql.NullString `db:"options"`
+	RetentionTime sql.NullInt64  `db:"retention_time"`
+}
+
+func (row applicationDBRow) convert() *Application {
+	return &Application{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		IsDefault:     row.IsDefault.String == "Y",
+		IsCurrent:     row.IsCurrent.String == "Y",
+		SourceType:    row.SourceType.String,
+		Source:        row.Source.String,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		Version:       row.Version.String,
+		Label:         row.Label.String,
+		Patch:         int(row.Patch.Int64),
+		Options:       row.Options.String,
+		RetentionTime: int(row.RetentionTime.Int64),
+	}
+}
+
+func (v *applications) Show(ctx context.Context, opts *ShowApplicationOptions) ([]Application, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[applicationDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[applicationDBRow, Application](dbRows)
+	return resultList, nil
+}
+
+func (v *applications) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Application, error) {
+	applications, err := v.Show(ctx, &ShowApplicationOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range applications {
+		if application.ID().name == id.Name() {
+			return &application, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// describeApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-application.
+type describeApplicationOptions struct {
+	describe    bool                    `ddl:"static" sql:"DESCRIBE"`
+	application bool                    `ddl:"static" sql:"APPLICATION"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeApplicationOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ApplicationDetails contains the properties of the application returned by DESCRIBE APPLICATION.
+type ApplicationDetails struct {
+	Name                    string
+	Source                  string
+	Version                 string
+	Patch                   *int
+	Label                   string
+	DebugMode               bool
+	ShareEventsWithProvider bool
+	UpgradeState            string
+	UpgradeTargetVersion    string
+	UpgradeTargetPatch      *int
+	UpgradeFailureReason    string
+}
+
+func applicationDetailsFromRows(rows []propertyRow) *ApplicationDetails {
+	v := &ApplicationDetails{}
+	for _, row := range rows {
+		switch strings.ToLower(row.Property) {
+		case "name":
+			v.Name = row.toStringProperty().Value
+		case "source":
+			v.Source = row.toStringProperty().Value
+		case "version":
+			v.Version = row.toStringProperty().Value
+		case "patch":
+			v.Patch = row.toIntProperty().Value
+		case "label":
+			v.Label = row.toStringProperty().Value
+		case "debug_mode":
+			v.DebugMode = row.toBoolProperty().Value
+		case "share_events_with_provider":
+			v.ShareEventsWithProvider = row.toBoolProperty().Value
+		case "upgrade_state":
+			v.UpgradeState = row.toStringProperty().Value
+		case "upgrade_target_version":
+			v.UpgradeTargetVersion = row.toStringProperty().Value
+		case "upgrade_target_patch":
+			v.UpgradeTargetPatch = row.toIntProperty().Value
+		case "upgrade_failure_reason":
+			v.UpgradeFailureReason = row.toStringProperty().Value
+		}
+	}
+	return v
+}
+
+func (v *applications) Describe(ctx context.Context, id AccountObjectIdentifier) (*ApplicationDetails, error) {
+	opts := &describeApplicationOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[propertyRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return applicationDetailsFromRows(rows), nil
+}
@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationsCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("app1")
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateApplicationOptions{FromApplicationPackage: Pointer(NewAccountObjectIdentifier("pkg1"))}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: no source", func(t *testing.T) {
+		opts := &CreateApplicationOptions{name: id}
+		assertOptsInvalid(t, opts, errors.New("exactly one of FromApplicationPackage or FromListing must be specified"))
+	})
+
+	t.Run("validation: version of a listing", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:        id,
+			FromListing: Pointer(NewAccountObjectIdentifier("GZ1234")),
+			Using:       &ApplicationVersion{Version: "v1_0"},
+		}
+		assertOptsInvalid(t, opts, errors.New("the version can only be specified when installing from an application package"))
+	})
+
+	t.Run("from application package", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:                   id,
+			FromApplicationPackage: Pointer(NewAccountObjectIdentifier("pkg1")),
+			Using:                  &ApplicationVersion{Version: "v1_0", Patch: Int(2)},
+			Comment:                String("installed"),
+			DebugMode:              Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION "app1" FROM APPLICATION PACKAGE "pkg1" USING VERSION v1_0 PATCH 2 COMMENT = 'installed' DEBUG_MODE = true`)
+	})
+
+	t.Run("from listing", func(t *testing.T) {
+		opts := &CreateApplicationOptions{
+			name:        id,
+			FromListing: Pointer(NewAccountObjectIdentifier("GZ1234")),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION "app1" FROM LISTING "GZ1234"`)
+	})
+}
+
+func TestApplicationsAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("app1")
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterApplicationOptions{name: id}
+		assertOptsInvalid(t, opts, errors.New("exactly one of SET, UNSET or UPGRADE must be specified"))
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := &AlterApplicationOptions{name: id, Unset: &ApplicationUnset{}}
+		assertOptsInvalid(t, opts, errors.New("at least one property must be specified for UNSET"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name: id,
+			Set:  &ApplicationSet{Comment: String("installed"), DebugMode: Bool(false)},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION "app1" SET COMMENT = 'installed' DEBUG_MODE = false`)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name:  id,
+			Unset: &ApplicationUnset{Comment: Bool(true), DebugMode: Bool(true)},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION "app1" UNSET COMMENT, DEBUG_MODE`)
+	})
+
+	t.Run("upgrade", func(t *testing.T) {
+		opts := &AlterApplicationOptions{name: id, Upgrade: &ApplicationUpgrade{}}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION "app1" UPGRADE`)
+	})
+
+	t.Run("upgrade to version", func(t *testing.T) {
+		opts := &AlterApplicationOptions{
+			name:    id,
+			Upgrade: &ApplicationUpgrade{Using: &ApplicationVersion{Version: "v2_0"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER APPLICATION "app1" UPGRADE USING VERSION v2_0`)
+	})
+}
+
+func TestApplicationsDrop(t *testing.T) {
+	t.Run("cascade", func(t *testing.T) {
+		opts := &DropApplicationOptions{name: NewAccountObjectIdentifier("app1"), IfExists: Bool(true), Cascade: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, `DROP APPLICATION IF EXISTS "app1" CASCADE`)
+	})
+}
+
+func TestApplicationsShowAndDescribe(t *testing.T) {
+	t.Run("show", func(t *testing.T) {
+		opts := &ShowApplicationOptions{Like: &Like{Pattern: String("app1")}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATIONS LIKE 'app1'`)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		opts := &describeApplicationOptions{name: NewAccountObjectIdentifier("app1")}
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE APPLICATION "app1"`)
+	})
+}
+
+func TestApplicationDetailsFromRows(t *testing.T) {
+	details := applicationDetailsFromRows([]propertyRow{
+		{Property: "name", Value: "APP1"},
+		{Property: "version", Value: "V1_0"},
+		{Property: "patch", Value: "2"},
+		{Property: "debug_mode", Value: "true"},
+		{Property: "upgrade_state", Value: "COMPLETE"},
+		{Property: "upgrade_target_patch", Value: "null"},
+	})
+	assert.Equal(t, "APP1", details.Name)
+	assert.Equal(t, "V1_0", details.Version)
+	require.NotNil(t, details.Patch)
+	assert.Equal(t, 2, *details.Patch)
+	assert.True(t, details.DebugMode)
+	assert.Equal(t, "COMPLETE", details.UpgradeState)
+	assert.Nil(t, details.UpgradeTargetPatch)
+}
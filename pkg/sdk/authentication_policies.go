@@ -0,0 +1,373 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+var _ AuthenticationPolicies = (*authenticationPolicies)(nil)
+
+var (
+	_ validatable = new(CreateAuthenticationPolicyOptions)
+	_ validatable = new(AlterAuthenticationPolicyOptions)
+	_ validatable = new(DropAuthenticationPolicyOptions)
+	_ validatable = new(ShowAuthenticationPolicyOptions)
+	_ validatable = new(describeAuthenticationPolicyOptions)
+)
+
+type AuthenticationPolicies interface {
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateAuthenticationPolicyOptions) error
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAuthenticationPolicyOptions) error
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAuthenticationPolicyOptions) error
+	Show(ctx context.Context, opts *ShowAuthenticationPolicyOptions) ([]AuthenticationPolicy, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AuthenticationPolicy, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*AuthenticationPolicyDetails, error)
+}
+
+// authenticationPolicies implements AuthenticationPolicies.
+type authenticationPolicies struct {
+	client *Client
+}
+
+type AuthenticationMethodsOption string
+
+const (
+	AuthenticationMethodsAll      AuthenticationMethodsOption = "ALL"
+	AuthenticationMethodsSaml     AuthenticationMethodsOption = "SAML"
+	AuthenticationMethodsPassword AuthenticationMethodsOption = "PASSWORD"
+	AuthenticationMethodsOauth    AuthenticationMethodsOption = "OAUTH"
+	AuthenticationMethodsKeyPair  AuthenticationMethodsOption = "KEYPAIR"
+)
+
+type MfaAuthenticationMethodsOption string
+
+const (
+	MfaAuthenticationMethodsAll      MfaAuthenticationMethodsOption = "ALL"
+	MfaAuthenticationMethodsSaml     MfaAuthenticationMethodsOption = "SAML"
+	MfaAuthenticationMethodsPassword MfaAuthenticationMethodsOption = "PASSWORD"
+)
+
+type MfaEnrollmentOption string
+
+const (
+	MfaEnrollmentRequired MfaEnrollmentOption = "REQUIRED"
+	MfaEnrollmentOptional MfaEnrollmentOption = "OPTIONAL"
+)
+
+type ClientTypesOption string
+
+const (
+	ClientTypesAll         ClientTypesOption = "ALL"
+	ClientTypesSnowflakeUi ClientTypesOption = "SNOWFLAKE_UI"
+	ClientTypesDrivers     ClientTypesOption = "DRIVERS"
+	ClientTypesSnowSql     ClientTypesOption = "SNOWSQL"
+)
+
+type AuthenticationMethods struct {
+	Method AuthenticationMethodsOption `ddl:"keyword,single_quotes"`
+}
+
+type MfaAuthenticationMethods struct {
+	Method MfaAuthenticationMethodsOption `ddl:"keyword,single_quotes"`
+}
+
+type ClientTypes struct {
+	ClientType ClientTypesOption `ddl:"keyword,single_quotes"`
+}
+
+// SecurityIntegrationsOption holds the name of a security integration or ALL.
+type SecurityIntegrationsOption struct {
+	Name string `ddl:"keyword,single_quotes"`
+}
+
+// CreateAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-authentication-policy.
+type CreateAuthenticationPolicyOptions struct {
+	create               bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace            *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	authenticationPolicy bool                   `ddl:"static" sql:"AUTHENTICATION POLICY"`
+	IfNotExists          *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                 SchemaObjectIdentifier `ddl:"identifier"`
+
+	AuthenticationMethods    []AuthenticationMethods      `ddl:"parameter,parentheses" sql:"AUTHENTICATION_METHODS"`
+	MfaAuthenticationMethods []MfaAuthenticationMethods   `ddl:"parameter,parentheses" sql:"MFA_AUTHENTICATION_METHODS"`
+	MfaEnrollment            *MfaEnrollmentOption         `ddl:"parameter" sql:"MFA_ENROLLMENT"`
+	ClientTypes              []ClientTypes                `ddl:"parameter,parentheses" sql:"CLIENT_TYPES"`
+	SecurityIntegrations     []SecurityIntegrationsOption `ddl:"parameter,parentheses" sql:"SECURITY_INTEGRATIONS"`
+	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateAuthenticationPolicyOptions) validate() error {
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		errs = append(errs, errOneOf("CreateAuthenticationPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	return errors.Join(errs...)
+}
+
+func (v *authenticationPolicies) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateAuthenticationPolicyOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// AlterAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-authentication-policy.
+type AlterAuthenticationPolicyOptions struct {
+	alter                bool                       `ddl:"static" sql:"ALTER"`
+	authenticationPolicy bool                       `ddl:"static" sql:"AUTHENTICATION POLICY"`
+	IfExists             *bool                      `ddl:"keyword" sql:"IF EXISTS"`
+	name                 SchemaObjectIdentifier     `ddl:"identifier"`
+	Set                  *AuthenticationPolicySet   `ddl:"keyword" sql:"SET"`
+	Unset                *AuthenticationPolicyUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	RenameTo             *SchemaObjectIdentifier    `ddl:"identifier" sql:"RENAME TO"`
+}
+
+func (opts *AlterAuthenticationPolicyOptions) validate() error {
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.RenameTo) {
+		errs = append(errs, errExactlyOneOf("Set", "Unset", "RenameTo"))
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type AuthenticationPolicySet struct {
+	AuthenticationMethods    []AuthenticationMethods      `ddl:"parameter,parentheses" sql:"AUTHENTICATION_METHODS"`
+	MfaAuthenticationMethods []MfaAuthenticationMethods   `ddl:"parameter,parentheses" sql:"MFA_AUTHENTICATION_METHODS"`
+	MfaEnrollment            *MfaEnrollmentOption         `ddl:"parameter" sql:"MFA_ENROLLMENT"`
+	ClientTypes              []ClientTypes                `ddl:"parameter,parentheses" sql:"CLIENT_TYPES"`
+	SecurityIntegrations     []SecurityIntegrationsOption `ddl:"parameter,parentheses" sql:"SECURITY_INTEGRATIONS"`
+	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *AuthenticationPolicySet) validate() error {
+	if !anyValueSet(v.AuthenticationMethods, v.MfaAuthenticationMethods, v.MfaEnrollment, v.ClientTypes, v.SecurityIntegrations, v.Comment) {
+		return errAtLeastOneOf("AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment")
+	}
+	return nil
+}
+
+type AuthenticationPolicyUnset struct {
+	AuthenticationMethods    *bool `ddl:"keyword" sql:"AUTHENTICATION_METHODS"`
+	MfaAuthenticationMethods *bool `ddl:"keyword" sql:"MFA_AUTHENTICATION_METHODS"`
+	MfaEnrollment            *bool `ddl:"keyword" sql:"MFA_ENROLLMENT"`
+	ClientTypes              *bool `ddl:"keyword" sql:"CLIENT_TYPES"`
+	SecurityIntegrations     *bool `ddl:"keyword" sql:"SECURITY_INTEGRATIONS"`
+	Comment                  *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *AuthenticationPolicyUnset) validate() error {
+	if !anyValueSet(v.AuthenticationMethods, v.MfaAuthenticationMethods, v.MfaEnrollment, v.ClientTypes, v.SecurityIntegrations, v.Comment) {
+		return errAtLeastOneOf("AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment")
+	}
+	return nil
+}
+
+func (v *authenticationPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAuthenticationPolicyOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// DropAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-authentication-policy.
+type DropAuthenticationPolicyOptions struct {
+	drop                 bool                   `ddl:"static" sql:"DROP"`
+	authenticationPolicy bool                   `ddl:"static" sql:"AUTHENTICATION POLICY"`
+	IfExists             *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name                 SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropAuthenticationPolicyOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *authenticationPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAuthenticationPolicyOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// ShowAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-authentication-policies.
+type ShowAuthenticationPolicyOptions struct {
+	show                   bool  `ddl:"static" sql:"SHOW"`
+	authenticationPolicies bool  `ddl:"static" sql:"AUTHENTICATION POLICIES"`
+	Like                   *Like `ddl:"keyword" sql:"LIKE"`
+	In                     *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowAuthenticationPolicyOptions) validate() error {
+	if valueSet(opts.Like) && !valueSet(opts.Like.Pattern) {
+		return ErrPatternRequiredForLikeKeyword
+	}
+	return nil
+}
+
+type AuthenticationPolicy struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Kind          string
+	Owner         string
+	Comment       string
+	OwnerRoleType string
+	Options       string
+}
+
+func (v *AuthenticationPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *AuthenticationPolicy) ObjectType() ObjectType {
+	return ObjectTypeAuthenticationPolicy
+}
+
+type authenticationPolicyDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Kind          string         `db:"kind"`
+	Owner         string         `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+	Options       sql.NullString `db:"options"`
+}
+
+func (row authenticationPolicyDBRow) convert() *AuthenticationPolicy {
+	return &AuthenticationPolicy{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		Kind:          row.Kind,
+		Owner:         row.Owner,
+		Comment:       row.Comment.String,
+		OwnerRoleType: row.OwnerRoleType.String,
+		Options:       row.Options.String,
+	}
+}
+
+func (v *authenticationPolicies) Show(ctx context.Context, opts *ShowAuthenticationPolicyOptions) ([]AuthenticationPolicy, error) {
+	opts = createIfNil(opts)
+	rows, err := validateAndQuery[authenticationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[authenticationPolicyDBRow, AuthenticationPolicy](rows), nil
+}
+
+func (v *authenticationPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AuthenticationPolicy, error) {
+	authenticationPolicies, err := v.Show(ctx, &ShowAuthenticationPolicyOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, authenticationPolicy := range authenticationPolicies {
+		if authenticationPolicy.ID().name == id.Name() {
+			return &authenticationPolicy, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// describeAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-authentication-policy.
+type describeAuthenticationPolicyOptions struct {
+	describe             bool                   `ddl:"static" sql:"DESCRIBE"`
+	authenticationPolicy bool                   `ddl:"static" sql:"AUTHENTICATION POLICY"`
+	name                 SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeAuthenticationPolicyOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type AuthenticationPolicyDetails struct {
+	Name                     *StringProperty
+	Owner                    *StringProperty
+	Comment                  *StringProperty
+	AuthenticationMethods    *StringProperty
+	MfaAuthenticationMethods *StringProperty
+	MfaEnrollment            *StringProperty
+	ClientTypes              *StringProperty
+	SecurityIntegrations     *StringProperty
+}
+
+func authenticationPolicyDetailsFromRows(rows []propertyRow) *AuthenticationPolicyDetails {
+	v := &AuthenticationPolicyDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "NAME":
+			v.Name = row.toStringProperty()
+		case "OWNER":
+			v.Owner = row.toStringProperty()
+		case "COMMENT":
+			v.Comment = row.toStringProperty()
+		case "AUTHENTICATION_METHODS":
+			v.AuthenticationMethods = row.toStringProperty()
+		case "MFA_AUTHENTICATION_METHODS":
+			v.MfaAuthenticationMethods = row.toStringProperty()
+		case "MFA_ENROLLMENT":
+			v.MfaEnrollment = row.toStringProperty()
+		case "CLIENT_TYPES":
+			v.ClientTypes = row.toStringProperty()
+		case "SECURITY_INTEGRATIONS":
+			v.SecurityIntegrations = row.toStringProperty()
+		}
+	}
+	return v
+}
+
+func (v *authenticationPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*AuthenticationPolicyDetails, error) {
+	opts := &describeAuthenticationPolicyOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[propertyRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return authenticationPolicyDetailsFromRows(rows), nil
+}
+
+// ParseAuthenticationPolicyList parses list values returned by DESCRIBE AUTHENTICATION POLICY, e.g. [PASSWORD, SAML].
+func ParseAuthenticationPolicyList(value string) []string {
+	trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]"))
+	if trimmed == "" {
+		return []string{}
+	}
+	parts := strings.Split(trimmed, ",")
+	result := make([]string, len(parts))
+	for i, part := range parts {
+		result[i] = strings.Trim(strings.TrimSpace(part), `'"`)
+	}
+	return result
+}
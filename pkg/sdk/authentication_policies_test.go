@@ -0,0 +1,180 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticationPolicyCreate(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &CreateAuthenticationPolicyOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateAuthenticationPolicyOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAuthenticationPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &CreateAuthenticationPolicyOptions{
+			name: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		mfaEnrollment := MfaEnrollmentRequired
+		opts := &CreateAuthenticationPolicyOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			AuthenticationMethods: []AuthenticationMethods{
+				{Method: AuthenticationMethodsPassword},
+				{Method: AuthenticationMethodsSaml},
+			},
+			MfaAuthenticationMethods: []MfaAuthenticationMethods{
+				{Method: MfaAuthenticationMethodsPassword},
+			},
+			MfaEnrollment: &mfaEnrollment,
+			ClientTypes: []ClientTypes{
+				{ClientType: ClientTypesSnowflakeUi},
+				{ClientType: ClientTypesDrivers},
+			},
+			SecurityIntegrations: []SecurityIntegrationsOption{
+				{Name: "ALL"},
+			},
+			Comment: String("test comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE AUTHENTICATION POLICY %s AUTHENTICATION_METHODS = ('PASSWORD', 'SAML') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = REQUIRED CLIENT_TYPES = ('SNOWFLAKE_UI', 'DRIVERS') SECURITY_INTEGRATIONS = ('ALL') COMMENT = 'test comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicyAlter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &AlterAuthenticationPolicyOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: only name", func(t *testing.T) {
+		opts := &AlterAuthenticationPolicyOptions{
+			name: id,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Set", "Unset", "RenameTo"))
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterAuthenticationPolicyOptions{
+			name: id,
+			Set:  &AuthenticationPolicySet{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		mfaEnrollment := MfaEnrollmentOptional
+		opts := &AlterAuthenticationPolicyOptions{
+			name:     id,
+			IfExists: Bool(true),
+			Set: &AuthenticationPolicySet{
+				AuthenticationMethods: []AuthenticationMethods{
+					{Method: AuthenticationMethodsKeyPair},
+				},
+				MfaEnrollment: &mfaEnrollment,
+				Comment:       String("new comment"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s SET AUTHENTICATION_METHODS = ('KEYPAIR') MFA_ENROLLMENT = OPTIONAL COMMENT = 'new comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterAuthenticationPolicyOptions{
+			name: id,
+			Unset: &AuthenticationPolicyUnset{
+				ClientTypes: Bool(true),
+				Comment:     Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s UNSET CLIENT_TYPES, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newID := NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), random.UUID())
+		opts := &AlterAuthenticationPolicyOptions{
+			name:     id,
+			RenameTo: &newID,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicyDrop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &DropAuthenticationPolicyOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropAuthenticationPolicyOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "DROP AUTHENTICATION POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicyShow(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowAuthenticationPolicyOptions{}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AUTHENTICATION POLICIES")
+	})
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		schemaIdentifier := NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())
+		opts := &ShowAuthenticationPolicyOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: schemaIdentifier,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW AUTHENTICATION POLICIES LIKE '%s' IN SCHEMA %s", id.Name(), schemaIdentifier.FullyQualifiedName())
+	})
+}
+
+func TestAuthenticationPolicyDescribe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &describeAuthenticationPolicyOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &describeAuthenticationPolicyOptions{
+			name: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE AUTHENTICATION POLICY %s", id.FullyQualifiedName())
+	})
+}
+
+func TestParseAuthenticationPolicyList(t *testing.T) {
+	assert.Equal(t, []string{"ALL"}, ParseAuthenticationPolicyList("[ALL]"))
+	assert.Equal(t, []string{"PASSWORD", "SAML"}, ParseAuthenticationPolicyList("[PASSWORD, SAML]"))
+	assert.Equal(t, []string{"SNOWFLAKE_UI"}, ParseAuthenticationPolicyList("['SNOWFLAKE_UI']"))
+	assert.Equal(t, []string{}, ParseAuthenticationPolicyList("[]"))
+}
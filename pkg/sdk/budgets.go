@@ -0,0 +1,295 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var _ Budgets = (*budgets)(nil)
+
+var (
+	_ validatable = new(CreateBudgetOptions)
+	_ validatable = new(DropBudgetOptions)
+	_ validatable = new(ShowBudgetOptions)
+)
+
+// Budgets manages instances of the SNOWFLAKE.CORE.BUDGET class. Apart from creating, dropping and listing the instances,
+// budgets are configured by calling the instance methods (e.g. <budget_name>!SET_SPENDING_LIMIT).
+type Budgets interface {
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateBudgetOptions) error
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropBudgetOptions) error
+	Show(ctx context.Context, opts *ShowBudgetOptions) ([]Budget, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error)
+	SetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier, spendingLimit int) error
+	GetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier) (int, error)
+	SetEmailNotifications(ctx context.Context, id SchemaObjectIdentifier, notificationIntegration AccountObjectIdentifier, emails []string) error
+	GetNotificationEmails(ctx context.Context, id SchemaObjectIdentifier) ([]string, error)
+	GetNotificationIntegrationName(ctx context.Context, id SchemaObjectIdentifier) (string, error)
+	AddResource(ctx context.Context, id SchemaObjectIdentifier, resourceType ObjectType, resourceID ObjectIdentifier) error
+	RemoveResource(ctx context.Context, id SchemaObjectIdentifier, resourceType ObjectType, resourceID ObjectIdentifier) error
+	GetLinkedResources(ctx context.Context, id SchemaObjectIdentifier) ([]BudgetLinkedResource, error)
+}
+
+// budgets implements Budgets.
+type budgets struct {
+	client *Client
+}
+
+// CreateBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/create-budget.
+type CreateBudgetOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	budget      bool                   `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"`
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	arguments   bool                   `ddl:"static" sql:"()"`
+}
+
+func (opts *CreateBudgetOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		return errOneOf("CreateBudgetOptions", "OrReplace", "IfNotExists")
+	}
+	return nil
+}
+
+func (v *budgets) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateBudgetOptions) error {
+	if opts == nil {
+		opts = &CreateBudgetOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/drop-budget.
+type DropBudgetOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	budget   bool                   `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropBudgetOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *budgets) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropBudgetOptions) error {
+	if opts == nil {
+		opts = &DropBudgetOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/show-budget.
+type ShowBudgetOptions struct {
+	show    bool  `ddl:"static" sql:"SHOW"`
+	budgets bool  `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET INSTANCES"`
+	Like    *Like `ddl:"keyword" sql:"LIKE"`
+	In      *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowBudgetOptions) validate() error {
+	return nil
+}
+
+type Budget struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	CurrentVersion string
+	Comment        string
+	Owner          string
+	OwnerRoleType  string
+}
+
+func (v *Budget) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+type budgetDBRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	CurrentVersion sql.NullString `db:"current_version"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          sql.NullString `db:"owner"`
+	OwnerRoleType  sql.NullString `db:"owner_role_type"`
+}
+
+func (row budgetDBRow) convert() *Budget {
+	return &Budget{
+		CreatedOn:      row.CreatedOn,
+		Name:           row.Name,
+		DatabaseName:   row.DatabaseName,
+		SchemaName:     row.SchemaName,
+		CurrentVersion: row.CurrentVersion.String,
+		Comment:        row.Comment.String,
+		Owner:          row.Owner.String,
+		OwnerRoleType:  row.OwnerRoleType.String,
+	}
+}
+
+func (v *budgets) Show(ctx context.Context, opts *ShowBudgetOptions) ([]Budget, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[budgetDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[budgetDBRow, Budget](dbRows), nil
+}
+
+func (v *budgets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error) {
+	budgets, err := v.Show(ctx, &ShowBudgetOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, budget := range budgets {
+		if budget.ID().name == id.Name() {
+			return &budget, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// BudgetLinkedResource is a single row returned by the <budget_name>!GET_LINKED_RESOURCES method.
+type BudgetLinkedResource struct {
+	ResourceID   int
+	Name         string
+	Domain       string
+	SchemaName   string
+	DatabaseName string
+}
+
+type budgetLinkedResourceDBRow struct {
+	ResourceID   int            `db:"RESOURCE_ID"`
+	Name         string         `db:"NAME"`
+	Domain       string         `db:"DOMAIN"`
+	SchemaName   sql.NullString `db:"SCHEMA_NAME"`
+	DatabaseName sql.NullString `db:"DATABASE_NAME"`
+}
+
+func (row budgetLinkedResourceDBRow) convert() *BudgetLinkedResource {
+	return &BudgetLinkedResource{
+		ResourceID:   row.ResourceID,
+		Name:         row.Name,
+		Domain:       row.Domain,
+		SchemaName:   row.SchemaName.String,
+		DatabaseName: row.DatabaseName.String,
+	}
+}
+
+// budgetMethodCall returns the SQL calling the given method of the budget instance,
+// e.g. CALL "db"."schema"."budget"!SET_SPENDING_LIMIT(100).
+func budgetMethodCall(id SchemaObjectIdentifier, method string, arguments ...string) string {
+	return fmt.Sprintf(`CALL %s!%s(%s)`, id.FullyQualifiedName(), method, strings.Join(arguments, ", "))
+}
+
+// budgetStringArgument returns the value as a string literal that can be passed to the budget methods.
+func budgetStringArgument(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return fmt.Sprintf(`'%s'`, strings.ReplaceAll(value, `'`, `\'`))
+}
+
+// budgetResourceReference returns the reference to the object that can be passed to the ADD_RESOURCE and REMOVE_RESOURCE methods.
+func budgetResourceReference(resourceType ObjectType, resourceID ObjectIdentifier) string {
+	return fmt.Sprintf(`SYSTEM$REFERENCE('%s', '%s', 'SESSION', 'APPLYBUDGET')`, resourceType, resourceID.FullyQualifiedName())
+}
+
+func (v *budgets) SetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier, spendingLimit int) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(id, "SET_SPENDING_LIMIT", fmt.Sprintf("%d", spendingLimit)))
+	return err
+}
+
+func (v *budgets) GetSpendingLimit(ctx context.Context, id SchemaObjectIdentifier) (int, error) {
+	s := &struct {
+		SpendingLimit int `db:"GET_SPENDING_LIMIT"`
+	}{}
+	if err := v.client.queryOne(ctx, s, budgetMethodCall(id, "GET_SPENDING_LIMIT")); err != nil {
+		return 0, err
+	}
+	return s.SpendingLimit, nil
+}
+
+func (v *budgets) SetEmailNotifications(ctx context.Context, id SchemaObjectIdentifier, notificationIntegration AccountObjectIdentifier, emails []string) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(id, "SET_EMAIL_NOTIFICATIONS", budgetStringArgument(notificationIntegration.Name()), budgetStringArgument(strings.Join(emails, ", "))))
+	return err
+}
+
+func (v *budgets) GetNotificationEmails(ctx context.Context, id SchemaObjectIdentifier) ([]string, error) {
+	s := &struct {
+		Emails sql.NullString `db:"GET_NOTIFICATION_EMAIL"`
+	}{}
+	if err := v.client.queryOne(ctx, s, budgetMethodCall(id, "GET_NOTIFICATION_EMAIL")); err != nil {
+		return nil, err
+	}
+	emails := make([]string, 0)
+	for _, email := range strings.Split(s.Emails.String, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, email)
+		}
+	}
+	return emails, nil
+}
+
+func (v *budgets) GetNotificationIntegrationName(ctx context.Context, id SchemaObjectIdentifier) (string, error) {
+	s := &struct {
+		NotificationIntegrationName sql.NullString `db:"GET_NOTIFICATION_INTEGRATION_NAME"`
+	}{}
+	if err := v.client.queryOne(ctx, s, budgetMethodCall(id, "GET_NOTIFICATION_INTEGRATION_NAME")); err != nil {
+		return "", err
+	}
+	return s.NotificationIntegrationName.String, nil
+}
+
+func (v *budgets) AddResource(ctx context.Context, id SchemaObjectIdentifier, resourceType ObjectType, resourceID ObjectIdentifier) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(id, "ADD_RESOURCE", budgetResourceReference(resourceType, resourceID)))
+	return err
+}
+
+func (v *budgets) RemoveResource(ctx context.Context, id SchemaObjectIdentifier, resourceType ObjectType, resourceID ObjectIdentifier) error {
+	_, err := v.client.exec(ctx, budgetMethodCall(id, "REMOVE_RESOURCE", budgetResourceReference(resourceType, resourceID)))
+	return err
+}
+
+func (v *budgets) GetLinkedResources(ctx context.Context, id SchemaObjectIdentifier) ([]BudgetLinkedResource, error) {
+	var rows []budgetLinkedResourceDBRow
+	if err := v.client.query(ctx, &rows, budgetMethodCall(id, "GET_LINKED_RESOURCES")); err != nil {
+		return nil, err
+	}
+	return convertRows[budgetLinkedResourceDBRow, BudgetLinkedResource](rows), nil
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetCreate(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateBudgetOptions{
+			name: NewSchemaObjectIdentifier("", "", ""),
+		}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: both ifNotExists and orReplace present", func(t *testing.T) {
+		opts := &CreateBudgetOptions{
+			name:        id,
+			IfNotExists: Bool(true),
+			OrReplace:   Bool(true),
+		}
+		assertOptsInvalid(t, opts, errOneOf("CreateBudgetOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &CreateBudgetOptions{
+			name: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SNOWFLAKE.CORE.BUDGET %s ()", id.FullyQualifiedName())
+	})
+
+	t.Run("with if not exists", func(t *testing.T) {
+		opts := &CreateBudgetOptions{
+			name:        id,
+			IfNotExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SNOWFLAKE.CORE.BUDGET IF NOT EXISTS %s ()", id.FullyQualifiedName())
+	})
+}
+
+func TestBudgetDrop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &DropBudgetOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropBudgetOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "DROP SNOWFLAKE.CORE.BUDGET IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestBudgetShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowBudgetOptions{}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SNOWFLAKE.CORE.BUDGET INSTANCES")
+	})
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		opts := &ShowBudgetOptions{
+			Like: &Like{
+				Pattern: String("budget"),
+			},
+			In: &In{
+				Schema: NewDatabaseObjectIdentifier("db", "schema"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SNOWFLAKE.CORE.BUDGET INSTANCES LIKE 'budget' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestBudgetMethodCall(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "budget")
+
+	t.Run("without arguments", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."budget"!GET_SPENDING_LIMIT()`, budgetMethodCall(id, "GET_SPENDING_LIMIT"))
+	})
+
+	t.Run("with arguments", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."budget"!SET_EMAIL_NOTIFICATIONS('integration', 'first@example.com, second@example.com')`,
+			budgetMethodCall(id, "SET_EMAIL_NOTIFICATIONS", "'integration'", "'first@example.com, second@example.com'"))
+	})
+
+	t.Run("with escaped arguments", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."budget"!SET_EMAIL_NOTIFICATIONS('integration', 'o\'brien@example.com, back\\slash@example.com')`,
+			budgetMethodCall(id, "SET_EMAIL_NOTIFICATIONS", budgetStringArgument("integration"), budgetStringArgument(`o'brien@example.com, back\slash@example.com`)))
+	})
+
+	t.Run("with resource reference", func(t *testing.T) {
+		assert.Equal(t, `CALL "db"."schema"."budget"!ADD_RESOURCE(SYSTEM$REFERENCE('WAREHOUSE', '"wh"', 'SESSION', 'APPLYBUDGET'))`,
+			budgetMethodCall(id, "ADD_RESOURCE", budgetResourceReference(ObjectTypeWarehouse, NewAccountObjectIdentifier("wh"))))
+	})
+}
@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
 
@@ -26,32 +27,50 @@ type Client struct {
 	ReplicationFunctions ReplicationFunctions
 
 	// DDL Commands
-	Accounts         Accounts
-	Alerts           Alerts
-	Comments         Comments
-	DatabaseRoles    DatabaseRoles
-	Databases        Databases
-	DynamicTables    DynamicTables
-	ExternalTables   ExternalTables
-	FailoverGroups   FailoverGroups
-	FileFormats      FileFormats
-	Grants           Grants
-	MaskingPolicies  MaskingPolicies
-	NetworkPolicies  NetworkPolicies
-	Parameters       Parameters
-	PasswordPolicies PasswordPolicies
-	Pipes            Pipes
-	ResourceMonitors ResourceMonitors
-	Roles            Roles
-	Schemas          Schemas
-	SessionPolicies  SessionPolicies
-	Sessions         Sessions
-	Shares           Shares
-	Streams          Streams
-	Tags             Tags
-	Tasks            Tasks
-	Users            Users
-	Warehouses       Warehouses
+	Accounts               Accounts
+	Alerts                 Alerts
+	ApplicationPackages    ApplicationPackages
+	Applications           Applications
+	AuthenticationPolicies AuthenticationPolicies
+	Budgets                Budgets
+	Comments               Comments
+	ComputePools           ComputePools
+	Connections            Connections
+	DatabaseRoles          DatabaseRoles
+	Databases              Databases
+	DynamicTables          DynamicTables
+	EventTables            EventTables
+	ExternalTables         ExternalTables
+	FailoverGroups         FailoverGroups
+	FileFormats            FileFormats
+	Grants                 Grants
+	IcebergTables          IcebergTables
+	Listings               Listings
+	ManagedAccounts        ManagedAccounts
+	MaskingPolicies        MaskingPolicies
+	NetworkPolicies        NetworkPolicies
+	NetworkRules           NetworkRules
+	PackagesPolicies       PackagesPolicies
+	Parameters             Parameters
+	PasswordPolicies       PasswordPolicies
+	Pipes                  Pipes
+	PolicyReferences       PolicyReferences
+	ProjectionPolicies     ProjectionPolicies
+	ReplicationGroups      ReplicationGroups
+	ResourceMonitors       ResourceMonitors
+	Roles                  Roles
+	Schemas                Schemas
+	Secrets                Secrets
+	Services               Services
+	SessionPolicies        SessionPolicies
+	Sessions               Sessions
+	Shares                 Shares
+	StageFiles             StageFiles
+	Streams                Streams
+	Tags                   Tags
+	Tasks                  Tasks
+	Users                  Users
+	Warehouses             Warehouses
 }
 
 func (c *Client) GetAccountLocator() string {
@@ -77,18 +96,9 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 		cfg = DefaultConfig()
 	}
 
-	var client *Client
 	// register the snowflake driver if it hasn't been registered yet
 	if !slices.Contains(sql.Drivers(), "snowflake-instrumented") {
-		logger := instrumentedsql.LoggerFunc(func(ctx context.Context, s string, kv ...interface{}) {
-			switch s {
-			case "sql-conn-query", "sql-conn-exec":
-				log.Printf("[DEBUG] %s: %v (%s)\n", s, kv, ctx.Value(snowflakeAccountLocatorContextKey))
-			default:
-				return
-			}
-		})
-		sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
+		sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(instrumentedSQLLogger)))
 	}
 
 	dsn, err := gosnowflake.DSN(cfg)
@@ -101,14 +111,52 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
 
-	client = &Client{
+	return newClient(cfg, db)
+}
+
+// NewClientFromConnector returns a client opening the connections with the connector instead of the DSN of the config,
+// e.g. to authenticate every new connection with a fresh OAuth access token.
+func NewClientFromConnector(cfg *gosnowflake.Config, connector driver.Connector) (*Client, error) {
+	// the connector is wrapped the same way as the registered driver, so that the queries are logged
+	instrumentedConnector, err := instrumentedsql.WrapDriver(connectorDriver{connector: connector}, instrumentedsql.WithLogger(instrumentedSQLLogger)).OpenConnector("")
+	if err != nil {
+		return nil, err
+	}
+	db := sqlx.NewDb(sql.OpenDB(instrumentedConnector), "snowflake")
+	return newClient(cfg, db)
+}
+
+var instrumentedSQLLogger = instrumentedsql.LoggerFunc(func(ctx context.Context, s string, kv ...interface{}) {
+	switch s {
+	case "sql-conn-query", "sql-conn-exec":
+		log.Printf("[DEBUG] %s: %v (%s)\n", s, kv, ctx.Value(snowflakeAccountLocatorContextKey))
+	default:
+		return
+	}
+})
+
+// connectorDriver exposes the connector as a driver, as instrumentedsql only wraps the connectors opened by a driver.
+type connectorDriver struct {
+	connector driver.Connector
+}
+
+func (d connectorDriver) Open(string) (driver.Conn, error) {
+	return d.connector.Connect(context.Background())
+}
+
+func (d connectorDriver) OpenConnector(string) (driver.Connector, error) {
+	return d.connector, nil
+}
+
+func newClient(cfg *gosnowflake.Config, db *sqlx.DB) (*Client, error) {
+	client := &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
 		db:     db.Unsafe(),
 		config: cfg,
 	}
 	client.initialize()
 
-	err = client.Ping()
+	err := client.Ping()
 	if err != nil {
 		return nil, fmt.Errorf("ping snowflake: %w", err)
 	}
@@ -141,28 +189,46 @@ func NewClientFromDB(db *sql.DB) *Client {
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
 	c.Alerts = &alerts{client: c}
+	c.ApplicationPackages = &applicationPackages{client: c}
+	c.Applications = &applications{client: c}
+	c.AuthenticationPolicies = &authenticationPolicies{client: c}
+	c.Budgets = &budgets{client: c}
 	c.Comments = &comments{client: c}
+	c.ComputePools = &computePools{client: c}
+	c.Connections = &connections{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.ConversionFunctions = &conversionFunctions{client: c}
 	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.EventTables = &eventTables{client: c}
 	c.ExternalTables = &externalTables{client: c}
 	c.FailoverGroups = &failoverGroups{client: c}
 	c.FileFormats = &fileFormats{client: c}
 	c.Grants = &grants{client: c}
+	c.IcebergTables = &icebergTables{client: c}
+	c.Listings = &listings{client: c}
+	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
 	c.NetworkPolicies = &networkPolicies{client: c}
+	c.NetworkRules = &networkRules{client: c}
+	c.PackagesPolicies = &packagesPolicies{client: c}
 	c.Parameters = &parameters{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
 	c.Pipes = &pipes{client: c}
+	c.PolicyReferences = &policyReferences{client: c}
+	c.ProjectionPolicies = &projectionPolicies{client: c}
+	c.ReplicationGroups = &replicationGroups{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
 	c.Schemas = &schemas{client: c}
+	c.Secrets = &secrets{client: c}
+	c.Services = &services{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
+	c.StageFiles = &stageFiles{client: c}
 	c.Streams = &streams{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
 	c.Tags = &tags{client: c}
@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+var _ ComputePools = (*computePools)(nil)
+
+var _ validatable = new(ShowComputePoolOptions)
+
+// ComputePools reads the Snowpark Container Services compute pools running the services.
+type ComputePools interface {
+	Show(ctx context.Context, opts *ShowComputePoolOptions) ([]ComputePool, error)
+}
+
+type computePools struct {
+	client *Client
+}
+
+// ShowComputePoolOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-compute-pools.
+type ShowComputePoolOptions struct {
+	show         bool       `ddl:"static" sql:"SHOW"`
+	computePools bool       `ddl:"static" sql:"COMPUTE POOLS"`
+	Like         *Like      `ddl:"keyword" sql:"LIKE"`
+	StartsWith   *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	LimitFrom    *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowComputePoolOptions) validate() error {
+	return nil
+}
+
+// ComputePool is a user friendly result for a SHOW COMPUTE POOLS query.
+type ComputePool struct {
+	Name            string
+	State           string
+	MinNodes        int
+	MaxNodes        int
+	InstanceFamily  string
+	NumServices     int
+	NumJobs         int
+	AutoSuspendSecs int
+	AutoResume      bool
+	ActiveNodes     int
+	IdleNodes       int
+	TargetNodes     int
+	CreatedOn       time.Time
+	Owner           string
+	Comment         string
+	IsExclusive     bool
+	Application     string
+}
+
+func (v *ComputePool) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ComputePool) ObjectType() ObjectType {
+	return ObjectTypeComputePool
+}
+
+// computePoolDBRow is used to decode the result of a SHOW COMPUTE POOLS query.
+type computePoolDBRow struct {
+	Name            string         `db:"name"`
+	State           sql.NullString `db:"state"`
+	MinNodes        sql.NullInt64  `db:"min_nodes"`
+	MaxNodes        sql.NullInt64  `db:"max_nodes"`
+	InstanceFamily  sql.NullString `db:"instance_family"`
+	NumServices     sql.NullInt64  `db:"num_services"`
+	NumJobs         sql.NullInt64  `db:"num_jobs"`
+	AutoSuspendSecs sql.NullInt64  `db:"auto_suspend_secs"`
+	AutoResume      sql.NullBool   `db:"auto_resume"`
+	ActiveNodes     sql.NullInt64  `db:"active_nodes"`
+	IdleNodes       sql.NullInt64  `db:"idle_nodes"`
+	TargetNodes     sql.NullInt64  `db:"target_nodes"`
+	CreatedOn       time.Time      `db:"created_on"`
+	Owner           sql.NullString `db:"owner"`
+	Comment         sql.NullString `db:"comment"`
+	IsExclusive     sql.NullBool   `db:"is_exclusive"`
+	Application     sql.NullString `db:"application"`
+}
+
+func (row computePoolDBRow) convert() *ComputePool {
+	return &ComputePool{
+		Name:            row.Name,
+		State:           row.State.String,
+		MinNodes:        int(row.MinNodes.Int64),
+		MaxNodes:        int(row.MaxNodes.Int64),
+		InstanceFamily:  row.InstanceFamily.String,
+		NumServices:     int(row.NumServices.Int64),
+		NumJobs:         int(row.NumJobs.Int64),
+		AutoSuspendSecs: int(row.AutoSuspendSecs.Int64),
+		AutoResume:      row.AutoResume.Bool,
+		ActiveNodes:     int(row.ActiveNodes.Int64),
+		IdleNodes:       int(row.IdleNodes.Int64),
+		TargetNodes:     int(row.TargetNodes.Int64),
+		CreatedOn:       row.CreatedOn,
+		Owner:           row.Owner.String,
+		Comment:         row.Comment.String,
+		IsExclusive:     row.IsExclusive.Bool,
+		Application:     row.Application.String,
+	}
+}
+
+func (v *computePools) Show(ctx context.Context, opts *ShowComputePoolOptions) ([]ComputePool, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[computePoolDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[computePoolDBRow, ComputePool](dbRows)
+	return resultList, nil
+}
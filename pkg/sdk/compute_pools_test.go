@@ -0,0 +1,23 @@
+package sdk
+
+import "testing"
+
+func TestComputePoolsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowComputePoolOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW COMPUTE POOLS`)
+	})
+
+	t.Run("like starts with and limit", func(t *testing.T) {
+		opts := &ShowComputePoolOptions{
+			Like: &Like{
+				Pattern: String("pool_1"),
+			},
+			StartsWith: String("pool"),
+			LimitFrom: &LimitFrom{
+				Rows: Int(10),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW COMPUTE POOLS LIKE 'pool_1' STARTS WITH 'pool' LIMIT 10`)
+	})
+}
@@ -0,0 +1,308 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+var _ Connections = (*connections)(nil)
+
+var (
+	_ validatable = new(CreateConnectionOptions)
+	_ validatable = new(CreateReplicaConnectionOptions)
+	_ validatable = new(AlterConnectionOptions)
+	_ validatable = new(DropConnectionOptions)
+	_ validatable = new(ShowConnectionOptions)
+)
+
+// Connections manages the connection objects used for Client Redirect.
+type Connections interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateConnectionOptions) error
+	CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryConnectionID ExternalObjectIdentifier, opts *CreateReplicaConnectionOptions) error
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterConnectionOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropConnectionOptions) error
+	Show(ctx context.Context, opts *ShowConnectionOptions) ([]Connection, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Connection, error)
+}
+
+// connections implements Connections.
+type connections struct {
+	client *Client
+}
+
+// CreateConnectionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-connection.
+type CreateConnectionOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`
+	connection  bool                    `ddl:"static" sql:"CONNECTION"`
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	Comment     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateConnectionOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *connections) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateConnectionOptions) error {
+	if opts == nil {
+		opts = &CreateConnectionOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// CreateReplicaConnectionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-connection.
+type CreateReplicaConnectionOptions struct {
+	create            bool                     `ddl:"static" sql:"CREATE"`
+	connection        bool                     `ddl:"static" sql:"CONNECTION"`
+	IfNotExists       *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name              AccountObjectIdentifier  `ddl:"identifier"`
+	primaryConnection ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+	Comment           *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateReplicaConnectionOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !ValidObjectIdentifier(opts.primaryConnection) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *connections) CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryConnectionID ExternalObjectIdentifier, opts *CreateReplicaConnectionOptions) error {
+	if opts == nil {
+		opts = &CreateReplicaConnectionOptions{}
+	}
+	opts.name = id
+	opts.primaryConnection = primaryConnectionID
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterConnectionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-connection.
+type AlterConnectionOptions struct {
+	alter           bool                       `ddl:"static" sql:"ALTER"`
+	connection      bool                       `ddl:"static" sql:"CONNECTION"`
+	IfExists        *bool                      `ddl:"keyword" sql:"IF EXISTS"`
+	name            AccountObjectIdentifier    `ddl:"identifier"`
+	EnableFailover  *ConnectionEnableFailover  `ddl:"keyword" sql:"ENABLE FAILOVER"`
+	DisableFailover *ConnectionDisableFailover `ddl:"keyword" sql:"DISABLE FAILOVER"`
+	Primary         *bool                      `ddl:"keyword" sql:"PRIMARY"`
+	Set             *ConnectionSet             `ddl:"keyword" sql:"SET"`
+	Unset           *ConnectionUnset           `ddl:"keyword" sql:"UNSET"`
+}
+
+type ConnectionEnableFailover struct {
+	ToAccounts         []AccountIdentifier `ddl:"keyword" sql:"TO ACCOUNTS"`
+	IgnoreEditionCheck *bool               `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+}
+
+type ConnectionDisableFailover struct {
+	// ToAccounts disables the failover only to the given accounts. When empty, failover is disabled to all accounts.
+	ToAccounts []AccountIdentifier `ddl:"keyword" sql:"TO ACCOUNTS"`
+}
+
+type ConnectionSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ConnectionUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (opts *AlterConnectionOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.EnableFailover, opts.DisableFailover, opts.Primary, opts.Set, opts.Unset) {
+		return errors.New("exactly one of ENABLE FAILOVER, DISABLE FAILOVER, PRIMARY, SET or UNSET must be specified")
+	}
+	if valueSet(opts.EnableFailover) && len(opts.EnableFailover.ToAccounts) == 0 {
+		return errors.New("at least one account must be specified for ENABLE FAILOVER")
+	}
+	if valueSet(opts.Set) && opts.Set.Comment == nil {
+		return errors.New("at least one property must be specified for SET")
+	}
+	if valueSet(opts.Unset) && opts.Unset.Comment == nil {
+		return errors.New("at least one property must be specified for UNSET")
+	}
+	return nil
+}
+
+func (v *connections) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterConnectionOptions) error {
+	if opts == nil {
+		opts = &AlterConnectionOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropConnectionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-connection.
+type DropConnectionOptions struct {
+	drop       bool                    `ddl:"static" sql:"DROP"`
+	connection bool                    `ddl:"static" sql:"CONNECTION"`
+	IfExists   *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name       AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropConnectionOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *connections) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropConnectionOptions) error {
+	if opts == nil {
+		opts = &DropConnectionOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowConnectionOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-connections.
+type ShowConnectionOptions struct {
+	show        bool  `ddl:"static" sql:"SHOW"`
+	connections bool  `ddl:"static" sql:"CONNECTIONS"`
+	Like        *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowConnectionOptions) validate() error {
+	return nil
+}
+
+// Connection is a user friendly result for a SHOW CONNECTIONS query.
+type Connection struct {
+	SnowflakeRegion           string
+	CreatedOn                 time.Time
+	AccountName               string
+	Name                      string
+	Comment                   string
+	IsPrimary                 bool
+	Primary                   ExternalObjectIdentifier
+	FailoverAllowedToAccounts []AccountIdentifier
+	ConnectionURL             string
+	OrganizationName          string
+	AccountLocator            string
+}
+
+func (v *Connection) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Connection) ObjectType() ObjectType {
+	return ObjectTypeConnection
+}
+
+// connectionDBRow is used to decode the result of a SHOW CONNECTIONS query.
+type connectionDBRow struct {
+	SnowflakeRegion           string         `db:"snowflake_region"`
+	CreatedOn                 time.Time      `db:"created_on"`
+	AccountName               string         `db:"account_name"`
+	Name                      string         `db:"name"`
+	Comment                   sql.NullString `db:"comment"`
+	IsPrimary                 bool           `db:"is_primary"`
+	Primary                   string         `db:"primary"`
+	FailoverAllowedToAccounts sql.NullString `db:"failover_allowed_to_accounts"`
+	ConnectionURL             string         `db:"connection_url"`
+	OrganizationName          string         `db:"organization_name"`
+	AccountLocator            string         `db:"account_locator"`
+}
+
+func (row connectionDBRow) convert() *Connection {
+	connection := &Connection{
+		SnowflakeRegion:  row.SnowflakeRegion,
+		CreatedOn:        row.CreatedOn,
+		AccountName:      row.AccountName,
+		Name:             row.Name,
+		IsPrimary:        row.IsPrimary,
+		Primary:          NewExternalObjectIdentifierFromFullyQualifiedName(row.Primary),
+		ConnectionURL:    row.ConnectionURL,
+		OrganizationName: row.OrganizationName,
+		AccountLocator:   row.AccountLocator,
+	}
+	if row.Comment.Valid {
+		connection.Comment = row.Comment.String
+	}
+	if row.FailoverAllowedToAccounts.Valid {
+		for _, account := range strings.Split(row.FailoverAllowedToAccounts.String, ",") {
+			parts := strings.Split(strings.TrimSpace(account), ".")
+			if len(parts) != 2 {
+				continue
+			}
+			connection.FailoverAllowedToAccounts = append(connection.FailoverAllowedToAccounts, NewAccountIdentifier(parts[0], parts[1]))
+		}
+	}
+	return connection
+}
+
+func (v *connections) Show(ctx context.Context, opts *ShowConnectionOptions) ([]Connection, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[connectionDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[connectionDBRow, Connection](dbRows)
+	return resultList, nil
+}
+
+// ShowByID returns the connection from the current account, as SHOW CONNECTIONS lists the replicas in the other accounts of the organization too.
+func (v *connections) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Connection, error) {
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := v.Show(ctx, &ShowConnectionOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, connection := range connections {
+		if connection.ID().name == id.Name() && connection.AccountLocator == currentAccount {
+			return &connection, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConnectionsCreate(t *testing.T) {
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateConnectionOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateConnectionOptions{
+			IfNotExists: Bool(true),
+			name:        NewAccountObjectIdentifier("conn1"),
+			Comment:     String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE CONNECTION IF NOT EXISTS "conn1" COMMENT = 'comment'`)
+	})
+}
+
+func TestConnectionsCreateReplica(t *testing.T) {
+	t.Run("as replica of", func(t *testing.T) {
+		opts := &CreateReplicaConnectionOptions{
+			name:              NewAccountObjectIdentifier("conn1"),
+			primaryConnection: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.conn1"),
+			Comment:           String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE CONNECTION "conn1" AS REPLICA OF myorg.myaccount."conn1" COMMENT = 'comment'`)
+	})
+}
+
+func TestConnectionsAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("conn1")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name: id,
+		}
+		assertOptsInvalid(t, opts, errors.New("exactly one of ENABLE FAILOVER, DISABLE FAILOVER, PRIMARY, SET or UNSET must be specified"))
+	})
+
+	t.Run("validation: enable failover without accounts", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name:           id,
+			EnableFailover: &ConnectionEnableFailover{},
+		}
+		assertOptsInvalid(t, opts, errors.New("at least one account must be specified for ENABLE FAILOVER"))
+	})
+
+	t.Run("enable failover", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name: id,
+			EnableFailover: &ConnectionEnableFailover{
+				ToAccounts:         []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"), NewAccountIdentifier("MY_ORG", "MY_ACCOUNT_2")},
+				IgnoreEditionCheck: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION "conn1" ENABLE FAILOVER TO ACCOUNTS "MY_ORG.MY_ACCOUNT", "MY_ORG.MY_ACCOUNT_2" IGNORE EDITION CHECK`)
+	})
+
+	t.Run("disable failover", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name:            id,
+			DisableFailover: &ConnectionDisableFailover{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION "conn1" DISABLE FAILOVER`)
+	})
+
+	t.Run("disable failover to accounts", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name: id,
+			DisableFailover: &ConnectionDisableFailover{
+				ToAccounts: []AccountIdentifier{NewAccountIdentifier("MY_ORG", "MY_ACCOUNT")},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION "conn1" DISABLE FAILOVER TO ACCOUNTS "MY_ORG.MY_ACCOUNT"`)
+	})
+
+	t.Run("primary", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name:    id,
+			Primary: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION "conn1" PRIMARY`)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &ConnectionSet{
+				Comment: String("comment"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION IF EXISTS "conn1" SET COMMENT = 'comment'`)
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := &AlterConnectionOptions{
+			name: id,
+			Unset: &ConnectionUnset{
+				Comment: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER CONNECTION "conn1" UNSET COMMENT`)
+	})
+}
+
+func TestConnectionsDrop(t *testing.T) {
+	t.Run("if exists", func(t *testing.T) {
+		opts := &DropConnectionOptions{
+			IfExists: Bool(true),
+			name:     NewAccountObjectIdentifier("conn1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP CONNECTION IF EXISTS "conn1"`)
+	})
+}
+
+func TestConnectionsShow(t *testing.T) {
+	t.Run("like", func(t *testing.T) {
+		opts := &ShowConnectionOptions{
+			Like: &Like{
+				Pattern: String("conn1"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CONNECTIONS LIKE 'conn1'`)
+	})
+}
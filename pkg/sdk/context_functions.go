@@ -11,6 +11,7 @@ import (
 type ContextFunctions interface {
 	// Session functions.
 	CurrentAccount(ctx context.Context) (string, error)
+	CurrentAccountName(ctx context.Context) (string, error)
 	CurrentRole(ctx context.Context) (string, error)
 	CurrentSecondaryRoles(ctx context.Context) (*CurrentSecondaryRoles, error)
 	CurrentRegion(ctx context.Context) (string, error)
@@ -41,6 +42,17 @@ func (c *contextFunctions) CurrentAccount(ctx context.Context) (string, error) {
 	return s.CurrentAccount, nil
 }
 
+func (c *contextFunctions) CurrentAccountName(ctx context.Context) (string, error) {
+	s := &struct {
+		CurrentAccountName string `db:"CURRENT_ACCOUNT_NAME"`
+	}{}
+	err := c.client.queryOne(ctx, s, "SELECT CURRENT_ACCOUNT_NAME() as CURRENT_ACCOUNT_NAME")
+	if err != nil {
+		return "", err
+	}
+	return s.CurrentAccountName, nil
+}
+
 func (c *contextFunctions) CurrentRole(ctx context.Context) (string, error) {
 	s := &struct {
 		CurrentRole string `db:"CURRENT_ROLE"`
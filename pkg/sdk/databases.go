@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -35,6 +36,7 @@ type Databases interface {
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Database, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) (*DatabaseDetails, error)
 	Use(ctx context.Context, id AccountObjectIdentifier) error
+	RefreshHistory(ctx context.Context, id AccountObjectIdentifier) ([]DatabaseRefresh, error)
 }
 
 var _ Databases = (*databases)(nil)
@@ -216,6 +218,7 @@ func (v *databases) CreateShared(ctx context.Context, id AccountObjectIdentifier
 // CreateSecondaryDatabaseOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-database.
 type CreateSecondaryDatabaseOptions struct {
 	create                  bool                     `ddl:"static" sql:"CREATE"`
+	Transient               *bool                    `ddl:"keyword" sql:"TRANSIENT"`
 	database                bool                     `ddl:"static" sql:"DATABASE"`
 	name                    AccountObjectIdentifier  `ddl:"identifier"`
 	primaryDatabase         ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
@@ -614,3 +617,44 @@ func (v *databases) Use(ctx context.Context, id AccountObjectIdentifier) error {
 	// proxy to sessions
 	return v.client.Sessions.UseDatabase(ctx, id)
 }
+
+// DatabaseRefresh is a refresh of a secondary database, as returned by the DATABASE_REFRESH_HISTORY table function.
+type DatabaseRefresh struct {
+	CurrentPhase string
+	StartTime    time.Time
+	EndTime      *time.Time
+	JobUUID      string
+}
+
+type databaseRefreshRow struct {
+	CurrentPhase string       `db:"CURRENT_PHASE"`
+	StartTime    time.Time    `db:"START_TIME"`
+	EndTime      sql.NullTime `db:"END_TIME"`
+	JobUUID      string       `db:"JOB_UUID"`
+}
+
+// RefreshHistory returns the refreshes of the secondary database from the last 14 days,
+// based on https://docs.snowflake.com/en/sql-reference/functions/database_refresh_history.
+func (v *databases) RefreshHistory(ctx context.Context, id AccountObjectIdentifier) ([]DatabaseRefresh, error) {
+	if !ValidObjectIdentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	rows := []databaseRefreshRow{}
+	sql := fmt.Sprintf(`SELECT CURRENT_PHASE, START_TIME, END_TIME, JOB_UUID FROM TABLE(%s.INFORMATION_SCHEMA.DATABASE_REFRESH_HISTORY('%s'))`, id.FullyQualifiedName(), id.Name())
+	if err := v.client.query(ctx, &rows, sql); err != nil {
+		return nil, err
+	}
+	refreshes := make([]DatabaseRefresh, len(rows))
+	for i, row := range rows {
+		refreshes[i] = DatabaseRefresh{
+			CurrentPhase: row.CurrentPhase,
+			StartTime:    row.StartTime,
+			JobUUID:      row.JobUUID,
+		}
+		if row.EndTime.Valid {
+			endTime := row.EndTime.Time
+			refreshes[i].EndTime = &endTime
+		}
+	}
+	return refreshes, nil
+}
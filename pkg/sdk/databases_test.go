@@ -64,6 +64,15 @@ func TestDatabasesCreateSecondary(t *testing.T) {
 		DataRetentionTimeInDays: Int(1),
 	}
 	assertOptsValidAndSQLEquals(t, opts, `CREATE DATABASE "db1" AS REPLICA OF account1."db1" DATA_RETENTION_TIME_IN_DAYS = 1`)
+
+	t.Run("transient", func(t *testing.T) {
+		opts := &CreateSecondaryDatabaseOptions{
+			name:            NewAccountObjectIdentifier("db1"),
+			Transient:       Bool(true),
+			primaryDatabase: NewExternalObjectIdentifier(NewAccountIdentifier("myorg", "account1"), NewAccountObjectIdentifier("db1")),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE TRANSIENT DATABASE "db1" AS REPLICA OF myorg.account1."db1"`)
+	})
 }
 
 func TestDatabasesDrop(t *testing.T) {
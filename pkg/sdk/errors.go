@@ -18,6 +18,7 @@ var (
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = errors.New("invalid object identifier")
 	ErrDifferentDatabase       = errors.New("database must be the same")
+	ErrTagNotSet               = errors.New("tag is not set on the object")
 )
 
 func errOneOf(structName string, fieldNames ...string) error {
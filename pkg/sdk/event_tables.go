@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+var _ EventTables = (*eventTables)(nil)
+
+var _ validatable = new(ShowEventTableOptions)
+
+// EventTables reads the event tables collecting the logs and traces of the functions, procedures and applications.
+type EventTables interface {
+	Show(ctx context.Context, opts *ShowEventTableOptions) ([]EventTable, error)
+}
+
+type eventTables struct {
+	client *Client
+}
+
+// ShowEventTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-event-tables.
+type ShowEventTableOptions struct {
+	show        bool       `ddl:"static" sql:"SHOW"`
+	eventTables bool       `ddl:"static" sql:"EVENT TABLES"`
+	Like        *Like      `ddl:"keyword" sql:"LIKE"`
+	In          *In        `ddl:"keyword" sql:"IN"`
+	StartsWith  *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	LimitFrom   *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowEventTableOptions) validate() error {
+	return nil
+}
+
+// EventTable is a user friendly result for a SHOW EVENT TABLES query.
+type EventTable struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Owner        string
+	Comment      string
+}
+
+func (v *EventTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *EventTable) ObjectType() ObjectType {
+	return ObjectTypeEventTable
+}
+
+// eventTableDBRow is used to decode the result of a SHOW EVENT TABLES query.
+type eventTableDBRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+}
+
+func (row eventTableDBRow) convert() *EventTable {
+	return &EventTable{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner.String,
+		Comment:      row.Comment.String,
+	}
+}
+
+func (v *eventTables) Show(ctx context.Context, opts *ShowEventTableOptions) ([]EventTable, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[eventTableDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[eventTableDBRow, EventTable](dbRows)
+	return resultList, nil
+}
@@ -0,0 +1,22 @@
+package sdk
+
+import "testing"
+
+func TestEventTablesShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowEventTableOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW EVENT TABLES`)
+	})
+
+	t.Run("like in schema", func(t *testing.T) {
+		opts := &ShowEventTableOptions{
+			Like: &Like{
+				Pattern: String("events"),
+			},
+			In: &In{
+				Schema: NewDatabaseObjectIdentifier("db", "schema"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW EVENT TABLES LIKE 'events' IN SCHEMA "db"."schema"`)
+	})
+}
@@ -90,6 +90,7 @@ type showFileFormatsOptionsResult struct {
 	EmptyFieldAsNull           bool     `json:"EMPTY_FIELD_AS_NULL"`
 	SkipByteOrderMark          bool     `json:"SKIP_BYTE_ORDER_MARK"`
 	Encoding                   string   `json:"ENCODING"`
+	MultiLine                  bool     `json:"MULTI_LINE"`
 
 	// JSON fields
 	EnableOctal      bool `json:"ENABLE_OCTAL"`
@@ -99,7 +100,9 @@ type showFileFormatsOptionsResult struct {
 	IgnoreUTF8Errors bool `json:"IGNORE_UTF8_ERRORS"`
 
 	// Parquet fields
-	BinaryAsText bool `json:"BINARY_AS_TEXT"`
+	BinaryAsText         bool `json:"BINARY_AS_TEXT"`
+	UseLogicalType       bool `json:"USE_LOGICAL_TYPE"`
+	UseVectorizedScanner bool `json:"USE_VECTORIZED_SCANNER"`
 
 	// XML fields
 	PreserveSpace        bool `json:"PRESERVE_SPACE"`
@@ -109,7 +112,8 @@ type showFileFormatsOptionsResult struct {
 }
 
 func (row FileFormatRow) convert() *FileFormat {
-	inputOptions := showFileFormatsOptionsResult{}
+	// MULTI_LINE is only returned by the accounts supporting it, and defaults to true
+	inputOptions := showFileFormatsOptionsResult{MultiLine: true}
 	err := json.Unmarshal([]byte(row.FormatOptions), &inputOptions)
 	if err != nil {
 		fmt.Printf("%s", err)
@@ -154,6 +158,7 @@ func (row FileFormatRow) convert() *FileFormat {
 		ff.Options.CSVEmptyFieldAsNull = &inputOptions.EmptyFieldAsNull
 		ff.Options.CSVSkipByteOrderMark = &inputOptions.SkipByteOrderMark
 		ff.Options.CSVEncoding = (*CSVEncoding)(&inputOptions.Encoding)
+		ff.Options.CSVMultiLine = &inputOptions.MultiLine
 	case FileFormatTypeJSON:
 		ff.Options.JSONCompression = (*JSONCompression)(&inputOptions.Compression)
 		ff.Options.JSONDateFormat = &inputOptions.DateFormat
@@ -170,6 +175,7 @@ func (row FileFormatRow) convert() *FileFormat {
 		ff.Options.JSONReplaceInvalidCharacters = &inputOptions.ReplaceInvalidCharacters
 		ff.Options.JSONIgnoreUTF8Errors = &inputOptions.IgnoreUTF8Errors
 		ff.Options.JSONSkipByteOrderMark = &inputOptions.SkipByteOrderMark
+		ff.Options.JSONMultiLine = &inputOptions.MultiLine
 	case FileFormatTypeAvro:
 		ff.Options.AvroTrimSpace = &inputOptions.TrimSpace
 		ff.Options.AvroNullIf = &newNullIf
@@ -185,6 +191,8 @@ func (row FileFormatRow) convert() *FileFormat {
 		ff.Options.ParquetCompression = (*ParquetCompression)(&inputOptions.Compression)
 		ff.Options.ParquetBinaryAsText = &inputOptions.BinaryAsText
 		ff.Options.ParquetReplaceInvalidCharacters = &inputOptions.ReplaceInvalidCharacters
+		ff.Options.ParquetUseLogicalType = &inputOptions.UseLogicalType
+		ff.Options.ParquetUseVectorizedScanner = &inputOptions.UseVectorizedScanner
 	case FileFormatTypeXML:
 		ff.Options.XMLCompression = (*XMLCompression)(&inputOptions.Compression)
 		ff.Options.XMLIgnoreUTF8Errors = &inputOptions.IgnoreUTF8Errors
@@ -420,6 +428,7 @@ type FileFormatTypeOptions struct {
 	CSVEmptyFieldAsNull           *bool           `ddl:"parameter" sql:"EMPTY_FIELD_AS_NULL"`
 	CSVSkipByteOrderMark          *bool           `ddl:"parameter" sql:"SKIP_BYTE_ORDER_MARK"`
 	CSVEncoding                   *CSVEncoding    `ddl:"parameter,single_quotes" sql:"ENCODING"`
+	CSVMultiLine                  *bool           `ddl:"parameter" sql:"MULTI_LINE"`
 
 	// JSON type options
 	JSONCompression              *JSONCompression `ddl:"parameter" sql:"COMPRESSION"`
@@ -437,6 +446,7 @@ type FileFormatTypeOptions struct {
 	JSONReplaceInvalidCharacters *bool            `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
 	JSONIgnoreUTF8Errors         *bool            `ddl:"parameter" sql:"IGNORE_UTF8_ERRORS"`
 	JSONSkipByteOrderMark        *bool            `ddl:"parameter" sql:"SKIP_BYTE_ORDER_MARK"`
+	JSONMultiLine                *bool            `ddl:"parameter" sql:"MULTI_LINE"`
 
 	// AVRO type options
 	AvroCompression              *AvroCompression `ddl:"parameter" sql:"COMPRESSION"`
@@ -456,6 +466,8 @@ type FileFormatTypeOptions struct {
 	ParquetTrimSpace                *bool               `ddl:"parameter" sql:"TRIM_SPACE"`
 	ParquetReplaceInvalidCharacters *bool               `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
 	ParquetNullIf                   *[]NullString       `ddl:"parameter,parentheses" sql:"NULL_IF"`
+	ParquetUseLogicalType           *bool               `ddl:"parameter" sql:"USE_LOGICAL_TYPE"`
+	ParquetUseVectorizedScanner     *bool               `ddl:"parameter" sql:"USE_VECTORIZED_SCANNER"`
 
 	// XML type options
 	XMLCompression              *XMLCompression `ddl:"parameter" sql:"COMPRESSION"`
@@ -494,6 +506,7 @@ func (opts *FileFormatTypeOptions) fieldsByType() map[FileFormatType][]any {
 			opts.CSVEmptyFieldAsNull,
 			opts.CSVSkipByteOrderMark,
 			opts.CSVEncoding,
+			opts.CSVMultiLine,
 		},
 		FileFormatTypeJSON: {
 			opts.JSONCompression,
@@ -511,6 +524,7 @@ func (opts *FileFormatTypeOptions) fieldsByType() map[FileFormatType][]any {
 			opts.JSONReplaceInvalidCharacters,
 			opts.JSONIgnoreUTF8Errors,
 			opts.JSONSkipByteOrderMark,
+			opts.JSONMultiLine,
 		},
 		FileFormatTypeAvro: {
 			opts.AvroCompression,
@@ -530,6 +544,8 @@ func (opts *FileFormatTypeOptions) fieldsByType() map[FileFormatType][]any {
 			opts.ParquetTrimSpace,
 			opts.ParquetReplaceInvalidCharacters,
 			opts.ParquetNullIf,
+			opts.ParquetUseLogicalType,
+			opts.ParquetUseVectorizedScanner,
 		},
 		FileFormatTypeXML: {
 			opts.XMLCompression,
@@ -805,6 +821,12 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 			case "ENCODING":
 				enc := CSVEncoding(v)
 				details.Options.CSVEncoding = &enc
+			case "MULTI_LINE":
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf(`cannot cast MULTI_LINE value "%s" to bool: %w`, v, err)
+				}
+				details.Options.CSVMultiLine = &b
 			}
 		}
 	case FileFormatTypeJSON:
@@ -882,6 +904,12 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 					return nil, fmt.Errorf(`cannot cast SKIP_BYTE_ORDER_MARK value "%s" to bool: %w`, v, err)
 				}
 				details.Options.JSONSkipByteOrderMark = &b
+			case "MULTI_LINE":
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf(`cannot cast MULTI_LINE value "%s" to bool: %w`, v, err)
+				}
+				details.Options.JSONMultiLine = &b
 			}
 		}
 	case FileFormatTypeAvro:
@@ -975,6 +1003,18 @@ func (v *fileFormats) Describe(ctx context.Context, id SchemaObjectIdentifier) (
 					return nil, fmt.Errorf(`cannot cast REPLACE_INVALID_CHARACTERS value "%s" to bool: %w`, v, err)
 				}
 				details.Options.ParquetReplaceInvalidCharacters = &b
+			case "USE_LOGICAL_TYPE":
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf(`cannot cast USE_LOGICAL_TYPE value "%s" to bool: %w`, v, err)
+				}
+				details.Options.ParquetUseLogicalType = &b
+			case "USE_VECTORIZED_SCANNER":
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf(`cannot cast USE_VECTORIZED_SCANNER value "%s" to bool: %w`, v, err)
+				}
+				details.Options.ParquetUseVectorizedScanner = &b
 			}
 		}
 	case FileFormatTypeXML:
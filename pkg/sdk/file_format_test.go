@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestFileFormatsCreate(t *testing.T) {
@@ -45,9 +47,10 @@ func TestFileFormatsCreate(t *testing.T) {
 				CSVEmptyFieldAsNull:           Bool(true),
 				CSVSkipByteOrderMark:          Bool(true),
 				CSVEncoding:                   &CSVEncodingISO2022KR,
+				CSVMultiLine:                  Bool(false),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY FILE FORMAT IF NOT EXISTS "db4"."schema5"."format6" TYPE = CSV COMPRESSION = BZ2 RECORD_DELIMITER = '-' FIELD_DELIMITER = ':' FILE_EXTENSION = 'csv' SKIP_HEADER = 5 SKIP_BLANK_LINES = true DATE_FORMAT = 'YYYY-MM-DD' TIME_FORMAT = 'HH:mm:SS' TIMESTAMP_FORMAT = 'time' BINARY_FORMAT = UTF8 ESCAPE = '\\' ESCAPE_UNENCLOSED_FIELD = '§' TRIM_SPACE = true FIELD_OPTIONALLY_ENCLOSED_BY = '\"' NULL_IF = ('nul', 'nulll') ERROR_ON_COLUMN_COUNT_MISMATCH = true REPLACE_INVALID_CHARACTERS = true EMPTY_FIELD_AS_NULL = true SKIP_BYTE_ORDER_MARK = true ENCODING = 'ISO2022KR'`)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY FILE FORMAT IF NOT EXISTS "db4"."schema5"."format6" TYPE = CSV COMPRESSION = BZ2 RECORD_DELIMITER = '-' FIELD_DELIMITER = ':' FILE_EXTENSION = 'csv' SKIP_HEADER = 5 SKIP_BLANK_LINES = true DATE_FORMAT = 'YYYY-MM-DD' TIME_FORMAT = 'HH:mm:SS' TIMESTAMP_FORMAT = 'time' BINARY_FORMAT = UTF8 ESCAPE = '\\' ESCAPE_UNENCLOSED_FIELD = '§' TRIM_SPACE = true FIELD_OPTIONALLY_ENCLOSED_BY = '\"' NULL_IF = ('nul', 'nulll') ERROR_ON_COLUMN_COUNT_MISMATCH = true REPLACE_INVALID_CHARACTERS = true EMPTY_FIELD_AS_NULL = true SKIP_BYTE_ORDER_MARK = true ENCODING = 'ISO2022KR' MULTI_LINE = false`)
 	})
 
 	t.Run("complete JSON", func(t *testing.T) {
@@ -76,9 +79,10 @@ func TestFileFormatsCreate(t *testing.T) {
 				JSONStripNullValues:          Bool(true),
 				JSONReplaceInvalidCharacters: Bool(true),
 				JSONSkipByteOrderMark:        Bool(true),
+				JSONMultiLine:                Bool(false),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY FILE FORMAT IF NOT EXISTS "db4"."schema5"."format6" TYPE = JSON COMPRESSION = BROTLI DATE_FORMAT = 'YYYY-MM-DD' TIME_FORMAT = 'HH:mm:SS' TIMESTAMP_FORMAT = 'aze' BINARY_FORMAT = HEX TRIM_SPACE = true NULL_IF = ('c1', 'c2') FILE_EXTENSION = 'json' ENABLE_OCTAL = true ALLOW_DUPLICATE = true STRIP_OUTER_ARRAY = true STRIP_NULL_VALUES = true REPLACE_INVALID_CHARACTERS = true SKIP_BYTE_ORDER_MARK = true`)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY FILE FORMAT IF NOT EXISTS "db4"."schema5"."format6" TYPE = JSON COMPRESSION = BROTLI DATE_FORMAT = 'YYYY-MM-DD' TIME_FORMAT = 'HH:mm:SS' TIMESTAMP_FORMAT = 'aze' BINARY_FORMAT = HEX TRIM_SPACE = true NULL_IF = ('c1', 'c2') FILE_EXTENSION = 'json' ENABLE_OCTAL = true ALLOW_DUPLICATE = true STRIP_OUTER_ARRAY = true STRIP_NULL_VALUES = true REPLACE_INVALID_CHARACTERS = true SKIP_BYTE_ORDER_MARK = true MULTI_LINE = false`)
 	})
 
 	t.Run("complete Avro", func(t *testing.T) {
@@ -130,9 +134,11 @@ func TestFileFormatsCreate(t *testing.T) {
 				ParquetTrimSpace:                Bool(true),
 				ParquetReplaceInvalidCharacters: Bool(true),
 				ParquetNullIf:                   &[]NullString{{"nil"}},
+				ParquetUseLogicalType:           Bool(true),
+				ParquetUseVectorizedScanner:     Bool(true),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY FILE FORMAT IF NOT EXISTS "db4"."schema5"."format6" TYPE = PARQUET COMPRESSION = LZO BINARY_AS_TEXT = true TRIM_SPACE = true REPLACE_INVALID_CHARACTERS = true NULL_IF = ('nil')`)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE TEMPORARY FILE FORMAT IF NOT EXISTS "db4"."schema5"."format6" TYPE = PARQUET COMPRESSION = LZO BINARY_AS_TEXT = true TRIM_SPACE = true REPLACE_INVALID_CHARACTERS = true NULL_IF = ('nil') USE_LOGICAL_TYPE = true USE_VECTORIZED_SCANNER = true`)
 	})
 
 	t.Run("complete XML", func(t *testing.T) {
@@ -263,3 +269,21 @@ func TestFileFormatsDescribe(t *testing.T) {
 	}
 	assertOptsValidAndSQLEquals(t, opts, `DESCRIBE FILE FORMAT "db"."schema"."ff"`)
 }
+
+func TestFileFormatRowConvert(t *testing.T) {
+	t.Run("multi line defaults to true", func(t *testing.T) {
+		row := FileFormatRow{Name: "ff", DatabaseName: "db", SchemaName: "schema", FormatType: "JSON", FormatOptions: `{"TYPE":"JSON","COMPRESSION":"AUTO"}`}
+		ff := row.convert()
+		assert.True(t, *ff.Options.JSONMultiLine)
+	})
+
+	t.Run("multi line and parquet options", func(t *testing.T) {
+		row := FileFormatRow{Name: "ff", DatabaseName: "db", SchemaName: "schema", FormatType: "CSV", FormatOptions: `{"TYPE":"CSV","MULTI_LINE":false}`}
+		assert.False(t, *row.convert().Options.CSVMultiLine)
+
+		row = FileFormatRow{Name: "ff", DatabaseName: "db", SchemaName: "schema", FormatType: "PARQUET", FormatOptions: `{"TYPE":"PARQUET","USE_LOGICAL_TYPE":true,"USE_VECTORIZED_SCANNER":false}`}
+		ff := row.convert()
+		assert.True(t, *ff.Options.ParquetUseLogicalType)
+		assert.False(t, *ff.Options.ParquetUseVectorizedScanner)
+	})
+}
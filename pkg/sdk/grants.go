@@ -201,6 +201,8 @@ type grantRow struct {
 	GranteeName string    `db:"grantee_name"`
 	GrantOption bool      `db:"grant_option"`
 	GrantedBy   string    `db:"granted_by"`
+	// Role is only returned by SHOW GRANTS TO USER and SHOW GRANTS OF ROLE.
+	Role string `db:"role"`
 }
 
 type Grant struct {
@@ -243,14 +245,24 @@ func (row grantRow) convert() *Grant {
 		grantOn = ObjectType(strings.ReplaceAll(row.GrantOn, "_", " "))
 	}
 
+	privilege := row.Privilege
+	name := row.Name
+	// SHOW GRANTS TO USER and SHOW GRANTS OF ROLE return the granted role in the role column, so the grant is returned
+	// as the usage of the role
+	if row.Role != "" {
+		privilege = "USAGE"
+		grantedOn = ObjectTypeRole
+		name = row.Role
+	}
+
 	return &Grant{
 		CreatedOn:   row.CreatedOn,
-		Privilege:   row.Privilege,
+		Privilege:   privilege,
 		GrantedOn:   grantedOn,
 		GrantOn:     grantOn,
 		GrantedTo:   grantedTo,
 		GrantTo:     grantTo,
-		Name:        NewAccountObjectIdentifier(strings.Trim(row.Name, "\"")),
+		Name:        NewAccountObjectIdentifier(strings.Trim(name, "\"")),
 		GranteeName: granteeName,
 		GrantOption: row.GrantOption,
 		GrantedBy:   NewAccountObjectIdentifier(row.GrantedBy),
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGrantPrivilegesToAccountRole(t *testing.T) {
@@ -1003,3 +1005,21 @@ func TestGrantShow(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS OF SHARE %s", shareID.FullyQualifiedName())
 	})
 }
+
+func TestGrantRowConvert(t *testing.T) {
+	t.Run("grant of role", func(t *testing.T) {
+		row := grantRow{Role: "ANALYST", GrantedTo: "ROLE", GranteeName: "SYSADMIN", GrantedBy: "SECURITYADMIN"}
+		grant := row.convert()
+		assert.Equal(t, ObjectTypeRole, grant.GrantedTo)
+		assert.Equal(t, "SYSADMIN", grant.GranteeName.Name())
+		assert.Equal(t, "ANALYST", grant.Name.Name())
+	})
+
+	t.Run("grant to user", func(t *testing.T) {
+		row := grantRow{Role: "ANALYST", GrantedTo: "USER", GranteeName: "user", GrantedBy: "SECURITYADMIN"}
+		grant := row.convert()
+		assert.Equal(t, "USAGE", grant.Privilege)
+		assert.Equal(t, ObjectTypeRole, grant.GrantedOn)
+		assert.Equal(t, "ANALYST", grant.Name.Name())
+	})
+}
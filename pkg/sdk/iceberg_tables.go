@@ -0,0 +1,389 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var _ IcebergTables = (*icebergTables)(nil)
+
+var (
+	_ validatable = new(CreateIcebergTableOptions)
+	_ validatable = new(AlterIcebergTableOptions)
+	_ validatable = new(DropIcebergTableOptions)
+	_ validatable = new(ShowIcebergTableOptions)
+	_ validatable = new(describeIcebergTableOptions)
+)
+
+type IcebergTables interface {
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateIcebergTableOptions) error
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterIcebergTableOptions) error
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropIcebergTableOptions) error
+	Show(ctx context.Context, opts *ShowIcebergTableOptions) ([]IcebergTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error)
+	DescribeColumns(ctx context.Context, id SchemaObjectIdentifier) ([]IcebergTableColumnDetails, error)
+}
+
+// icebergTables implements IcebergTables.
+type icebergTables struct {
+	client *Client
+}
+
+// IcebergTableCatalogSnowflake is the catalog value used for Snowflake-managed Iceberg tables.
+const IcebergTableCatalogSnowflake = "SNOWFLAKE"
+
+// CreateIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-iceberg-table.
+type CreateIcebergTableOptions struct {
+	create       bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace    *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfNotExists  *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+
+	Columns   []IcebergTableColumn `ddl:"list,parentheses"`
+	ClusterBy []string             `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+
+	ExternalVolume *string `ddl:"parameter,single_quotes" sql:"EXTERNAL_VOLUME"`
+	Catalog        *string `ddl:"parameter,single_quotes" sql:"CATALOG"`
+
+	// Snowflake catalog
+	BaseLocation *string `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+
+	// Object store catalog integration
+	MetadataFilePath *string `ddl:"parameter,single_quotes" sql:"METADATA_FILE_PATH"`
+	// AWS Glue catalog integration
+	CatalogTableName *string `ddl:"parameter,single_quotes" sql:"CATALOG_TABLE_NAME"`
+
+	ReplaceInvalidCharacters   *bool   `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDDLCollation        *string `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	CopyGrants                 *bool   `ddl:"keyword" sql:"COPY GRANTS"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+
+	Tag []TagAssociation `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+type IcebergTableColumn struct {
+	Name    string   `ddl:"keyword,double_quotes"`
+	Type    DataType `ddl:"keyword"`
+	NotNull *bool    `ddl:"keyword" sql:"NOT NULL"`
+	Comment *string  `ddl:"parameter,no_equals,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateIcebergTableOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		errs = append(errs, errOneOf("CreateIcebergTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if moreThanOneValueSet(opts.BaseLocation, opts.MetadataFilePath, opts.CatalogTableName) {
+		errs = append(errs, errOneOf("CreateIcebergTableOptions", "BaseLocation", "MetadataFilePath", "CatalogTableName"))
+	}
+	if valueSet(opts.BaseLocation) && valueSet(opts.Catalog) && *opts.Catalog != IcebergTableCatalogSnowflake {
+		errs = append(errs, errors.New("BaseLocation can only be used with the SNOWFLAKE catalog"))
+	}
+	for _, column := range opts.Columns {
+		if column.Name == "" {
+			errs = append(errs, errNotSet("IcebergTableColumn", "Name"))
+		}
+		if column.Type == "" {
+			errs = append(errs, errNotSet("IcebergTableColumn", "Type"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (v *icebergTables) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateIcebergTableOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// AlterIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-iceberg-table.
+type AlterIcebergTableOptions struct {
+	alter        bool                   `ddl:"static" sql:"ALTER"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+
+	// One of
+	Refresh          *IcebergTableRefresh          `ddl:"keyword" sql:"REFRESH"`
+	ConvertToManaged *IcebergTableConvertToManaged `ddl:"keyword" sql:"CONVERT TO MANAGED"`
+	Set              *IcebergTableSet              `ddl:"keyword" sql:"SET"`
+	Unset            *IcebergTableUnset            `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTags          []TagAssociation              `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags        []ObjectIdentifier            `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type IcebergTableRefresh struct {
+	MetadataFilePath *string `ddl:"keyword,single_quotes"`
+}
+
+type IcebergTableConvertToManaged struct {
+	BaseLocation *string `ddl:"parameter,single_quotes" sql:"BASE_LOCATION"`
+}
+
+type IcebergTableSet struct {
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDDLCollation        *string `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	ReplaceInvalidCharacters   *bool   `ddl:"parameter" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *IcebergTableSet) validate() error {
+	if everyValueNil(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ChangeTracking, v.DefaultDDLCollation, v.ReplaceInvalidCharacters, v.Comment) {
+		return errAtLeastOneOf("DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDDLCollation", "ReplaceInvalidCharacters", "Comment")
+	}
+	return nil
+}
+
+type IcebergTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool `ddl:"keyword" sql:"CHANGE_TRACKING"`
+	DefaultDDLCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
+	ReplaceInvalidCharacters   *bool `ddl:"keyword" sql:"REPLACE_INVALID_CHARACTERS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *IcebergTableUnset) validate() error {
+	if everyValueNil(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ChangeTracking, v.DefaultDDLCollation, v.ReplaceInvalidCharacters, v.Comment) {
+		return errAtLeastOneOf("DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDDLCollation", "ReplaceInvalidCharacters", "Comment")
+	}
+	return nil
+}
+
+func (opts *AlterIcebergTableOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Refresh, opts.ConvertToManaged, opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("Refresh", "ConvertToManaged", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (v *icebergTables) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterIcebergTableOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// DropIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-iceberg-table.
+type DropIcebergTableOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropIcebergTableOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *icebergTables) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropIcebergTableOptions) error {
+	opts = createIfNil(opts)
+	opts.name = id
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// ShowIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-iceberg-tables.
+type ShowIcebergTableOptions struct {
+	show          bool       `ddl:"static" sql:"SHOW"`
+	icebergTables bool       `ddl:"static" sql:"ICEBERG TABLES"`
+	Like          *Like      `ddl:"keyword" sql:"LIKE"`
+	In            *In        `ddl:"keyword" sql:"IN"`
+	StartsWith    *string    `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
+	Limit         *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowIcebergTableOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if valueSet(opts.Like) && !valueSet(opts.Like.Pattern) {
+		errs = append(errs, ErrPatternRequiredForLikeKeyword)
+	}
+	if valueSet(opts.In) && !exactlyOneValueSet(opts.In.Account, opts.In.Database, opts.In.Schema) {
+		errs = append(errs, errScopeRequiredForInKeyword)
+	}
+	return errors.Join(errs...)
+}
+
+type IcebergTable struct {
+	CreatedOn          time.Time
+	Name               string
+	DatabaseName       string
+	SchemaName         string
+	Owner              string
+	ExternalVolumeName string
+	CatalogName        string
+	IcebergTableType   string
+	CatalogTableName   string
+	CatalogNamespace   string
+	BaseLocation       string
+	Comment            string
+	OwnerRoleType      string
+}
+
+func (v *IcebergTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *IcebergTable) ObjectType() ObjectType {
+	return ObjectTypeIcebergTable
+}
+
+// IsManaged reports whether the table uses Snowflake as its Iceberg catalog.
+func (v *IcebergTable) IsManaged() bool {
+	return v.IcebergTableType == "MANAGED"
+}
+
+type icebergTableRow struct {
+	CreatedOn          time.Time      `db:"created_on"`
+	Name               string         `db:"name"`
+	DatabaseName       string         `db:"database_name"`
+	SchemaName         string         `db:"schema_name"`
+	Owner              sql.NullString `db:"owner"`
+	ExternalVolumeName sql.NullString `db:"external_volume_name"`
+	CatalogName        sql.NullString `db:"catalog_name"`
+	IcebergTableType   sql.NullString `db:"iceberg_table_type"`
+	CatalogTableName   sql.NullString `db:"catalog_table_name"`
+	CatalogNamespace   sql.NullString `db:"catalog_namespace"`
+	BaseLocation       sql.NullString `db:"base_location"`
+	Comment            sql.NullString `db:"comment"`
+	OwnerRoleType      sql.NullString `db:"owner_role_type"`
+}
+
+func (row icebergTableRow) convert() *IcebergTable {
+	return &IcebergTable{
+		CreatedOn:          row.CreatedOn,
+		Name:               row.Name,
+		DatabaseName:       row.DatabaseName,
+		SchemaName:         row.SchemaName,
+		Owner:              row.Owner.String,
+		ExternalVolumeName: row.ExternalVolumeName.String,
+		CatalogName:        row.CatalogName.String,
+		IcebergTableType:   row.IcebergTableType.String,
+		CatalogTableName:   row.CatalogTableName.String,
+		CatalogNamespace:   row.CatalogNamespace.String,
+		BaseLocation:       row.BaseLocation.String,
+		Comment:            row.Comment.String,
+		OwnerRoleType:      row.OwnerRoleType.String,
+	}
+}
+
+func (v *icebergTables) Show(ctx context.Context, opts *ShowIcebergTableOptions) ([]IcebergTable, error) {
+	opts = createIfNil(opts)
+	rows, err := validateAndQuery[icebergTableRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[icebergTableRow, IcebergTable](rows), nil
+}
+
+func (v *icebergTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*IcebergTable, error) {
+	icebergTables, err := v.Show(ctx, &ShowIcebergTableOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, icebergTable := range icebergTables {
+		if icebergTable.ID().name == id.Name() {
+			return &icebergTable, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// describeIcebergTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-iceberg-table.
+type describeIcebergTableOptions struct {
+	describe     bool                   `ddl:"static" sql:"DESCRIBE"`
+	icebergTable bool                   `ddl:"static" sql:"ICEBERG TABLE"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeIcebergTableOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+type IcebergTableColumnDetails struct {
+	Name     string
+	Type     DataType
+	Kind     string
+	Nullable bool
+	Comment  string
+}
+
+type icebergTableColumnDetailsRow struct {
+	Name    string         `db:"name"`
+	Type    string         `db:"type"`
+	Kind    string         `db:"kind"`
+	IsNull  string         `db:"null?"`
+	Comment sql.NullString `db:"comment"`
+}
+
+func (row icebergTableColumnDetailsRow) convert() *IcebergTableColumnDetails {
+	return &IcebergTableColumnDetails{
+		Name:     row.Name,
+		Type:     DataType(row.Type),
+		Kind:     row.Kind,
+		Nullable: row.IsNull == "Y",
+		Comment:  row.Comment.String,
+	}
+}
+
+func (v *icebergTables) DescribeColumns(ctx context.Context, id SchemaObjectIdentifier) ([]IcebergTableColumnDetails, error) {
+	opts := &describeIcebergTableOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[icebergTableColumnDetailsRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[icebergTableColumnDetailsRow, IcebergTableColumnDetails](rows), nil
+}
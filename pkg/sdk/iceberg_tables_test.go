@@ -0,0 +1,242 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIcebergTableCreate(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIcebergTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: base location and metadata file path", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{
+			name:             id,
+			BaseLocation:     String("path"),
+			MetadataFilePath: String("path/metadata.json"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIcebergTableOptions", "BaseLocation", "MetadataFilePath", "CatalogTableName"))
+	})
+
+	t.Run("validation: base location with catalog integration", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{
+			name:         id,
+			Catalog:      String("my_catalog_integration"),
+			BaseLocation: String("path"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errors.New("BaseLocation can only be used with the SNOWFLAKE catalog"))
+	})
+
+	t.Run("validation: column without type", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{
+			name:    id,
+			Columns: []IcebergTableColumn{{Name: "a"}},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("IcebergTableColumn", "Type"))
+	})
+
+	t.Run("snowflake catalog", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Columns: []IcebergTableColumn{
+				{Name: "id", Type: DataTypeNumber, NotNull: Bool(true)},
+				{Name: "name", Type: DataTypeString, Comment: String("full name")},
+			},
+			ClusterBy:               []string{"id"},
+			ExternalVolume:          String("my_volume"),
+			Catalog:                 String(IcebergTableCatalogSnowflake),
+			BaseLocation:            String("path/to/table"),
+			DataRetentionTimeInDays: Int(1),
+			ChangeTracking:          Bool(true),
+			CopyGrants:              Bool(true),
+			Comment:                 String("comment"),
+			Tag: []TagAssociation{
+				{
+					Name:  NewAccountObjectIdentifier("tag1"),
+					Value: "value1",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE ICEBERG TABLE %s ("id" NUMBER NOT NULL, "name" STRING COMMENT 'full name') CLUSTER BY (id) EXTERNAL_VOLUME = 'my_volume' CATALOG = 'SNOWFLAKE' BASE_LOCATION = 'path/to/table' DATA_RETENTION_TIME_IN_DAYS = 1 CHANGE_TRACKING = true COPY GRANTS COMMENT = 'comment' TAG ("tag1" = 'value1')`, id.FullyQualifiedName())
+	})
+
+	t.Run("object store catalog", func(t *testing.T) {
+		opts := &CreateIcebergTableOptions{
+			IfNotExists:              Bool(true),
+			name:                     id,
+			ExternalVolume:           String("my_volume"),
+			Catalog:                  String("my_catalog_integration"),
+			MetadataFilePath:         String("path/to/metadata/v1.metadata.json"),
+			ReplaceInvalidCharacters: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ICEBERG TABLE IF NOT EXISTS %s EXTERNAL_VOLUME = 'my_volume' CATALOG = 'my_catalog_integration' METADATA_FILE_PATH = 'path/to/metadata/v1.metadata.json' REPLACE_INVALID_CHARACTERS = true`, id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTableAlter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier, errExactlyOneOf("Refresh", "ConvertToManaged", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name: id,
+			Set:  &IcebergTableSet{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ChangeTracking", "DefaultDDLCollation", "ReplaceInvalidCharacters", "Comment"))
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name:    id,
+			Refresh: &IcebergTableRefresh{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s REFRESH`, id.FullyQualifiedName())
+	})
+
+	t.Run("refresh with metadata file", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name: id,
+			Refresh: &IcebergTableRefresh{
+				MetadataFilePath: String("metadata/v2.metadata.json"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s REFRESH 'metadata/v2.metadata.json'`, id.FullyQualifiedName())
+	})
+
+	t.Run("convert to managed", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name: id,
+			ConvertToManaged: &IcebergTableConvertToManaged{
+				BaseLocation: String("path"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s CONVERT TO MANAGED BASE_LOCATION = 'path'`, id.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &IcebergTableSet{
+				DataRetentionTimeInDays: Int(2),
+				ChangeTracking:          Bool(false),
+				Comment:                 String("comment"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE IF EXISTS %s SET DATA_RETENTION_TIME_IN_DAYS = 2 CHANGE_TRACKING = false COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name: id,
+			Unset: &IcebergTableUnset{
+				DataRetentionTimeInDays: Bool(true),
+				Comment:                 Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS, COMMENT`, id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name: id,
+			SetTags: []TagAssociation{
+				{
+					Name:  NewAccountObjectIdentifier("tag1"),
+					Value: "value1",
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s SET TAG "tag1" = 'value1'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := &AlterIcebergTableOptions{
+			name: id,
+			UnsetTags: []ObjectIdentifier{
+				NewAccountObjectIdentifier("tag1"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ICEBERG TABLE %s UNSET TAG "tag1"`, id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTableDrop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &DropIcebergTableOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropIcebergTableOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP ICEBERG TABLE IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTableShow(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowIcebergTableOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ICEBERG TABLES`)
+	})
+
+	t.Run("validation: empty like", func(t *testing.T) {
+		opts := &ShowIcebergTableOptions{
+			Like: &Like{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrPatternRequiredForLikeKeyword)
+	})
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		schemaIdentifier := NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName())
+		opts := &ShowIcebergTableOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: schemaIdentifier,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ICEBERG TABLES LIKE '%s' IN SCHEMA %s`, id.Name(), schemaIdentifier.FullyQualifiedName())
+	})
+}
+
+func TestIcebergTableDescribe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &describeIcebergTableOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &describeIcebergTableOptions{
+			name: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE ICEBERG TABLE %s`, id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,346 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var _ Listings = (*listings)(nil)
+
+var (
+	_ validatable = new(CreateListingOptions)
+	_ validatable = new(AlterListingOptions)
+	_ validatable = new(DropListingOptions)
+	_ validatable = new(ShowListingOptions)
+)
+
+// Listings manages the listings offered to other accounts, either privately or on the Snowflake Marketplace.
+type Listings interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, manifest string, opts *CreateListingOptions) error
+	Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterListingOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropListingOptions) error
+	Show(ctx context.Context, opts *ShowListingOptions) ([]Listing, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Listing, error)
+	Describe(ctx context.Context, id AccountObjectIdentifier) (*ListingDetails, error)
+}
+
+// listings implements Listings.
+type listings struct {
+	client *Client
+}
+
+// listingManifest encloses the YAML manifest in dollar quotes, so that it does not have to be escaped.
+func listingManifest(manifest string) string {
+	return fmt.Sprintf("$$%s$$", manifest)
+}
+
+// CreateListingOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-listing.
+type CreateListingOptions struct {
+	create             bool                     `ddl:"static" sql:"CREATE"`
+	listing            bool                     `ddl:"static" sql:"EXTERNAL LISTING"`
+	IfNotExists        *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name               AccountObjectIdentifier  `ddl:"identifier"`
+	Share              *AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	ApplicationPackage *AccountObjectIdentifier `ddl:"identifier" sql:"APPLICATION PACKAGE"`
+	manifest           string                   `ddl:"parameter,no_equals" sql:"AS"`
+	Publish            *bool                    `ddl:"parameter" sql:"PUBLISH"`
+	Review             *bool                    `ddl:"parameter" sql:"REVIEW"`
+	Comment            *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateListingOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.Share, opts.ApplicationPackage) {
+		return errors.New("only one of Share or ApplicationPackage can be set")
+	}
+	if opts.manifest == "" {
+		return errors.New("manifest must not be empty")
+	}
+	return nil
+}
+
+func (v *listings) Create(ctx context.Context, id AccountObjectIdentifier, manifest string, opts *CreateListingOptions) error {
+	if opts == nil {
+		opts = &CreateListingOptions{}
+	}
+	opts.name = id
+	opts.manifest = listingManifest(manifest)
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterListingOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-listing.
+type AlterListingOptions struct {
+	alter     bool                    `ddl:"static" sql:"ALTER"`
+	listing   bool                    `ddl:"static" sql:"LISTING"`
+	IfExists  *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name      AccountObjectIdentifier `ddl:"identifier"`
+	Publish   *bool                   `ddl:"keyword" sql:"PUBLISH"`
+	Unpublish *bool                   `ddl:"keyword" sql:"UNPUBLISH"`
+	Review    *bool                   `ddl:"keyword" sql:"REVIEW"`
+	As        *ListingAs              `ddl:"keyword"`
+	NewName   AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set       *ListingSet             `ddl:"keyword" sql:"SET"`
+}
+
+// ListingAs replaces the manifest of the listing. The manifest is enclosed in dollar quotes by Alter.
+type ListingAs struct {
+	Manifest string  `ddl:"parameter,no_equals" sql:"AS"`
+	Publish  *bool   `ddl:"parameter" sql:"PUBLISH"`
+	Review   *bool   `ddl:"parameter" sql:"REVIEW"`
+	Comment  *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ListingSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *AlterListingOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Publish, opts.Unpublish, opts.Review, opts.As, opts.NewName, opts.Set) {
+		return errors.New("exactly one of PUBLISH, UNPUBLISH, REVIEW, AS, RENAME TO or SET must be specified")
+	}
+	if valueSet(opts.As) && opts.As.Manifest == "" {
+		return errors.New("manifest must not be empty")
+	}
+	if valueSet(opts.Set) && opts.Set.Comment == nil {
+		return errors.New("at least one property must be specified for SET")
+	}
+	return nil
+}
+
+func (v *listings) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterListingOptions) error {
+	if opts == nil {
+		opts = &AlterListingOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.As != nil {
+		as := *opts.As
+		as.Manifest = listingManifest(as.Manifest)
+		opts.As = &as
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropListingOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-listing.
+type DropListingOptions struct {
+	drop     bool                    `ddl:"static" sql:"DROP"`
+	listing  bool                    `ddl:"static" sql:"LISTING"`
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropListingOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *listings) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropListingOptions) error {
+	if opts == nil {
+		opts = &DropListingOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowListingOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-listings.
+type ShowListingOptions struct {
+	show     bool  `ddl:"static" sql:"SHOW"`
+	listings bool  `ddl:"static" sql:"LISTINGS"`
+	Like     *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowListingOptions) validate() error {
+	return nil
+}
+
+type ListingState string
+
+const (
+	ListingStateDraft       ListingState = "DRAFT"
+	ListingStatePublished   ListingState = "PUBLISHED"
+	ListingStateUnpublished ListingState = "UNPUBLISHED"
+)
+
+// Listing is a user friendly result for a SHOW LISTINGS query.
+type Listing struct {
+	GlobalName     string
+	Name           string
+	Title          string
+	Subtitle       string
+	Profile        string
+	CreatedOn      time.Time
+	State          ListingState
+	ReviewState    string
+	Comment        string
+	Owner          string
+	Regions        string
+	TargetAccounts string
+	IsMonetized    bool
+	IsApplication  bool
+	IsTargeted     bool
+}
+
+func (v *Listing) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *Listing) ObjectType() ObjectType {
+	return ObjectTypeListing
+}
+
+// listingDBRow is used to decode the result of a SHOW LISTINGS query.
+type listingDBRow struct {
+	GlobalName     string         `db:"global_name"`
+	Name           string         `db:"name"`
+	Title          sql.NullString `db:"title"`
+	Subtitle       sql.NullString `db:"subtitle"`
+	Profile        sql.NullString `db:"profile"`
+	CreatedOn      time.Time      `db:"created_on"`
+	State          string         `db:"state"`
+	ReviewState    sql.NullString `db:"review_state"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          sql.NullString `db:"owner"`
+	Regions        sql.NullString `db:"regions"`
+	TargetAccounts sql.NullString `db:"target_accounts"`
+	IsMonetized    bool           `db:"is_monetized"`
+	IsApplication  bool           `db:"is_application"`
+	IsTargeted     bool           `db:"is_targeted"`
+}
+
+func (row listingDBRow) convert() *Listing {
+	return &Listing{
+		GlobalName:     row.GlobalName,
+		Name:           row.Name,
+		Title:          row.Title.String,
+		Subtitle:       row.Subtitle.String,
+		Profile:        row.Profile.String,
+		CreatedOn:      row.CreatedOn,
+		State:          ListingState(row.State),
+		ReviewState:    row.ReviewState.String,
+		Comment:        row.Comment.String,
+		Owner:          row.Owner.String,
+		Regions:        row.Regions.String,
+		TargetAccounts: row.TargetAccounts.String,
+		IsMonetized:    row.IsMonetized,
+		IsApplication:  row.IsApplication,
+		IsTargeted:     row.IsTargeted,
+	}
+}
+
+func (v *listings) Show(ctx context.Context, opts *ShowListingOptions) ([]Listing, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[listingDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[listingDBRow, Listing](dbRows)
+	return resultList, nil
+}
+
+func (v *listings) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Listing, error) {
+	listings, err := v.Show(ctx, &ShowListingOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, listing := range listings {
+		if listing.ID().name == id.Name() {
+			return &listing, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// describeListingOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-listing.
+type describeListingOptions struct {
+	describe bool                    `ddl:"static" sql:"DESCRIBE"`
+	listing  bool                    `ddl:"static" sql:"LISTING"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeListingOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ListingDetails is a user friendly result for a DESCRIBE LISTING query.
+type ListingDetails struct {
+	Name               string
+	State              ListingState
+	Share              string
+	ApplicationPackage string
+	TargetAccounts     string
+	ManifestYaml       string
+}
+
+type listingDetailsDBRow struct {
+	Name               string         `db:"name"`
+	State              string         `db:"state"`
+	Share              sql.NullString `db:"share"`
+	ApplicationPackage sql.NullString `db:"application_package"`
+	TargetAccounts     sql.NullString `db:"target_accounts"`
+	ManifestYaml       sql.NullString `db:"manifest_yaml"`
+}
+
+func (v *listings) Describe(ctx context.Context, id AccountObjectIdentifier) (*ListingDetails, error) {
+	opts := &describeListingOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	row := listingDetailsDBRow{}
+	if err := v.client.queryOne(ctx, &row, sql); err != nil {
+		return nil, err
+	}
+	return &ListingDetails{
+		Name:               row.Name,
+		State:              ListingState(row.State),
+		Share:              row.Share.String,
+		ApplicationPackage: row.ApplicationPackage.String,
+		TargetAccounts:     row.TargetAccounts.String,
+		ManifestYaml:       row.ManifestYaml.String,
+	}, nil
+}
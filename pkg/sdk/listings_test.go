@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestListingsCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("listing1")
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			manifest: "$$title: t$$",
+		}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: share and application package", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			name:               id,
+			Share:              Pointer(NewAccountObjectIdentifier("share1")),
+			ApplicationPackage: Pointer(NewAccountObjectIdentifier("package1")),
+			manifest:           "$$title: t$$",
+		}
+		assertOptsInvalid(t, opts, errors.New("only one of Share or ApplicationPackage can be set"))
+	})
+
+	t.Run("validation: empty manifest", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			name: id,
+		}
+		assertOptsInvalid(t, opts, errors.New("manifest must not be empty"))
+	})
+
+	t.Run("with share", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+			Share:       Pointer(NewAccountObjectIdentifier("share1")),
+			manifest:    listingManifest("title: t"),
+			Publish:     Bool(false),
+			Review:      Bool(false),
+			Comment:     String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE EXTERNAL LISTING IF NOT EXISTS "listing1" SHARE "share1" AS $$title: t$$ PUBLISH = false REVIEW = false COMMENT = 'comment'`)
+	})
+
+	t.Run("with application package", func(t *testing.T) {
+		opts := &CreateListingOptions{
+			name:               id,
+			ApplicationPackage: Pointer(NewAccountObjectIdentifier("package1")),
+			manifest:           listingManifest("title: t"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE EXTERNAL LISTING "listing1" APPLICATION PACKAGE "package1" AS $$title: t$$`)
+	})
+}
+
+func TestListingsAlter(t *testing.T) {
+	id := NewAccountObjectIdentifier("listing1")
+
+	t.Run("validation: no alter action", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name: id,
+		}
+		assertOptsInvalid(t, opts, errors.New("exactly one of PUBLISH, UNPUBLISH, REVIEW, AS, RENAME TO or SET must be specified"))
+	})
+
+	t.Run("publish", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name:    id,
+			Publish: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER LISTING "listing1" PUBLISH`)
+	})
+
+	t.Run("unpublish", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			IfExists:  Bool(true),
+			name:      id,
+			Unpublish: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER LISTING IF EXISTS "listing1" UNPUBLISH`)
+	})
+
+	t.Run("as manifest", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name: id,
+			As: &ListingAs{
+				Manifest: listingManifest("title: t2"),
+				Publish:  Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER LISTING "listing1" AS $$title: t2$$ PUBLISH = true`)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name:    id,
+			NewName: NewAccountObjectIdentifier("listing2"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER LISTING "listing1" RENAME TO "listing2"`)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := &AlterListingOptions{
+			name: id,
+			Set: &ListingSet{
+				Comment: String("comment"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER LISTING "listing1" SET COMMENT = 'comment'`)
+	})
+}
+
+func TestListingsDrop(t *testing.T) {
+	t.Run("if exists", func(t *testing.T) {
+		opts := &DropListingOptions{
+			IfExists: Bool(true),
+			name:     NewAccountObjectIdentifier("listing1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP LISTING IF EXISTS "listing1"`)
+	})
+}
+
+func TestListingsShow(t *testing.T) {
+	t.Run("like", func(t *testing.T) {
+		opts := &ShowListingOptions{
+			Like: &Like{
+				Pattern: String("listing1"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW LISTINGS LIKE 'listing1'`)
+	})
+}
+
+func TestListingsDescribe(t *testing.T) {
+	t.Run("describe", func(t *testing.T) {
+		opts := &describeListingOptions{
+			name: NewAccountObjectIdentifier("listing1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE LISTING "listing1"`)
+	})
+}
@@ -0,0 +1,198 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var _ ManagedAccounts = (*managedAccounts)(nil)
+
+var (
+	_ validatable = new(CreateManagedAccountOptions)
+	_ validatable = new(DropManagedAccountOptions)
+	_ validatable = new(ShowManagedAccountOptions)
+)
+
+// ManagedAccounts manages the reader accounts created by a data provider for its consumers.
+type ManagedAccounts interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateManagedAccountOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropManagedAccountOptions) error
+	Show(ctx context.Context, opts *ShowManagedAccountOptions) ([]ManagedAccount, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ManagedAccount, error)
+}
+
+// managedAccounts implements ManagedAccounts.
+type managedAccounts struct {
+	client *Client
+}
+
+type ManagedAccountType string
+
+const (
+	ManagedAccountTypeReader ManagedAccountType = "READER"
+)
+
+// CreateManagedAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-managed-account.
+type CreateManagedAccountOptions struct {
+	create         bool                        `ddl:"static" sql:"CREATE"`
+	managedAccount bool                        `ddl:"static" sql:"MANAGED ACCOUNT"`
+	name           AccountObjectIdentifier     `ddl:"identifier"`
+	Params         *CreateManagedAccountParams `ddl:"list,no_parentheses"`
+}
+
+type CreateManagedAccountParams struct {
+	AdminName     string             `ddl:"parameter,single_quotes" sql:"ADMIN_NAME"`
+	AdminPassword string             `ddl:"parameter,single_quotes" sql:"ADMIN_PASSWORD"`
+	Type          ManagedAccountType `ddl:"parameter" sql:"TYPE"`
+	Comment       *string            `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateManagedAccountOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.Params == nil {
+		return errors.New("Params must be set")
+	}
+	if opts.Params.AdminName == "" {
+		return errors.New("AdminName must be set")
+	}
+	if opts.Params.AdminPassword == "" {
+		return errors.New("AdminPassword must be set")
+	}
+	if opts.Params.Type == "" {
+		return errors.New("Type must be set")
+	}
+	return nil
+}
+
+func (v *managedAccounts) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateManagedAccountOptions) error {
+	if opts == nil {
+		opts = &CreateManagedAccountOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropManagedAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-managed-account.
+type DropManagedAccountOptions struct {
+	drop           bool                    `ddl:"static" sql:"DROP"`
+	managedAccount bool                    `ddl:"static" sql:"MANAGED ACCOUNT"`
+	name           AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropManagedAccountOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *managedAccounts) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropManagedAccountOptions) error {
+	if opts == nil {
+		opts = &DropManagedAccountOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowManagedAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-managed-accounts.
+type ShowManagedAccountOptions struct {
+	show            bool  `ddl:"static" sql:"SHOW"`
+	managedAccounts bool  `ddl:"static" sql:"MANAGED ACCOUNTS"`
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *ShowManagedAccountOptions) validate() error {
+	return nil
+}
+
+// ManagedAccount is a user friendly result for a SHOW MANAGED ACCOUNTS query.
+type ManagedAccount struct {
+	Name      string
+	Cloud     string
+	Region    string
+	Locator   string
+	CreatedOn time.Time
+	URL       string
+	Comment   string
+	IsReader  bool
+}
+
+func (v *ManagedAccount) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ManagedAccount) ObjectType() ObjectType {
+	return ObjectTypeManagedAccount
+}
+
+// managedAccountDBRow is used to decode the result of a SHOW MANAGED ACCOUNTS query.
+type managedAccountDBRow struct {
+	Name      string         `db:"name"`
+	Cloud     sql.NullString `db:"cloud"`
+	Region    sql.NullString `db:"region"`
+	Locator   sql.NullString `db:"locator"`
+	CreatedOn time.Time      `db:"created_on"`
+	URL       sql.NullString `db:"url"`
+	Comment   sql.NullString `db:"comment"`
+	IsReader  bool           `db:"is_reader"`
+}
+
+func (row managedAccountDBRow) convert() *ManagedAccount {
+	return &ManagedAccount{
+		Name:      row.Name,
+		Cloud:     row.Cloud.String,
+		Region:    row.Region.String,
+		Locator:   row.Locator.String,
+		CreatedOn: row.CreatedOn,
+		URL:       row.URL.String,
+		Comment:   row.Comment.String,
+		IsReader:  row.IsReader,
+	}
+}
+
+func (v *managedAccounts) Show(ctx context.Context, opts *ShowManagedAccountOptions) ([]ManagedAccount, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[managedAccountDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[managedAccountDBRow, ManagedAccount](dbRows)
+	return resultList, nil
+}
+
+func (v *managedAccounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ManagedAccount, error) {
+	managedAccounts, err := v.Show(ctx, &ShowManagedAccountOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, managedAccount := range managedAccounts {
+		if managedAccount.ID().name == id.Name() {
+			return &managedAccount, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
@@ -0,0 +1,87 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestManagedAccountsCreate(t *testing.T) {
+	id := NewAccountObjectIdentifier("reader1")
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{
+			Params: &CreateManagedAccountParams{
+				AdminName:     "admin",
+				AdminPassword: "secret",
+				Type:          ManagedAccountTypeReader,
+			},
+		}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: missing admin name", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{
+			name: id,
+			Params: &CreateManagedAccountParams{
+				AdminPassword: "secret",
+				Type:          ManagedAccountTypeReader,
+			},
+		}
+		assertOptsInvalid(t, opts, errors.New("AdminName must be set"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{
+			name: id,
+			Params: &CreateManagedAccountParams{
+				AdminName:     "admin",
+				AdminPassword: "secret",
+				Type:          ManagedAccountTypeReader,
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE MANAGED ACCOUNT "reader1" ADMIN_NAME = 'admin', ADMIN_PASSWORD = 'secret', TYPE = READER`)
+	})
+
+	t.Run("with comment", func(t *testing.T) {
+		opts := &CreateManagedAccountOptions{
+			name: id,
+			Params: &CreateManagedAccountParams{
+				AdminName:     "admin",
+				AdminPassword: "secret",
+				Type:          ManagedAccountTypeReader,
+				Comment:       String("for consumers"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE MANAGED ACCOUNT "reader1" ADMIN_NAME = 'admin', ADMIN_PASSWORD = 'secret', TYPE = READER, COMMENT = 'for consumers'`)
+	})
+}
+
+func TestManagedAccountsDrop(t *testing.T) {
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &DropManagedAccountOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := &DropManagedAccountOptions{
+			name: NewAccountObjectIdentifier("reader1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP MANAGED ACCOUNT "reader1"`)
+	})
+}
+
+func TestManagedAccountsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowManagedAccountOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW MANAGED ACCOUNTS`)
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		opts := &ShowManagedAccountOptions{
+			Like: &Like{
+				Pattern: String("reader1"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW MANAGED ACCOUNTS LIKE 'reader1'`)
+	})
+}
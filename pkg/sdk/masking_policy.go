@@ -324,30 +324,53 @@ func (row maskingPolicyDetailsRow) toMaskingPolicyDetails() *MaskingPolicyDetail
 	if err != nil {
 		return nil
 	}
-	v := &MaskingPolicyDetails{
+	return &MaskingPolicyDetails{
 		Name:       row.Name,
-		Signature:  []TableColumnSignature{},
+		Signature:  parseTableColumnSignature(row.Signature),
 		ReturnType: dataType,
 		Body:       row.Body,
 	}
-	s := strings.Trim(row.Signature, "()")
-	parts := strings.Split(s, ",")
+}
+
+// parseTableColumnSignature parses a signature returned by DESCRIBE, e.g. (VAL VARCHAR, AMOUNT NUMBER(38,0)).
+// Commas nested in parentheses (like in NUMBER(38,0)) are not treated as argument separators.
+func parseTableColumnSignature(signature string) []TableColumnSignature {
+	s := strings.TrimSpace(signature)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+
+	var parts []string
+	var depth, start int
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, s[start:])
+
+	columns := []TableColumnSignature{}
 	for _, part := range parts {
-		p := strings.Split(strings.TrimSpace(part), " ")
+		p := strings.SplitN(strings.TrimSpace(part), " ", 2)
 		if len(p) != 2 {
 			continue
 		}
-		dType, err := ToDataType(p[1])
+		dType, err := ToDataType(strings.TrimSpace(p[1]))
 		if err != nil {
 			continue
 		}
-		v.Signature = append(v.Signature, TableColumnSignature{
+		columns = append(columns, TableColumnSignature{
 			Name: p[0],
 			Type: dType,
 		})
 	}
-
-	return v
+	return columns
 }
 
 func (v *maskingPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*MaskingPolicyDetails, error) {
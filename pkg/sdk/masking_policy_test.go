@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMaskingPolicyCreate(t *testing.T) {
@@ -217,3 +218,23 @@ func TestMaskingPolicyDescribe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE MASKING POLICY %s", id.FullyQualifiedName())
 	})
 }
+
+func TestMaskingPolicyParseSignature(t *testing.T) {
+	t.Run("single argument", func(t *testing.T) {
+		signature := parseTableColumnSignature("(VAL VARCHAR)")
+		assert.Equal(t, []TableColumnSignature{{Name: "VAL", Type: DataTypeVARCHAR}}, signature)
+	})
+
+	t.Run("multiple arguments", func(t *testing.T) {
+		signature := parseTableColumnSignature("(VAL VARCHAR, AMOUNT NUMBER(38,0), CREATED_ON TIMESTAMP_NTZ(9))")
+		assert.Equal(t, []TableColumnSignature{
+			{Name: "VAL", Type: DataTypeVARCHAR},
+			{Name: "AMOUNT", Type: DataTypeNumber},
+			{Name: "CREATED_ON", Type: DataTypeTimestampNTZ},
+		}, signature)
+	})
+
+	t.Run("empty signature", func(t *testing.T) {
+		assert.Empty(t, parseTableColumnSignature("()"))
+	})
+}
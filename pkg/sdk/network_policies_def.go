@@ -22,6 +22,8 @@ var (
 				Name().
 				ListQueryStructField("AllowedIpList", ip, g.ParameterOptions().SQL("ALLOWED_IP_LIST").Parentheses()).
 				ListQueryStructField("BlockedIpList", ip, g.ParameterOptions().SQL("BLOCKED_IP_LIST").Parentheses()).
+				ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+				ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
 				OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 				WithValidation(g.ValidIdentifier, "name"),
 		).
@@ -37,14 +39,42 @@ var (
 					g.QueryStruct("NetworkPolicySet").
 						ListQueryStructField("AllowedIpList", ip, g.ParameterOptions().SQL("ALLOWED_IP_LIST").Parentheses()).
 						ListQueryStructField("BlockedIpList", ip, g.ParameterOptions().SQL("BLOCKED_IP_LIST").Parentheses()).
+						ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+						ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
 						OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
-						WithValidation(g.AtLeastOneValueSet, "AllowedIpList", "BlockedIpList", "Comment"),
+						WithValidation(g.AtLeastOneValueSet, "AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "Comment"),
 					g.KeywordOptions().SQL("SET"),
 				).
 				OptionalSQL("UNSET COMMENT").
+				OptionalQueryStructField(
+					"Unset",
+					g.QueryStruct("NetworkPolicyUnset").
+						OptionalSQL("ALLOWED_IP_LIST").
+						OptionalSQL("BLOCKED_IP_LIST").
+						OptionalSQL("ALLOWED_NETWORK_RULE_LIST").
+						OptionalSQL("BLOCKED_NETWORK_RULE_LIST").
+						WithValidation(g.AtLeastOneValueSet, "AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList"),
+					g.ListOptions().NoParentheses().SQL("UNSET"),
+				).
+				OptionalQueryStructField(
+					"Add",
+					g.QueryStruct("NetworkPolicyAddNetworkRule").
+						ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+						ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+						WithValidation(g.ExactlyOneValueSet, "AllowedNetworkRuleList", "BlockedNetworkRuleList"),
+					g.KeywordOptions().SQL("ADD"),
+				).
+				OptionalQueryStructField(
+					"Remove",
+					g.QueryStruct("NetworkPolicyRemoveNetworkRule").
+						ListAssignment("ALLOWED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+						ListAssignment("BLOCKED_NETWORK_RULE_LIST", "SchemaObjectIdentifier", g.ParameterOptions().Parentheses()).
+						WithValidation(g.ExactlyOneValueSet, "AllowedNetworkRuleList", "BlockedNetworkRuleList"),
+					g.KeywordOptions().SQL("REMOVE"),
+				).
 				Identifier("RenameTo", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
 				WithValidation(g.ValidIdentifier, "name").
-				WithValidation(g.ExactlyOneValueSet, "Set", "UnsetComment", "RenameTo").
+				WithValidation(g.ExactlyOneValueSet, "Set", "UnsetComment", "Unset", "Add", "Remove", "RenameTo").
 				WithValidation(g.ValidIdentifierIfSet, "RenameTo"),
 		).
 		DropOperation(
@@ -63,13 +93,17 @@ var (
 				Field("name", "string").
 				Field("comment", "string").
 				Field("entries_in_allowed_ip_list", "int").
-				Field("entries_in_blocked_ip_list", "int"),
+				Field("entries_in_blocked_ip_list", "int").
+				Field("entries_in_allowed_network_rules", "int").
+				Field("entries_in_blocked_network_rules", "int"),
 			g.PlainStruct("NetworkPolicy").
 				Field("CreatedOn", "string").
 				Field("Name", "string").
 				Field("Comment", "string").
 				Field("EntriesInAllowedIpList", "int").
-				Field("EntriesInBlockedIpList", "int"),
+				Field("EntriesInBlockedIpList", "int").
+				Field("EntriesInAllowedNetworkRules", "int").
+				Field("EntriesInBlockedNetworkRules", "int"),
 			g.QueryStruct("ShowNetworkPolicies").
 				Show().
 				SQL("NETWORK POLICIES"),
@@ -27,6 +27,16 @@ func (s *CreateNetworkPolicyRequest) WithBlockedIpList(BlockedIpList []IPRequest
 	return s
 }
 
+func (s *CreateNetworkPolicyRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *CreateNetworkPolicyRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *CreateNetworkPolicyRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *CreateNetworkPolicyRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func (s *CreateNetworkPolicyRequest) WithComment(Comment *string) *CreateNetworkPolicyRequest {
 	s.Comment = Comment
 	return s
@@ -63,6 +73,21 @@ func (s *AlterNetworkPolicyRequest) WithUnsetComment(UnsetComment *bool) *AlterN
 	return s
 }
 
+func (s *AlterNetworkPolicyRequest) WithUnset(Unset *NetworkPolicyUnsetRequest) *AlterNetworkPolicyRequest {
+	s.Unset = Unset
+	return s
+}
+
+func (s *AlterNetworkPolicyRequest) WithAdd(Add *NetworkPolicyAddNetworkRuleRequest) *AlterNetworkPolicyRequest {
+	s.Add = Add
+	return s
+}
+
+func (s *AlterNetworkPolicyRequest) WithRemove(Remove *NetworkPolicyRemoveNetworkRuleRequest) *AlterNetworkPolicyRequest {
+	s.Remove = Remove
+	return s
+}
+
 func (s *AlterNetworkPolicyRequest) WithRenameTo(RenameTo *AccountObjectIdentifier) *AlterNetworkPolicyRequest {
 	s.RenameTo = RenameTo
 	return s
@@ -82,11 +107,73 @@ func (s *NetworkPolicySetRequest) WithBlockedIpList(BlockedIpList []IPRequest) *
 	return s
 }
 
+func (s *NetworkPolicySetRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicySetRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *NetworkPolicySetRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicySetRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func (s *NetworkPolicySetRequest) WithComment(Comment *string) *NetworkPolicySetRequest {
 	s.Comment = Comment
 	return s
 }
 
+func NewNetworkPolicyUnsetRequest() *NetworkPolicyUnsetRequest {
+	return &NetworkPolicyUnsetRequest{}
+}
+
+func (s *NetworkPolicyUnsetRequest) WithAllowedIpList(AllowedIpList *bool) *NetworkPolicyUnsetRequest {
+	s.AllowedIpList = AllowedIpList
+	return s
+}
+
+func (s *NetworkPolicyUnsetRequest) WithBlockedIpList(BlockedIpList *bool) *NetworkPolicyUnsetRequest {
+	s.BlockedIpList = BlockedIpList
+	return s
+}
+
+func (s *NetworkPolicyUnsetRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList *bool) *NetworkPolicyUnsetRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *NetworkPolicyUnsetRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList *bool) *NetworkPolicyUnsetRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
+func NewNetworkPolicyAddNetworkRuleRequest() *NetworkPolicyAddNetworkRuleRequest {
+	return &NetworkPolicyAddNetworkRuleRequest{}
+}
+
+func (s *NetworkPolicyAddNetworkRuleRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicyAddNetworkRuleRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *NetworkPolicyAddNetworkRuleRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicyAddNetworkRuleRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
+func NewNetworkPolicyRemoveNetworkRuleRequest() *NetworkPolicyRemoveNetworkRuleRequest {
+	return &NetworkPolicyRemoveNetworkRuleRequest{}
+}
+
+func (s *NetworkPolicyRemoveNetworkRuleRequest) WithAllowedNetworkRuleList(AllowedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicyRemoveNetworkRuleRequest {
+	s.AllowedNetworkRuleList = AllowedNetworkRuleList
+	return s
+}
+
+func (s *NetworkPolicyRemoveNetworkRuleRequest) WithBlockedNetworkRuleList(BlockedNetworkRuleList []SchemaObjectIdentifier) *NetworkPolicyRemoveNetworkRuleRequest {
+	s.BlockedNetworkRuleList = BlockedNetworkRuleList
+	return s
+}
+
 func NewDropNetworkPolicyRequest(
 	name AccountObjectIdentifier,
 ) *DropNetworkPolicyRequest {
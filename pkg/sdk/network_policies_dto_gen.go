@@ -11,11 +11,13 @@ var (
 )
 
 type CreateNetworkPolicyRequest struct {
-	OrReplace     *bool
-	name          AccountObjectIdentifier // required
-	AllowedIpList []IPRequest
-	BlockedIpList []IPRequest
-	Comment       *string
+	OrReplace              *bool
+	name                   AccountObjectIdentifier // required
+	AllowedIpList          []IPRequest
+	BlockedIpList          []IPRequest
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+	Comment                *string
 }
 
 func (r *CreateNetworkPolicyRequest) GetName() AccountObjectIdentifier {
@@ -31,13 +33,35 @@ type AlterNetworkPolicyRequest struct {
 	name         AccountObjectIdentifier // required
 	Set          *NetworkPolicySetRequest
 	UnsetComment *bool
+	Unset        *NetworkPolicyUnsetRequest
+	Add          *NetworkPolicyAddNetworkRuleRequest
+	Remove       *NetworkPolicyRemoveNetworkRuleRequest
 	RenameTo     *AccountObjectIdentifier
 }
 
 type NetworkPolicySetRequest struct {
-	AllowedIpList []IPRequest
-	BlockedIpList []IPRequest
-	Comment       *string
+	AllowedIpList          []IPRequest
+	BlockedIpList          []IPRequest
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+	Comment                *string
+}
+
+type NetworkPolicyUnsetRequest struct {
+	AllowedIpList          *bool
+	BlockedIpList          *bool
+	AllowedNetworkRuleList *bool
+	BlockedNetworkRuleList *bool
+}
+
+type NetworkPolicyAddNetworkRuleRequest struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
+}
+
+type NetworkPolicyRemoveNetworkRuleRequest struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier
+	BlockedNetworkRuleList []SchemaObjectIdentifier
 }
 
 type DropNetworkPolicyRequest struct {
@@ -1,6 +1,9 @@
 package sdk
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+)
 
 type NetworkPolicies interface {
 	Create(ctx context.Context, request *CreateNetworkPolicyRequest) error
@@ -13,13 +16,15 @@ type NetworkPolicies interface {
 
 // CreateNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-network-policy.
 type CreateNetworkPolicyOptions struct {
-	create        bool                    `ddl:"static" sql:"CREATE"`
-	OrReplace     *bool                   `ddl:"keyword" sql:"OR REPLACE"`
-	networkPolicy bool                    `ddl:"static" sql:"NETWORK POLICY"`
-	name          AccountObjectIdentifier `ddl:"identifier"`
-	AllowedIpList []IP                    `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
-	BlockedIpList []IP                    `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
-	Comment       *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                 bool                     `ddl:"static" sql:"CREATE"`
+	OrReplace              *bool                    `ddl:"keyword" sql:"OR REPLACE"`
+	networkPolicy          bool                     `ddl:"static" sql:"NETWORK POLICY"`
+	name                   AccountObjectIdentifier  `ddl:"identifier"`
+	AllowedIpList          []IP                     `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
+	BlockedIpList          []IP                     `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
+	AllowedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"BLOCKED_NETWORK_RULE_LIST"`
+	Comment                *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type IP struct {
@@ -28,19 +33,41 @@ type IP struct {
 
 // AlterNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-network-policy.
 type AlterNetworkPolicyOptions struct {
-	alter         bool                     `ddl:"static" sql:"ALTER"`
-	networkPolicy bool                     `ddl:"static" sql:"NETWORK POLICY"`
-	IfExists      *bool                    `ddl:"keyword" sql:"IF EXISTS"`
-	name          AccountObjectIdentifier  `ddl:"identifier"`
-	Set           *NetworkPolicySet        `ddl:"keyword" sql:"SET"`
-	UnsetComment  *bool                    `ddl:"keyword" sql:"UNSET COMMENT"`
-	RenameTo      *AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	alter         bool                            `ddl:"static" sql:"ALTER"`
+	networkPolicy bool                            `ddl:"static" sql:"NETWORK POLICY"`
+	IfExists      *bool                           `ddl:"keyword" sql:"IF EXISTS"`
+	name          AccountObjectIdentifier         `ddl:"identifier"`
+	Set           *NetworkPolicySet               `ddl:"keyword" sql:"SET"`
+	UnsetComment  *bool                           `ddl:"keyword" sql:"UNSET COMMENT"`
+	Unset         *NetworkPolicyUnset             `ddl:"list,no_parentheses" sql:"UNSET"`
+	Add           *NetworkPolicyAddNetworkRule    `ddl:"keyword" sql:"ADD"`
+	Remove        *NetworkPolicyRemoveNetworkRule `ddl:"keyword" sql:"REMOVE"`
+	RenameTo      *AccountObjectIdentifier        `ddl:"identifier" sql:"RENAME TO"`
 }
 
 type NetworkPolicySet struct {
-	AllowedIpList []IP    `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
-	BlockedIpList []IP    `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
-	Comment       *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	AllowedIpList          []IP                     `ddl:"parameter,parentheses" sql:"ALLOWED_IP_LIST"`
+	BlockedIpList          []IP                     `ddl:"parameter,parentheses" sql:"BLOCKED_IP_LIST"`
+	AllowedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"BLOCKED_NETWORK_RULE_LIST"`
+	Comment                *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type NetworkPolicyUnset struct {
+	AllowedIpList          *bool `ddl:"keyword" sql:"ALLOWED_IP_LIST"`
+	BlockedIpList          *bool `ddl:"keyword" sql:"BLOCKED_IP_LIST"`
+	AllowedNetworkRuleList *bool `ddl:"keyword" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList *bool `ddl:"keyword" sql:"BLOCKED_NETWORK_RULE_LIST"`
+}
+
+type NetworkPolicyAddNetworkRule struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"BLOCKED_NETWORK_RULE_LIST"`
+}
+
+type NetworkPolicyRemoveNetworkRule struct {
+	AllowedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"ALLOWED_NETWORK_RULE_LIST"`
+	BlockedNetworkRuleList []SchemaObjectIdentifier `ddl:"parameter,parentheses" sql:"BLOCKED_NETWORK_RULE_LIST"`
 }
 
 // DropNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-network-policy.
@@ -58,19 +85,23 @@ type ShowNetworkPolicyOptions struct {
 }
 
 type showNetworkPolicyDBRow struct {
-	CreatedOn              string `db:"created_on"`
-	Name                   string `db:"name"`
-	Comment                string `db:"comment"`
-	EntriesInAllowedIpList int    `db:"entries_in_allowed_ip_list"`
-	EntriesInBlockedIpList int    `db:"entries_in_blocked_ip_list"`
+	CreatedOn                    string `db:"created_on"`
+	Name                         string `db:"name"`
+	Comment                      string `db:"comment"`
+	EntriesInAllowedIpList       int    `db:"entries_in_allowed_ip_list"`
+	EntriesInBlockedIpList       int    `db:"entries_in_blocked_ip_list"`
+	EntriesInAllowedNetworkRules int    `db:"entries_in_allowed_network_rules"`
+	EntriesInBlockedNetworkRules int    `db:"entries_in_blocked_network_rules"`
 }
 
 type NetworkPolicy struct {
-	CreatedOn              string
-	Name                   string
-	Comment                string
-	EntriesInAllowedIpList int
-	EntriesInBlockedIpList int
+	CreatedOn                    string
+	Name                         string
+	Comment                      string
+	EntriesInAllowedIpList       int
+	EntriesInBlockedIpList       int
+	EntriesInAllowedNetworkRules int
+	EntriesInBlockedNetworkRules int
 }
 
 // DescribeNetworkPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-network-policy.
@@ -89,3 +120,17 @@ type NetworkPolicyDescription struct {
 	Name  string
 	Value string
 }
+
+type NetworkRulesSnowflakeDTO struct {
+	FullyQualifiedRuleName string `json:"fullyQualifiedRuleName"`
+}
+
+// ParseNetworkRulesSnowflakeDto parses the ALLOWED_NETWORK_RULE_LIST and BLOCKED_NETWORK_RULE_LIST values
+// returned by DESCRIBE NETWORK POLICY, e.g. [{"fullyQualifiedRuleName":"DB.SCHEMA.RULE"}].
+func ParseNetworkRulesSnowflakeDto(networkRulesStringValue string) ([]NetworkRulesSnowflakeDTO, error) {
+	var result []NetworkRulesSnowflakeDTO
+	if err := json.Unmarshal([]byte(networkRulesStringValue), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
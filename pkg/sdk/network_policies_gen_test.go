@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestNetworkPolicies_Create(t *testing.T) {
@@ -33,6 +35,17 @@ func TestNetworkPolicies_Create(t *testing.T) {
 		opts := defaultOpts()
 		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE NETWORK POLICY %s ALLOWED_IP_LIST = ('123.0.0.1', '321.0.0.1') BLOCKED_IP_LIST = ('123.0.0.1', '321.0.0.1') COMMENT = 'some_comment'", opts.name.FullyQualifiedName())
 	})
+
+	t.Run("with network rule lists", func(t *testing.T) {
+		allowedRule := RandomSchemaObjectIdentifier()
+		blockedRule := RandomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.AllowedIpList = nil
+		opts.BlockedIpList = nil
+		opts.AllowedNetworkRuleList = []SchemaObjectIdentifier{allowedRule}
+		opts.BlockedNetworkRuleList = []SchemaObjectIdentifier{blockedRule}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE NETWORK POLICY %s ALLOWED_NETWORK_RULE_LIST = (%s) BLOCKED_NETWORK_RULE_LIST = (%s) COMMENT = 'some_comment'", opts.name.FullyQualifiedName(), allowedRule.FullyQualifiedName(), blockedRule.FullyQualifiedName())
+	})
 }
 
 func TestNetworkPolicies_Alter(t *testing.T) {
@@ -58,15 +71,36 @@ func TestNetworkPolicies_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: exactly one field from [opts.Set opts.UnsetComment opts.RenameTo] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.Set opts.UnsetComment opts.Unset opts.Add opts.Remove opts.RenameTo] should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Set", "UnsetComment", "RenameTo"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Set", "UnsetComment", "Unset", "Add", "Remove", "RenameTo"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.AllowedIpList opts.Set.BlockedIpList opts.Set.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.AllowedIpList opts.Set.BlockedIpList opts.Set.AllowedNetworkRuleList opts.Set.BlockedNetworkRuleList opts.Set.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &NetworkPolicySet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AllowedIpList", "BlockedIpList", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.AllowedIpList opts.Unset.BlockedIpList opts.Unset.AllowedNetworkRuleList opts.Unset.BlockedNetworkRuleList] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &NetworkPolicyUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Add.AllowedNetworkRuleList opts.Add.BlockedNetworkRuleList] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &NetworkPolicyAddNetworkRule{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AllowedNetworkRuleList", "BlockedNetworkRuleList"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Remove.AllowedNetworkRuleList opts.Remove.BlockedNetworkRuleList] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Remove = &NetworkPolicyRemoveNetworkRule{
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{RandomSchemaObjectIdentifier()},
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{RandomSchemaObjectIdentifier()},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AllowedNetworkRuleList", "BlockedNetworkRuleList"))
 	})
 
 	t.Run("set allowed ip list", func(t *testing.T) {
@@ -93,6 +127,47 @@ func TestNetworkPolicies_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET COMMENT = 'some_comment'", id.FullyQualifiedName())
 	})
 
+	t.Run("set network rule lists", func(t *testing.T) {
+		allowedRule := RandomSchemaObjectIdentifier()
+		blockedRule := RandomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &NetworkPolicySet{
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{allowedRule},
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{blockedRule},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s SET ALLOWED_NETWORK_RULE_LIST = (%s) BLOCKED_NETWORK_RULE_LIST = (%s)", id.FullyQualifiedName(), allowedRule.FullyQualifiedName(), blockedRule.FullyQualifiedName())
+	})
+
+	t.Run("unset lists", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &NetworkPolicyUnset{
+			AllowedIpList:          Bool(true),
+			BlockedIpList:          Bool(true),
+			AllowedNetworkRuleList: Bool(true),
+			BlockedNetworkRuleList: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s UNSET ALLOWED_IP_LIST, BLOCKED_IP_LIST, ALLOWED_NETWORK_RULE_LIST, BLOCKED_NETWORK_RULE_LIST", id.FullyQualifiedName())
+	})
+
+	t.Run("add allowed network rules", func(t *testing.T) {
+		rule1 := RandomSchemaObjectIdentifier()
+		rule2 := RandomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Add = &NetworkPolicyAddNetworkRule{
+			AllowedNetworkRuleList: []SchemaObjectIdentifier{rule1, rule2},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s ADD ALLOWED_NETWORK_RULE_LIST = (%s, %s)", id.FullyQualifiedName(), rule1.FullyQualifiedName(), rule2.FullyQualifiedName())
+	})
+
+	t.Run("remove blocked network rules", func(t *testing.T) {
+		rule := RandomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Remove = &NetworkPolicyRemoveNetworkRule{
+			BlockedNetworkRuleList: []SchemaObjectIdentifier{rule},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER NETWORK POLICY IF EXISTS %s REMOVE BLOCKED_NETWORK_RULE_LIST = (%s)", id.FullyQualifiedName(), rule.FullyQualifiedName())
+	})
+
 	t.Run("unset comment", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.UnsetComment = Bool(true)
@@ -178,3 +253,25 @@ func TestNetworkPolicies_Describe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE NETWORK POLICY %s", id.FullyQualifiedName())
 	})
 }
+
+func TestParseNetworkRulesSnowflakeDto(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		rules, err := ParseNetworkRulesSnowflakeDto("[]")
+		require.NoError(t, err)
+		require.Empty(t, rules)
+	})
+
+	t.Run("multiple rules", func(t *testing.T) {
+		rules, err := ParseNetworkRulesSnowflakeDto(`[{"fullyQualifiedRuleName":"DB.SCHEMA.RULE_1"},{"fullyQualifiedRuleName":"DB.SCHEMA.RULE_2"}]`)
+		require.NoError(t, err)
+		require.Equal(t, []NetworkRulesSnowflakeDTO{
+			{FullyQualifiedRuleName: "DB.SCHEMA.RULE_1"},
+			{FullyQualifiedRuleName: "DB.SCHEMA.RULE_2"},
+		}, rules)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := ParseNetworkRulesSnowflakeDto("DB.SCHEMA.RULE")
+		require.Error(t, err)
+	})
+}
@@ -62,7 +62,9 @@ func (r *CreateNetworkPolicyRequest) toOpts() *CreateNetworkPolicyOptions {
 		OrReplace: r.OrReplace,
 		name:      r.name,
 
-		Comment: r.Comment,
+		AllowedNetworkRuleList: r.AllowedNetworkRuleList,
+		BlockedNetworkRuleList: r.BlockedNetworkRuleList,
+		Comment:                r.Comment,
 	}
 	if r.AllowedIpList != nil {
 		s := make([]IP, len(r.AllowedIpList))
@@ -91,7 +93,9 @@ func (r *AlterNetworkPolicyRequest) toOpts() *AlterNetworkPolicyOptions {
 	}
 	if r.Set != nil {
 		opts.Set = &NetworkPolicySet{
-			Comment: r.Set.Comment,
+			AllowedNetworkRuleList: r.Set.AllowedNetworkRuleList,
+			BlockedNetworkRuleList: r.Set.BlockedNetworkRuleList,
+			Comment:                r.Set.Comment,
 		}
 		if r.Set.AllowedIpList != nil {
 			s := make([]IP, len(r.Set.AllowedIpList))
@@ -108,6 +112,26 @@ func (r *AlterNetworkPolicyRequest) toOpts() *AlterNetworkPolicyOptions {
 			opts.Set.BlockedIpList = s
 		}
 	}
+	if r.Unset != nil {
+		opts.Unset = &NetworkPolicyUnset{
+			AllowedIpList:          r.Unset.AllowedIpList,
+			BlockedIpList:          r.Unset.BlockedIpList,
+			AllowedNetworkRuleList: r.Unset.AllowedNetworkRuleList,
+			BlockedNetworkRuleList: r.Unset.BlockedNetworkRuleList,
+		}
+	}
+	if r.Add != nil {
+		opts.Add = &NetworkPolicyAddNetworkRule{
+			AllowedNetworkRuleList: r.Add.AllowedNetworkRuleList,
+			BlockedNetworkRuleList: r.Add.BlockedNetworkRuleList,
+		}
+	}
+	if r.Remove != nil {
+		opts.Remove = &NetworkPolicyRemoveNetworkRule{
+			AllowedNetworkRuleList: r.Remove.AllowedNetworkRuleList,
+			BlockedNetworkRuleList: r.Remove.BlockedNetworkRuleList,
+		}
+	}
 	return opts
 }
 
@@ -126,11 +150,13 @@ func (r *ShowNetworkPolicyRequest) toOpts() *ShowNetworkPolicyOptions {
 
 func (r showNetworkPolicyDBRow) convert() *NetworkPolicy {
 	return &NetworkPolicy{
-		CreatedOn:              r.CreatedOn,
-		Name:                   r.Name,
-		Comment:                r.Comment,
-		EntriesInAllowedIpList: r.EntriesInAllowedIpList,
-		EntriesInBlockedIpList: r.EntriesInBlockedIpList,
+		CreatedOn:                    r.CreatedOn,
+		Name:                         r.Name,
+		Comment:                      r.Comment,
+		EntriesInAllowedIpList:       r.EntriesInAllowedIpList,
+		EntriesInBlockedIpList:       r.EntriesInBlockedIpList,
+		EntriesInAllowedNetworkRules: r.EntriesInAllowedNetworkRules,
+		EntriesInBlockedNetworkRules: r.EntriesInBlockedNetworkRules,
 	}
 }
 
@@ -29,15 +29,30 @@ func (opts *AlterNetworkPolicyOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Set, opts.UnsetComment, opts.RenameTo); !ok {
-		errs = append(errs, errExactlyOneOf("Set", "UnsetComment", "RenameTo"))
+	if ok := exactlyOneValueSet(opts.Set, opts.UnsetComment, opts.Unset, opts.Add, opts.Remove, opts.RenameTo); !ok {
+		errs = append(errs, errExactlyOneOf("Set", "UnsetComment", "Unset", "Add", "Remove", "RenameTo"))
 	}
 	if valueSet(opts.RenameTo) && !ValidObjectIdentifier(opts.RenameTo) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if valueSet(opts.Set) {
-		if ok := anyValueSet(opts.Set.AllowedIpList, opts.Set.BlockedIpList, opts.Set.Comment); !ok {
-			errs = append(errs, errAtLeastOneOf("AllowedIpList", "BlockedIpList", "Comment"))
+		if ok := anyValueSet(opts.Set.AllowedIpList, opts.Set.BlockedIpList, opts.Set.AllowedNetworkRuleList, opts.Set.BlockedNetworkRuleList, opts.Set.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if ok := anyValueSet(opts.Unset.AllowedIpList, opts.Unset.BlockedIpList, opts.Unset.AllowedNetworkRuleList, opts.Unset.BlockedNetworkRuleList); !ok {
+			errs = append(errs, errAtLeastOneOf("AllowedIpList", "BlockedIpList", "AllowedNetworkRuleList", "BlockedNetworkRuleList"))
+		}
+	}
+	if valueSet(opts.Add) {
+		if ok := exactlyOneValueSet(opts.Add.AllowedNetworkRuleList, opts.Add.BlockedNetworkRuleList); !ok {
+			errs = append(errs, errExactlyOneOf("AllowedNetworkRuleList", "BlockedNetworkRuleList"))
+		}
+	}
+	if valueSet(opts.Remove) {
+		if ok := exactlyOneValueSet(opts.Remove.AllowedNetworkRuleList, opts.Remove.BlockedNetworkRuleList); !ok {
+			errs = append(errs, errExactlyOneOf("AllowedNetworkRuleList", "BlockedNetworkRuleList"))
 		}
 	}
 	return errors.Join(errs...)
@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ NetworkRules = (*networkRules)(nil)
+
+type networkRules struct {
+	client *Client
+}
+
+func (v *networkRules) Create(ctx context.Context, request *CreateNetworkRuleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *networkRules) Alter(ctx context.Context, request *AlterNetworkRuleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *networkRules) Drop(ctx context.Context, request *DropNetworkRuleRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *networkRules) Show(ctx context.Context, request *ShowNetworkRuleRequest) ([]NetworkRule, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showNetworkRulesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showNetworkRulesRow, NetworkRule](dbRows)
+	return resultList, nil
+}
+
+func (v *networkRules) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error) {
+	networkRules, err := v.Show(ctx, NewShowNetworkRuleRequest().WithLike(&Like{
+		Pattern: String(id.Name()),
+	}).WithIn(&In{
+		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(networkRules, func(r NetworkRule) bool { return r.Name == id.Name() })
+}
+
+func (v *networkRules) Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error) {
+	opts := &DescribeNetworkRuleOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeNetworkRulesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateNetworkRuleRequest) toOpts() *CreateNetworkRuleOptions {
+	opts := &CreateNetworkRuleOptions{
+		OrReplace: r.OrReplace,
+		name:      r.name,
+		Type:      r.Type,
+
+		Mode:    r.Mode,
+		Comment: r.Comment,
+	}
+	if r.ValueList != nil {
+		s := make([]NetworkRuleValue, len(r.ValueList))
+		for i, v := range r.ValueList {
+			s[i] = NetworkRuleValue{
+				Value: v.Value,
+			}
+		}
+		opts.ValueList = s
+	}
+	return opts
+}
+
+func (r *AlterNetworkRuleRequest) toOpts() *AlterNetworkRuleOptions {
+	opts := &AlterNetworkRuleOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &NetworkRuleSet{
+
+			Comment: r.Set.Comment,
+		}
+		if r.Set.ValueList != nil {
+			s := make([]NetworkRuleValue, len(r.Set.ValueList))
+			for i, v := range r.Set.ValueList {
+				s[i] = NetworkRuleValue{
+					Value: v.Value,
+				}
+			}
+			opts.Set.ValueList = s
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &NetworkRuleUnset{
+			ValueList: r.Unset.ValueList,
+			Comment:   r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropNetworkRuleRequest) toOpts() *DropNetworkRuleOptions {
+	opts := &DropNetworkRuleOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowNetworkRuleRequest) toOpts() *ShowNetworkRuleOptions {
+	opts := &ShowNetworkRuleOptions{
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r showNetworkRulesRow) convert() *NetworkRule {
+	return &NetworkRule{
+		CreatedOn:          r.CreatedOn,
+		Name:               r.Name,
+		DatabaseName:       r.DatabaseName,
+		SchemaName:         r.SchemaName,
+		Owner:              r.Owner,
+		Comment:            r.Comment.String,
+		Type:               NetworkRuleType(r.Type),
+		Mode:               NetworkRuleMode(r.Mode),
+		EntriesInValueList: r.EntriesInValuelist,
+		OwnerRoleType:      r.OwnerRoleType.String,
+	}
+}
+
+func (r *DescribeNetworkRuleRequest) toOpts() *DescribeNetworkRuleOptions {
+	opts := &DescribeNetworkRuleOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeNetworkRulesRow) convert() *NetworkRuleDetails {
+	valueList := make([]string, 0)
+	for _, value := range strings.Split(r.ValueList, ",") {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			valueList = append(valueList, trimmed)
+		}
+	}
+	return &NetworkRuleDetails{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+		Owner:        r.Owner,
+		Comment:      r.Comment.String,
+		Type:         NetworkRuleType(r.Type),
+		Mode:         NetworkRuleMode(r.Mode),
+		ValueList:    valueList,
+	}
+}
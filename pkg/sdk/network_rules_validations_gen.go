@@ -0,0 +1,76 @@
+package sdk
+
+import "errors"
+
+var (
+	_ validatable = new(CreateNetworkRuleOptions)
+	_ validatable = new(AlterNetworkRuleOptions)
+	_ validatable = new(DropNetworkRuleOptions)
+	_ validatable = new(ShowNetworkRuleOptions)
+	_ validatable = new(DescribeNetworkRuleOptions)
+)
+
+func (opts *CreateNetworkRuleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *AlterNetworkRuleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if ok := exactlyOneValueSet(opts.Set, opts.Unset); !ok {
+		errs = append(errs, errExactlyOneOf("Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if ok := anyValueSet(opts.Set.ValueList, opts.Set.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("ValueList", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if ok := anyValueSet(opts.Unset.ValueList, opts.Unset.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("ValueList", "Comment"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *DropNetworkRuleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *ShowNetworkRuleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	return errors.Join(errs...)
+}
+
+func (opts *DescribeNetworkRuleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
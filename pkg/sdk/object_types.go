@@ -16,48 +16,56 @@ type Object struct {
 type ObjectType string
 
 const (
-	ObjectTypeAccount            ObjectType = "ACCOUNT"
-	ObjectTypeManagedAccount     ObjectType = "MANAGED ACCOUNT"
-	ObjectTypeUser               ObjectType = "USER"
-	ObjectTypeDatabaseRole       ObjectType = "DATABASE ROLE"
-	ObjectTypeRole               ObjectType = "ROLE"
-	ObjectTypeIntegration        ObjectType = "INTEGRATION"
-	ObjectTypeNetworkPolicy      ObjectType = "NETWORK POLICY"
-	ObjectTypePasswordPolicy     ObjectType = "PASSWORD POLICY"
-	ObjectTypeSessionPolicy      ObjectType = "SESSION POLICY"
-	ObjectTypeReplicationGroup   ObjectType = "REPLICATION GROUP"
-	ObjectTypeFailoverGroup      ObjectType = "FAILOVER GROUP"
-	ObjectTypeConnection         ObjectType = "CONNECTION"
-	ObjectTypeParameter          ObjectType = "PARAMETER"
-	ObjectTypeWarehouse          ObjectType = "WAREHOUSE"
-	ObjectTypeResourceMonitor    ObjectType = "RESOURCE MONITOR"
-	ObjectTypeDatabase           ObjectType = "DATABASE"
-	ObjectTypeSchema             ObjectType = "SCHEMA"
-	ObjectTypeShare              ObjectType = "SHARE"
-	ObjectTypeTable              ObjectType = "TABLE"
-	ObjectTypeDynamicTable       ObjectType = "DYNAMIC TABLE"
-	ObjectTypeExternalTable      ObjectType = "EXTERNAL TABLE"
-	ObjectTypeEventTable         ObjectType = "EVENT TABLE"
-	ObjectTypeView               ObjectType = "VIEW"
-	ObjectTypeMaterializedView   ObjectType = "MATERIALIZED VIEW"
-	ObjectTypeSequence           ObjectType = "SEQUENCE"
-	ObjectTypeFunction           ObjectType = "FUNCTION"
-	ObjectTypeExternalFunction   ObjectType = "EXTERNAL FUNCTION"
-	ObjectTypeProcedure          ObjectType = "PROCEDURE"
-	ObjectTypeStream             ObjectType = "STREAM"
-	ObjectTypeTask               ObjectType = "TASK"
-	ObjectTypeMaskingPolicy      ObjectType = "MASKING POLICY"
-	ObjectTypeRowAccessPolicy    ObjectType = "ROW ACCESS POLICY"
-	ObjectTypeTag                ObjectType = "TAG"
-	ObjectTypeSecret             ObjectType = "SECRET"
-	ObjectTypeStage              ObjectType = "STAGE"
-	ObjectTypeFileFormat         ObjectType = "FILE FORMAT"
-	ObjectTypePipe               ObjectType = "PIPE"
-	ObjectTypeAlert              ObjectType = "ALERT"
-	ObjectTypeApplication        ObjectType = "APPLICATION"
-	ObjectTypeApplicationPackage ObjectType = "APPLICATION PACKAGE"
-	ObjectTypeApplicationRole    ObjectType = "APPLICATION ROLE"
-	ObjectTypeStreamlit          ObjectType = "STREAMLIT"
+	ObjectTypeAccount              ObjectType = "ACCOUNT"
+	ObjectTypeManagedAccount       ObjectType = "MANAGED ACCOUNT"
+	ObjectTypeUser                 ObjectType = "USER"
+	ObjectTypeDatabaseRole         ObjectType = "DATABASE ROLE"
+	ObjectTypeRole                 ObjectType = "ROLE"
+	ObjectTypeIntegration          ObjectType = "INTEGRATION"
+	ObjectTypeNetworkPolicy        ObjectType = "NETWORK POLICY"
+	ObjectTypeNetworkRule          ObjectType = "NETWORK RULE"
+	ObjectTypePasswordPolicy       ObjectType = "PASSWORD POLICY"
+	ObjectTypeSessionPolicy        ObjectType = "SESSION POLICY"
+	ObjectTypeAuthenticationPolicy ObjectType = "AUTHENTICATION POLICY"
+	ObjectTypePackagesPolicy       ObjectType = "PACKAGES POLICY"
+	ObjectTypeProjectionPolicy     ObjectType = "PROJECTION POLICY"
+	ObjectTypeReplicationGroup     ObjectType = "REPLICATION GROUP"
+	ObjectTypeFailoverGroup        ObjectType = "FAILOVER GROUP"
+	ObjectTypeConnection           ObjectType = "CONNECTION"
+	ObjectTypeParameter            ObjectType = "PARAMETER"
+	ObjectTypeWarehouse            ObjectType = "WAREHOUSE"
+	ObjectTypeResourceMonitor      ObjectType = "RESOURCE MONITOR"
+	ObjectTypeDatabase             ObjectType = "DATABASE"
+	ObjectTypeSchema               ObjectType = "SCHEMA"
+	ObjectTypeShare                ObjectType = "SHARE"
+	ObjectTypeTable                ObjectType = "TABLE"
+	ObjectTypeDynamicTable         ObjectType = "DYNAMIC TABLE"
+	ObjectTypeExternalTable        ObjectType = "EXTERNAL TABLE"
+	ObjectTypeIcebergTable         ObjectType = "ICEBERG TABLE"
+	ObjectTypeEventTable           ObjectType = "EVENT TABLE"
+	ObjectTypeView                 ObjectType = "VIEW"
+	ObjectTypeMaterializedView     ObjectType = "MATERIALIZED VIEW"
+	ObjectTypeSequence             ObjectType = "SEQUENCE"
+	ObjectTypeFunction             ObjectType = "FUNCTION"
+	ObjectTypeExternalFunction     ObjectType = "EXTERNAL FUNCTION"
+	ObjectTypeProcedure            ObjectType = "PROCEDURE"
+	ObjectTypeStream               ObjectType = "STREAM"
+	ObjectTypeTask                 ObjectType = "TASK"
+	ObjectTypeMaskingPolicy        ObjectType = "MASKING POLICY"
+	ObjectTypeRowAccessPolicy      ObjectType = "ROW ACCESS POLICY"
+	ObjectTypeTag                  ObjectType = "TAG"
+	ObjectTypeSecret               ObjectType = "SECRET"
+	ObjectTypeStage                ObjectType = "STAGE"
+	ObjectTypeFileFormat           ObjectType = "FILE FORMAT"
+	ObjectTypePipe                 ObjectType = "PIPE"
+	ObjectTypeAlert                ObjectType = "ALERT"
+	ObjectTypeApplication          ObjectType = "APPLICATION"
+	ObjectTypeApplicationPackage   ObjectType = "APPLICATION PACKAGE"
+	ObjectTypeApplicationRole      ObjectType = "APPLICATION ROLE"
+	ObjectTypeStreamlit            ObjectType = "STREAMLIT"
+	ObjectTypeListing              ObjectType = "LISTING"
+	ObjectTypeComputePool          ObjectType = "COMPUTE POOL"
+	ObjectTypeService              ObjectType = "SERVICE"
 )
 
 func (o ObjectType) String() string {
@@ -66,48 +74,56 @@ func (o ObjectType) String() string {
 
 func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 	return map[ObjectType]PluralObjectType{
-		ObjectTypeAccount:            PluralObjectTypeAccounts,
-		ObjectTypeManagedAccount:     PluralObjectTypeManagedAccounts,
-		ObjectTypeUser:               PluralObjectTypeUsers,
-		ObjectTypeDatabaseRole:       PluralObjectTypeDatabaseRoles,
-		ObjectTypeRole:               PluralObjectTypeRoles,
-		ObjectTypeIntegration:        PluralObjectTypeIntegrations,
-		ObjectTypeNetworkPolicy:      PluralObjectTypeNetworkPolicies,
-		ObjectTypePasswordPolicy:     PluralObjectTypePasswordPolicies,
-		ObjectTypeSessionPolicy:      PluralObjectTypeSessionPolicies,
-		ObjectTypeReplicationGroup:   PluralObjectTypeReplicationGroups,
-		ObjectTypeFailoverGroup:      PluralObjectTypeFailoverGroups,
-		ObjectTypeConnection:         PluralObjectTypeConnections,
-		ObjectTypeParameter:          PluralObjectTypeParameters,
-		ObjectTypeWarehouse:          PluralObjectTypeWarehouses,
-		ObjectTypeResourceMonitor:    PluralObjectTypeResourceMonitors,
-		ObjectTypeDatabase:           PluralObjectTypeDatabases,
-		ObjectTypeSchema:             PluralObjectTypeSchemas,
-		ObjectTypeShare:              PluralObjectTypeShares,
-		ObjectTypeTable:              PluralObjectTypeTables,
-		ObjectTypeDynamicTable:       PluralObjectTypeDynamicTables,
-		ObjectTypeExternalTable:      PluralObjectTypeExternalTables,
-		ObjectTypeEventTable:         PluralObjectTypeEventTables,
-		ObjectTypeView:               PluralObjectTypeViews,
-		ObjectTypeMaterializedView:   PluralObjectTypeMaterializedViews,
-		ObjectTypeSequence:           PluralObjectTypeSequences,
-		ObjectTypeFunction:           PluralObjectTypeFunctions,
-		ObjectTypeExternalFunction:   PluralObjectTypeExternalFunctions,
-		ObjectTypeProcedure:          PluralObjectTypeProcedures,
-		ObjectTypeStream:             PluralObjectTypeStreams,
-		ObjectTypeTask:               PluralObjectTypeTasks,
-		ObjectTypeMaskingPolicy:      PluralObjectTypeMaskingPolicies,
-		ObjectTypeRowAccessPolicy:    PluralObjectTypeRowAccessPolicies,
-		ObjectTypeTag:                PluralObjectTypeTags,
-		ObjectTypeSecret:             PluralObjectTypeSecrets,
-		ObjectTypeStage:              PluralObjectTypeStages,
-		ObjectTypeFileFormat:         PluralObjectTypeFileFormats,
-		ObjectTypePipe:               PluralObjectTypePipes,
-		ObjectTypeAlert:              PluralObjectTypeAlerts,
-		ObjectTypeApplication:        PluralObjectTypeApplications,
-		ObjectTypeApplicationPackage: PluralObjectTypeApplicationPackages,
-		ObjectTypeApplicationRole:    PluralObjectTypeApplicationRoles,
-		ObjectTypeStreamlit:          PluralObjectTypeStreamlits,
+		ObjectTypeAccount:              PluralObjectTypeAccounts,
+		ObjectTypeManagedAccount:       PluralObjectTypeManagedAccounts,
+		ObjectTypeUser:                 PluralObjectTypeUsers,
+		ObjectTypeDatabaseRole:         PluralObjectTypeDatabaseRoles,
+		ObjectTypeRole:                 PluralObjectTypeRoles,
+		ObjectTypeIntegration:          PluralObjectTypeIntegrations,
+		ObjectTypeNetworkPolicy:        PluralObjectTypeNetworkPolicies,
+		ObjectTypeNetworkRule:          PluralObjectTypeNetworkRules,
+		ObjectTypePasswordPolicy:       PluralObjectTypePasswordPolicies,
+		ObjectTypeSessionPolicy:        PluralObjectTypeSessionPolicies,
+		ObjectTypeAuthenticationPolicy: PluralObjectTypeAuthenticationPolicies,
+		ObjectTypePackagesPolicy:       PluralObjectTypePackagesPolicies,
+		ObjectTypeProjectionPolicy:     PluralObjectTypeProjectionPolicies,
+		ObjectTypeReplicationGroup:     PluralObjectTypeReplicationGroups,
+		ObjectTypeFailoverGroup:        PluralObjectTypeFailoverGroups,
+		ObjectTypeConnection:           PluralObjectTypeConnections,
+		ObjectTypeParameter:            PluralObjectTypeParameters,
+		ObjectTypeWarehouse:            PluralObjectTypeWarehouses,
+		ObjectTypeResourceMonitor:      PluralObjectTypeResourceMonitors,
+		ObjectTypeDatabase:             PluralObjectTypeDatabases,
+		ObjectTypeSchema:               PluralObjectTypeSchemas,
+		ObjectTypeShare:                PluralObjectTypeShares,
+		ObjectTypeTable:                PluralObjectTypeTables,
+		ObjectTypeDynamicTable:         PluralObjectTypeDynamicTables,
+		ObjectTypeExternalTable:        PluralObjectTypeExternalTables,
+		ObjectTypeIcebergTable:         PluralObjectTypeIcebergTables,
+		ObjectTypeEventTable:           PluralObjectTypeEventTables,
+		ObjectTypeView:                 PluralObjectTypeViews,
+		ObjectTypeMaterializedView:     PluralObjectTypeMaterializedViews,
+		ObjectTypeSequence:             PluralObjectTypeSequences,
+		ObjectTypeFunction:             PluralObjectTypeFunctions,
+		ObjectTypeExternalFunction:     PluralObjectTypeExternalFunctions,
+		ObjectTypeProcedure:            PluralObjectTypeProcedures,
+		ObjectTypeStream:               PluralObjectTypeStreams,
+		ObjectTypeTask:                 PluralObjectTypeTasks,
+		ObjectTypeMaskingPolicy:        PluralObjectTypeMaskingPolicies,
+		ObjectTypeRowAccessPolicy:      PluralObjectTypeRowAccessPolicies,
+		ObjectTypeTag:                  PluralObjectTypeTags,
+		ObjectTypeSecret:               PluralObjectTypeSecrets,
+		ObjectTypeStage:                PluralObjectTypeStages,
+		ObjectTypeFileFormat:           PluralObjectTypeFileFormats,
+		ObjectTypePipe:                 PluralObjectTypePipes,
+		ObjectTypeAlert:                PluralObjectTypeAlerts,
+		ObjectTypeApplication:          PluralObjectTypeApplications,
+		ObjectTypeApplicationPackage:   PluralObjectTypeApplicationPackages,
+		ObjectTypeApplicationRole:      PluralObjectTypeApplicationRoles,
+		ObjectTypeStreamlit:            PluralObjectTypeStreamlits,
+		ObjectTypeListing:              PluralObjectTypeListings,
+		ObjectTypeComputePool:          PluralObjectTypeComputePools,
+		ObjectTypeService:              PluralObjectTypeServices,
 	}
 }
 
@@ -156,48 +172,56 @@ func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentif
 type PluralObjectType string
 
 const (
-	PluralObjectTypeAccounts            PluralObjectType = "ACCOUNTS"
-	PluralObjectTypeManagedAccounts     PluralObjectType = "MANAGED ACCOUNTS"
-	PluralObjectTypeUsers               PluralObjectType = "USERS"
-	PluralObjectTypeDatabaseRoles       PluralObjectType = "DATABASE ROLES"
-	PluralObjectTypeRoles               PluralObjectType = "ROLES"
-	PluralObjectTypeIntegrations        PluralObjectType = "INTEGRATIONS"
-	PluralObjectTypeNetworkPolicies     PluralObjectType = "NETWORK POLICIES"
-	PluralObjectTypePasswordPolicies    PluralObjectType = "PASSWORD POLICIES"
-	PluralObjectTypeSessionPolicies     PluralObjectType = "SESSION POLICIES"
-	PluralObjectTypeReplicationGroups   PluralObjectType = "REPLICATION GROUPS"
-	PluralObjectTypeFailoverGroups      PluralObjectType = "FAILOVER GROUPS"
-	PluralObjectTypeConnections         PluralObjectType = "CONNECTIONS"
-	PluralObjectTypeParameters          PluralObjectType = "PARAMETERS"
-	PluralObjectTypeWarehouses          PluralObjectType = "WAREHOUSES"
-	PluralObjectTypeResourceMonitors    PluralObjectType = "RESOURCE MONITORS"
-	PluralObjectTypeDatabases           PluralObjectType = "DATABASES"
-	PluralObjectTypeSchemas             PluralObjectType = "SCHEMAS"
-	PluralObjectTypeShares              PluralObjectType = "SHARES"
-	PluralObjectTypeTables              PluralObjectType = "TABLES"
-	PluralObjectTypeDynamicTables       PluralObjectType = "DYNAMIC TABLES"
-	PluralObjectTypeExternalTables      PluralObjectType = "EXTERNAL TABLES"
-	PluralObjectTypeEventTables         PluralObjectType = "EVENT TABLES"
-	PluralObjectTypeViews               PluralObjectType = "VIEWS"
-	PluralObjectTypeMaterializedViews   PluralObjectType = "MATERIALIZED VIEWS"
-	PluralObjectTypeSequences           PluralObjectType = "SEQUENCES"
-	PluralObjectTypeFunctions           PluralObjectType = "FUNCTIONS"
-	PluralObjectTypeExternalFunctions   PluralObjectType = "EXTERNAL FUNCTIONS"
-	PluralObjectTypeProcedures          PluralObjectType = "PROCEDURES"
-	PluralObjectTypeStreams             PluralObjectType = "STREAMS"
-	PluralObjectTypeTasks               PluralObjectType = "TASKS"
-	PluralObjectTypeMaskingPolicies     PluralObjectType = "MASKING POLICIES"
-	PluralObjectTypeRowAccessPolicies   PluralObjectType = "ROW ACCESS POLICIES"
-	PluralObjectTypeTags                PluralObjectType = "TAGS"
-	PluralObjectTypeSecrets             PluralObjectType = "SECRETS"
-	PluralObjectTypeStages              PluralObjectType = "STAGES"
-	PluralObjectTypeFileFormats         PluralObjectType = "FILE FORMATS"
-	PluralObjectTypePipes               PluralObjectType = "PIPES"
-	PluralObjectTypeAlerts              PluralObjectType = "ALERTS"
-	PluralObjectTypeApplications        PluralObjectType = "APPLICATIONS"
-	PluralObjectTypeApplicationPackages PluralObjectType = "APPLICATION PACKAGES"
-	PluralObjectTypeApplicationRoles    PluralObjectType = "APPLICATION ROLES"
-	PluralObjectTypeStreamlits          PluralObjectType = "STREAMLITS"
+	PluralObjectTypeAccounts               PluralObjectType = "ACCOUNTS"
+	PluralObjectTypeManagedAccounts        PluralObjectType = "MANAGED ACCOUNTS"
+	PluralObjectTypeUsers                  PluralObjectType = "USERS"
+	PluralObjectTypeDatabaseRoles          PluralObjectType = "DATABASE ROLES"
+	PluralObjectTypeRoles                  PluralObjectType = "ROLES"
+	PluralObjectTypeIntegrations           PluralObjectType = "INTEGRATIONS"
+	PluralObjectTypeNetworkPolicies        PluralObjectType = "NETWORK POLICIES"
+	PluralObjectTypeNetworkRules           PluralObjectType = "NETWORK RULES"
+	PluralObjectTypePasswordPolicies       PluralObjectType = "PASSWORD POLICIES"
+	PluralObjectTypeSessionPolicies        PluralObjectType = "SESSION POLICIES"
+	PluralObjectTypeAuthenticationPolicies PluralObjectType = "AUTHENTICATION POLICIES"
+	PluralObjectTypePackagesPolicies       PluralObjectType = "PACKAGES POLICIES"
+	PluralObjectTypeProjectionPolicies     PluralObjectType = "PROJECTION POLICIES"
+	PluralObjectTypeReplicationGroups      PluralObjectType = "REPLICATION GROUPS"
+	PluralObjectTypeFailoverGroups         PluralObjectType = "FAILOVER GROUPS"
+	PluralObjectTypeConnections            PluralObjectType = "CONNECTIONS"
+	PluralObjectTypeParameters             PluralObjectType = "PARAMETERS"
+	PluralObjectTypeWarehouses             PluralObjectType = "WAREHOUSES"
+	PluralObjectTypeResourceMonitors       PluralObjectType = "RESOURCE MONITORS"
+	PluralObjectTypeDatabases              PluralObjectType = "DATABASES"
+	PluralObjectTypeSchemas                PluralObjectType = "SCHEMAS"
+	PluralObjectTypeShares                 PluralObjectType = "SHARES"
+	PluralObjectTypeTables                 PluralObjectType = "TABLES"
+	PluralObjectTypeDynamicTables          PluralObjectType = "DYNAMIC TABLES"
+	PluralObjectTypeExternalTables         PluralObjectType = "EXTERNAL TABLES"
+	PluralObjectTypeIcebergTables          PluralObjectType = "ICEBERG TABLES"
+	PluralObjectTypeEventTables            PluralObjectType = "EVENT TABLES"
+	PluralObjectTypeViews                  PluralObjectType = "VIEWS"
+	PluralObjectTypeMaterializedViews      PluralObjectType = "MATERIALIZED VIEWS"
+	PluralObjectTypeSequences              PluralObjectType = "SEQUENCES"
+	PluralObjectTypeFunctions              PluralObjectType = "FUNCTIONS"
+	PluralObjectTypeExternalFunctions      PluralObjectType = "EXTERNAL FUNCTIONS"
+	PluralObjectTypeProcedures             PluralObjectType = "PROCEDURES"
+	PluralObjectTypeStreams                PluralObjectType = "STREAMS"
+	PluralObjectTypeTasks                  PluralObjectType = "TASKS"
+	PluralObjectTypeMaskingPolicies        PluralObjectType = "MASKING POLICIES"
+	PluralObjectTypeRowAccessPolicies      PluralObjectType = "ROW ACCESS POLICIES"
+	PluralObjectTypeTags                   PluralObjectType = "TAGS"
+	PluralObjectTypeSecrets                PluralObjectType = "SECRETS"
+	PluralObjectTypeStages                 PluralObjectType = "STAGES"
+	PluralObjectTypeFileFormats            PluralObjectType = "FILE FORMATS"
+	PluralObjectTypePipes                  PluralObjectType = "PIPES"
+	PluralObjectTypeAlerts                 PluralObjectType = "ALERTS"
+	PluralObjectTypeApplications           PluralObjectType = "APPLICATIONS"
+	PluralObjectTypeApplicationPackages    PluralObjectType = "APPLICATION PACKAGES"
+	PluralObjectTypeApplicationRoles       PluralObjectType = "APPLICATION ROLES"
+	PluralObjectTypeStreamlits             PluralObjectType = "STREAMLITS"
+	PluralObjectTypeListings               PluralObjectType = "LISTINGS"
+	PluralObjectTypeComputePools           PluralObjectType = "COMPUTE POOLS"
+	PluralObjectTypeServices               PluralObjectType = "SERVICES"
 )
 
 func (p PluralObjectType) String() string {
@@ -0,0 +1,127 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+type PackagesPolicyLanguage string
+
+const (
+	PackagesPolicyLanguagePython PackagesPolicyLanguage = "PYTHON"
+)
+
+var (
+	packagesPolicyPackage = g.QueryStruct("PackagesPolicyPackage").
+				Text("Package", g.KeywordOptions().SingleQuotes().Required())
+
+	PackagesPoliciesDef = g.NewInterface(
+		"PackagesPolicies",
+		"PackagesPolicy",
+		g.KindOfT[SchemaObjectIdentifier](),
+	).
+		CreateOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/create-packages-policy",
+			g.QueryStruct("CreatePackagesPolicy").
+				Create().
+				OrReplace().
+				SQL("PACKAGES POLICY").
+				IfNotExists().
+				Name().
+				Assignment("LANGUAGE", g.KindOfT[PackagesPolicyLanguage](), g.ParameterOptions().NoQuotes().NoEquals().Required()).
+				ListQueryStructField("Allowlist", packagesPolicyPackage, g.ParameterOptions().SQL("ALLOWLIST").Parentheses()).
+				ListQueryStructField("Blocklist", packagesPolicyPackage, g.ParameterOptions().SQL("BLOCKLIST").Parentheses()).
+				ListQueryStructField("AdditionalCreationBlocklist", packagesPolicyPackage, g.ParameterOptions().SQL("ADDITIONAL_CREATION_BLOCKLIST").Parentheses()).
+				OptionalComment().
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+		).
+		AlterOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/alter-packages-policy",
+			g.QueryStruct("AlterPackagesPolicy").
+				Alter().
+				SQL("PACKAGES POLICY").
+				IfExists().
+				Name().
+				OptionalQueryStructField(
+					"Set",
+					g.QueryStruct("PackagesPolicySet").
+						ListQueryStructField("Allowlist", packagesPolicyPackage, g.ParameterOptions().SQL("ALLOWLIST").Parentheses()).
+						ListQueryStructField("Blocklist", packagesPolicyPackage, g.ParameterOptions().SQL("BLOCKLIST").Parentheses()).
+						ListQueryStructField("AdditionalCreationBlocklist", packagesPolicyPackage, g.ParameterOptions().SQL("ADDITIONAL_CREATION_BLOCKLIST").Parentheses()).
+						OptionalComment().
+						WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"),
+					g.KeywordOptions().SQL("SET"),
+				).
+				OptionalQueryStructField(
+					"Unset",
+					g.QueryStruct("PackagesPolicyUnset").
+						OptionalSQL("ALLOWLIST").
+						OptionalSQL("BLOCKLIST").
+						OptionalSQL("ADDITIONAL_CREATION_BLOCKLIST").
+						OptionalSQL("COMMENT").
+						WithValidation(g.AtLeastOneValueSet, "Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"),
+					g.ListOptions().NoParentheses().SQL("UNSET"),
+				).
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ExactlyOneValueSet, "Set", "Unset"),
+		).
+		DropOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/drop-packages-policy",
+			g.QueryStruct("DropPackagesPolicy").
+				Drop().
+				SQL("PACKAGES POLICY").
+				IfExists().
+				Name().
+				WithValidation(g.ValidIdentifier, "name"),
+		).
+		ShowOperation(
+			"https://docs.snowflake.com/en/sql-reference/sql/show-packages-policies",
+			g.DbStruct("showPackagesPolicyDBRow").
+				Field("created_on", "time.Time").
+				Field("name", "string").
+				Field("database_name", "string").
+				Field("schema_name", "string").
+				Field("kind", "string").
+				Field("owner", "string").
+				Field("comment", "string").
+				Field("owner_role_type", "string"),
+			g.PlainStruct("PackagesPolicy").
+				Field("CreatedOn", "time.Time").
+				Field("Name", "string").
+				Field("DatabaseName", "string").
+				Field("SchemaName", "string").
+				Field("Kind", "string").
+				Field("Owner", "string").
+				Field("Comment", "string").
+				Field("OwnerRoleType", "string"),
+			g.QueryStruct("ShowPackagesPolicies").
+				Show().
+				SQL("PACKAGES POLICIES").
+				OptionalLike().
+				OptionalIn(),
+		).
+		ShowByIdOperation().
+		DescribeOperation(
+			g.DescriptionMappingKindSingleValue,
+			"https://docs.snowflake.com/en/sql-reference/sql/desc-packages-policy",
+			g.DbStruct("describePackagesPolicyDBRow").
+				Field("name", "string").
+				Field("language", "string").
+				Field("allowlist", "string").
+				Field("blocklist", "string").
+				Field("additional_creation_blocklist", "string").
+				Field("comment", "string"),
+			g.PlainStruct("PackagesPolicyDetails").
+				Field("Name", "string").
+				Field("Language", "PackagesPolicyLanguage").
+				Field("Allowlist", "[]string").
+				Field("Blocklist", "[]string").
+				Field("AdditionalCreationBlocklist", "[]string").
+				Field("Comment", "string"),
+			g.QueryStruct("DescribePackagesPolicy").
+				Describe().
+				SQL("PACKAGES POLICY").
+				Name().
+				WithValidation(g.ValidIdentifier, "name"),
+		)
+)
@@ -0,0 +1,61 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreatePackagesPolicyOptions]   = new(CreatePackagesPolicyRequest)
+	_ optionsProvider[AlterPackagesPolicyOptions]    = new(AlterPackagesPolicyRequest)
+	_ optionsProvider[DropPackagesPolicyOptions]     = new(DropPackagesPolicyRequest)
+	_ optionsProvider[ShowPackagesPolicyOptions]     = new(ShowPackagesPolicyRequest)
+	_ optionsProvider[DescribePackagesPolicyOptions] = new(DescribePackagesPolicyRequest)
+)
+
+type CreatePackagesPolicyRequest struct {
+	OrReplace                   *bool
+	IfNotExists                 *bool
+	name                        SchemaObjectIdentifier // required
+	Language                    PackagesPolicyLanguage // required
+	Allowlist                   []PackagesPolicyPackageRequest
+	Blocklist                   []PackagesPolicyPackageRequest
+	AdditionalCreationBlocklist []PackagesPolicyPackageRequest
+	Comment                     *string
+}
+
+type PackagesPolicyPackageRequest struct {
+	Package string // required
+}
+
+type AlterPackagesPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+	Set      *PackagesPolicySetRequest
+	Unset    *PackagesPolicyUnsetRequest
+}
+
+type PackagesPolicySetRequest struct {
+	Allowlist                   []PackagesPolicyPackageRequest
+	Blocklist                   []PackagesPolicyPackageRequest
+	AdditionalCreationBlocklist []PackagesPolicyPackageRequest
+	Comment                     *string
+}
+
+type PackagesPolicyUnsetRequest struct {
+	Allowlist                   *bool
+	Blocklist                   *bool
+	AdditionalCreationBlocklist *bool
+	Comment                     *bool
+}
+
+type DropPackagesPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowPackagesPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribePackagesPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+type PackagesPolicies interface {
+	Create(ctx context.Context, request *CreatePackagesPolicyRequest) error
+	Alter(ctx context.Context, request *AlterPackagesPolicyRequest) error
+	Drop(ctx context.Context, request *DropPackagesPolicyRequest) error
+	Show(ctx context.Context, request *ShowPackagesPolicyRequest) ([]PackagesPolicy, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicy, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicyDetails, error)
+}
+
+// CreatePackagesPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-packages-policy.
+type CreatePackagesPolicyOptions struct {
+	create                      bool                    `ddl:"static" sql:"CREATE"`
+	OrReplace                   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	packagesPolicy              bool                    `ddl:"static" sql:"PACKAGES POLICY"`
+	IfNotExists                 *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                        SchemaObjectIdentifier  `ddl:"identifier"`
+	Language                    PackagesPolicyLanguage  `ddl:"parameter,no_quotes,no_equals" sql:"LANGUAGE"`
+	Allowlist                   []PackagesPolicyPackage `ddl:"parameter,parentheses" sql:"ALLOWLIST"`
+	Blocklist                   []PackagesPolicyPackage `ddl:"parameter,parentheses" sql:"BLOCKLIST"`
+	AdditionalCreationBlocklist []PackagesPolicyPackage `ddl:"parameter,parentheses" sql:"ADDITIONAL_CREATION_BLOCKLIST"`
+	Comment                     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type PackagesPolicyPackage struct {
+	Package string `ddl:"keyword,single_quotes"`
+}
+
+// AlterPackagesPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-packages-policy.
+type AlterPackagesPolicyOptions struct {
+	alter          bool                   `ddl:"static" sql:"ALTER"`
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"`
+	IfExists       *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+	Set            *PackagesPolicySet     `ddl:"keyword" sql:"SET"`
+	Unset          *PackagesPolicyUnset   `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+type PackagesPolicySet struct {
+	Allowlist                   []PackagesPolicyPackage `ddl:"parameter,parentheses" sql:"ALLOWLIST"`
+	Blocklist                   []PackagesPolicyPackage `ddl:"parameter,parentheses" sql:"BLOCKLIST"`
+	AdditionalCreationBlocklist []PackagesPolicyPackage `ddl:"parameter,parentheses" sql:"ADDITIONAL_CREATION_BLOCKLIST"`
+	Comment                     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type PackagesPolicyUnset struct {
+	Allowlist                   *bool `ddl:"keyword" sql:"ALLOWLIST"`
+	Blocklist                   *bool `ddl:"keyword" sql:"BLOCKLIST"`
+	AdditionalCreationBlocklist *bool `ddl:"keyword" sql:"ADDITIONAL_CREATION_BLOCKLIST"`
+	Comment                     *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropPackagesPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-packages-policy.
+type DropPackagesPolicyOptions struct {
+	drop           bool                   `ddl:"static" sql:"DROP"`
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"`
+	IfExists       *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowPackagesPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-packages-policies.
+type ShowPackagesPolicyOptions struct {
+	show             bool  `ddl:"static" sql:"SHOW"`
+	packagesPolicies bool  `ddl:"static" sql:"PACKAGES POLICIES"`
+	Like             *Like `ddl:"keyword" sql:"LIKE"`
+	In               *In   `ddl:"keyword" sql:"IN"`
+}
+
+type showPackagesPolicyDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Kind          string         `db:"kind"`
+	Owner         string         `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+type PackagesPolicy struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Kind          string
+	Owner         string
+	Comment       string
+	OwnerRoleType string
+}
+
+// DescribePackagesPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-packages-policy.
+type DescribePackagesPolicyOptions struct {
+	describe       bool                   `ddl:"static" sql:"DESCRIBE"`
+	packagesPolicy bool                   `ddl:"static" sql:"PACKAGES POLICY"`
+	name           SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type describePackagesPolicyDBRow struct {
+	Name                        string         `db:"name"`
+	Language                    string         `db:"language"`
+	Allowlist                   sql.NullString `db:"allowlist"`
+	Blocklist                   sql.NullString `db:"blocklist"`
+	AdditionalCreationBlocklist sql.NullString `db:"additional_creation_blocklist"`
+	Comment                     sql.NullString `db:"comment"`
+}
+
+type PackagesPolicyDetails struct {
+	Name                        string
+	Language                    PackagesPolicyLanguage
+	Allowlist                   []string
+	Blocklist                   []string
+	AdditionalCreationBlocklist []string
+	Comment                     string
+}
+
+// ParsePackagesPolicyList parses package lists returned by DESCRIBE PACKAGES POLICY, e.g. ['numpy', 'pandas==2.0.*'].
+func ParsePackagesPolicyList(value string) []string {
+	trimmed := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]"))
+	if trimmed == "" {
+		return []string{}
+	}
+	parts := strings.Split(trimmed, ",")
+	result := make([]string, len(parts))
+	for i, part := range parts {
+		result[i] = strings.Trim(strings.TrimSpace(part), `'"`)
+	}
+	return result
+}
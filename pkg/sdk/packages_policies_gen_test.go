@@ -0,0 +1,221 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackagesPolicies_Create(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid CreatePackagesPolicyOptions
+	defaultOpts := func() *CreatePackagesPolicyOptions {
+		return &CreatePackagesPolicyOptions{
+			name:     id,
+			Language: PackagesPolicyLanguagePython,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreatePackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreatePackagesPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE PACKAGES POLICY %s LANGUAGE PYTHON", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Allowlist = []PackagesPolicyPackage{{Package: "numpy"}, {Package: "pandas==2.0.*"}}
+		opts.Blocklist = []PackagesPolicyPackage{{Package: "requests"}}
+		opts.AdditionalCreationBlocklist = []PackagesPolicyPackage{{Package: "scipy"}}
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE PACKAGES POLICY %s LANGUAGE PYTHON ALLOWLIST = ('numpy', 'pandas==2.0.*') BLOCKLIST = ('requests') ADDITIONAL_CREATION_BLOCKLIST = ('scipy') COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestPackagesPolicies_Alter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid AlterPackagesPolicyOptions
+	defaultOpts := func() *AlterPackagesPolicyOptions {
+		return &AlterPackagesPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterPackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &PackagesPolicyUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Set", "Unset"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Allowlist opts.Set.Blocklist opts.Set.AdditionalCreationBlocklist opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &PackagesPolicySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Allowlist opts.Unset.Blocklist opts.Unset.AdditionalCreationBlocklist opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &PackagesPolicyUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &PackagesPolicySet{
+			Allowlist:                   []PackagesPolicyPackage{{Package: "numpy"}},
+			Blocklist:                   []PackagesPolicyPackage{{Package: "requests"}, {Package: "urllib3"}},
+			AdditionalCreationBlocklist: []PackagesPolicyPackage{{Package: "scipy"}},
+			Comment:                     String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PACKAGES POLICY IF EXISTS %s SET ALLOWLIST = ('numpy') BLOCKLIST = ('requests', 'urllib3') ADDITIONAL_CREATION_BLOCKLIST = ('scipy') COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &PackagesPolicyUnset{
+			Allowlist:                   Bool(true),
+			Blocklist:                   Bool(true),
+			AdditionalCreationBlocklist: Bool(true),
+			Comment:                     Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PACKAGES POLICY %s UNSET ALLOWLIST, BLOCKLIST, ADDITIONAL_CREATION_BLOCKLIST, COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestPackagesPolicies_Drop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DropPackagesPolicyOptions
+	defaultOpts := func() *DropPackagesPolicyOptions {
+		return &DropPackagesPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropPackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP PACKAGES POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP PACKAGES POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestPackagesPolicies_Show(t *testing.T) {
+	// Minimal valid ShowPackagesPolicyOptions
+	defaultOpts := func() *ShowPackagesPolicyOptions {
+		return &ShowPackagesPolicyOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowPackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PACKAGES POLICIES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW PACKAGES POLICIES LIKE 'some pattern' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestPackagesPolicies_Describe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DescribePackagesPolicyOptions
+	defaultOpts := func() *DescribePackagesPolicyOptions {
+		return &DescribePackagesPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribePackagesPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PACKAGES POLICY %s", id.FullyQualifiedName())
+	})
+}
+
+func TestParsePackagesPolicyList(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected []string
+	}{
+		{value: "", expected: []string{}},
+		{value: "[]", expected: []string{}},
+		{value: "['numpy']", expected: []string{"numpy"}},
+		{value: "['numpy', 'pandas==2.0.*']", expected: []string{"numpy", "pandas==2.0.*"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			require.Equal(t, tc.expected, ParsePackagesPolicyList(tc.value))
+		})
+	}
+}
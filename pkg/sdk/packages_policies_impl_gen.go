@@ -0,0 +1,195 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ PackagesPolicies = (*packagesPolicies)(nil)
+
+type packagesPolicies struct {
+	client *Client
+}
+
+func (v *packagesPolicies) Create(ctx context.Context, request *CreatePackagesPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *packagesPolicies) Alter(ctx context.Context, request *AlterPackagesPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *packagesPolicies) Drop(ctx context.Context, request *DropPackagesPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *packagesPolicies) Show(ctx context.Context, request *ShowPackagesPolicyRequest) ([]PackagesPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showPackagesPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showPackagesPolicyDBRow, PackagesPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *packagesPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicy, error) {
+	packagesPolicies, err := v.Show(ctx, NewShowPackagesPolicyRequest().WithLike(&Like{
+		Pattern: String(id.Name()),
+	}).WithIn(&In{
+		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(packagesPolicies, func(r PackagesPolicy) bool { return r.Name == id.Name() })
+}
+
+func (v *packagesPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*PackagesPolicyDetails, error) {
+	opts := &DescribePackagesPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describePackagesPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreatePackagesPolicyRequest) toOpts() *CreatePackagesPolicyOptions {
+	opts := &CreatePackagesPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Language:    r.Language,
+
+		Comment: r.Comment,
+	}
+	if r.Allowlist != nil {
+		s := make([]PackagesPolicyPackage, len(r.Allowlist))
+		for i, v := range r.Allowlist {
+			s[i] = PackagesPolicyPackage{
+				Package: v.Package,
+			}
+		}
+		opts.Allowlist = s
+	}
+	if r.Blocklist != nil {
+		s := make([]PackagesPolicyPackage, len(r.Blocklist))
+		for i, v := range r.Blocklist {
+			s[i] = PackagesPolicyPackage{
+				Package: v.Package,
+			}
+		}
+		opts.Blocklist = s
+	}
+	if r.AdditionalCreationBlocklist != nil {
+		s := make([]PackagesPolicyPackage, len(r.AdditionalCreationBlocklist))
+		for i, v := range r.AdditionalCreationBlocklist {
+			s[i] = PackagesPolicyPackage{
+				Package: v.Package,
+			}
+		}
+		opts.AdditionalCreationBlocklist = s
+	}
+	return opts
+}
+
+func (r *AlterPackagesPolicyRequest) toOpts() *AlterPackagesPolicyOptions {
+	opts := &AlterPackagesPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	if r.Set != nil {
+		opts.Set = &PackagesPolicySet{
+			Comment: r.Set.Comment,
+		}
+		if r.Set.Allowlist != nil {
+			s := make([]PackagesPolicyPackage, len(r.Set.Allowlist))
+			for i, v := range r.Set.Allowlist {
+				s[i] = PackagesPolicyPackage{
+					Package: v.Package,
+				}
+			}
+			opts.Set.Allowlist = s
+		}
+		if r.Set.Blocklist != nil {
+			s := make([]PackagesPolicyPackage, len(r.Set.Blocklist))
+			for i, v := range r.Set.Blocklist {
+				s[i] = PackagesPolicyPackage{
+					Package: v.Package,
+				}
+			}
+			opts.Set.Blocklist = s
+		}
+		if r.Set.AdditionalCreationBlocklist != nil {
+			s := make([]PackagesPolicyPackage, len(r.Set.AdditionalCreationBlocklist))
+			for i, v := range r.Set.AdditionalCreationBlocklist {
+				s[i] = PackagesPolicyPackage{
+					Package: v.Package,
+				}
+			}
+			opts.Set.AdditionalCreationBlocklist = s
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &PackagesPolicyUnset{
+			Allowlist:                   r.Unset.Allowlist,
+			Blocklist:                   r.Unset.Blocklist,
+			AdditionalCreationBlocklist: r.Unset.AdditionalCreationBlocklist,
+			Comment:                     r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropPackagesPolicyRequest) toOpts() *DropPackagesPolicyOptions {
+	opts := &DropPackagesPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowPackagesPolicyRequest) toOpts() *ShowPackagesPolicyOptions {
+	opts := &ShowPackagesPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showPackagesPolicyDBRow) convert() *PackagesPolicy {
+	return &PackagesPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Comment:       r.Comment.String,
+		OwnerRoleType: r.OwnerRoleType.String,
+	}
+}
+
+func (r *DescribePackagesPolicyRequest) toOpts() *DescribePackagesPolicyOptions {
+	opts := &DescribePackagesPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describePackagesPolicyDBRow) convert() *PackagesPolicyDetails {
+	return &PackagesPolicyDetails{
+		Name:                        r.Name,
+		Language:                    PackagesPolicyLanguage(r.Language),
+		Allowlist:                   ParsePackagesPolicyList(r.Allowlist.String),
+		Blocklist:                   ParsePackagesPolicyList(r.Blocklist.String),
+		AdditionalCreationBlocklist: ParsePackagesPolicyList(r.AdditionalCreationBlocklist.String),
+		Comment:                     r.Comment.String,
+	}
+}
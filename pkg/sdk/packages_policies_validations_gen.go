@@ -0,0 +1,79 @@
+package sdk
+
+import "errors"
+
+var (
+	_ validatable = new(CreatePackagesPolicyOptions)
+	_ validatable = new(AlterPackagesPolicyOptions)
+	_ validatable = new(DropPackagesPolicyOptions)
+	_ validatable = new(ShowPackagesPolicyOptions)
+	_ validatable = new(DescribePackagesPolicyOptions)
+)
+
+func (opts *CreatePackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreatePackagesPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *AlterPackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if ok := exactlyOneValueSet(opts.Set, opts.Unset); !ok {
+		errs = append(errs, errExactlyOneOf("Set", "Unset"))
+	}
+	if valueSet(opts.Set) {
+		if ok := anyValueSet(opts.Set.Allowlist, opts.Set.Blocklist, opts.Set.AdditionalCreationBlocklist, opts.Set.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if ok := anyValueSet(opts.Unset.Allowlist, opts.Unset.Blocklist, opts.Unset.AdditionalCreationBlocklist, opts.Unset.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("Allowlist", "Blocklist", "AdditionalCreationBlocklist", "Comment"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *DropPackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *ShowPackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	return errors.Join(errs...)
+}
+
+func (opts *DescribePackagesPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
@@ -14,16 +14,20 @@ var (
 	_ validatable = new(ObjectParameters)
 	_ validatable = new(UserParameters)
 	_ validatable = new(setParameterOnObject)
+	_ validatable = new(unsetAccountParameter)
+	_ validatable = new(unsetParameterOnObject)
 )
 
 var _ Parameters = (*parameters)(nil)
 
 type Parameters interface {
 	SetAccountParameter(ctx context.Context, parameter AccountParameter, value string) error
+	UnsetAccountParameter(ctx context.Context, parameter AccountParameter) error
 	SetSessionParameterOnAccount(ctx context.Context, parameter SessionParameter, value string) error
 	SetSessionParameterOnUser(ctx context.Context, userID AccountObjectIdentifier, parameter SessionParameter, value string) error
 	SetObjectParameterOnAccount(ctx context.Context, parameter ObjectParameter, value string) error
 	SetObjectParameterOnObject(ctx context.Context, object Object, parameter ObjectParameter, value string) error
+	UnsetObjectParameterOnObject(ctx context.Context, object Object, parameter ObjectParameter) error
 	ShowParameters(ctx context.Context, opts *ShowParametersOptions) ([]*Parameter, error)
 	ShowAccountParameter(ctx context.Context, parameter AccountParameter) (*Parameter, error)
 	ShowSessionParameter(ctx context.Context, parameter SessionParameter) (*Parameter, error)
@@ -67,7 +71,7 @@ func (parameters *parameters) SetAccountParameter(ctx context.Context, parameter
 		if err != nil {
 			return err
 		}
-		opts.Set.Parameters.AccountParameters.AllowIDToken = b
+		opts.Set.Parameters.AccountParameters.EnableInternalStagesPrivatelink = b
 	case AccountParameterEventTable:
 		opts.Set.Parameters.AccountParameters.EventTable = &value
 	case AccountParameterEnableUnredactedQuerySyntaxError:
@@ -148,6 +152,36 @@ func (parameters *parameters) SetAccountParameter(ctx context.Context, parameter
 	return nil
 }
 
+// unsetAccountParameter resets any parameter set on the account level, i.e. an account, session or object parameter, to its default value.
+type unsetAccountParameter struct {
+	alter        bool             `ddl:"static" sql:"ALTER"`   //lint:ignore U1000 This is used in the ddl tag
+	account      bool             `ddl:"static" sql:"ACCOUNT"` //lint:ignore U1000 This is used in the ddl tag
+	unset        bool             `ddl:"static" sql:"UNSET"`   //lint:ignore U1000 This is used in the ddl tag
+	parameterKey AccountParameter `ddl:"keyword"`
+}
+
+func (v *unsetAccountParameter) validate() error {
+	if v.parameterKey == "" {
+		return fmt.Errorf("parameter must be set")
+	}
+	return nil
+}
+
+func (parameters *parameters) UnsetAccountParameter(ctx context.Context, parameter AccountParameter) error {
+	opts := &unsetAccountParameter{
+		parameterKey: parameter,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = parameters.client.exec(ctx, sql)
+	return err
+}
+
 func (parameters *parameters) SetSessionParameterOnAccount(ctx context.Context, parameter SessionParameter, value string) error {
 	opts := AlterAccountOptions{Set: &AccountSet{Parameters: &AccountLevelParameters{SessionParameters: &SessionParameters{}}}}
 	switch parameter {
@@ -592,6 +626,39 @@ func (parameters *parameters) SetObjectParameterOnObject(ctx context.Context, ob
 	return err
 }
 
+type unsetParameterOnObject struct {
+	alter            bool             `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	objectType       ObjectType       `ddl:"keyword"`
+	objectIdentifier ObjectIdentifier `ddl:"identifier"`
+	unset            bool             `ddl:"static" sql:"UNSET"` //lint:ignore U1000 This is used in the ddl tag
+	parameterKey     ObjectParameter  `ddl:"keyword"`
+}
+
+func (v *unsetParameterOnObject) validate() error {
+	if v.parameterKey == "" {
+		return fmt.Errorf("parameter must be set")
+	}
+	return nil
+}
+
+// UnsetObjectParameterOnObject resets the parameter on the object, so that it inherits the value from its parent again.
+func (parameters *parameters) UnsetObjectParameterOnObject(ctx context.Context, object Object, parameter ObjectParameter) error {
+	opts := &unsetParameterOnObject{
+		objectType:       object.ObjectType,
+		objectIdentifier: object.Name,
+		parameterKey:     parameter,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = parameters.client.exec(ctx, sql)
+	return err
+}
+
 func parseBooleanParameter(parameter, value string) (_ *bool, err error) {
 	b, err := strconv.ParseBool(value)
 	if err != nil {
@@ -1165,6 +1232,9 @@ const (
 	ParameterTypeUser    ParameterType = "USER"
 	ParameterTypeSession ParameterType = "SESSION"
 	ParameterTypeObject  ParameterType = "OBJECT"
+
+	// ParameterTypeWarehouse is the level of the parameters set directly on a warehouse.
+	ParameterTypeWarehouse ParameterType = "WAREHOUSE"
 )
 
 type Parameter struct {
@@ -1291,6 +1361,8 @@ func (v *parameters) ShowObjectParameter(ctx context.Context, parameter ObjectPa
 		opts.In.Task = object.Name.(SchemaObjectIdentifier)
 	case ObjectTypeTable:
 		opts.In.Table = object.Name.(SchemaObjectIdentifier)
+	case ObjectTypeUser:
+		opts.In.User = object.Name.(AccountObjectIdentifier)
 	default:
 		return nil, fmt.Errorf("unsupported object type %s", object.Name)
 	}
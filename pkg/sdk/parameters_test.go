@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -22,3 +23,45 @@ func TestSetObjectParameterOnObject(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = TRUE", id.FullyQualifiedName())
 	})
 }
+
+func TestUnsetAccountParameter(t *testing.T) {
+	t.Run("validation: empty parameter", func(t *testing.T) {
+		opts := &unsetAccountParameter{}
+		assertOptsInvalid(t, opts, fmt.Errorf("parameter must be set"))
+	})
+
+	t.Run("account parameter", func(t *testing.T) {
+		opts := &unsetAccountParameter{
+			parameterKey: AccountParameterPreventUnloadToInlineURL,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ACCOUNT UNSET PREVENT_UNLOAD_TO_INLINE_URL")
+	})
+
+	t.Run("session parameter set on the account", func(t *testing.T) {
+		opts := &unsetAccountParameter{
+			parameterKey: AccountParameter(SessionParameterStatementTimeoutInSeconds),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ACCOUNT UNSET STATEMENT_TIMEOUT_IN_SECONDS")
+	})
+}
+
+func TestUnsetObjectParameterOnObject(t *testing.T) {
+	id := RandomAccountObjectIdentifier()
+
+	t.Run("validation: empty parameter", func(t *testing.T) {
+		opts := &unsetParameterOnObject{
+			objectType:       ObjectTypeDatabase,
+			objectIdentifier: id,
+		}
+		assertOptsInvalid(t, opts, fmt.Errorf("parameter must be set"))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := &unsetParameterOnObject{
+			objectType:       ObjectTypeDatabase,
+			objectIdentifier: id,
+			parameterKey:     ObjectParameterLogLevel,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER DATABASE %s UNSET LOG_LEVEL", id.FullyQualifiedName())
+	})
+}
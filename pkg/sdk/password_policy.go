@@ -48,6 +48,8 @@ type CreatePasswordPolicyOptions struct {
 	PasswordMaxAgeDays        *int `ddl:"parameter" sql:"PASSWORD_MAX_AGE_DAYS"`
 	PasswordMaxRetries        *int `ddl:"parameter" sql:"PASSWORD_MAX_RETRIES"`
 	PasswordLockoutTimeMins   *int `ddl:"parameter" sql:"PASSWORD_LOCKOUT_TIME_MINS"`
+	PasswordMinAgeDays        *int `ddl:"parameter" sql:"PASSWORD_MIN_AGE_DAYS"`
+	PasswordHistory           *int `ddl:"parameter" sql:"PASSWORD_HISTORY"`
 
 	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
@@ -121,6 +123,8 @@ type PasswordPolicySet struct {
 	PasswordMaxAgeDays        *int    `ddl:"parameter" sql:"PASSWORD_MAX_AGE_DAYS"`
 	PasswordMaxRetries        *int    `ddl:"parameter" sql:"PASSWORD_MAX_RETRIES"`
 	PasswordLockoutTimeMins   *int    `ddl:"parameter" sql:"PASSWORD_LOCKOUT_TIME_MINS"`
+	PasswordMinAgeDays        *int    `ddl:"parameter" sql:"PASSWORD_MIN_AGE_DAYS"`
+	PasswordHistory           *int    `ddl:"parameter" sql:"PASSWORD_HISTORY"`
 	Comment                   *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
@@ -135,6 +139,8 @@ func (v *PasswordPolicySet) validate() error {
 		v.PasswordMaxAgeDays,
 		v.PasswordMaxRetries,
 		v.PasswordLockoutTimeMins,
+		v.PasswordMinAgeDays,
+		v.PasswordHistory,
 		v.Comment) {
 		return errors.New("must set at least one parameter")
 	}
@@ -151,6 +157,8 @@ type PasswordPolicyUnset struct {
 	PasswordMaxAgeDays        *bool `ddl:"keyword" sql:"PASSWORD_MAX_AGE_DAYS"`
 	PasswordMaxRetries        *bool `ddl:"keyword" sql:"PASSWORD_MAX_RETRIES"`
 	PasswordLockoutTimeMins   *bool `ddl:"keyword" sql:"PASSWORD_LOCKOUT_TIME_MINS"`
+	PasswordMinAgeDays        *bool `ddl:"keyword" sql:"PASSWORD_MIN_AGE_DAYS"`
+	PasswordHistory           *bool `ddl:"keyword" sql:"PASSWORD_HISTORY"`
 	Comment                   *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
@@ -165,6 +173,8 @@ func (v *PasswordPolicyUnset) validate() error {
 		v.PasswordMaxAgeDays,
 		v.PasswordMaxRetries,
 		v.PasswordLockoutTimeMins,
+		v.PasswordMinAgeDays,
+		v.PasswordHistory,
 		v.Comment) {
 		return errors.New("must unset at least one parameter")
 	}
@@ -178,6 +188,8 @@ func (v *PasswordPolicyUnset) validate() error {
 		v.PasswordMaxAgeDays,
 		v.PasswordMaxRetries,
 		v.PasswordLockoutTimeMins,
+		v.PasswordMinAgeDays,
+		v.PasswordHistory,
 		v.Comment) {
 		return errors.New("cannot unset more than one parameter in the same ALTER statement")
 	}
@@ -359,6 +371,8 @@ type PasswordPolicyDetails struct {
 	PasswordMaxAgeDays        *IntProperty
 	PasswordMaxRetries        *IntProperty
 	PasswordLockoutTimeMins   *IntProperty
+	PasswordMinAgeDays        *IntProperty
+	PasswordHistory           *IntProperty
 }
 
 func passwordPolicyDetailsFromRows(rows []propertyRow) *PasswordPolicyDetails {
@@ -389,6 +403,10 @@ func passwordPolicyDetailsFromRows(rows []propertyRow) *PasswordPolicyDetails {
 			v.PasswordMaxRetries = row.toIntProperty()
 		case "PASSWORD_LOCKOUT_TIME_MINS":
 			v.PasswordLockoutTimeMins = row.toIntProperty()
+		case "PASSWORD_MIN_AGE_DAYS":
+			v.PasswordMinAgeDays = row.toIntProperty()
+		case "PASSWORD_HISTORY":
+			v.PasswordHistory = row.toIntProperty()
 		}
 	}
 	return v
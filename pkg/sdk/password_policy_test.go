@@ -35,9 +35,11 @@ func TestPasswordPolicyCreate(t *testing.T) {
 			PasswordMaxAgeDays:        Int(30),
 			PasswordMaxRetries:        Int(5),
 			PasswordLockoutTimeMins:   Int(30),
+			PasswordMinAgeDays:        Int(1),
+			PasswordHistory:           Int(5),
 			Comment:                   String("test comment"),
 		}
-		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE PASSWORD POLICY IF NOT EXISTS %s PASSWORD_MIN_LENGTH = 10 PASSWORD_MAX_LENGTH = 20 PASSWORD_MIN_UPPER_CASE_CHARS = 1 PASSWORD_MIN_LOWER_CASE_CHARS = 1 PASSWORD_MIN_NUMERIC_CHARS = 1 PASSWORD_MIN_SPECIAL_CHARS = 1 PASSWORD_MAX_AGE_DAYS = 30 PASSWORD_MAX_RETRIES = 5 PASSWORD_LOCKOUT_TIME_MINS = 30 COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE PASSWORD POLICY IF NOT EXISTS %s PASSWORD_MIN_LENGTH = 10 PASSWORD_MAX_LENGTH = 20 PASSWORD_MIN_UPPER_CASE_CHARS = 1 PASSWORD_MIN_LOWER_CASE_CHARS = 1 PASSWORD_MIN_NUMERIC_CHARS = 1 PASSWORD_MIN_SPECIAL_CHARS = 1 PASSWORD_MAX_AGE_DAYS = 30 PASSWORD_MAX_RETRIES = 5 PASSWORD_LOCKOUT_TIME_MINS = 30 PASSWORD_MIN_AGE_DAYS = 1 PASSWORD_HISTORY = 5 COMMENT = 'test comment'`, id.FullyQualifiedName())
 	})
 }
 
@@ -68,6 +70,17 @@ func TestPasswordPolicyAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER PASSWORD POLICY %s SET PASSWORD_MIN_LENGTH = 10 PASSWORD_MAX_LENGTH = 20 PASSWORD_MIN_UPPER_CASE_CHARS = 1", id.FullyQualifiedName())
 	})
 
+	t.Run("with set history", func(t *testing.T) {
+		opts := &AlterPasswordPolicyOptions{
+			name: id,
+			Set: &PasswordPolicySet{
+				PasswordMinAgeDays: Int(1),
+				PasswordHistory:    Int(24),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PASSWORD POLICY %s SET PASSWORD_MIN_AGE_DAYS = 1 PASSWORD_HISTORY = 24", id.FullyQualifiedName())
+	})
+
 	t.Run("with unset", func(t *testing.T) {
 		opts := &AlterPasswordPolicyOptions{
 			name: id,
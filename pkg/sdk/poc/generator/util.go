@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	// Split by empty space or underscore
-	splitSQLPattern   = regexp.MustCompile(`\s+|_`)
+	// Split by any non-alphanumeric characters (e.g. spaces, underscores, or parentheses)
+	splitSQLPattern   = regexp.MustCompile(`[^A-Za-z0-9]+`)
 	englishLowerCaser = cases.Lower(language.English)
 	englishTitleCaser = cases.Title(language.English)
 )
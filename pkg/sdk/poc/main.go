@@ -16,11 +16,14 @@ import (
 )
 
 var definitionMapping = map[string]*generator.Interface{
-	"database_role_def.go":    example.DatabaseRole,
-	"network_policies_def.go": sdk.NetworkPoliciesDef,
-	"session_policies_def.go": sdk.SessionPoliciesDef,
-	"tasks_def.go":            sdk.TasksDef,
-	"streams_def.go":          sdk.StreamsDef,
+	"database_role_def.go":       example.DatabaseRole,
+	"network_policies_def.go":    sdk.NetworkPoliciesDef,
+	"network_rules_def.go":       sdk.NetworkRulesDef,
+	"packages_policies_def.go":   sdk.PackagesPoliciesDef,
+	"projection_policies_def.go": sdk.ProjectionPoliciesDef,
+	"session_policies_def.go":    sdk.SessionPoliciesDef,
+	"tasks_def.go":               sdk.TasksDef,
+	"streams_def.go":             sdk.StreamsDef,
 }
 
 func main() {
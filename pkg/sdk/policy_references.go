@@ -0,0 +1,166 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+var _ PolicyReferences = (*policyReferences)(nil)
+
+var _ validatable = new(getForEntityPolicyReferenceOptions)
+
+type PolicyReferences interface {
+	GetForEntity(ctx context.Context, refEntityName string, refEntityDomain PolicyEntityDomain) ([]PolicyReference, error)
+}
+
+type policyReferences struct {
+	client *Client
+}
+
+type PolicyEntityDomain string
+
+const (
+	PolicyEntityDomainAccount PolicyEntityDomain = "ACCOUNT"
+	PolicyEntityDomainColumn  PolicyEntityDomain = "COLUMN"
+	PolicyEntityDomainTable   PolicyEntityDomain = "TABLE"
+	PolicyEntityDomainTag     PolicyEntityDomain = "TAG"
+	PolicyEntityDomainUser    PolicyEntityDomain = "USER"
+	PolicyEntityDomainView    PolicyEntityDomain = "VIEW"
+)
+
+type PolicyKind string
+
+const (
+	PolicyKindAggregationPolicy    PolicyKind = "AGGREGATION_POLICY"
+	PolicyKindAuthenticationPolicy PolicyKind = "AUTHENTICATION_POLICY"
+	PolicyKindMaskingPolicy        PolicyKind = "MASKING_POLICY"
+	PolicyKindPackagesPolicy       PolicyKind = "PACKAGES_POLICY"
+	PolicyKindPasswordPolicy       PolicyKind = "PASSWORD_POLICY"
+	PolicyKindProjectionPolicy     PolicyKind = "PROJECTION_POLICY"
+	PolicyKindRowAccessPolicy      PolicyKind = "ROW_ACCESS_POLICY"
+	PolicyKindSessionPolicy        PolicyKind = "SESSION_POLICY"
+)
+
+// getForEntityPolicyReferenceOptions is based on https://docs.snowflake.com/en/sql-reference/functions/policy_references.
+type getForEntityPolicyReferenceOptions struct {
+	selectEverythingFrom bool                       `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *policyReferenceParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type policyReferenceParameters struct {
+	functionFullyQualifiedName bool                              `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES"`
+	arguments                  *policyReferenceFunctionArguments `ddl:"list,parentheses"`
+}
+
+type policyReferenceFunctionArguments struct {
+	refEntityName   *string             `ddl:"parameter,single_quotes,arrow_equals" sql:"REF_ENTITY_NAME"`
+	refEntityDomain *PolicyEntityDomain `ddl:"parameter,single_quotes,arrow_equals" sql:"REF_ENTITY_DOMAIN"`
+}
+
+func (opts *getForEntityPolicyReferenceOptions) validate() error {
+	if opts.parameters == nil || opts.parameters.arguments == nil {
+		return errNotSet("getForEntityPolicyReferenceOptions", "parameters")
+	}
+	arguments := opts.parameters.arguments
+	var errs []error
+	if arguments.refEntityName == nil || *arguments.refEntityName == "" {
+		errs = append(errs, errNotSet("policyReferenceFunctionArguments", "refEntityName"))
+	}
+	if arguments.refEntityDomain == nil {
+		errs = append(errs, errNotSet("policyReferenceFunctionArguments", "refEntityDomain"))
+	}
+	return errors.Join(errs...)
+}
+
+type PolicyReference struct {
+	PolicyDb          string
+	PolicySchema      string
+	PolicyName        string
+	PolicyKind        PolicyKind
+	RefDatabaseName   string
+	RefSchemaName     string
+	RefEntityName     string
+	RefEntityDomain   string
+	RefColumnName     string
+	RefArgColumnNames string
+	TagDatabase       string
+	TagSchema         string
+	TagName           string
+	PolicyStatus      string
+}
+
+// PolicyID returns the identifier of the referenced policy.
+func (v *PolicyReference) PolicyID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.PolicyDb, v.PolicySchema, v.PolicyName)
+}
+
+// ArgColumnNames returns the names of the argument columns of the referenced policy,
+// e.g. [ "REGION", "DEPARTMENT" ] returned for row access policies is parsed into [REGION DEPARTMENT].
+func (v *PolicyReference) ArgColumnNames() []string {
+	s := strings.TrimSpace(v.RefArgColumnNames)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	names := make([]string, 0)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.Trim(strings.TrimSpace(name), `"`)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+type policyReferenceDBRow struct {
+	PolicyDb          string         `db:"POLICY_DB"`
+	PolicySchema      string         `db:"POLICY_SCHEMA"`
+	PolicyName        string         `db:"POLICY_NAME"`
+	PolicyKind        string         `db:"POLICY_KIND"`
+	RefDatabaseName   sql.NullString `db:"REF_DATABASE_NAME"`
+	RefSchemaName     sql.NullString `db:"REF_SCHEMA_NAME"`
+	RefEntityName     string         `db:"REF_ENTITY_NAME"`
+	RefEntityDomain   string         `db:"REF_ENTITY_DOMAIN"`
+	RefColumnName     sql.NullString `db:"REF_COLUMN_NAME"`
+	RefArgColumnNames sql.NullString `db:"REF_ARG_COLUMN_NAMES"`
+	TagDatabase       sql.NullString `db:"TAG_DATABASE"`
+	TagSchema         sql.NullString `db:"TAG_SCHEMA"`
+	TagName           sql.NullString `db:"TAG_NAME"`
+	PolicyStatus      string         `db:"POLICY_STATUS"`
+}
+
+func (row policyReferenceDBRow) convert() *PolicyReference {
+	return &PolicyReference{
+		PolicyDb:          row.PolicyDb,
+		PolicySchema:      row.PolicySchema,
+		PolicyName:        row.PolicyName,
+		PolicyKind:        PolicyKind(row.PolicyKind),
+		RefDatabaseName:   row.RefDatabaseName.String,
+		RefSchemaName:     row.RefSchemaName.String,
+		RefEntityName:     row.RefEntityName,
+		RefEntityDomain:   row.RefEntityDomain,
+		RefColumnName:     row.RefColumnName.String,
+		RefArgColumnNames: row.RefArgColumnNames.String,
+		TagDatabase:       row.TagDatabase.String,
+		TagSchema:         row.TagSchema.String,
+		TagName:           row.TagName.String,
+		PolicyStatus:      row.PolicyStatus,
+	}
+}
+
+// GetForEntity returns all policies attached to the given entity. For entities other than the account,
+// refEntityName should be the fully qualified name of the entity.
+func (v *policyReferences) GetForEntity(ctx context.Context, refEntityName string, refEntityDomain PolicyEntityDomain) ([]PolicyReference, error) {
+	opts := &getForEntityPolicyReferenceOptions{
+		parameters: &policyReferenceParameters{
+			arguments: &policyReferenceFunctionArguments{
+				refEntityName:   String(refEntityName),
+				refEntityDomain: &refEntityDomain,
+			},
+		},
+	}
+	rows, err := validateAndQuery[policyReferenceDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[policyReferenceDBRow, PolicyReference](rows), nil
+}
@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyReferencesGetForEntity(t *testing.T) {
+	t.Run("validation: missing arguments", func(t *testing.T) {
+		opts := &getForEntityPolicyReferenceOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("getForEntityPolicyReferenceOptions", "parameters"))
+	})
+
+	t.Run("validation: missing entity name and domain", func(t *testing.T) {
+		opts := &getForEntityPolicyReferenceOptions{
+			parameters: &policyReferenceParameters{
+				arguments: &policyReferenceFunctionArguments{},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("policyReferenceFunctionArguments", "refEntityName"), errNotSet("policyReferenceFunctionArguments", "refEntityDomain"))
+	})
+
+	t.Run("account domain", func(t *testing.T) {
+		domain := PolicyEntityDomainAccount
+		opts := &getForEntityPolicyReferenceOptions{
+			parameters: &policyReferenceParameters{
+				arguments: &policyReferenceFunctionArguments{
+					refEntityName:   String("ABC12345"),
+					refEntityDomain: &domain,
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES (REF_ENTITY_NAME => 'ABC12345', REF_ENTITY_DOMAIN => 'ACCOUNT'))`)
+	})
+
+	t.Run("user domain", func(t *testing.T) {
+		id := NewAccountObjectIdentifier("user_name")
+		domain := PolicyEntityDomainUser
+		opts := &getForEntityPolicyReferenceOptions{
+			parameters: &policyReferenceParameters{
+				arguments: &policyReferenceFunctionArguments{
+					refEntityName:   String(id.FullyQualifiedName()),
+					refEntityDomain: &domain,
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES (REF_ENTITY_NAME => '\"user_name\"', REF_ENTITY_DOMAIN => 'USER'))`)
+	})
+}
+
+func TestPolicyReferenceArgColumnNames(t *testing.T) {
+	t.Run("multiple columns", func(t *testing.T) {
+		ref := PolicyReference{RefArgColumnNames: `[ "REGION", "DEPARTMENT" ]`}
+		assert.Equal(t, []string{"REGION", "DEPARTMENT"}, ref.ArgColumnNames())
+	})
+
+	t.Run("no columns", func(t *testing.T) {
+		ref := PolicyReference{}
+		assert.Empty(t, ref.ArgColumnNames())
+	})
+}
@@ -0,0 +1,109 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var ProjectionPoliciesDef = g.NewInterface(
+	"ProjectionPolicies",
+	"ProjectionPolicy",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-projection-policy",
+		g.QueryStruct("CreateProjectionPolicy").
+			Create().
+			OrReplace().
+			SQL("PROJECTION POLICY").
+			IfNotExists().
+			Name().
+			SQL("AS () RETURNS PROJECTION_CONSTRAINT ->").
+			Text("Body", g.KeywordOptions().NoQuotes().Required()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-projection-policy",
+		g.QueryStruct("AlterProjectionPolicy").
+			Alter().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalQueryStructField(
+				"Set",
+				g.QueryStruct("ProjectionPolicySet").
+					OptionalTextAssignment("BODY ->", g.ParameterOptions().NoQuotes().NoEquals()).
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Body", "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			SetTags().
+			UnsetTags().
+			OptionalQueryStructField(
+				"Unset",
+				g.QueryStruct("ProjectionPolicyUnset").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.KeywordOptions().SQL("UNSET"),
+			).
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "SetTags", "UnsetTags", "Unset").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-projection-policy",
+		g.QueryStruct("DropProjectionPolicy").
+			Drop().
+			SQL("PROJECTION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies",
+		g.DbStruct("showProjectionPolicyDBRow").
+			Field("created_on", "time.Time").
+			Field("name", "string").
+			Field("database_name", "string").
+			Field("schema_name", "string").
+			Field("kind", "string").
+			Field("owner", "string").
+			Field("comment", "string").
+			Field("owner_role_type", "string"),
+		g.PlainStruct("ProjectionPolicy").
+			Field("CreatedOn", "time.Time").
+			Field("Name", "string").
+			Field("DatabaseName", "string").
+			Field("SchemaName", "string").
+			Field("Kind", "string").
+			Field("Owner", "string").
+			Field("Comment", "string").
+			Field("OwnerRoleType", "string"),
+		g.QueryStruct("ShowProjectionPolicies").
+			Show().
+			SQL("PROJECTION POLICIES").
+			OptionalLike().
+			OptionalIn(),
+	).
+	ShowByIdOperation().
+	DescribeOperation(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-projection-policy",
+		g.DbStruct("describeProjectionPolicyDBRow").
+			Field("name", "string").
+			Field("signature", "string").
+			Field("return_type", "string").
+			Field("body", "string"),
+		g.PlainStruct("ProjectionPolicyDetails").
+			Field("Name", "string").
+			Field("Signature", "string").
+			Field("ReturnType", "string").
+			Field("Body", "string"),
+		g.QueryStruct("DescribeProjectionPolicy").
+			Describe().
+			SQL("PROJECTION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	)
@@ -0,0 +1,52 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateProjectionPolicyOptions]   = new(CreateProjectionPolicyRequest)
+	_ optionsProvider[AlterProjectionPolicyOptions]    = new(AlterProjectionPolicyRequest)
+	_ optionsProvider[DropProjectionPolicyOptions]     = new(DropProjectionPolicyRequest)
+	_ optionsProvider[ShowProjectionPolicyOptions]     = new(ShowProjectionPolicyRequest)
+	_ optionsProvider[DescribeProjectionPolicyOptions] = new(DescribeProjectionPolicyRequest)
+)
+
+type CreateProjectionPolicyRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	Body        string                 // required
+	Comment     *string
+}
+
+type AlterProjectionPolicyRequest struct {
+	IfExists  *bool
+	name      SchemaObjectIdentifier // required
+	RenameTo  *SchemaObjectIdentifier
+	Set       *ProjectionPolicySetRequest
+	SetTags   []TagAssociation
+	UnsetTags []ObjectIdentifier
+	Unset     *ProjectionPolicyUnsetRequest
+}
+
+type ProjectionPolicySetRequest struct {
+	Body    *string
+	Comment *string
+}
+
+type ProjectionPolicyUnsetRequest struct {
+	Comment *bool
+}
+
+type DropProjectionPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowProjectionPolicyRequest struct {
+	Like *Like
+	In   *In
+}
+
+type DescribeProjectionPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,222 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+)
+
+func TestProjectionPolicies_Create(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid CreateProjectionPolicyOptions
+	defaultOpts := func() *CreateProjectionPolicyOptions {
+		return &CreateProjectionPolicyOptions{
+			name: id,
+			Body: "PROJECTION_CONSTRAINT(ALLOW => true)",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateProjectionPolicyOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => true)", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.Body = "CASE WHEN CURRENT_ROLE() = 'ANALYST' THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END"
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> CASE WHEN CURRENT_ROLE() = 'ANALYST' THEN PROJECTION_CONSTRAINT(ALLOW => true) ELSE PROJECTION_CONSTRAINT(ALLOW => false) END COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestProjectionPolicies_Alter(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid AlterProjectionPolicyOptions
+	defaultOpts := func() *AlterProjectionPolicyOptions {
+		return &AlterProjectionPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		opts.Unset = &ProjectionPolicyUnset{Comment: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.Set opts.SetTags opts.UnsetTags opts.Unset] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("RenameTo", "Set", "SetTags", "UnsetTags", "Unset"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Body opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ProjectionPolicySet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Body", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ProjectionPolicyUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Comment"))
+	})
+
+	t.Run("rename to", func(t *testing.T) {
+		opts := defaultOpts()
+		newID := NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), random.AlphanumericN(12))
+		opts.RenameTo = &newID
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+	})
+
+	t.Run("set body and comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &ProjectionPolicySet{
+			Body:    String("PROJECTION_CONSTRAINT(ALLOW => false)"),
+			Comment: String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY IF EXISTS %s SET BODY -> PROJECTION_CONSTRAINT(ALLOW => false) COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROJECTION POLICY %s SET TAG "tag1" = 'value1'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER PROJECTION POLICY %s UNSET TAG "tag1"`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ProjectionPolicyUnset{
+			Comment: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER PROJECTION POLICY %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+}
+
+func TestProjectionPolicies_Drop(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DropProjectionPolicyOptions
+	defaultOpts := func() *DropProjectionPolicyOptions {
+		return &DropProjectionPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP PROJECTION POLICY %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP PROJECTION POLICY IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestProjectionPolicies_Show(t *testing.T) {
+	// Minimal valid ShowProjectionPolicyOptions
+	defaultOpts := func() *ShowProjectionPolicyOptions {
+		return &ShowProjectionPolicyOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW PROJECTION POLICIES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("some pattern"),
+		}
+		opts.In = &In{
+			Schema: NewDatabaseObjectIdentifier("db", "schema"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW PROJECTION POLICIES LIKE 'some pattern' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestProjectionPolicies_Describe(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+
+	// Minimal valid DescribeProjectionPolicyOptions
+	defaultOpts := func() *DescribeProjectionPolicyOptions {
+		return &DescribeProjectionPolicyOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeProjectionPolicyOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE PROJECTION POLICY %s", id.FullyQualifiedName())
+	})
+}
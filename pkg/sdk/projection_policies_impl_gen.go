@@ -0,0 +1,140 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+)
+
+var _ ProjectionPolicies = (*projectionPolicies)(nil)
+
+type projectionPolicies struct {
+	client *Client
+}
+
+func (v *projectionPolicies) Create(ctx context.Context, request *CreateProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Alter(ctx context.Context, request *AlterProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Drop(ctx context.Context, request *DropProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Show(ctx context.Context, request *ShowProjectionPolicyRequest) ([]ProjectionPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showProjectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showProjectionPolicyDBRow, ProjectionPolicy](dbRows)
+	return resultList, nil
+}
+
+func (v *projectionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	projectionPolicies, err := v.Show(ctx, NewShowProjectionPolicyRequest().WithLike(&Like{
+		Pattern: String(id.Name()),
+	}).WithIn(&In{
+		Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindOne(projectionPolicies, func(r ProjectionPolicy) bool { return r.Name == id.Name() })
+}
+
+func (v *projectionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDetails, error) {
+	opts := &DescribeProjectionPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeProjectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (r *CreateProjectionPolicyRequest) toOpts() *CreateProjectionPolicyOptions {
+	opts := &CreateProjectionPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Body:        r.Body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterProjectionPolicyRequest) toOpts() *AlterProjectionPolicyOptions {
+	opts := &AlterProjectionPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+		RenameTo: r.RenameTo,
+
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &ProjectionPolicySet{
+			Body:    r.Set.Body,
+			Comment: r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ProjectionPolicyUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropProjectionPolicyRequest) toOpts() *DropProjectionPolicyOptions {
+	opts := &DropProjectionPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowProjectionPolicyRequest) toOpts() *ShowProjectionPolicyOptions {
+	opts := &ShowProjectionPolicyOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r showProjectionPolicyDBRow) convert() *ProjectionPolicy {
+	return &ProjectionPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Comment:       r.Comment.String,
+		OwnerRoleType: r.OwnerRoleType.String,
+	}
+}
+
+func (r *DescribeProjectionPolicyRequest) toOpts() *DescribeProjectionPolicyOptions {
+	opts := &DescribeProjectionPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeProjectionPolicyDBRow) convert() *ProjectionPolicyDetails {
+	return &ProjectionPolicyDetails{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+}
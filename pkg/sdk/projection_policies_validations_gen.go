@@ -0,0 +1,82 @@
+package sdk
+
+import "errors"
+
+var (
+	_ validatable = new(CreateProjectionPolicyOptions)
+	_ validatable = new(AlterProjectionPolicyOptions)
+	_ validatable = new(DropProjectionPolicyOptions)
+	_ validatable = new(ShowProjectionPolicyOptions)
+	_ validatable = new(DescribeProjectionPolicyOptions)
+)
+
+func (opts *CreateProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateProjectionPolicyOptions", "OrReplace", "IfNotExists"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *AlterProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if ok := exactlyOneValueSet(opts.RenameTo, opts.Set, opts.SetTags, opts.UnsetTags, opts.Unset); !ok {
+		errs = append(errs, errExactlyOneOf("RenameTo", "Set", "SetTags", "UnsetTags", "Unset"))
+	}
+	if valueSet(opts.RenameTo) && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if valueSet(opts.Set) {
+		if ok := anyValueSet(opts.Set.Body, opts.Set.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("Body", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if ok := anyValueSet(opts.Unset.Comment); !ok {
+			errs = append(errs, errAtLeastOneOf("Comment"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *DropProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *ShowProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	return errors.Join(errs...)
+}
+
+func (opts *DescribeProjectionPolicyOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
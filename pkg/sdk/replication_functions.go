@@ -2,14 +2,19 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 	"time"
 )
 
-var _ validatable = new(ShowRegionsOptions)
+var (
+	_ validatable = new(ShowRegionsOptions)
+	_ validatable = new(ShowReplicationDatabasesOptions)
+)
 
 type ReplicationFunctions interface {
 	ShowReplicationAccounts(ctx context.Context) ([]*ReplicationAccount, error)
-	// todo: ShowReplicationDatabases(ctx context.Context, opts *ShowReplicationDatabasesOptions) ([]*ReplicationDatabase, error)
+	ShowReplicationDatabases(ctx context.Context, opts *ShowReplicationDatabasesOptions) ([]*ReplicationDatabase, error)
 	ShowRegions(ctx context.Context, opts *ShowRegionsOptions) ([]*Region, error)
 }
 
@@ -53,6 +58,95 @@ func (c *replicationFunctions) ShowReplicationAccounts(ctx context.Context) ([]*
 	return replicationAccounts, nil
 }
 
+// ShowReplicationDatabasesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-replication-databases.
+type ShowReplicationDatabasesOptions struct {
+	show                 bool                      `ddl:"static" sql:"SHOW"`
+	replicationDatabases bool                      `ddl:"static" sql:"REPLICATION DATABASES"`
+	Like                 *Like                     `ddl:"keyword" sql:"LIKE"`
+	WithPrimary          *ExternalObjectIdentifier `ddl:"identifier" sql:"WITH PRIMARY"`
+}
+
+func (opts *ShowReplicationDatabasesOptions) validate() error {
+	return nil
+}
+
+// ReplicationDatabase is a database of the organization for which the replication is enabled, either a primary database or one of its replicas.
+type ReplicationDatabase struct {
+	RegionGroup                  string
+	SnowflakeRegion              string
+	CreatedOn                    time.Time
+	AccountName                  string
+	Name                         string
+	Comment                      string
+	IsPrimary                    bool
+	Primary                      string
+	ReplicationAllowedToAccounts []AccountIdentifier
+	FailoverAllowedToAccounts    []AccountIdentifier
+	OrganizationName             string
+	AccountLocator               string
+}
+
+type replicationDatabaseRow struct {
+	RegionGroup                  sql.NullString `db:"region_group"`
+	SnowflakeRegion              string         `db:"snowflake_region"`
+	CreatedOn                    time.Time      `db:"created_on"`
+	AccountName                  string         `db:"account_name"`
+	Name                         string         `db:"name"`
+	Comment                      sql.NullString `db:"comment"`
+	IsPrimary                    bool           `db:"is_primary"`
+	Primary                      sql.NullString `db:"primary"`
+	ReplicationAllowedToAccounts sql.NullString `db:"replication_allowed_to_accounts"`
+	FailoverAllowedToAccounts    sql.NullString `db:"failover_allowed_to_accounts"`
+	OrganizationName             string         `db:"organization_name"`
+	AccountLocator               string         `db:"account_locator"`
+	DifferenceInSeconds          sql.NullInt64  `db:"difference_in_seconds"`
+}
+
+func (row *replicationDatabaseRow) toReplicationDatabase() *ReplicationDatabase {
+	return &ReplicationDatabase{
+		RegionGroup:                  row.RegionGroup.String,
+		SnowflakeRegion:              row.SnowflakeRegion,
+		CreatedOn:                    row.CreatedOn,
+		AccountName:                  row.AccountName,
+		Name:                         row.Name,
+		Comment:                      row.Comment.String,
+		IsPrimary:                    row.IsPrimary,
+		Primary:                      row.Primary.String,
+		ReplicationAllowedToAccounts: parseAccountIdentifiers(row.ReplicationAllowedToAccounts.String),
+		FailoverAllowedToAccounts:    parseAccountIdentifiers(row.FailoverAllowedToAccounts.String),
+		OrganizationName:             row.OrganizationName,
+		AccountLocator:               row.AccountLocator,
+	}
+}
+
+// parseAccountIdentifiers parses a comma separated list of accounts in the <organization_name>.<account_name> format.
+func parseAccountIdentifiers(s string) []AccountIdentifier {
+	accounts := make([]AccountIdentifier, 0)
+	for _, account := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(account), ".")
+		if len(parts) != 2 {
+			continue
+		}
+		accounts = append(accounts, NewAccountIdentifier(parts[0], parts[1]))
+	}
+	return accounts
+}
+
+// ShowReplicationDatabases is based on https://docs.snowflake.com/en/sql-reference/sql/show-replication-databases.
+// It lists the replication databases of all the accounts of the organization, so the results have to be filtered by the account locator.
+func (c *replicationFunctions) ShowReplicationDatabases(ctx context.Context, opts *ShowReplicationDatabasesOptions) ([]*ReplicationDatabase, error) {
+	opts = createIfNil(opts)
+	rows, err := validateAndQuery[replicationDatabaseRow](c.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	replicationDatabases := make([]*ReplicationDatabase, len(rows))
+	for i, row := range rows {
+		replicationDatabases[i] = row.toReplicationDatabase()
+	}
+	return replicationDatabases, nil
+}
+
 type CloudType string
 
 const (
@@ -0,0 +1,28 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShowReplicationDatabases(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowReplicationDatabasesOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION DATABASES`)
+	})
+
+	t.Run("with like and primary", func(t *testing.T) {
+		opts := &ShowReplicationDatabasesOptions{
+			Like:        &Like{Pattern: String("db1")},
+			WithPrimary: Pointer(NewExternalObjectIdentifier(NewAccountIdentifier("myorg", "account1"), NewAccountObjectIdentifier("db1"))),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION DATABASES LIKE 'db1' WITH PRIMARY myorg.account1."db1"`)
+	})
+}
+
+func TestParseAccountIdentifiers(t *testing.T) {
+	accounts := parseAccountIdentifiers("MYORG.ACCOUNT1, MYORG.ACCOUNT2")
+	assert.Equal(t, []AccountIdentifier{NewAccountIdentifier("MYORG", "ACCOUNT1"), NewAccountIdentifier("MYORG", "ACCOUNT2")}, accounts)
+	assert.Empty(t, parseAccountIdentifiers(""))
+}
@@ -1,4 +1,373 @@
 package sdk
 
-// note: once Replication Groups is implemented, Databases Integration test for CreateSecondary needs to be implemented
-// also: TestInt_AlterReplication
+import (
+	"context"
+	"errors"
+)
+
+var _ ReplicationGroups = (*replicationGroups)(nil)
+
+var (
+	_ validatable = new(CreateReplicationGroupOptions)
+	_ validatable = new(CreateReplicaReplicationGroupOptions)
+	_ validatable = new(AlterSourceReplicationGroupOptions)
+	_ validatable = new(AlterTargetReplicationGroupOptions)
+	_ validatable = new(DropReplicationGroupOptions)
+	_ validatable = new(ShowReplicationGroupOptions)
+)
+
+// ReplicationGroups manages read-only replication groups. Unlike failover groups, the secondary groups cannot be promoted to primary.
+type ReplicationGroups interface {
+	Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error
+	CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateReplicaReplicationGroupOptions) error
+	AlterSource(ctx context.Context, id AccountObjectIdentifier, opts *AlterSourceReplicationGroupOptions) error
+	AlterTarget(ctx context.Context, id AccountObjectIdentifier, opts *AlterTargetReplicationGroupOptions) error
+	Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error
+	Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]ReplicationGroup, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error)
+	ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+	ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error)
+}
+
+// replicationGroups implements ReplicationGroups.
+type replicationGroups struct {
+	client *Client
+}
+
+// CreateReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicationGroupOptions struct {
+	create           bool                    `ddl:"static" sql:"CREATE"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists      *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+
+	objectTypes             []PluralObjectType        `ddl:"parameter" sql:"OBJECT_TYPES"`
+	AllowedDatabases        []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_DATABASES"`
+	AllowedShares           []AccountObjectIdentifier `ddl:"parameter" sql:"ALLOWED_SHARES"`
+	AllowedIntegrationTypes []IntegrationType         `ddl:"parameter" sql:"ALLOWED_INTEGRATION_TYPES"`
+	allowedAccounts         []AccountIdentifier       `ddl:"parameter" sql:"ALLOWED_ACCOUNTS"`
+	IgnoreEditionCheck      *bool                     `ddl:"keyword" sql:"IGNORE EDITION CHECK"`
+	ReplicationSchedule     *string                   `ddl:"parameter,single_quotes" sql:"REPLICATION_SCHEDULE"`
+}
+
+func (opts *CreateReplicationGroupOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) Create(ctx context.Context, id AccountObjectIdentifier, objectTypes []PluralObjectType, allowedAccounts []AccountIdentifier, opts *CreateReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateReplicationGroupOptions{}
+	}
+	opts.name = id
+	opts.allowedAccounts = allowedAccounts
+	opts.objectTypes = objectTypes
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// CreateReplicaReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-replication-group.
+type CreateReplicaReplicationGroupOptions struct {
+	create                  bool                     `ddl:"static" sql:"CREATE"`
+	replicationGroup        bool                     `ddl:"static" sql:"REPLICATION GROUP"`
+	IfNotExists             *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                    AccountObjectIdentifier  `ddl:"identifier"`
+	primaryReplicationGroup ExternalObjectIdentifier `ddl:"identifier" sql:"AS REPLICA OF"`
+}
+
+func (opts *CreateReplicaReplicationGroupOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !ValidObjectIdentifier(opts.primaryReplicationGroup) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) CreateReplica(ctx context.Context, id AccountObjectIdentifier, primaryReplicationGroupID ExternalObjectIdentifier, opts *CreateReplicaReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &CreateReplicaReplicationGroupOptions{}
+	}
+	opts.name = id
+	opts.primaryReplicationGroup = primaryReplicationGroupID
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// The SET, ADD and REMOVE clauses of ALTER REPLICATION GROUP are the same as the ones of ALTER FAILOVER GROUP.
+type (
+	ReplicationGroupSet    = FailoverGroupSet
+	ReplicationGroupAdd    = FailoverGroupAdd
+	ReplicationGroupRemove = FailoverGroupRemove
+)
+
+// AlterSourceReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterSourceReplicationGroupOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+	NewName          AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set              *ReplicationGroupSet    `ddl:"keyword" sql:"SET"`
+	Add              *ReplicationGroupAdd    `ddl:"keyword" sql:"ADD"`
+	Remove           *ReplicationGroupRemove `ddl:"keyword" sql:"REMOVE"`
+}
+
+func (opts *AlterSourceReplicationGroupOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Add, opts.Remove, opts.NewName) {
+		return errors.New("exactly one of SET, ADD, REMOVE, or NewName must be specified")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *replicationGroups) AlterSource(ctx context.Context, id AccountObjectIdentifier, opts *AlterSourceReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterSourceReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterTargetReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-replication-group.
+type AlterTargetReplicationGroupOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+	Refresh          *bool                   `ddl:"keyword" sql:"REFRESH"`
+	Suspend          *bool                   `ddl:"keyword" sql:"SUSPEND"`
+	Resume           *bool                   `ddl:"keyword" sql:"RESUME"`
+}
+
+func (opts *AlterTargetReplicationGroupOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Refresh, opts.Suspend, opts.Resume) {
+		return errors.New("must set one of [Refresh, Suspend, Resume]")
+	}
+	return nil
+}
+
+func (v *replicationGroups) AlterTarget(ctx context.Context, id AccountObjectIdentifier, opts *AlterTargetReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &AlterTargetReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-replication-group.
+type DropReplicationGroupOptions struct {
+	drop             bool                    `ddl:"static" sql:"DROP"`
+	replicationGroup bool                    `ddl:"static" sql:"REPLICATION GROUP"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropReplicationGroupOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropReplicationGroupOptions) error {
+	if opts == nil {
+		opts = &DropReplicationGroupOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowReplicationGroupOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-replication-groups.
+type ShowReplicationGroupOptions struct {
+	show              bool              `ddl:"static" sql:"SHOW"`
+	replicationGroups bool              `ddl:"static" sql:"REPLICATION GROUPS"`
+	InAccount         AccountIdentifier `ddl:"identifier" sql:"IN ACCOUNT"`
+}
+
+func (opts *ShowReplicationGroupOptions) validate() error {
+	return nil
+}
+
+// ReplicationGroup is a user friendly result for a SHOW REPLICATION GROUPS query.
+// SHOW REPLICATION GROUPS returns the same columns as SHOW FAILOVER GROUPS.
+type ReplicationGroup FailoverGroup
+
+func (v *ReplicationGroup) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+
+func (v *ReplicationGroup) ExternalID() ExternalObjectIdentifier {
+	return NewExternalObjectIdentifier(AccountIdentifier{
+		organizationName: v.OrganizationName,
+		accountName:      v.AccountName,
+		accountLocator:   v.AccountLocator,
+	}, v.ID())
+}
+
+func (v *ReplicationGroup) ObjectType() ObjectType {
+	return ObjectTypeReplicationGroup
+}
+
+// replicationGroupDBRow is used to decode the result of a SHOW REPLICATION GROUPS query.
+type replicationGroupDBRow failoverGroupDBRow
+
+func (row replicationGroupDBRow) convert() *ReplicationGroup {
+	return (*ReplicationGroup)(failoverGroupDBRow(row).convert())
+}
+
+func (v *replicationGroups) Show(ctx context.Context, opts *ShowReplicationGroupOptions) ([]ReplicationGroup, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[replicationGroupDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[replicationGroupDBRow, ReplicationGroup](dbRows)
+	return resultList, nil
+}
+
+func (v *replicationGroups) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ReplicationGroup, error) {
+	currentAccount, err := v.client.ContextFunctions.CurrentAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	replicationGroups, err := v.Show(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, replicationGroup := range replicationGroups {
+		if replicationGroup.ID() == id && replicationGroup.AccountLocator == currentAccount {
+			return &replicationGroup, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// showReplicationGroupDatabasesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-replication-group.
+type showReplicationGroupDatabasesOptions struct {
+	show      bool                    `ddl:"static" sql:"SHOW"`
+	databases bool                    `ddl:"static" sql:"DATABASES"`
+	in        AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupDatabasesOptions) validate() error {
+	if !ValidObjectIdentifier(opts.in) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowDatabases(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewAccountObjectIdentifier(row.Name)
+	}
+	return resultList, nil
+}
+
+// showReplicationGroupSharesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-replication-group.
+type showReplicationGroupSharesOptions struct {
+	show   bool                    `ddl:"static" sql:"SHOW"`
+	shares bool                    `ddl:"static" sql:"SHARES"`
+	in     AccountObjectIdentifier `ddl:"identifier" sql:"IN REPLICATION GROUP"`
+}
+
+func (opts *showReplicationGroupSharesOptions) validate() error {
+	if !ValidObjectIdentifier(opts.in) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *replicationGroups) ShowShares(ctx context.Context, id AccountObjectIdentifier) ([]AccountObjectIdentifier, error) {
+	opts := &showReplicationGroupSharesOptions{
+		in: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []struct {
+		Name string `db:"name"`
+	}{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]AccountObjectIdentifier, len(dest))
+	for i, row := range dest {
+		resultList[i] = NewExternalObjectIdentifierFromFullyQualifiedName(row.Name).objectIdentifier.(AccountObjectIdentifier)
+	}
+	return resultList, nil
+}
@@ -0,0 +1,179 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReplicationGroupsCreate(t *testing.T) {
+	t.Run("validation: invalid name", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			IfNotExists: Bool(true),
+			name:        NewAccountObjectIdentifier("rg1"),
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeShares,
+				PluralObjectTypeDatabases,
+			},
+			AllowedDatabases: []AccountObjectIdentifier{
+				NewAccountObjectIdentifier("db1"),
+			},
+			AllowedShares: []AccountObjectIdentifier{
+				NewAccountObjectIdentifier("share1"),
+			},
+			allowedAccounts: []AccountIdentifier{
+				NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+			},
+			IgnoreEditionCheck:  Bool(true),
+			ReplicationSchedule: String("10 MINUTE"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" OBJECT_TYPES = SHARES, DATABASES ALLOWED_DATABASES = "db1" ALLOWED_SHARES = "share1" ALLOWED_ACCOUNTS = "MY_ORG.MY_ACCOUNT" IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`)
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		opts := &CreateReplicationGroupOptions{
+			name: NewAccountObjectIdentifier("rg1"),
+			objectTypes: []PluralObjectType{
+				PluralObjectTypeDatabases,
+			},
+			allowedAccounts: []AccountIdentifier{
+				NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP "rg1" OBJECT_TYPES = DATABASES ALLOWED_ACCOUNTS = "MY_ORG.MY_ACCOUNT"`)
+	})
+}
+
+func TestReplicationGroupsCreateReplica(t *testing.T) {
+	t.Run("as replica of", func(t *testing.T) {
+		opts := &CreateReplicaReplicationGroupOptions{
+			IfNotExists:             Bool(true),
+			name:                    NewAccountObjectIdentifier("rg1"),
+			primaryReplicationGroup: NewExternalObjectIdentifierFromFullyQualifiedName("myorg.myaccount.rg1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE REPLICATION GROUP IF NOT EXISTS "rg1" AS REPLICA OF myorg.myaccount."rg1"`)
+	})
+}
+
+func TestReplicationGroupsAlterSource(t *testing.T) {
+	id := NewAccountObjectIdentifier("rg1")
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+		}
+		assertOptsInvalid(t, opts, errors.New("exactly one of SET, ADD, REMOVE, or NewName must be specified"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name:    id,
+			NewName: NewAccountObjectIdentifier("myrg1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" RENAME TO "myrg1"`)
+	})
+
+	t.Run("set object types and replication schedule", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Set: &ReplicationGroupSet{
+				ObjectTypes:         []PluralObjectType{PluralObjectTypeDatabases},
+				ReplicationSchedule: String("USING CRON 0 0 * * * UTC"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" SET OBJECT_TYPES = DATABASES REPLICATION_SCHEDULE = 'USING CRON 0 0 * * * UTC'`)
+	})
+
+	t.Run("add allowed databases", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Add: &ReplicationGroupAdd{
+				AllowedDatabases: []AccountObjectIdentifier{
+					NewAccountObjectIdentifier("db1"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" ADD "db1" TO ALLOWED_DATABASES`)
+	})
+
+	t.Run("remove allowed accounts", func(t *testing.T) {
+		opts := &AlterSourceReplicationGroupOptions{
+			name: id,
+			Remove: &ReplicationGroupRemove{
+				AllowedAccounts: []AccountIdentifier{
+					NewAccountIdentifier("MY_ORG", "MY_ACCOUNT"),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" REMOVE "MY_ORG.MY_ACCOUNT" FROM ALLOWED_ACCOUNTS`)
+	})
+}
+
+func TestReplicationGroupsAlterTarget(t *testing.T) {
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    NewAccountObjectIdentifier("rg1"),
+			Refresh: Bool(true),
+			Suspend: Bool(true),
+		}
+		assertOptsInvalid(t, opts, errors.New("must set one of [Refresh, Suspend, Resume]"))
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    NewAccountObjectIdentifier("rg1"),
+			Refresh: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" REFRESH`)
+	})
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &AlterTargetReplicationGroupOptions{
+			name:    NewAccountObjectIdentifier("rg1"),
+			Suspend: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER REPLICATION GROUP "rg1" SUSPEND`)
+	})
+}
+
+func TestReplicationGroupsDrop(t *testing.T) {
+	t.Run("with IfExists", func(t *testing.T) {
+		opts := &DropReplicationGroupOptions{
+			name:     NewAccountObjectIdentifier("rg1"),
+			IfExists: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP REPLICATION GROUP IF EXISTS "rg1"`)
+	})
+}
+
+func TestReplicationGroupsShow(t *testing.T) {
+	t.Run("without show options", func(t *testing.T) {
+		opts := &ShowReplicationGroupOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS`)
+	})
+
+	t.Run("with show options", func(t *testing.T) {
+		opts := &ShowReplicationGroupOptions{
+			InAccount: NewAccountIdentifierFromAccountLocator("abcd123"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW REPLICATION GROUPS IN ACCOUNT "abcd123"`)
+	})
+}
+
+func TestReplicationGroupsShowDatabases(t *testing.T) {
+	opts := &showReplicationGroupDatabasesOptions{
+		in: NewAccountObjectIdentifier("rg1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW DATABASES IN REPLICATION GROUP "rg1"`)
+}
+
+func TestReplicationGroupsShowShares(t *testing.T) {
+	opts := &showReplicationGroupSharesOptions{
+		in: NewAccountObjectIdentifier("rg1"),
+	}
+	assertOptsValidAndSQLEquals(t, opts, `SHOW SHARES IN REPLICATION GROUP "rg1"`)
+}
@@ -276,7 +276,6 @@ type AlterResourceMonitorOptions struct {
 	IfExists        *bool                   `ddl:"keyword" sql:"IF EXISTS"`
 	name            AccountObjectIdentifier `ddl:"identifier"`
 	Set             *ResourceMonitorSet     `ddl:"keyword" sql:"SET"`
-	NotifyUsers     *NotifyUsers            `ddl:"parameter,equals" sql:"NOTIFY_USERS"`
 	Triggers        []TriggerDefinition     `ddl:"keyword,no_comma" sql:"TRIGGERS"`
 }
 
@@ -287,6 +286,9 @@ func (opts *AlterResourceMonitorOptions) validate() error {
 	if opts.Set == nil {
 		return nil
 	}
+	if everyValueSet(opts.Set.NotifyUsers, opts.Set.RemoveAllNotifyUsers) {
+		return errOneOf("ResourceMonitorSet", "NotifyUsers", "RemoveAllNotifyUsers")
+	}
 	if (opts.Set.Frequency != nil && opts.Set.StartTimestamp == nil) || (opts.Set.Frequency == nil && opts.Set.StartTimestamp != nil) {
 		return errors.New("must specify frequency and start time together")
 	}
@@ -313,10 +315,13 @@ func (v *resourceMonitors) Alter(ctx context.Context, id AccountObjectIdentifier
 
 type ResourceMonitorSet struct {
 	// at least one
-	CreditQuota    *int       `ddl:"parameter,equals" sql:"CREDIT_QUOTA"`
-	Frequency      *Frequency `ddl:"parameter,equals" sql:"FREQUENCY"`
-	StartTimestamp *string    `ddl:"parameter,equals,single_quotes" sql:"START_TIMESTAMP"`
-	EndTimestamp   *string    `ddl:"parameter,equals,single_quotes" sql:"END_TIMESTAMP"`
+	CreditQuota    *int         `ddl:"parameter,equals" sql:"CREDIT_QUOTA"`
+	Frequency      *Frequency   `ddl:"parameter,equals" sql:"FREQUENCY"`
+	StartTimestamp *string      `ddl:"parameter,equals,single_quotes" sql:"START_TIMESTAMP"`
+	EndTimestamp   *string      `ddl:"parameter,equals,single_quotes" sql:"END_TIMESTAMP"`
+	NotifyUsers    *NotifyUsers `ddl:"parameter,equals" sql:"NOTIFY_USERS"`
+	// RemoveAllNotifyUsers removes all users from the notification list
+	RemoveAllNotifyUsers *bool `ddl:"keyword" sql:"NOTIFY_USERS = ()"`
 }
 
 // dropResourceMonitorOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-resource-monitor.
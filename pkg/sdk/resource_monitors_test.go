@@ -90,6 +90,48 @@ func TestResourceMonitorAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, "ALTER RESOURCE MONITOR %s SET CREDIT_QUOTA = %d FREQUENCY = %s START_TIMESTAMP = '%s'", id.FullyQualifiedName(), *newCreditQuota, newFrequency, newStartTimeStamp)
 	})
+
+	t.Run("set notify users", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set: &ResourceMonitorSet{
+				NotifyUsers: &NotifyUsers{Users: []NotifiedUser{{Name: "FIRST_USER"}, {Name: "SECOND_USER"}}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER RESOURCE MONITOR %s SET NOTIFY_USERS = ("FIRST_USER", "SECOND_USER")`, id.FullyQualifiedName())
+	})
+
+	t.Run("validation: notify users and remove all notify users together", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set: &ResourceMonitorSet{
+				NotifyUsers:          &NotifyUsers{Users: []NotifiedUser{{Name: "FIRST_USER"}}},
+				RemoveAllNotifyUsers: Bool(true),
+			},
+		}
+		assertOptsInvalid(t, opts, errOneOf("ResourceMonitorSet", "NotifyUsers", "RemoveAllNotifyUsers"))
+	})
+
+	t.Run("remove all notify users", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Set: &ResourceMonitorSet{
+				RemoveAllNotifyUsers: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER RESOURCE MONITOR %s SET NOTIFY_USERS = ()", id.FullyQualifiedName())
+	})
+
+	t.Run("only triggers", func(t *testing.T) {
+		opts := &AlterResourceMonitorOptions{
+			name: id,
+			Triggers: []TriggerDefinition{
+				{Threshold: 80, TriggerAction: TriggerActionSuspend},
+				{Threshold: 60, TriggerAction: TriggerActionNotify},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER RESOURCE MONITOR %s TRIGGERS ON 80 PERCENT DO SUSPEND ON 60 PERCENT DO NOTIFY", id.FullyQualifiedName())
+	})
 }
 
 func TestResourceMonitorDrop(t *testing.T) {
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
 )
@@ -41,7 +42,11 @@ func (v *roles) ShowByID(ctx context.Context, req *ShowRoleByIdRequest) (*Role,
 	if err != nil {
 		return nil, err
 	}
-	return collections.FindOne(roleList, func(r Role) bool { return r.ID().name == req.id.Name() })
+	role, err := collections.FindOne(roleList, func(r Role) bool { return r.ID().name == req.id.Name() })
+	if errors.Is(err, collections.ErrObjectNotFound) {
+		return nil, ErrObjectNotExistOrAuthorized
+	}
+	return role, err
 }
 
 func (v *roles) Grant(ctx context.Context, req *GrantRoleRequest) error {
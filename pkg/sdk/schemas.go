@@ -374,6 +374,10 @@ func (v *schemas) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*S
 		Like: &Like{
 			Pattern: String(id.Name()),
 		},
+		In: &SchemaIn{
+			Database: Bool(true),
+			Name:     NewAccountObjectIdentifier(id.DatabaseName()),
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,87 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+var _ Secrets = (*secrets)(nil)
+
+var _ validatable = new(ShowSecretOptions)
+
+// Secrets reads the secrets used e.g. by the external access integrations. The values of the secrets are never returned.
+type Secrets interface {
+	Show(ctx context.Context, opts *ShowSecretOptions) ([]Secret, error)
+}
+
+type secrets struct {
+	client *Client
+}
+
+// ShowSecretOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-secrets.
+type ShowSecretOptions struct {
+	show    bool  `ddl:"static" sql:"SHOW"`
+	secrets bool  `ddl:"static" sql:"SECRETS"`
+	Like    *Like `ddl:"keyword" sql:"LIKE"`
+	In      *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowSecretOptions) validate() error {
+	return nil
+}
+
+// Secret is a user friendly result for a SHOW SECRETS query.
+type Secret struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Owner        string
+	Comment      string
+	SecretType   string
+	OauthScopes  string
+}
+
+func (v *Secret) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Secret) ObjectType() ObjectType {
+	return ObjectTypeSecret
+}
+
+// secretDBRow is used to decode the result of a SHOW SECRETS query.
+type secretDBRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	SecretType   sql.NullString `db:"secret_type"`
+	OauthScopes  sql.NullString `db:"oauth_scopes"`
+}
+
+func (row secretDBRow) convert() *Secret {
+	return &Secret{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner.String,
+		Comment:      row.Comment.String,
+		SecretType:   row.SecretType.String,
+		OauthScopes:  row.OauthScopes.String,
+	}
+}
+
+func (v *secrets) Show(ctx context.Context, opts *ShowSecretOptions) ([]Secret, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[secretDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[secretDBRow, Secret](dbRows)
+	return resultList, nil
+}
@@ -0,0 +1,22 @@
+package sdk
+
+import "testing"
+
+func TestSecretsShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowSecretOptions{}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SECRETS`)
+	})
+
+	t.Run("like in schema", func(t *testing.T) {
+		opts := &ShowSecretOptions{
+			Like: &Like{
+				Pattern: String("secret1"),
+			},
+			In: &In{
+				Schema: NewDatabaseObjectIdentifier("db", "schema"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW SECRETS LIKE 'secret1' IN SCHEMA "db"."schema"`)
+	})
+}
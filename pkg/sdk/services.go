@@ -0,0 +1,336 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var _ Services = (*services)(nil)
+
+var (
+	_ validatable = new(ExecuteJobServiceOptions)
+	_ validatable = new(DropServiceOptions)
+	_ validatable = new(ShowServiceOptions)
+	_ validatable = new(showServiceEndpointsOptions)
+)
+
+// Services manages the Snowpark Container Services services, including the job services running to completion.
+type Services interface {
+	ExecuteJob(ctx context.Context, id SchemaObjectIdentifier, computePool AccountObjectIdentifier, opts *ExecuteJobServiceOptions) error
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropServiceOptions) error
+	Show(ctx context.Context, opts *ShowServiceOptions) ([]Service, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error)
+	GetStatus(ctx context.Context, id SchemaObjectIdentifier) ([]ServiceContainerStatus, error)
+	ShowEndpoints(ctx context.Context, id SchemaObjectIdentifier) ([]ServiceEndpoint, error)
+}
+
+// services implements Services.
+type services struct {
+	client *Client
+}
+
+// serviceSpecification encloses the YAML specification in dollar quotes, so that it does not have to be escaped.
+func serviceSpecification(specification string) string {
+	return fmt.Sprintf("$$%s$$", specification)
+}
+
+// ExecuteJobServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/execute-job-service.
+type ExecuteJobServiceOptions struct {
+	executeJobService          bool                      `ddl:"static" sql:"EXECUTE JOB SERVICE"`
+	computePool                AccountObjectIdentifier   `ddl:"identifier" sql:"IN COMPUTE POOL"`
+	FromStage                  *ServiceFromStage         `ddl:"keyword"`
+	FromSpecification          *string                   `ddl:"parameter,no_equals" sql:"FROM SPECIFICATION"`
+	name                       SchemaObjectIdentifier    `ddl:"identifier,equals" sql:"NAME"`
+	Async                      *bool                     `ddl:"parameter" sql:"ASYNC"`
+	QueryWarehouse             *AccountObjectIdentifier  `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	Comment                    *string                   `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Replicas                   *int                      `ddl:"parameter" sql:"REPLICAS"`
+}
+
+// ServiceFromStage points to the specification file uploaded to a stage, e.g. @db.schema.stage.
+type ServiceFromStage struct {
+	Stage             string `ddl:"parameter,no_equals" sql:"FROM"`
+	SpecificationFile string `ddl:"parameter,single_quotes" sql:"SPECIFICATION_FILE"`
+}
+
+func (opts *ExecuteJobServiceOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !ValidObjectIdentifier(opts.computePool) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.FromStage, opts.FromSpecification) {
+		return errors.New("exactly one of FromStage or FromSpecification must be specified")
+	}
+	if valueSet(opts.FromStage) && (!strings.HasPrefix(opts.FromStage.Stage, "@") || opts.FromStage.SpecificationFile == "") {
+		return errors.New("the stage must start with @ and the specification file must not be empty")
+	}
+	return nil
+}
+
+func (v *services) ExecuteJob(ctx context.Context, id SchemaObjectIdentifier, computePool AccountObjectIdentifier, opts *ExecuteJobServiceOptions) error {
+	if opts == nil {
+		opts = &ExecuteJobServiceOptions{}
+	}
+	opts.name = id
+	opts.computePool = computePool
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.FromSpecification != nil {
+		opts.FromSpecification = String(serviceSpecification(*opts.FromSpecification))
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-service.
+type DropServiceOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	service  bool                   `ddl:"static" sql:"SERVICE"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Force    *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+func (opts *DropServiceOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *services) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropServiceOptions) error {
+	if opts == nil {
+		opts = &DropServiceOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowServiceOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-services.
+type ShowServiceOptions struct {
+	show     bool  `ddl:"static" sql:"SHOW"`
+	Job      *bool `ddl:"keyword" sql:"JOB"`
+	services bool  `ddl:"static" sql:"SERVICES"`
+	Like     *Like `ddl:"keyword" sql:"LIKE"`
+	In       *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowServiceOptions) validate() error {
+	return nil
+}
+
+// Service is a user friendly result for a SHOW SERVICES query.
+type Service struct {
+	Name                       string
+	Status                     string
+	DatabaseName               string
+	SchemaName                 string
+	Owner                      string
+	ComputePool                string
+	DnsName                    string
+	MinInstances               int
+	MaxInstances               int
+	AutoResume                 bool
+	ExternalAccessIntegrations string
+	CreatedOn                  time.Time
+	Comment                    string
+	QueryWarehouse             string
+	IsJob                      bool
+}
+
+func (v *Service) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Service) ObjectType() ObjectType {
+	return ObjectTypeService
+}
+
+// serviceDBRow is used to decode the result of a SHOW SERVICES query.
+type serviceDBRow struct {
+	Name                       string         `db:"name"`
+	Status                     sql.NullString `db:"status"`
+	DatabaseName               string         `db:"database_name"`
+	SchemaName                 string         `db:"schema_name"`
+	Owner                      sql.NullString `db:"owner"`
+	ComputePool                string         `db:"compute_pool"`
+	DnsName                    sql.NullString `db:"dns_name"`
+	MinInstances               sql.NullInt64  `db:"min_instances"`
+	MaxInstances               sql.NullInt64  `db:"max_instances"`
+	AutoResume                 sql.NullBool   `db:"auto_resume"`
+	ExternalAccessIntegrations sql.NullString `db:"external_access_integrations"`
+	CreatedOn                  time.Time      `db:"created_on"`
+	Comment                    sql.NullString `db:"comment"`
+	QueryWarehouse             sql.NullString `db:"query_warehouse"`
+	IsJob                      sql.NullBool   `db:"is_job"`
+}
+
+func (row serviceDBRow) convert() *Service {
+	return &Service{
+		Name:                       row.Name,
+		Status:                     row.Status.String,
+		DatabaseName:               row.DatabaseName,
+		SchemaName:                 row.SchemaName,
+		Owner:                      row.Owner.String,
+		ComputePool:                row.ComputePool,
+		DnsName:                    row.DnsName.String,
+		MinInstances:               int(row.MinInstances.Int64),
+		MaxInstances:               int(row.MaxInstances.Int64),
+		AutoResume:                 row.AutoResume.Bool,
+		ExternalAccessIntegrations: row.ExternalAccessIntegrations.String,
+		CreatedOn:                  row.CreatedOn,
+		Comment:                    row.Comment.String,
+		QueryWarehouse:             row.QueryWarehouse.String,
+		IsJob:                      row.IsJob.Bool,
+	}
+}
+
+func (v *services) Show(ctx context.Context, opts *ShowServiceOptions) ([]Service, error) {
+	opts = createIfNil(opts)
+	dbRows, err := validateAndQuery[serviceDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[serviceDBRow, Service](dbRows)
+	return resultList, nil
+}
+
+func (v *services) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Service, error) {
+	services, err := v.Show(ctx, &ShowServiceOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range services {
+		if service.ID().name == id.Name() {
+			return &service, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// showServiceEndpointsOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-endpoints.
+type showServiceEndpointsOptions struct {
+	show bool                   `ddl:"static" sql:"SHOW ENDPOINTS IN SERVICE"`
+	name SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *showServiceEndpointsOptions) validate() error {
+	if !ValidObjectIdentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// ServiceEndpoint is an endpoint exposed by a service, as returned by SHOW ENDPOINTS.
+type ServiceEndpoint struct {
+	Name       string
+	Port       string
+	PortRange  string
+	Protocol   string
+	IsPublic   bool
+	IngressURL string
+}
+
+type serviceEndpointDBRow struct {
+	Name       string         `db:"name"`
+	Port       sql.NullString `db:"port"`
+	PortRange  sql.NullString `db:"port_range"`
+	Protocol   sql.NullString `db:"protocol"`
+	IsPublic   sql.NullBool   `db:"is_public"`
+	IngressURL sql.NullString `db:"ingress_url"`
+}
+
+func (row serviceEndpointDBRow) convert() *ServiceEndpoint {
+	return &ServiceEndpoint{
+		Name:       row.Name,
+		Port:       row.Port.String,
+		PortRange:  row.PortRange.String,
+		Protocol:   row.Protocol.String,
+		IsPublic:   row.IsPublic.Bool,
+		IngressURL: row.IngressURL.String,
+	}
+}
+
+// ShowEndpoints returns the endpoints of the service. The ingress URL of the public endpoints is empty until it is
+// provisioned, which takes a few minutes after the service is created.
+func (v *services) ShowEndpoints(ctx context.Context, id SchemaObjectIdentifier) ([]ServiceEndpoint, error) {
+	opts := &showServiceEndpointsOptions{
+		name: id,
+	}
+	dbRows, err := validateAndQuery[serviceEndpointDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[serviceEndpointDBRow, ServiceEndpoint](dbRows), nil
+}
+
+type ServiceContainerStatusValue string
+
+const (
+	ServiceContainerStatusPending  ServiceContainerStatusValue = "PENDING"
+	ServiceContainerStatusReady    ServiceContainerStatusValue = "READY"
+	ServiceContainerStatusDone     ServiceContainerStatusValue = "DONE"
+	ServiceContainerStatusFailed   ServiceContainerStatusValue = "FAILED"
+	ServiceContainerStatusUnknown  ServiceContainerStatusValue = "UNKNOWN"
+	ServiceContainerStatusInternal ServiceContainerStatusValue = "INTERNAL_ERROR"
+)
+
+// ServiceContainerStatus is the status of a single container of a service, as returned by SYSTEM$GET_SERVICE_STATUS.
+type ServiceContainerStatus struct {
+	Status        ServiceContainerStatusValue `json:"status"`
+	Message       string                      `json:"message"`
+	ContainerName string                      `json:"containerName"`
+	InstanceID    string                      `json:"instanceId"`
+	ServiceName   string                      `json:"serviceName"`
+	Image         string                      `json:"image"`
+	RestartCount  int                         `json:"restartCount"`
+	StartTime     string                      `json:"startTime"`
+}
+
+// GetStatus returns the status of the containers of the service based on https://docs.snowflake.com/en/sql-reference/functions/system_get_service_status.
+func (v *services) GetStatus(ctx context.Context, id SchemaObjectIdentifier) ([]ServiceContainerStatus, error) {
+	row := &struct {
+		ServiceStatus string `db:"SERVICE_STATUS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$GET_SERVICE_STATUS('%s') AS "SERVICE_STATUS"`, id.FullyQualifiedName())
+	if err := v.client.queryOne(ctx, row, sql); err != nil {
+		return nil, err
+	}
+	return parseServiceContainerStatuses(row.ServiceStatus)
+}
+
+func parseServiceContainerStatuses(serviceStatus string) ([]ServiceContainerStatus, error) {
+	var statuses []ServiceContainerStatus
+	if err := json.Unmarshal([]byte(serviceStatus), &statuses); err != nil {
+		return nil, fmt.Errorf("unable to parse the service status %s err = %w", serviceStatus, err)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,119 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicesExecuteJob(t *testing.T) {
+	id := NewSchemaObjectIdentifier("db", "schema", "job1")
+	computePool := NewAccountObjectIdentifier("pool1")
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &ExecuteJobServiceOptions{
+			computePool:       computePool,
+			FromSpecification: String("$$spec: {}$$"),
+		}
+		assertOptsInvalid(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: no specification", func(t *testing.T) {
+		opts := &ExecuteJobServiceOptions{
+			name:        id,
+			computePool: computePool,
+		}
+		assertOptsInvalid(t, opts, errors.New("exactly one of FromStage or FromSpecification must be specified"))
+	})
+
+	t.Run("validation: stage without @", func(t *testing.T) {
+		opts := &ExecuteJobServiceOptions{
+			name:        id,
+			computePool: computePool,
+			FromStage:   &ServiceFromStage{Stage: "db.schema.stage", SpecificationFile: "job.yaml"},
+		}
+		assertOptsInvalid(t, opts, errors.New("the stage must start with @ and the specification file must not be empty"))
+	})
+
+	t.Run("from specification", func(t *testing.T) {
+		opts := &ExecuteJobServiceOptions{
+			name:              id,
+			computePool:       computePool,
+			FromSpecification: String(serviceSpecification("spec: {}")),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `EXECUTE JOB SERVICE IN COMPUTE POOL "pool1" FROM SPECIFICATION $$spec: {}$$ NAME = "db"."schema"."job1"`)
+	})
+
+	t.Run("from stage with all options", func(t *testing.T) {
+		opts := &ExecuteJobServiceOptions{
+			name:                       id,
+			computePool:                computePool,
+			FromStage:                  &ServiceFromStage{Stage: "@db.schema.stage", SpecificationFile: "job.yaml"},
+			Async:                      Bool(true),
+			QueryWarehouse:             Pointer(NewAccountObjectIdentifier("wh1")),
+			Comment:                    String("migration"),
+			ExternalAccessIntegrations: []AccountObjectIdentifier{NewAccountObjectIdentifier("eai1"), NewAccountObjectIdentifier("eai2")},
+			Replicas:                   Int(2),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `EXECUTE JOB SERVICE IN COMPUTE POOL "pool1" FROM @db.schema.stage SPECIFICATION_FILE = 'job.yaml' NAME = "db"."schema"."job1" ASYNC = true QUERY_WAREHOUSE = "wh1" COMMENT = 'migration' EXTERNAL_ACCESS_INTEGRATIONS = ("eai1", "eai2") REPLICAS = 2`)
+	})
+}
+
+func TestServicesDrop(t *testing.T) {
+	t.Run("if exists", func(t *testing.T) {
+		opts := &DropServiceOptions{
+			IfExists: Bool(true),
+			name:     NewSchemaObjectIdentifier("db", "schema", "job1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `DROP SERVICE IF EXISTS "db"."schema"."job1"`)
+	})
+}
+
+func TestServicesShow(t *testing.T) {
+	t.Run("job services like in schema", func(t *testing.T) {
+		opts := &ShowServiceOptions{
+			Job: Bool(true),
+			Like: &Like{
+				Pattern: String("job1"),
+			},
+			In: &In{
+				Schema: NewDatabaseObjectIdentifier("db", "schema"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW JOB SERVICES LIKE 'job1' IN SCHEMA "db"."schema"`)
+	})
+}
+
+func TestServicesShowEndpoints(t *testing.T) {
+	t.Run("in service", func(t *testing.T) {
+		opts := &showServiceEndpointsOptions{
+			name: NewSchemaObjectIdentifier("db", "schema", "service1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW ENDPOINTS IN SERVICE "db"."schema"."service1"`)
+	})
+
+	t.Run("validation: invalid identifier", func(t *testing.T) {
+		opts := &showServiceEndpointsOptions{
+			name: NewSchemaObjectIdentifier("", "", ""),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestParseServiceContainerStatuses(t *testing.T) {
+	t.Run("finished job", func(t *testing.T) {
+		statuses, err := parseServiceContainerStatuses(`[{"status":"DONE","message":"Completed successfully","containerName":"main","instanceId":"0","serviceName":"JOB1","image":"/db/schema/repo/image:latest","restartCount":0,"startTime":""}]`)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, ServiceContainerStatusDone, statuses[0].Status)
+		assert.Equal(t, "Completed successfully", statuses[0].Message)
+		assert.Equal(t, "main", statuses[0].ContainerName)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, err := parseServiceContainerStatuses("not json")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,114 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+)
+
+var _ StageFiles = (*stageFiles)(nil)
+
+// StageFiles manages the files stored in internal named stages.
+type StageFiles interface {
+	Put(ctx context.Context, localFile string, location StageLocation, opts *PutStageFileOptions) error
+	List(ctx context.Context, location StageLocation) ([]StageFile, error)
+	Remove(ctx context.Context, location StageLocation) error
+}
+
+// stageFiles implements StageFiles.
+type stageFiles struct {
+	client *Client
+}
+
+// StageLocation is a path inside of a named stage, e.g. @"db"."schema"."stage"/path/file.jar.
+type StageLocation struct {
+	Stage SchemaObjectIdentifier
+	Path  string
+}
+
+func NewStageLocation(stage SchemaObjectIdentifier, path string) StageLocation {
+	return StageLocation{Stage: stage, Path: strings.Trim(path, "/")}
+}
+
+func (l StageLocation) String() string {
+	if l.Path == "" {
+		return "@" + l.Stage.FullyQualifiedName()
+	}
+	return fmt.Sprintf("@%s/%s", l.Stage.FullyQualifiedName(), l.Path)
+}
+
+// PutStageFileOptions is based on https://docs.snowflake.com/en/sql-reference/sql/put.
+type PutStageFileOptions struct {
+	AutoCompress *bool
+	Overwrite    *bool
+}
+
+// StageFile is a row of LIST based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type StageFile struct {
+	Name         string
+	Size         int64
+	MD5          string
+	LastModified string
+}
+
+type stageFileRow struct {
+	Name         string `db:"name"`
+	Size         int64  `db:"size"`
+	MD5          string `db:"md5"`
+	LastModified string `db:"last_modified"`
+}
+
+// Put uploads the local file to the stage location, which is the directory of the file in the stage.
+func (v *stageFiles) Put(ctx context.Context, localFile string, location StageLocation, opts *PutStageFileOptions) error {
+	if !ValidObjectIdentifier(location.Stage) {
+		return ErrInvalidObjectIdentifier
+	}
+	if localFile == "" {
+		return errNotSet("PutStageFileOptions", "localFile")
+	}
+	sql := fmt.Sprintf(`PUT 'file://%s' %s`, strings.ReplaceAll(localFile, `'`, `\'`), location)
+	if opts != nil && opts.AutoCompress != nil {
+		sql += fmt.Sprintf(" AUTO_COMPRESS = %t", *opts.AutoCompress)
+	}
+	if opts != nil && opts.Overwrite != nil {
+		sql += fmt.Sprintf(" OVERWRITE = %t", *opts.Overwrite)
+	}
+	_, err := v.client.exec(ctx, sql)
+	return err
+}
+
+// List returns the files of the stage location. The location is a prefix, so it matches the files of the
+// directories too.
+func (v *stageFiles) List(ctx context.Context, location StageLocation) ([]StageFile, error) {
+	if !ValidObjectIdentifier(location.Stage) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	var rows []stageFileRow
+	if err := v.client.query(ctx, &rows, fmt.Sprintf(`LIST %s`, location)); err != nil {
+		return nil, err
+	}
+	files := make([]StageFile, len(rows))
+	for i, row := range rows {
+		files[i] = StageFile(row)
+	}
+	return files, nil
+}
+
+// Remove removes the files of the stage location.
+func (v *stageFiles) Remove(ctx context.Context, location StageLocation) error {
+	if !ValidObjectIdentifier(location.Stage) {
+		return ErrInvalidObjectIdentifier
+	}
+	_, err := v.client.exec(ctx, fmt.Sprintf(`REMOVE %s`, location))
+	return err
+}
+
+// PathInStage returns the path of the listed file relative to its stage, as LIST prefixes it with the stage name.
+func (f StageFile) PathInStage() string {
+	_, p, found := strings.Cut(f.Name, "/")
+	if !found {
+		return f.Name
+	}
+	return path.Clean(p)
+}
@@ -0,0 +1,19 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageLocation(t *testing.T) {
+	stage := NewSchemaObjectIdentifier("db", "schema", "stage")
+
+	require.Equal(t, `@"db"."schema"."stage"`, NewStageLocation(stage, "").String())
+	require.Equal(t, `@"db"."schema"."stage"/jars/v1`, NewStageLocation(stage, "/jars/v1/").String())
+}
+
+func TestStageFilePathInStage(t *testing.T) {
+	require.Equal(t, "jars/v1/udf.jar", StageFile{Name: "stage/jars/v1/udf.jar"}.PathInStage())
+	require.Equal(t, "udf.jar", StageFile{Name: "stage/udf.jar"}.PathInStage())
+}
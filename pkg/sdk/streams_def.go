@@ -137,6 +137,25 @@ var (
 				WithValidation(g.ValidIdentifier, "ViewId").
 				WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
 		).
+		CustomOperation(
+			"CreateOnDynamicTable",
+			"https://docs.snowflake.com/en/sql-reference/sql/create-stream",
+			g.QueryStruct("CreateStreamOnDynamicTable").
+				Create().
+				OrReplace().
+				SQL("STREAM").
+				IfNotExists().
+				Name().
+				OptionalCopyGrants().
+				SQL("ON DYNAMIC TABLE").
+				Identifier("DynamicTableId", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+				OptionalQueryStructField("On", onStreamDef, g.KeywordOptions()).
+				OptionalBooleanAssignment("SHOW_INITIAL_ROWS", nil).
+				OptionalComment().
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ValidIdentifier, "DynamicTableId").
+				WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+		).
 		CustomOperation(
 			"Clone",
 			"https://docs.snowflake.com/en/sql-reference/sql/create-stream#variant-syntax",
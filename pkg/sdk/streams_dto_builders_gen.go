@@ -205,6 +205,46 @@ func (s *CreateOnViewStreamRequest) WithComment(Comment *string) *CreateOnViewSt
 	return s
 }
 
+func NewCreateStreamOnDynamicTableRequest(
+	name SchemaObjectIdentifier,
+	DynamicTableId SchemaObjectIdentifier,
+) *CreateOnDynamicTableStreamRequest {
+	s := CreateOnDynamicTableStreamRequest{}
+	s.name = name
+	s.DynamicTableId = DynamicTableId
+	return &s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithOrReplace(OrReplace *bool) *CreateOnDynamicTableStreamRequest {
+	s.OrReplace = OrReplace
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithIfNotExists(IfNotExists *bool) *CreateOnDynamicTableStreamRequest {
+	s.IfNotExists = IfNotExists
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithCopyGrants(CopyGrants *bool) *CreateOnDynamicTableStreamRequest {
+	s.CopyGrants = CopyGrants
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithOn(On *OnStreamRequest) *CreateOnDynamicTableStreamRequest {
+	s.On = On
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithShowInitialRows(ShowInitialRows *bool) *CreateOnDynamicTableStreamRequest {
+	s.ShowInitialRows = ShowInitialRows
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithComment(Comment *string) *CreateOnDynamicTableStreamRequest {
+	s.Comment = Comment
+	return s
+}
+
 func NewCloneStreamRequest(
 	name SchemaObjectIdentifier,
 	sourceStream SchemaObjectIdentifier,
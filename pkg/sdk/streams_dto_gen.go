@@ -7,6 +7,7 @@ var (
 	_ optionsProvider[CreateOnExternalTableStreamOptions]  = new(CreateOnExternalTableStreamRequest)
 	_ optionsProvider[CreateOnDirectoryTableStreamOptions] = new(CreateOnDirectoryTableStreamRequest)
 	_ optionsProvider[CreateOnViewStreamOptions]           = new(CreateOnViewStreamRequest)
+	_ optionsProvider[CreateOnDynamicTableStreamOptions]   = new(CreateOnDynamicTableStreamRequest)
 	_ optionsProvider[CloneStreamOptions]                  = new(CloneStreamRequest)
 	_ optionsProvider[AlterStreamOptions]                  = new(AlterStreamRequest)
 	_ optionsProvider[DropStreamOptions]                   = new(DropStreamRequest)
@@ -71,6 +72,17 @@ type CreateOnViewStreamRequest struct {
 	Comment         *string
 }
 
+type CreateOnDynamicTableStreamRequest struct {
+	OrReplace       *bool
+	IfNotExists     *bool
+	name            SchemaObjectIdentifier // required
+	CopyGrants      *bool
+	DynamicTableId  SchemaObjectIdentifier // required
+	On              *OnStreamRequest
+	ShowInitialRows *bool
+	Comment         *string
+}
+
 type CloneStreamRequest struct {
 	OrReplace    *bool
 	name         SchemaObjectIdentifier // required
@@ -285,6 +285,73 @@ func TestStreams_CreateOnView(t *testing.T) {
 	})
 }
 
+func TestStreams_CreateOnDynamicTable(t *testing.T) {
+	id := RandomSchemaObjectIdentifier()
+	dynamicTableId := RandomSchemaObjectIdentifier()
+
+	// Minimal valid CreateOnDynamicTableStreamOptions
+	defaultOpts := func() *CreateOnDynamicTableStreamOptions {
+		return &CreateOnDynamicTableStreamOptions{
+			name:           id,
+			DynamicTableId: dynamicTableId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOnDynamicTableStreamOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.DynamicTableId]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DynamicTableId = NewSchemaObjectIdentifier("", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOnDynamicTableStreamOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.At opts.On.Before] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On = &OnStream{
+			Statement: OnStreamStatement{
+				Offset: String("-60"),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("At", "Before"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE STREAM %s ON DYNAMIC TABLE %s", id.FullyQualifiedName(), dynamicTableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.CopyGrants = Bool(true)
+		opts.On = &OnStream{
+			At: Bool(true),
+			Statement: OnStreamStatement{
+				Offset: String("-60"),
+			},
+		}
+		opts.ShowInitialRows = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE STREAM %s COPY GRANTS ON DYNAMIC TABLE %s AT (OFFSET => -60) SHOW_INITIAL_ROWS = true COMMENT = 'some comment'`, id.FullyQualifiedName(), dynamicTableId.FullyQualifiedName())
+	})
+}
+
 func TestStreams_Clone(t *testing.T) {
 	id := RandomSchemaObjectIdentifier()
 	sourceId := RandomSchemaObjectIdentifier()
@@ -2,11 +2,14 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 )
 
 type SystemFunctions interface {
 	GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error)
+	PipeStatus(ctx context.Context, pipeId SchemaObjectIdentifier) (PipeExecutionState, error)
 }
 
 var _ SystemFunctions = (*systemFunctions)(nil)
@@ -17,12 +20,67 @@ type systemFunctions struct {
 
 func (c *systemFunctions) GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error) {
 	s := &struct {
-		Tag string `db:"TAG"`
+		Tag sql.NullString `db:"TAG"`
 	}{}
-	sql := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
-	err := c.client.queryOne(ctx, s, sql)
+	stmt := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
+	err := c.client.queryOne(ctx, s, stmt)
 	if err != nil {
 		return "", err
 	}
-	return s.Tag, nil
+	// SYSTEM$GET_TAG returns NULL when neither the object nor its parents have the tag
+	if !s.Tag.Valid {
+		return "", ErrTagNotSet
+	}
+	return s.Tag.String, nil
+}
+
+type PipeExecutionState string
+
+const (
+	FailingOverPipeExecutionState                           PipeExecutionState = "FAILING_OVER"
+	PausedPipeExecutionState                                PipeExecutionState = "PAUSED"
+	ReadOnlyPipeExecutionState                              PipeExecutionState = "READ_ONLY"
+	RunningPipeExecutionState                               PipeExecutionState = "RUNNING"
+	StoppedBySnowflakeAdminPipeExecutionState               PipeExecutionState = "STOPPED_BY_SNOWFLAKE_ADMIN"
+	StoppedClonedPipeExecutionState                         PipeExecutionState = "STOPPED_CLONED"
+	StoppedFeatureDisabledPipeExecutionState                PipeExecutionState = "STOPPED_FEATURE_DISABLED"
+	StoppedStageAlteredPipeExecutionState                   PipeExecutionState = "STOPPED_STAGE_ALTERED"
+	StoppedStageDroppedPipeExecutionState                   PipeExecutionState = "STOPPED_STAGE_DROPPED"
+	StoppedFileFormatDroppedPipeExecutionState              PipeExecutionState = "STOPPED_FILE_FORMAT_DROPPED"
+	StoppedNotificationIntegrationDroppedPipeExecutionState PipeExecutionState = "STOPPED_NOTIFICATION_INTEGRATION_DROPPED"
+	StoppedMissingPipePipeExecutionState                    PipeExecutionState = "STOPPED_MISSING_PIPE"
+	StoppedMissingTablePipeExecutionState                   PipeExecutionState = "STOPPED_MISSING_TABLE"
+	StalledCompilationErrorPipeExecutionState               PipeExecutionState = "STALLED_COMPILATION_ERROR"
+	StalledInitializationErrorPipeExecutionState            PipeExecutionState = "STALLED_INITIALIZATION_ERROR"
+	StalledExecutionErrorPipeExecutionState                 PipeExecutionState = "STALLED_EXECUTION_ERROR"
+	StalledInternalErrorPipeExecutionState                  PipeExecutionState = "STALLED_INTERNAL_ERROR"
+	StalledStagePermissionErrorPipeExecutionState           PipeExecutionState = "STALLED_STAGE_PERMISSION_ERROR"
+)
+
+// PipeStatus returns the execution state of the pipe based on https://docs.snowflake.com/en/sql-reference/functions/system_pipe_status.
+func (c *systemFunctions) PipeStatus(ctx context.Context, pipeId SchemaObjectIdentifier) (PipeExecutionState, error) {
+	row := &struct {
+		PipeStatus string `db:"PIPE_STATUS"`
+	}{}
+	sql := fmt.Sprintf(`SELECT SYSTEM$PIPE_STATUS('%s') AS "PIPE_STATUS"`, pipeId.FullyQualifiedName())
+
+	err := c.client.queryOne(ctx, row, sql)
+	if err != nil {
+		return "", err
+	}
+
+	return parsePipeExecutionState(row.PipeStatus)
+}
+
+func parsePipeExecutionState(pipeStatus string) (PipeExecutionState, error) {
+	var status map[string]any
+	if err := json.Unmarshal([]byte(pipeStatus), &status); err != nil {
+		return "", err
+	}
+
+	if executionState, ok := status["executionState"].(string); ok {
+		return PipeExecutionState(executionState), nil
+	}
+
+	return "", fmt.Errorf("executionState key not found in the pipe status: %s", pipeStatus)
 }
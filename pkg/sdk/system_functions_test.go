@@ -0,0 +1,32 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePipeExecutionState(t *testing.T) {
+	t.Run("running pipe", func(t *testing.T) {
+		state, err := parsePipeExecutionState(`{"executionState":"RUNNING","pendingFileCount":0}`)
+		require.NoError(t, err)
+		assert.Equal(t, RunningPipeExecutionState, state)
+	})
+
+	t.Run("paused pipe", func(t *testing.T) {
+		state, err := parsePipeExecutionState(`{"executionState":"PAUSED","pendingFileCount":0}`)
+		require.NoError(t, err)
+		assert.Equal(t, PausedPipeExecutionState, state)
+	})
+
+	t.Run("missing execution state", func(t *testing.T) {
+		_, err := parsePipeExecutionState(`{"pendingFileCount":0}`)
+		require.ErrorContains(t, err, "executionState key not found")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parsePipeExecutionState(`not a json`)
+		require.Error(t, err)
+	})
+}
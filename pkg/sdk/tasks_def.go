@@ -24,7 +24,8 @@ var taskDbRow = g.DbStruct("taskDBRow").
 	Field("last_suspended_on", "string").
 	Field("owner_role_type", "string").
 	Field("config", "string").
-	Field("budget", "string")
+	Field("budget", "string").
+	Field("task_relations", "string")
 
 var task = g.PlainStruct("Task").
 	Field("CreatedOn", "string").
@@ -46,7 +47,8 @@ var task = g.PlainStruct("Task").
 	Field("LastSuspendedOn", "string").
 	Field("OwnerRoleType", "string").
 	Field("Config", "string").
-	Field("Budget", "string")
+	Field("Budget", "string").
+	Field("TaskRelations", "TaskRelations")
 
 var TasksDef = g.NewInterface(
 	"Tasks",
@@ -75,16 +77,20 @@ var TasksDef = g.NewInterface(
 			OptionalSessionParameters().
 			OptionalNumberAssignment("USER_TASK_TIMEOUT_MS", nil).
 			OptionalNumberAssignment("SUSPEND_TASK_AFTER_NUM_FAILURES", nil).
+			OptionalNumberAssignment("TASK_AUTO_RETRY_ATTEMPTS", nil).
 			OptionalTextAssignment("ERROR_INTEGRATION", g.ParameterOptions().NoQuotes()).
 			OptionalSQL("COPY GRANTS").
 			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalIdentifier("Finalize", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("FINALIZE")).
 			ListAssignment("AFTER", "SchemaObjectIdentifier", g.ParameterOptions().NoEquals()).
 			WithTags().
 			OptionalTextAssignment("WHEN", g.ParameterOptions().NoQuotes().NoEquals()).
 			SQL("AS").
 			Text("sql", g.KeywordOptions().NoQuotes().Required()).
 			WithValidation(g.ValidIdentifier, "name").
-			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ConflictingFields, "Finalize", "After").
+			WithValidation(g.ConflictingFields, "Finalize", "Schedule"),
 	).
 	CustomOperation(
 		"Clone",
@@ -111,6 +117,8 @@ var TasksDef = g.NewInterface(
 			OptionalSQL("SUSPEND").
 			ListAssignment("REMOVE AFTER", "SchemaObjectIdentifier", g.ParameterOptions().NoEquals()).
 			ListAssignment("ADD AFTER", "SchemaObjectIdentifier", g.ParameterOptions().NoEquals()).
+			OptionalIdentifier("SetFinalize", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("SET FINALIZE")).
+			OptionalSQL("UNSET FINALIZE").
 			OptionalQueryStructField(
 				"Set",
 				g.QueryStruct("TaskSet").
@@ -121,10 +129,11 @@ var TasksDef = g.NewInterface(
 					OptionalBooleanAssignment("ALLOW_OVERLAPPING_EXECUTION", nil).
 					OptionalNumberAssignment("USER_TASK_TIMEOUT_MS", nil).
 					OptionalNumberAssignment("SUSPEND_TASK_AFTER_NUM_FAILURES", nil).
+					OptionalNumberAssignment("TASK_AUTO_RETRY_ATTEMPTS", nil).
 					OptionalTextAssignment("ERROR_INTEGRATION", g.ParameterOptions().NoQuotes()).
 					OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 					OptionalSessionParameters().
-					WithValidation(g.AtLeastOneValueSet, "Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "ErrorIntegration", "Comment", "SessionParameters").
+					WithValidation(g.AtLeastOneValueSet, "Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "ErrorIntegration", "Comment", "SessionParameters").
 					WithValidation(g.ConflictingFields, "Warehouse", "UserTaskManagedInitialWarehouseSize"),
 				g.KeywordOptions().SQL("SET"),
 			).
@@ -132,15 +141,17 @@ var TasksDef = g.NewInterface(
 				"Unset",
 				g.QueryStruct("TaskUnset").
 					OptionalSQL("WAREHOUSE").
+					OptionalSQL("USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE").
 					OptionalSQL("SCHEDULE").
 					OptionalSQL("CONFIG").
 					OptionalSQL("ALLOW_OVERLAPPING_EXECUTION").
 					OptionalSQL("USER_TASK_TIMEOUT_MS").
 					OptionalSQL("SUSPEND_TASK_AFTER_NUM_FAILURES").
+					OptionalSQL("TASK_AUTO_RETRY_ATTEMPTS").
 					OptionalSQL("ERROR_INTEGRATION").
 					OptionalSQL("COMMENT").
 					OptionalSessionParametersUnset().
-					WithValidation(g.AtLeastOneValueSet, "Warehouse", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "ErrorIntegration", "Comment", "SessionParametersUnset"),
+					WithValidation(g.AtLeastOneValueSet, "Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "ErrorIntegration", "Comment", "SessionParametersUnset"),
 				g.KeywordOptions().SQL("UNSET"),
 			).
 			SetTags().
@@ -148,7 +159,7 @@ var TasksDef = g.NewInterface(
 			OptionalTextAssignment("MODIFY AS", g.ParameterOptions().NoQuotes().NoEquals()).
 			OptionalTextAssignment("MODIFY WHEN", g.ParameterOptions().NoQuotes().NoEquals()).
 			WithValidation(g.ValidIdentifier, "name").
-			WithValidation(g.ExactlyOneValueSet, "Resume", "Suspend", "RemoveAfter", "AddAfter", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"),
+			WithValidation(g.ExactlyOneValueSet, "Resume", "Suspend", "RemoveAfter", "AddAfter", "SetFinalize", "UnsetFinalize", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"),
 	).
 	DropOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/drop-task",
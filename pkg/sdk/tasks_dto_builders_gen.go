@@ -59,6 +59,11 @@ func (s *CreateTaskRequest) WithSuspendTaskAfterNumFailures(SuspendTaskAfterNumF
 	return s
 }
 
+func (s *CreateTaskRequest) WithTaskAutoRetryAttempts(TaskAutoRetryAttempts *int) *CreateTaskRequest {
+	s.TaskAutoRetryAttempts = TaskAutoRetryAttempts
+	return s
+}
+
 func (s *CreateTaskRequest) WithErrorIntegration(ErrorIntegration *string) *CreateTaskRequest {
 	s.ErrorIntegration = ErrorIntegration
 	return s
@@ -74,6 +79,11 @@ func (s *CreateTaskRequest) WithComment(Comment *string) *CreateTaskRequest {
 	return s
 }
 
+func (s *CreateTaskRequest) WithFinalize(Finalize *SchemaObjectIdentifier) *CreateTaskRequest {
+	s.Finalize = Finalize
+	return s
+}
+
 func (s *CreateTaskRequest) WithAfter(After []SchemaObjectIdentifier) *CreateTaskRequest {
 	s.After = After
 	return s
@@ -156,6 +166,16 @@ func (s *AlterTaskRequest) WithAddAfter(AddAfter []SchemaObjectIdentifier) *Alte
 	return s
 }
 
+func (s *AlterTaskRequest) WithSetFinalize(SetFinalize *SchemaObjectIdentifier) *AlterTaskRequest {
+	s.SetFinalize = SetFinalize
+	return s
+}
+
+func (s *AlterTaskRequest) WithUnsetFinalize(UnsetFinalize *bool) *AlterTaskRequest {
+	s.UnsetFinalize = UnsetFinalize
+	return s
+}
+
 func (s *AlterTaskRequest) WithSet(Set *TaskSetRequest) *AlterTaskRequest {
 	s.Set = Set
 	return s
@@ -225,6 +245,11 @@ func (s *TaskSetRequest) WithSuspendTaskAfterNumFailures(SuspendTaskAfterNumFail
 	return s
 }
 
+func (s *TaskSetRequest) WithTaskAutoRetryAttempts(TaskAutoRetryAttempts *int) *TaskSetRequest {
+	s.TaskAutoRetryAttempts = TaskAutoRetryAttempts
+	return s
+}
+
 func (s *TaskSetRequest) WithErrorIntegration(ErrorIntegration *string) *TaskSetRequest {
 	s.ErrorIntegration = ErrorIntegration
 	return s
@@ -249,6 +274,11 @@ func (s *TaskUnsetRequest) WithWarehouse(Warehouse *bool) *TaskUnsetRequest {
 	return s
 }
 
+func (s *TaskUnsetRequest) WithUserTaskManagedInitialWarehouseSize(UserTaskManagedInitialWarehouseSize *bool) *TaskUnsetRequest {
+	s.UserTaskManagedInitialWarehouseSize = UserTaskManagedInitialWarehouseSize
+	return s
+}
+
 func (s *TaskUnsetRequest) WithSchedule(Schedule *bool) *TaskUnsetRequest {
 	s.Schedule = Schedule
 	return s
@@ -274,6 +304,11 @@ func (s *TaskUnsetRequest) WithSuspendTaskAfterNumFailures(SuspendTaskAfterNumFa
 	return s
 }
 
+func (s *TaskUnsetRequest) WithTaskAutoRetryAttempts(TaskAutoRetryAttempts *bool) *TaskUnsetRequest {
+	s.TaskAutoRetryAttempts = TaskAutoRetryAttempts
+	return s
+}
+
 func (s *TaskUnsetRequest) WithErrorIntegration(ErrorIntegration *bool) *TaskUnsetRequest {
 	s.ErrorIntegration = ErrorIntegration
 	return s
@@ -23,9 +23,11 @@ type CreateTaskRequest struct {
 	SessionParameters           *SessionParameters
 	UserTaskTimeoutMs           *int
 	SuspendTaskAfterNumFailures *int
+	TaskAutoRetryAttempts       *int
 	ErrorIntegration            *string
 	CopyGrants                  *bool
 	Comment                     *string
+	Finalize                    *SchemaObjectIdentifier
 	After                       []SchemaObjectIdentifier
 	Tag                         []TagAssociation
 	When                        *string
@@ -53,18 +55,20 @@ func (r *CloneTaskRequest) GetName() SchemaObjectIdentifier {
 }
 
 type AlterTaskRequest struct {
-	IfExists    *bool
-	name        SchemaObjectIdentifier // required
-	Resume      *bool
-	Suspend     *bool
-	RemoveAfter []SchemaObjectIdentifier
-	AddAfter    []SchemaObjectIdentifier
-	Set         *TaskSetRequest
-	Unset       *TaskUnsetRequest
-	SetTags     []TagAssociation
-	UnsetTags   []ObjectIdentifier
-	ModifyAs    *string
-	ModifyWhen  *string
+	IfExists      *bool
+	name          SchemaObjectIdentifier // required
+	Resume        *bool
+	Suspend       *bool
+	RemoveAfter   []SchemaObjectIdentifier
+	AddAfter      []SchemaObjectIdentifier
+	SetFinalize   *SchemaObjectIdentifier
+	UnsetFinalize *bool
+	Set           *TaskSetRequest
+	Unset         *TaskUnsetRequest
+	SetTags       []TagAssociation
+	UnsetTags     []ObjectIdentifier
+	ModifyAs      *string
+	ModifyWhen    *string
 }
 
 type TaskSetRequest struct {
@@ -75,21 +79,24 @@ type TaskSetRequest struct {
 	AllowOverlappingExecution           *bool
 	UserTaskTimeoutMs                   *int
 	SuspendTaskAfterNumFailures         *int
+	TaskAutoRetryAttempts               *int
 	ErrorIntegration                    *string
 	Comment                             *string
 	SessionParameters                   *SessionParameters
 }
 
 type TaskUnsetRequest struct {
-	Warehouse                   *bool
-	Schedule                    *bool
-	Config                      *bool
-	AllowOverlappingExecution   *bool
-	UserTaskTimeoutMs           *bool
-	SuspendTaskAfterNumFailures *bool
-	ErrorIntegration            *bool
-	Comment                     *bool
-	SessionParametersUnset      *SessionParametersUnset
+	Warehouse                           *bool
+	UserTaskManagedInitialWarehouseSize *bool
+	Schedule                            *bool
+	Config                              *bool
+	AllowOverlappingExecution           *bool
+	UserTaskTimeoutMs                   *bool
+	SuspendTaskAfterNumFailures         *bool
+	TaskAutoRetryAttempts               *bool
+	ErrorIntegration                    *bool
+	Comment                             *bool
+	SessionParametersUnset              *SessionParametersUnset
 }
 
 type DropTaskRequest struct {
@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 )
 
 type Tasks interface {
@@ -30,9 +31,11 @@ type CreateTaskOptions struct {
 	SessionParameters           *SessionParameters       `ddl:"list,no_parentheses"`
 	UserTaskTimeoutMs           *int                     `ddl:"parameter" sql:"USER_TASK_TIMEOUT_MS"`
 	SuspendTaskAfterNumFailures *int                     `ddl:"parameter" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
+	TaskAutoRetryAttempts       *int                     `ddl:"parameter" sql:"TASK_AUTO_RETRY_ATTEMPTS"`
 	ErrorIntegration            *string                  `ddl:"parameter,no_quotes" sql:"ERROR_INTEGRATION"`
 	CopyGrants                  *bool                    `ddl:"keyword" sql:"COPY GRANTS"`
 	Comment                     *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Finalize                    *SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"FINALIZE"`
 	After                       []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"AFTER"`
 	Tag                         []TagAssociation         `ddl:"keyword,parentheses" sql:"TAG"`
 	When                        *string                  `ddl:"parameter,no_quotes,no_equals" sql:"WHEN"`
@@ -58,20 +61,22 @@ type CloneTaskOptions struct {
 
 // AlterTaskOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-task.
 type AlterTaskOptions struct {
-	alter       bool                     `ddl:"static" sql:"ALTER"`
-	task        bool                     `ddl:"static" sql:"TASK"`
-	IfExists    *bool                    `ddl:"keyword" sql:"IF EXISTS"`
-	name        SchemaObjectIdentifier   `ddl:"identifier"`
-	Resume      *bool                    `ddl:"keyword" sql:"RESUME"`
-	Suspend     *bool                    `ddl:"keyword" sql:"SUSPEND"`
-	RemoveAfter []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"REMOVE AFTER"`
-	AddAfter    []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"ADD AFTER"`
-	Set         *TaskSet                 `ddl:"keyword" sql:"SET"`
-	Unset       *TaskUnset               `ddl:"keyword" sql:"UNSET"`
-	SetTags     []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
-	UnsetTags   []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
-	ModifyAs    *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY AS"`
-	ModifyWhen  *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY WHEN"`
+	alter         bool                     `ddl:"static" sql:"ALTER"`
+	task          bool                     `ddl:"static" sql:"TASK"`
+	IfExists      *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name          SchemaObjectIdentifier   `ddl:"identifier"`
+	Resume        *bool                    `ddl:"keyword" sql:"RESUME"`
+	Suspend       *bool                    `ddl:"keyword" sql:"SUSPEND"`
+	RemoveAfter   []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"REMOVE AFTER"`
+	AddAfter      []SchemaObjectIdentifier `ddl:"parameter,no_equals" sql:"ADD AFTER"`
+	SetFinalize   *SchemaObjectIdentifier  `ddl:"identifier,equals" sql:"SET FINALIZE"`
+	UnsetFinalize *bool                    `ddl:"keyword" sql:"UNSET FINALIZE"`
+	Set           *TaskSet                 `ddl:"keyword" sql:"SET"`
+	Unset         *TaskUnset               `ddl:"keyword" sql:"UNSET"`
+	SetTags       []TagAssociation         `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags     []ObjectIdentifier       `ddl:"keyword" sql:"UNSET TAG"`
+	ModifyAs      *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY AS"`
+	ModifyWhen    *string                  `ddl:"parameter,no_quotes,no_equals" sql:"MODIFY WHEN"`
 }
 
 type TaskSet struct {
@@ -82,21 +87,24 @@ type TaskSet struct {
 	AllowOverlappingExecution           *bool                    `ddl:"parameter" sql:"ALLOW_OVERLAPPING_EXECUTION"`
 	UserTaskTimeoutMs                   *int                     `ddl:"parameter" sql:"USER_TASK_TIMEOUT_MS"`
 	SuspendTaskAfterNumFailures         *int                     `ddl:"parameter" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
+	TaskAutoRetryAttempts               *int                     `ddl:"parameter" sql:"TASK_AUTO_RETRY_ATTEMPTS"`
 	ErrorIntegration                    *string                  `ddl:"parameter,no_quotes" sql:"ERROR_INTEGRATION"`
 	Comment                             *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 	SessionParameters                   *SessionParameters       `ddl:"list,no_parentheses"`
 }
 
 type TaskUnset struct {
-	Warehouse                   *bool                   `ddl:"keyword" sql:"WAREHOUSE"`
-	Schedule                    *bool                   `ddl:"keyword" sql:"SCHEDULE"`
-	Config                      *bool                   `ddl:"keyword" sql:"CONFIG"`
-	AllowOverlappingExecution   *bool                   `ddl:"keyword" sql:"ALLOW_OVERLAPPING_EXECUTION"`
-	UserTaskTimeoutMs           *bool                   `ddl:"keyword" sql:"USER_TASK_TIMEOUT_MS"`
-	SuspendTaskAfterNumFailures *bool                   `ddl:"keyword" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
-	ErrorIntegration            *bool                   `ddl:"keyword" sql:"ERROR_INTEGRATION"`
-	Comment                     *bool                   `ddl:"keyword" sql:"COMMENT"`
-	SessionParametersUnset      *SessionParametersUnset `ddl:"list,no_parentheses"`
+	Warehouse                           *bool                   `ddl:"keyword" sql:"WAREHOUSE"`
+	UserTaskManagedInitialWarehouseSize *bool                   `ddl:"keyword" sql:"USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE"`
+	Schedule                            *bool                   `ddl:"keyword" sql:"SCHEDULE"`
+	Config                              *bool                   `ddl:"keyword" sql:"CONFIG"`
+	AllowOverlappingExecution           *bool                   `ddl:"keyword" sql:"ALLOW_OVERLAPPING_EXECUTION"`
+	UserTaskTimeoutMs                   *bool                   `ddl:"keyword" sql:"USER_TASK_TIMEOUT_MS"`
+	SuspendTaskAfterNumFailures         *bool                   `ddl:"keyword" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
+	TaskAutoRetryAttempts               *bool                   `ddl:"keyword" sql:"TASK_AUTO_RETRY_ATTEMPTS"`
+	ErrorIntegration                    *bool                   `ddl:"keyword" sql:"ERROR_INTEGRATION"`
+	Comment                             *bool                   `ddl:"keyword" sql:"COMMENT"`
+	SessionParametersUnset              *SessionParametersUnset `ddl:"list,no_parentheses"`
 }
 
 // DropTaskOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-task.
@@ -140,6 +148,7 @@ type taskDBRow struct {
 	OwnerRoleType             sql.NullString `db:"owner_role_type"`
 	Config                    sql.NullString `db:"config"`
 	Budget                    sql.NullString `db:"budget"`
+	TaskRelations             sql.NullString `db:"task_relations"`
 }
 
 type Task struct {
@@ -163,6 +172,7 @@ type Task struct {
 	OwnerRoleType             string
 	Config                    string
 	Budget                    string
+	TaskRelations             TaskRelations
 }
 
 // DescribeTaskOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-task.
@@ -194,3 +204,35 @@ const (
 func (v *Task) IsStarted() bool {
 	return v.State == TaskStateStarted
 }
+
+// TaskRelations is based on the task_relations column of SHOW TASKS which describes the position of the task in its graph.
+type TaskRelations struct {
+	Predecessors      []SchemaObjectIdentifier
+	FinalizerTask     *SchemaObjectIdentifier
+	FinalizedRootTask *SchemaObjectIdentifier
+}
+
+func ToTaskRelations(s string) (TaskRelations, error) {
+	var rawTaskRelations struct {
+		Predecessors      []string `json:"Predecessors"`
+		FinalizerTask     string   `json:"FinalizerTask"`
+		FinalizedRootTask string   `json:"FinalizedRootTask"`
+	}
+	if err := json.Unmarshal([]byte(s), &rawTaskRelations); err != nil {
+		return TaskRelations{}, err
+	}
+
+	taskRelations := TaskRelations{
+		Predecessors: make([]SchemaObjectIdentifier, len(rawTaskRelations.Predecessors)),
+	}
+	for i, predecessor := range rawTaskRelations.Predecessors {
+		taskRelations.Predecessors[i] = NewSchemaObjectIdentifierFromFullyQualifiedName(predecessor)
+	}
+	if rawTaskRelations.FinalizerTask != "" {
+		taskRelations.FinalizerTask = Pointer(NewSchemaObjectIdentifierFromFullyQualifiedName(rawTaskRelations.FinalizerTask))
+	}
+	if rawTaskRelations.FinalizedRootTask != "" {
+		taskRelations.FinalizedRootTask = Pointer(NewSchemaObjectIdentifierFromFullyQualifiedName(rawTaskRelations.FinalizedRootTask))
+	}
+	return taskRelations, nil
+}
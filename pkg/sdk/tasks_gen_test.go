@@ -35,6 +35,22 @@ func TestTasks_Create(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateTaskOptions", "OrReplace", "IfNotExists"))
 	})
 
+	t.Run("validation: conflicting fields for [opts.Finalize opts.After]", func(t *testing.T) {
+		opts := defaultOpts()
+		rootTaskId := RandomSchemaObjectIdentifier()
+		opts.Finalize = &rootTaskId
+		opts.After = []SchemaObjectIdentifier{RandomSchemaObjectIdentifier()}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateTaskOptions", "Finalize", "After"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.Finalize opts.Schedule]", func(t *testing.T) {
+		opts := defaultOpts()
+		rootTaskId := RandomSchemaObjectIdentifier()
+		opts.Finalize = &rootTaskId
+		opts.Schedule = String("10 MINUTE")
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateTaskOptions", "Finalize", "Schedule"))
+	})
+
 	t.Run("validation: exactly one field from [opts.Warehouse.Warehouse opts.Warehouse.UserTaskManagedInitialWarehouseSize] should be present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Warehouse = &CreateTaskWarehouse{}
@@ -60,6 +76,15 @@ func TestTasks_Create(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, req.toOpts(), "CREATE TASK %s USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE = 'XSMALL' AS %s", id.FullyQualifiedName(), sql)
 	})
 
+	t.Run("finalizer", func(t *testing.T) {
+		rootTaskId := RandomSchemaObjectIdentifier()
+		req := NewCreateTaskRequest(id, sql).
+			WithWarehouse(NewCreateTaskWarehouseRequest().WithUserTaskManagedInitialWarehouseSize(&WarehouseSizeXSmall)).
+			WithTaskAutoRetryAttempts(Int(3)).
+			WithFinalize(&rootTaskId)
+		assertOptsValidAndSQLEquals(t, req.toOpts(), "CREATE TASK %s USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE = 'XSMALL' TASK_AUTO_RETRY_ATTEMPTS = 3 FINALIZE = %s AS %s", id.FullyQualifiedName(), rootTaskId.FullyQualifiedName(), sql)
+	})
+
 	t.Run("all options", func(t *testing.T) {
 		warehouseId := RandomAccountObjectIdentifier()
 		otherTaskId := RandomSchemaObjectIdentifier()
@@ -76,6 +101,7 @@ func TestTasks_Create(t *testing.T) {
 			}).
 			WithUserTaskTimeoutMs(Int(5)).
 			WithSuspendTaskAfterNumFailures(Int(6)).
+			WithTaskAutoRetryAttempts(Int(2)).
 			WithErrorIntegration(String("some_error_integration")).
 			WithCopyGrants(Bool(true)).
 			WithComment(String("some comment")).
@@ -86,7 +112,7 @@ func TestTasks_Create(t *testing.T) {
 			}}).
 			WithWhen(String(`SYSTEM$STREAM_HAS_DATA('MYSTREAM')`))
 
-		assertOptsValidAndSQLEquals(t, req.toOpts(), "CREATE OR REPLACE TASK %s WAREHOUSE = %s SCHEDULE = '10 MINUTE' CONFIG = $${\"output_dir\": \"/temp/test_directory/\", \"learning_rate\": 0.1}$$ ALLOW_OVERLAPPING_EXECUTION = true JSON_INDENT = 10 USER_TASK_TIMEOUT_MS = 5 SUSPEND_TASK_AFTER_NUM_FAILURES = 6 ERROR_INTEGRATION = some_error_integration COPY GRANTS COMMENT = 'some comment' AFTER %s TAG (%s = 'v1') WHEN SYSTEM$STREAM_HAS_DATA('MYSTREAM') AS SELECT CURRENT_TIMESTAMP", id.FullyQualifiedName(), warehouseId.FullyQualifiedName(), otherTaskId.FullyQualifiedName(), tagId.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, req.toOpts(), "CREATE OR REPLACE TASK %s WAREHOUSE = %s SCHEDULE = '10 MINUTE' CONFIG = $${\"output_dir\": \"/temp/test_directory/\", \"learning_rate\": 0.1}$$ ALLOW_OVERLAPPING_EXECUTION = true JSON_INDENT = 10 USER_TASK_TIMEOUT_MS = 5 SUSPEND_TASK_AFTER_NUM_FAILURES = 6 TASK_AUTO_RETRY_ATTEMPTS = 2 ERROR_INTEGRATION = some_error_integration COPY GRANTS COMMENT = 'some comment' AFTER %s TAG (%s = 'v1') WHEN SYSTEM$STREAM_HAS_DATA('MYSTREAM') AS SELECT CURRENT_TIMESTAMP", id.FullyQualifiedName(), warehouseId.FullyQualifiedName(), otherTaskId.FullyQualifiedName(), tagId.FullyQualifiedName())
 	})
 }
 
@@ -154,22 +180,22 @@ func TestTasks_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.SetFinalize opts.UnsetFinalize opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Resume", "Suspend", "RemoveAfter", "AddAfter", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Resume", "Suspend", "RemoveAfter", "AddAfter", "SetFinalize", "UnsetFinalize", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
 	})
 
-	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present - more present", func(t *testing.T) {
+	t.Run("validation: exactly one field from [opts.Resume opts.Suspend opts.RemoveAfter opts.AddAfter opts.SetFinalize opts.UnsetFinalize opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.ModifyAs opts.ModifyWhen] should be present - more present", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Resume = Bool(true)
 		opts.Suspend = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Resume", "Suspend", "RemoveAfter", "AddAfter", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("Resume", "Suspend", "RemoveAfter", "AddAfter", "SetFinalize", "UnsetFinalize", "Set", "Unset", "SetTags", "UnsetTags", "ModifyAs", "ModifyWhen"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.Warehouse opts.Set.UserTaskManagedInitialWarehouseSize opts.Set.Schedule opts.Set.Config opts.Set.AllowOverlappingExecution opts.Set.UserTaskTimeoutMs opts.Set.SuspendTaskAfterNumFailures opts.Set.ErrorIntegration opts.Set.Comment opts.Set.SessionParameters] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.Warehouse opts.Set.UserTaskManagedInitialWarehouseSize opts.Set.Schedule opts.Set.Config opts.Set.AllowOverlappingExecution opts.Set.UserTaskTimeoutMs opts.Set.SuspendTaskAfterNumFailures opts.Set.TaskAutoRetryAttempts opts.Set.ErrorIntegration opts.Set.Comment opts.Set.SessionParameters] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &TaskSet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "ErrorIntegration", "Comment", "SessionParameters"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "ErrorIntegration", "Comment", "SessionParameters"))
 	})
 
 	t.Run("validation: conflicting fields for [opts.Set.Warehouse opts.Set.UserTaskManagedInitialWarehouseSize]", func(t *testing.T) {
@@ -190,10 +216,10 @@ func TestTasks_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("JSON_INDENT must be between 0 and 16"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Unset.Warehouse opts.Unset.Schedule opts.Unset.Config opts.Unset.AllowOverlappingExecution opts.Unset.UserTaskTimeoutMs opts.Unset.SuspendTaskAfterNumFailures opts.Unset.ErrorIntegration opts.Unset.Comment opts.Unset.SessionParametersUnset] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Unset.Warehouse opts.Unset.UserTaskManagedInitialWarehouseSize opts.Unset.Schedule opts.Unset.Config opts.Unset.AllowOverlappingExecution opts.Unset.UserTaskTimeoutMs opts.Unset.SuspendTaskAfterNumFailures opts.Unset.TaskAutoRetryAttempts opts.Unset.ErrorIntegration opts.Unset.Comment opts.Unset.SessionParametersUnset] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &TaskUnset{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Warehouse", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "ErrorIntegration", "Comment", "SessionParametersUnset"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("Warehouse", "UserTaskManagedInitialWarehouseSize", "Schedule", "Config", "AllowOverlappingExecution", "UserTaskTimeoutMs", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "ErrorIntegration", "Comment", "SessionParametersUnset"))
 	})
 
 	t.Run("validation: opts.Unset.SessionParametersUnset.SessionParametersUnset should be valid", func(t *testing.T) {
@@ -227,6 +253,36 @@ func TestTasks_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s ADD AFTER %s", id.FullyQualifiedName(), otherTaskId.FullyQualifiedName())
 	})
 
+	t.Run("alter set finalize", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetFinalize = &otherTaskId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s SET FINALIZE = %s", id.FullyQualifiedName(), otherTaskId.FullyQualifiedName())
+	})
+
+	t.Run("alter unset finalize", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetFinalize = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s UNSET FINALIZE", id.FullyQualifiedName())
+	})
+
+	t.Run("alter set retry and failure settings", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &TaskSet{
+			SuspendTaskAfterNumFailures: Int(5),
+			TaskAutoRetryAttempts:       Int(2),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s SET SUSPEND_TASK_AFTER_NUM_FAILURES = 5 TASK_AUTO_RETRY_ATTEMPTS = 2", id.FullyQualifiedName())
+	})
+
+	t.Run("alter unset serverless and retry settings", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &TaskUnset{
+			UserTaskManagedInitialWarehouseSize: Bool(true),
+			TaskAutoRetryAttempts:               Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TASK %s UNSET USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE TASK_AUTO_RETRY_ATTEMPTS", id.FullyQualifiedName())
+	})
+
 	t.Run("alter set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &TaskSet{
@@ -75,6 +75,10 @@ func GetRootTasks(v Tasks, ctx context.Context, id SchemaObjectIdentifier) ([]Ta
 	}
 
 	predecessors := task.Predecessors
+	// a finalizer task belongs to the graph of the root task it finalizes
+	if task.TaskRelations.FinalizedRootTask != nil {
+		predecessors = append(predecessors, *task.TaskRelations.FinalizedRootTask)
+	}
 	// no predecessors mean this is a root task
 	if len(predecessors) == 0 {
 		return []Task{*task}, nil
@@ -114,9 +118,11 @@ func (r *CreateTaskRequest) toOpts() *CreateTaskOptions {
 		SessionParameters:           r.SessionParameters,
 		UserTaskTimeoutMs:           r.UserTaskTimeoutMs,
 		SuspendTaskAfterNumFailures: r.SuspendTaskAfterNumFailures,
+		TaskAutoRetryAttempts:       r.TaskAutoRetryAttempts,
 		ErrorIntegration:            r.ErrorIntegration,
 		CopyGrants:                  r.CopyGrants,
 		Comment:                     r.Comment,
+		Finalize:                    r.Finalize,
 		After:                       r.After,
 		Tag:                         r.Tag,
 		When:                        r.When,
@@ -143,12 +149,14 @@ func (r *CloneTaskRequest) toOpts() *CloneTaskOptions {
 
 func (r *AlterTaskRequest) toOpts() *AlterTaskOptions {
 	opts := &AlterTaskOptions{
-		IfExists:    r.IfExists,
-		name:        r.name,
-		Resume:      r.Resume,
-		Suspend:     r.Suspend,
-		RemoveAfter: r.RemoveAfter,
-		AddAfter:    r.AddAfter,
+		IfExists:      r.IfExists,
+		name:          r.name,
+		Resume:        r.Resume,
+		Suspend:       r.Suspend,
+		RemoveAfter:   r.RemoveAfter,
+		AddAfter:      r.AddAfter,
+		SetFinalize:   r.SetFinalize,
+		UnsetFinalize: r.UnsetFinalize,
 
 		SetTags:    r.SetTags,
 		UnsetTags:  r.UnsetTags,
@@ -164,6 +172,7 @@ func (r *AlterTaskRequest) toOpts() *AlterTaskOptions {
 			AllowOverlappingExecution:           r.Set.AllowOverlappingExecution,
 			UserTaskTimeoutMs:                   r.Set.UserTaskTimeoutMs,
 			SuspendTaskAfterNumFailures:         r.Set.SuspendTaskAfterNumFailures,
+			TaskAutoRetryAttempts:               r.Set.TaskAutoRetryAttempts,
 			ErrorIntegration:                    r.Set.ErrorIntegration,
 			Comment:                             r.Set.Comment,
 			SessionParameters:                   r.Set.SessionParameters,
@@ -171,15 +180,17 @@ func (r *AlterTaskRequest) toOpts() *AlterTaskOptions {
 	}
 	if r.Unset != nil {
 		opts.Unset = &TaskUnset{
-			Warehouse:                   r.Unset.Warehouse,
-			Schedule:                    r.Unset.Schedule,
-			Config:                      r.Unset.Config,
-			AllowOverlappingExecution:   r.Unset.AllowOverlappingExecution,
-			UserTaskTimeoutMs:           r.Unset.UserTaskTimeoutMs,
-			SuspendTaskAfterNumFailures: r.Unset.SuspendTaskAfterNumFailures,
-			ErrorIntegration:            r.Unset.ErrorIntegration,
-			Comment:                     r.Unset.Comment,
-			SessionParametersUnset:      r.Unset.SessionParametersUnset,
+			Warehouse:                           r.Unset.Warehouse,
+			UserTaskManagedInitialWarehouseSize: r.Unset.UserTaskManagedInitialWarehouseSize,
+			Schedule:                            r.Unset.Schedule,
+			Config:                              r.Unset.Config,
+			AllowOverlappingExecution:           r.Unset.AllowOverlappingExecution,
+			UserTaskTimeoutMs:                   r.Unset.UserTaskTimeoutMs,
+			SuspendTaskAfterNumFailures:         r.Unset.SuspendTaskAfterNumFailures,
+			TaskAutoRetryAttempts:               r.Unset.TaskAutoRetryAttempts,
+			ErrorIntegration:                    r.Unset.ErrorIntegration,
+			Comment:                             r.Unset.Comment,
+			SessionParametersUnset:              r.Unset.SessionParametersUnset,
 		}
 	}
 	return opts
@@ -271,6 +282,11 @@ func (r taskDBRow) convert() *Task {
 	if r.Budget.Valid {
 		task.Budget = r.Budget.String
 	}
+	if r.TaskRelations.Valid {
+		if taskRelations, err := ToTaskRelations(r.TaskRelations.String); err == nil {
+			task.TaskRelations = taskRelations
+		}
+	}
 	return &task
 }
 
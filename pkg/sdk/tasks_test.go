@@ -111,3 +111,55 @@ func Test_getPredecessors(t *testing.T) {
 		require.ErrorContains(t, err, "invalid character ']'")
 	})
 }
+
+func TestTasks_GetRootTasksForFinalizer(t *testing.T) {
+	ctx := context.Background()
+	rootTaskId := NewSchemaObjectIdentifier("database", "schema", "root")
+	finalizerId := NewSchemaObjectIdentifier("database", "schema", "finalizer")
+
+	client := new(testTasks)
+	client.stubbedTasks = map[string]*Task{
+		"root": {DatabaseName: "database", SchemaName: "schema", Name: "root"},
+		"finalizer": {
+			DatabaseName:  "database",
+			SchemaName:    "schema",
+			Name:          "finalizer",
+			TaskRelations: TaskRelations{FinalizedRootTask: &rootTaskId},
+		},
+	}
+
+	rootTasks, err := GetRootTasks(client, ctx, finalizerId)
+	require.NoError(t, err)
+	require.Len(t, rootTasks, 1)
+	assert.Equal(t, "root", rootTasks[0].Name)
+}
+
+func TestToTaskRelations(t *testing.T) {
+	t.Run("root task with finalizer", func(t *testing.T) {
+		taskRelations, err := ToTaskRelations(`{"Predecessors":[],"FinalizerTask":"DB.SCHEMA.FINALIZER"}`)
+		require.NoError(t, err)
+		assert.Empty(t, taskRelations.Predecessors)
+		require.NotNil(t, taskRelations.FinalizerTask)
+		assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "FINALIZER"), *taskRelations.FinalizerTask)
+		assert.Nil(t, taskRelations.FinalizedRootTask)
+	})
+
+	t.Run("finalizer task", func(t *testing.T) {
+		taskRelations, err := ToTaskRelations(`{"FinalizedRootTask":"DB.SCHEMA.ROOT","Predecessors":[]}`)
+		require.NoError(t, err)
+		assert.Nil(t, taskRelations.FinalizerTask)
+		require.NotNil(t, taskRelations.FinalizedRootTask)
+		assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "ROOT"), *taskRelations.FinalizedRootTask)
+	})
+
+	t.Run("child task", func(t *testing.T) {
+		taskRelations, err := ToTaskRelations(`{"Predecessors":["DB.SCHEMA.PARENT"]}`)
+		require.NoError(t, err)
+		assert.Equal(t, []SchemaObjectIdentifier{NewSchemaObjectIdentifier("DB", "SCHEMA", "PARENT")}, taskRelations.Predecessors)
+	})
+
+	t.Run("incorrect json", func(t *testing.T) {
+		_, err := ToTaskRelations("{")
+		require.Error(t, err)
+	})
+}
@@ -244,6 +244,19 @@ func TestInt_AccountAlter(t *testing.T) {
 		err = client.Accounts.Alter(ctx, opts)
 		require.NoError(t, err)
 
+		account, err := client.ContextFunctions.CurrentAccount(ctx)
+		require.NoError(t, err)
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, account, sdk.PolicyEntityDomainAccount)
+		require.NoError(t, err)
+		var found bool
+		for _, policyReference := range policyReferences {
+			if policyReference.PolicyKind == sdk.PolicyKindPasswordPolicy {
+				found = true
+				assert.Equal(t, passwordPolicyTest.ID().FullyQualifiedName(), policyReference.PolicyID().FullyQualifiedName())
+			}
+		}
+		assert.True(t, found)
+
 		// now unset
 		opts = &sdk.AlterAccountOptions{
 			Unset: &sdk.AccountUnset{
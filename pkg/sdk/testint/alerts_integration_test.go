@@ -221,6 +221,26 @@ func TestInt_AlertCreate(t *testing.T) {
 		assert.Equal(t, name, alert[0].Name)
 		assert.Equal(t, "", *alert[0].Comment)
 	})
+
+	t.Run("test serverless", func(t *testing.T) {
+		name := random.String()
+		schedule := "USING CRON * * * * TUE,THU UTC"
+		condition := "SELECT 1"
+		action := "SELECT 1"
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, name)
+		err := client.Alerts.Create(ctx, id, sdk.AccountObjectIdentifier{}, schedule, condition, action, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Alerts.Drop(ctx, id)
+			require.NoError(t, err)
+		})
+
+		alert, err := client.Alerts.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, name, alert.Name)
+		assert.Equal(t, schedule, alert.Schedule)
+		assert.True(t, alert.IsServerless())
+	})
 }
 
 func TestInt_AlertDescribe(t *testing.T) {
@@ -0,0 +1,130 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_AuthenticationPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	databaseTest, schemaTest := testDb(t), testSchema(t)
+
+	createAuthenticationPolicy := func(t *testing.T, opts *sdk.CreateAuthenticationPolicyOptions) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.AlphanumericN(12))
+		err := client.AuthenticationPolicies.Create(ctx, id, opts)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.AuthenticationPolicies.Drop(ctx, id, &sdk.DropAuthenticationPolicyOptions{IfExists: sdk.Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create and describe", func(t *testing.T) {
+		mfaEnrollment := sdk.MfaEnrollmentRequired
+		id := createAuthenticationPolicy(t, &sdk.CreateAuthenticationPolicyOptions{
+			AuthenticationMethods: []sdk.AuthenticationMethods{
+				{Method: sdk.AuthenticationMethodsPassword},
+				{Method: sdk.AuthenticationMethodsSaml},
+			},
+			MfaEnrollment: &mfaEnrollment,
+			ClientTypes: []sdk.ClientTypes{
+				{ClientType: sdk.ClientTypesSnowflakeUi},
+			},
+			Comment: sdk.String("test comment"),
+		})
+
+		authenticationPolicy, err := client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), authenticationPolicy.Name)
+		assert.Equal(t, "test comment", authenticationPolicy.Comment)
+
+		details, err := client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"PASSWORD", "SAML"}, sdk.ParseAuthenticationPolicyList(details.AuthenticationMethods.Value))
+		assert.Equal(t, "REQUIRED", details.MfaEnrollment.Value)
+		assert.Equal(t, []string{"SNOWFLAKE_UI"}, sdk.ParseAuthenticationPolicyList(details.ClientTypes.Value))
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id := createAuthenticationPolicy(t, nil)
+
+		err := client.AuthenticationPolicies.Alter(ctx, id, &sdk.AlterAuthenticationPolicyOptions{
+			Set: &sdk.AuthenticationPolicySet{
+				AuthenticationMethods: []sdk.AuthenticationMethods{
+					{Method: sdk.AuthenticationMethodsKeyPair},
+				},
+				Comment: sdk.String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+
+		details, err := client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"KEYPAIR"}, sdk.ParseAuthenticationPolicyList(details.AuthenticationMethods.Value))
+		assert.Equal(t, "new comment", details.Comment.Value)
+
+		err = client.AuthenticationPolicies.Alter(ctx, id, &sdk.AlterAuthenticationPolicyOptions{
+			Unset: &sdk.AuthenticationPolicyUnset{
+				AuthenticationMethods: sdk.Bool(true),
+				Comment:               sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		details, err = client.AuthenticationPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ALL"}, sdk.ParseAuthenticationPolicyList(details.AuthenticationMethods.Value))
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := createAuthenticationPolicy(t, nil)
+		newID := sdk.NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, random.AlphanumericN(12))
+
+		err := client.AuthenticationPolicies.Alter(ctx, id, &sdk.AlterAuthenticationPolicyOptions{
+			RenameTo: &newID,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.AuthenticationPolicies.Drop(ctx, newID, nil)
+			require.NoError(t, err)
+		})
+
+		_, err = client.AuthenticationPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+		_, err = client.AuthenticationPolicies.ShowByID(ctx, newID)
+		require.NoError(t, err)
+	})
+
+	t.Run("attach to user", func(t *testing.T) {
+		id := createAuthenticationPolicy(t, nil)
+		user, userCleanup := createUser(t, client)
+		t.Cleanup(userCleanup)
+
+		err := client.Users.Alter(ctx, user.ID(), &sdk.AlterUserOptions{
+			Set: &sdk.UserSet{
+				AuthenticationPolicy: sdk.String(id.FullyQualifiedName()),
+			},
+		})
+		require.NoError(t, err)
+
+		policyReferences, err := client.PolicyReferences.GetForEntity(ctx, user.ID().FullyQualifiedName(), sdk.PolicyEntityDomainUser)
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		assert.Equal(t, sdk.PolicyKindAuthenticationPolicy, policyReferences[0].PolicyKind)
+
+		err = client.Users.Alter(ctx, user.ID(), &sdk.AlterUserOptions{
+			Unset: &sdk.UserUnset{
+				AuthenticationPolicy: sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,82 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Budgets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	createBudget := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+
+		err := client.Budgets.Create(ctx, id, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Budgets.Drop(ctx, id, &sdk.DropBudgetOptions{IfExists: sdk.Bool(true)})
+			require.NoError(t, err)
+		})
+
+		return id
+	}
+
+	t.Run("create and show", func(t *testing.T) {
+		id := createBudget(t)
+
+		budget, err := client.Budgets.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), budget.Name)
+		assert.Equal(t, id.DatabaseName(), budget.DatabaseName)
+		assert.Equal(t, id.SchemaName(), budget.SchemaName)
+	})
+
+	t.Run("spending limit", func(t *testing.T) {
+		id := createBudget(t)
+
+		err := client.Budgets.SetSpendingLimit(ctx, id, 100)
+		require.NoError(t, err)
+
+		spendingLimit, err := client.Budgets.GetSpendingLimit(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 100, spendingLimit)
+	})
+
+	t.Run("linked resources", func(t *testing.T) {
+		id := createBudget(t)
+		warehouse, warehouseCleanup := createWarehouse(t, client)
+		t.Cleanup(warehouseCleanup)
+
+		err := client.Budgets.AddResource(ctx, id, sdk.ObjectTypeWarehouse, warehouse.ID())
+		require.NoError(t, err)
+
+		linkedResources, err := client.Budgets.GetLinkedResources(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, linkedResources, 1)
+		assert.Equal(t, warehouse.Name, linkedResources[0].Name)
+		assert.Equal(t, "WAREHOUSE", linkedResources[0].Domain)
+
+		err = client.Budgets.RemoveResource(ctx, id, sdk.ObjectTypeWarehouse, warehouse.ID())
+		require.NoError(t, err)
+
+		linkedResources, err = client.Budgets.GetLinkedResources(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, linkedResources)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		id := createBudget(t)
+
+		err := client.Budgets.Drop(ctx, id, nil)
+		require.NoError(t, err)
+
+		_, err = client.Budgets.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,98 @@
+package testint
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_IcebergTables(t *testing.T) {
+	externalVolume := os.Getenv("SNOWFLAKE_TEST_EXTERNAL_VOLUME")
+	if externalVolume == "" {
+		t.Skip("Skipping TestInt_IcebergTables, SNOWFLAKE_TEST_EXTERNAL_VOLUME is not set")
+	}
+
+	client := testClient(t)
+	ctx := testContext(t)
+
+	createIcebergTable := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+		err := client.IcebergTables.Create(ctx, id, &sdk.CreateIcebergTableOptions{
+			Columns: []sdk.IcebergTableColumn{
+				{Name: "id", Type: sdk.DataTypeNumber, NotNull: sdk.Bool(true)},
+				{Name: "name", Type: sdk.DataTypeString, Comment: sdk.String("name column")},
+			},
+			ExternalVolume: sdk.String(externalVolume),
+			Catalog:        sdk.String(sdk.IcebergTableCatalogSnowflake),
+			BaseLocation:   sdk.String(id.Name()),
+			Comment:        sdk.String("comment"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.IcebergTables.Drop(ctx, id, &sdk.DropIcebergTableOptions{IfExists: sdk.Bool(true)})
+			require.NoError(t, err)
+		})
+		return id
+	}
+
+	t.Run("create and show", func(t *testing.T) {
+		id := createIcebergTable(t)
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), icebergTable.Name)
+		assert.Equal(t, "comment", icebergTable.Comment)
+		assert.True(t, icebergTable.IsManaged())
+
+		columns, err := client.IcebergTables.DescribeColumns(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, columns, 2)
+		assert.Equal(t, "id", columns[0].Name)
+		assert.False(t, columns[0].Nullable)
+		assert.Equal(t, "name column", columns[1].Comment)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id := createIcebergTable(t)
+
+		err := client.IcebergTables.Alter(ctx, id, &sdk.AlterIcebergTableOptions{
+			Set: &sdk.IcebergTableSet{
+				DataRetentionTimeInDays: sdk.Int(2),
+				Comment:                 sdk.String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+
+		icebergTable, err := client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", icebergTable.Comment)
+
+		err = client.IcebergTables.Alter(ctx, id, &sdk.AlterIcebergTableOptions{
+			Unset: &sdk.IcebergTableUnset{
+				DataRetentionTimeInDays: sdk.Bool(true),
+				Comment:                 sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		icebergTable, err = client.IcebergTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", icebergTable.Comment)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		id := createIcebergTable(t)
+
+		icebergTables, err := client.IcebergTables.Show(ctx, &sdk.ShowIcebergTableOptions{
+			Like: &sdk.Like{Pattern: sdk.String(id.Name())},
+			In:   &sdk.In{Schema: testSchema(t).ID()},
+		})
+		require.NoError(t, err)
+		assert.Len(t, icebergTables, 1)
+	})
+}
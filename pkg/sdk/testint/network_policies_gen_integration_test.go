@@ -6,6 +6,7 @@ import (
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -155,6 +156,50 @@ func TestInt_NetworkPolicies(t *testing.T) {
 		assert.Equal(t, len(req.BlockedIpList), np.EntriesInBlockedIpList)
 	})
 
+	t.Run("Alter - network rule lists", func(t *testing.T) {
+		allowedRuleID := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+		err := client.NetworkRules.Create(ctx, sdk.NewCreateNetworkRuleRequest(allowedRuleID, sdk.NetworkRuleTypeIpv4, sdk.NetworkRuleModeIngress).
+			WithValueList([]sdk.NetworkRuleValueRequest{{Value: "0.0.0.0/0"}}))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(allowedRuleID))
+			require.NoError(t, err)
+		})
+
+		req := sdk.NewCreateNetworkPolicyRequest(sdk.RandomAccountObjectIdentifier())
+		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
+		require.NoError(t, err)
+		t.Cleanup(dropNetworkPolicy)
+
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(req.GetName()).
+			WithAdd(sdk.NewNetworkPolicyAddNetworkRuleRequest().WithAllowedNetworkRuleList([]sdk.SchemaObjectIdentifier{allowedRuleID})))
+		require.NoError(t, err)
+
+		np, err := client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 1, np.EntriesInAllowedNetworkRules)
+
+		desc, err := client.NetworkPolicies.Describe(ctx, req.GetName())
+		require.NoError(t, err)
+		var allowedRules []sdk.NetworkRulesSnowflakeDTO
+		for _, d := range desc {
+			if d.Name == "ALLOWED_NETWORK_RULE_LIST" {
+				allowedRules, err = sdk.ParseNetworkRulesSnowflakeDto(d.Value)
+				require.NoError(t, err)
+			}
+		}
+		require.Len(t, allowedRules, 1)
+		assert.Equal(t, allowedRuleID, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(allowedRules[0].FullyQualifiedRuleName))
+
+		err = client.NetworkPolicies.Alter(ctx, sdk.NewAlterNetworkPolicyRequest(req.GetName()).
+			WithRemove(sdk.NewNetworkPolicyRemoveNetworkRuleRequest().WithAllowedNetworkRuleList([]sdk.SchemaObjectIdentifier{allowedRuleID})))
+		require.NoError(t, err)
+
+		np, err = client.NetworkPolicies.ShowByID(ctx, req.GetName())
+		require.NoError(t, err)
+		assert.Equal(t, 0, np.EntriesInAllowedNetworkRules)
+	})
+
 	t.Run("Describe", func(t *testing.T) {
 		req := defaultCreateRequest()
 		err, dropNetworkPolicy := createNetworkPolicy(t, client, req)
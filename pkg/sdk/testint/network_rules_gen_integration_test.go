@@ -0,0 +1,123 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_NetworkRules(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	cleanupNetworkRuleProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createNetworkRule := func(t *testing.T, values ...string) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+		valueList := make([]sdk.NetworkRuleValueRequest, len(values))
+		for i, value := range values {
+			valueList[i] = sdk.NetworkRuleValueRequest{Value: value}
+		}
+
+		err := client.NetworkRules.Create(ctx, sdk.NewCreateNetworkRuleRequest(id, sdk.NetworkRuleTypeIpv4, sdk.NetworkRuleModeIngress).WithValueList(valueList))
+		require.NoError(t, err)
+		t.Cleanup(cleanupNetworkRuleProvider(id))
+
+		return id
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+		request := sdk.NewCreateNetworkRuleRequest(id, sdk.NetworkRuleTypeHostPort, sdk.NetworkRuleModeEgress).
+			WithOrReplace(sdk.Bool(true)).
+			WithValueList([]sdk.NetworkRuleValueRequest{{Value: "example.com"}, {Value: "company.com:443"}}).
+			WithComment(sdk.String("some comment"))
+
+		err := client.NetworkRules.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupNetworkRuleProvider(id))
+
+		networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), networkRule.Name)
+		assert.Equal(t, id.DatabaseName(), networkRule.DatabaseName)
+		assert.Equal(t, id.SchemaName(), networkRule.SchemaName)
+		assert.Equal(t, sdk.NetworkRuleTypeHostPort, networkRule.Type)
+		assert.Equal(t, sdk.NetworkRuleModeEgress, networkRule.Mode)
+		assert.Equal(t, 2, networkRule.EntriesInValueList)
+		assert.Equal(t, "some comment", networkRule.Comment)
+	})
+
+	t.Run("Alter: set and unset", func(t *testing.T) {
+		id := createNetworkRule(t, "0.0.0.0/0")
+
+		err := client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithSet(
+			sdk.NewNetworkRuleSetRequest().
+				WithValueList([]sdk.NetworkRuleValueRequest{{Value: "10.0.0.1"}, {Value: "10.0.0.2"}}).
+				WithComment(sdk.String("new comment")),
+		))
+		require.NoError(t, err)
+
+		details, err := client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, details.ValueList)
+		assert.Equal(t, "new comment", details.Comment)
+
+		err = client.NetworkRules.Alter(ctx, sdk.NewAlterNetworkRuleRequest(id).WithUnset(
+			sdk.NewNetworkRuleUnsetRequest().WithValueList(sdk.Bool(true)).WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+
+		details, err = client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, details.ValueList)
+		assert.Equal(t, "", details.Comment)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createNetworkRule(t)
+
+		err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.NetworkRules.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id := createNetworkRule(t)
+		id2 := createNetworkRule(t)
+
+		networkRules, err := client.NetworkRules.Show(ctx, sdk.NewShowNetworkRuleRequest().WithIn(&sdk.In{
+			Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		}))
+		require.NoError(t, err)
+		names := make([]string, len(networkRules))
+		for i, networkRule := range networkRules {
+			names[i] = networkRule.Name
+		}
+		assert.Contains(t, names, id.Name())
+		assert.Contains(t, names, id2.Name())
+	})
+
+	t.Run("Describe", func(t *testing.T) {
+		id := createNetworkRule(t, "192.168.0.0/24")
+
+		details, err := client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+		assert.Equal(t, sdk.NetworkRuleTypeIpv4, details.Type)
+		assert.Equal(t, sdk.NetworkRuleModeIngress, details.Mode)
+		assert.Equal(t, []string{"192.168.0.0/24"}, details.ValueList)
+	})
+}
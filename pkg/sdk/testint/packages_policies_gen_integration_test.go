@@ -0,0 +1,116 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_PackagesPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	cleanupPackagesPolicyProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createPackagesPolicy := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+
+		err := client.PackagesPolicies.Create(ctx, sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguagePython))
+		require.NoError(t, err)
+		t.Cleanup(cleanupPackagesPolicyProvider(id))
+
+		return id
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+		request := sdk.NewCreatePackagesPolicyRequest(id, sdk.PackagesPolicyLanguagePython).
+			WithOrReplace(sdk.Bool(true)).
+			WithAllowlist([]sdk.PackagesPolicyPackageRequest{{Package: "numpy"}, {Package: "pandas"}}).
+			WithBlocklist([]sdk.PackagesPolicyPackageRequest{{Package: "requests"}}).
+			WithComment(sdk.String("some comment"))
+
+		err := client.PackagesPolicies.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupPackagesPolicyProvider(id))
+
+		packagesPolicy, err := client.PackagesPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), packagesPolicy.Name)
+		assert.Equal(t, id.DatabaseName(), packagesPolicy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), packagesPolicy.SchemaName)
+		assert.Equal(t, "some comment", packagesPolicy.Comment)
+
+		details, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.PackagesPolicyLanguagePython, details.Language)
+		assert.ElementsMatch(t, []string{"numpy", "pandas"}, details.Allowlist)
+		assert.ElementsMatch(t, []string{"requests"}, details.Blocklist)
+	})
+
+	t.Run("Alter: set and unset", func(t *testing.T) {
+		id := createPackagesPolicy(t)
+
+		err := client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithSet(
+			sdk.NewPackagesPolicySetRequest().
+				WithAllowlist([]sdk.PackagesPolicyPackageRequest{{Package: "numpy"}}).
+				WithAdditionalCreationBlocklist([]sdk.PackagesPolicyPackageRequest{{Package: "scipy"}}).
+				WithComment(sdk.String("new comment")),
+		))
+		require.NoError(t, err)
+
+		details, err := client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"numpy"}, details.Allowlist)
+		assert.Equal(t, []string{"scipy"}, details.AdditionalCreationBlocklist)
+		assert.Equal(t, "new comment", details.Comment)
+
+		err = client.PackagesPolicies.Alter(ctx, sdk.NewAlterPackagesPolicyRequest(id).WithUnset(
+			sdk.NewPackagesPolicyUnsetRequest().
+				WithAdditionalCreationBlocklist(sdk.Bool(true)).
+				WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+
+		details, err = client.PackagesPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, details.AdditionalCreationBlocklist)
+		assert.Equal(t, "", details.Comment)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createPackagesPolicy(t)
+
+		err := client.PackagesPolicies.Drop(ctx, sdk.NewDropPackagesPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.PackagesPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id := createPackagesPolicy(t)
+		id2 := createPackagesPolicy(t)
+
+		packagesPolicies, err := client.PackagesPolicies.Show(ctx, sdk.NewShowPackagesPolicyRequest().WithIn(&sdk.In{
+			Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		}))
+		require.NoError(t, err)
+		names := make([]string, len(packagesPolicies))
+		for i, packagesPolicy := range packagesPolicies {
+			names[i] = packagesPolicy.Name
+		}
+		assert.Contains(t, names, id.Name())
+		assert.Contains(t, names, id2.Name())
+	})
+}
@@ -96,6 +96,8 @@ func TestInt_PasswordPolicyCreate(t *testing.T) {
 			PasswordMaxAgeDays:        sdk.Int(30),
 			PasswordMaxRetries:        sdk.Int(5),
 			PasswordLockoutTimeMins:   sdk.Int(30),
+			PasswordMinAgeDays:        sdk.Int(1),
+			PasswordHistory:           sdk.Int(5),
 			// todo [SNOW-928909]: uncomment this once comments are working again
 			// Comment:                   String("test comment"),
 		})
@@ -114,6 +116,8 @@ func TestInt_PasswordPolicyCreate(t *testing.T) {
 		assert.Equal(t, 30, *passwordPolicyDetails.PasswordMaxAgeDays.Value)
 		assert.Equal(t, 5, *passwordPolicyDetails.PasswordMaxRetries.Value)
 		assert.Equal(t, 30, *passwordPolicyDetails.PasswordLockoutTimeMins.Value)
+		assert.Equal(t, 1, *passwordPolicyDetails.PasswordMinAgeDays.Value)
+		assert.Equal(t, 5, *passwordPolicyDetails.PasswordHistory.Value)
 	})
 
 	t.Run("test if_not_exists", func(t *testing.T) {
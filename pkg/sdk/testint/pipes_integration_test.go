@@ -294,6 +294,34 @@ func TestInt_PipeAlter(t *testing.T) {
 		assert.Equal(t, "", alteredPipe.Comment)
 	})
 
+	t.Run("pause and resume", func(t *testing.T) {
+		pipeName := random.AlphanumericN(20)
+		pipe, pipeCleanup := createPipe(t, itc.client, testDb(t), testSchema(t), pipeName, pipeCopyStatement)
+		t.Cleanup(pipeCleanup)
+
+		err := itc.client.Pipes.Alter(itc.ctx, pipe.ID(), &sdk.AlterPipeOptions{
+			Set: &sdk.PipeSet{
+				PipeExecutionPaused: sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		state, err := itc.client.SystemFunctions.PipeStatus(itc.ctx, pipe.ID())
+		require.NoError(t, err)
+		assert.Equal(t, sdk.PausedPipeExecutionState, state)
+
+		err = itc.client.Pipes.Alter(itc.ctx, pipe.ID(), &sdk.AlterPipeOptions{
+			Set: &sdk.PipeSet{
+				PipeExecutionPaused: sdk.Bool(false),
+			},
+		})
+		require.NoError(t, err)
+
+		state, err = itc.client.SystemFunctions.PipeStatus(itc.ctx, pipe.ID())
+		require.NoError(t, err)
+		assert.Equal(t, sdk.RunningPipeExecutionState, state)
+	})
+
 	t.Run("set and unset tag", func(t *testing.T) {
 		tag, tagCleanup := createTag(t, itc.client, testDb(t), testSchema(t))
 		t.Cleanup(tagCleanup)
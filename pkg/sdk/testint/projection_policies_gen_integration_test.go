@@ -0,0 +1,131 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ProjectionPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	const allowBody = "PROJECTION_CONSTRAINT(ALLOW => true)"
+	const denyBody = "PROJECTION_CONSTRAINT(ALLOW => false)"
+
+	cleanupProjectionPolicyProvider := func(id sdk.SchemaObjectIdentifier) func() {
+		return func() {
+			err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id).WithIfExists(sdk.Bool(true)))
+			require.NoError(t, err)
+		}
+	}
+
+	createProjectionPolicy := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, allowBody))
+		require.NoError(t, err)
+		t.Cleanup(cleanupProjectionPolicyProvider(id))
+
+		return id
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		id := sdk.NewSchemaObjectIdentifier(testDb(t).Name, testSchema(t).Name, random.AlphanumericN(12))
+		request := sdk.NewCreateProjectionPolicyRequest(id, allowBody).
+			WithOrReplace(sdk.Bool(true)).
+			WithComment(sdk.String("some comment"))
+
+		err := client.ProjectionPolicies.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupProjectionPolicyProvider(id))
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), projectionPolicy.Name)
+		assert.Equal(t, id.DatabaseName(), projectionPolicy.DatabaseName)
+		assert.Equal(t, id.SchemaName(), projectionPolicy.SchemaName)
+		assert.Equal(t, "some comment", projectionPolicy.Comment)
+
+		details, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+		assert.Equal(t, "PROJECTION_CONSTRAINT", details.ReturnType)
+		assert.Equal(t, allowBody, details.Body)
+	})
+
+	t.Run("Alter: set and unset", func(t *testing.T) {
+		id := createProjectionPolicy(t)
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSet(
+			sdk.NewProjectionPolicySetRequest().
+				WithBody(sdk.String(denyBody)).
+				WithComment(sdk.String("new comment")),
+		))
+		require.NoError(t, err)
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", projectionPolicy.Comment)
+
+		details, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, denyBody, details.Body)
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnset(
+			sdk.NewProjectionPolicyUnsetRequest().WithComment(sdk.Bool(true)),
+		))
+		require.NoError(t, err)
+
+		projectionPolicy, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "", projectionPolicy.Comment)
+	})
+
+	t.Run("Alter: rename", func(t *testing.T) {
+		id := createProjectionPolicy(t)
+		newID := sdk.NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), random.AlphanumericN(12))
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithRenameTo(&newID))
+		require.NoError(t, err)
+		t.Cleanup(cleanupProjectionPolicyProvider(newID))
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		projectionPolicy, err := client.ProjectionPolicies.ShowByID(ctx, newID)
+		require.NoError(t, err)
+		assert.Equal(t, newID.Name(), projectionPolicy.Name)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		id := createProjectionPolicy(t)
+
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("Show", func(t *testing.T) {
+		id := createProjectionPolicy(t)
+		id2 := createProjectionPolicy(t)
+
+		projectionPolicies, err := client.ProjectionPolicies.Show(ctx, sdk.NewShowProjectionPolicyRequest().WithIn(&sdk.In{
+			Schema: sdk.NewDatabaseObjectIdentifier(id.DatabaseName(), id.SchemaName()),
+		}))
+		require.NoError(t, err)
+		names := make([]string, len(projectionPolicies))
+		for i, projectionPolicy := range projectionPolicies {
+			names[i] = projectionPolicy.Name
+		}
+		assert.Contains(t, names, id.Name())
+		assert.Contains(t, names, id2.Name())
+	})
+}
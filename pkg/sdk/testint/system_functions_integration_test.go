@@ -42,7 +42,7 @@ func TestInt_GetTag(t *testing.T) {
 		t.Cleanup(maskingPolicyCleanup)
 
 		s, err := client.SystemFunctions.GetTag(ctx, tagTest.ID(), maskingPolicyTest.ID(), sdk.ObjectTypeMaskingPolicy)
-		require.Error(t, err)
+		require.ErrorIs(t, err, sdk.ErrTagNotSet)
 		assert.Equal(t, "", s)
 	})
 }
@@ -34,6 +34,7 @@ type users struct {
 type User struct {
 	Name                  string
 	CreatedOn             time.Time
+	Type                  string
 	LoginName             string
 	DisplayName           string
 	FirstName             string
@@ -62,6 +63,7 @@ type User struct {
 type userDBRow struct {
 	Name                  string         `db:"name"`
 	CreatedOn             time.Time      `db:"created_on"`
+	Type                  sql.NullString `db:"type"`
 	LoginName             string         `db:"login_name"`
 	DisplayName           sql.NullString `db:"display_name"`
 	FirstName             sql.NullString `db:"first_name"`
@@ -106,6 +108,9 @@ func (row userDBRow) convert() *User {
 		HasPassword:           row.HasPassword,
 		HasRsaPublicKey:       row.HasRsaPublicKey,
 	}
+	if row.Type.Valid {
+		user.Type = row.Type.String
+	}
 	if row.DisplayName.Valid {
 		user.DisplayName = row.DisplayName.String
 	}
@@ -173,6 +178,11 @@ func (opts *CreateUserOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		return errors.New("invalid object identifier")
 	}
+	if valueSet(opts.ObjectProperties) {
+		if err := opts.ObjectProperties.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -192,7 +202,19 @@ func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *Cr
 	return err
 }
 
+// UserType distinguishes the users representing people from the users representing services (workload identities).
+type UserType string
+
+const (
+	UserTypePerson        UserType = "PERSON"
+	UserTypeService       UserType = "SERVICE"
+	UserTypeLegacyService UserType = "LEGACY_SERVICE"
+)
+
+var AllUserTypes = []UserType{UserTypePerson, UserTypeService, UserTypeLegacyService}
+
 type UserObjectProperties struct {
+	Type                 *UserType       `ddl:"parameter,no_quotes" sql:"TYPE"`
 	Password             *string         `ddl:"parameter,single_quotes" sql:"PASSWORD"`
 	LoginName            *string         `ddl:"parameter,single_quotes" sql:"LOGIN_NAME"`
 	DisplayName          *string         `ddl:"parameter,single_quotes" sql:"DISPLAY_NAME"`
@@ -214,6 +236,13 @@ type UserObjectProperties struct {
 	Comment              *string         `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
+func (opts *UserObjectProperties) validate() error {
+	if opts.Type != nil && *opts.Type == UserTypeService && anyValueSet(opts.Password, opts.MustChangePassword, opts.FirstName, opts.MiddleName, opts.LastName) {
+		return errors.New("users of type SERVICE cannot have a password, must change password, first name, middle name or last name")
+	}
+	return nil
+}
+
 type SecondaryRoles struct {
 	equals     bool            `ddl:"static" sql:"="`
 	leftParen  bool            `ddl:"static" sql:"("`
@@ -225,6 +254,7 @@ type SecondaryRole struct {
 	Value string `ddl:"keyword,single_quotes"`
 }
 type UserObjectPropertiesUnset struct {
+	Type                 *bool `ddl:"keyword" sql:"TYPE"`
 	Password             *bool `ddl:"keyword" sql:"PASSWORD"`
 	LoginName            *bool `ddl:"keyword" sql:"LOGIN_NAME"`
 	DisplayName          *bool `ddl:"keyword" sql:"DISPLAY_NAME"`
@@ -345,41 +375,43 @@ func (opts *RemoveDelegatedAuthorization) validate() error {
 }
 
 type UserSet struct {
-	PasswordPolicy    *string               `ddl:"parameter" sql:"PASSWORD POLICY"`
-	SessionPolicy     *string               `ddl:"parameter" sql:"SESSION POLICY"`
-	Tags              []TagAssociation      `ddl:"keyword,parentheses" sql:"TAG"`
-	ObjectProperties  *UserObjectProperties `ddl:"keyword"`
-	ObjectParameters  *UserObjectParameters `ddl:"keyword"`
-	SessionParameters *SessionParameters    `ddl:"keyword"`
+	PasswordPolicy       *string               `ddl:"parameter,no_equals" sql:"PASSWORD POLICY"`
+	SessionPolicy        *string               `ddl:"parameter,no_equals" sql:"SESSION POLICY"`
+	AuthenticationPolicy *string               `ddl:"parameter,no_equals" sql:"AUTHENTICATION POLICY"`
+	Tags                 []TagAssociation      `ddl:"keyword,parentheses" sql:"TAG"`
+	ObjectProperties     *UserObjectProperties `ddl:"keyword"`
+	ObjectParameters     *UserObjectParameters `ddl:"keyword"`
+	SessionParameters    *SessionParameters    `ddl:"keyword"`
 }
 
 func (opts *UserSet) validate() error {
-	if !anyValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.Tags, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
-		return fmt.Errorf("at least one of password policy, tag, object properties, object parameters, or session parameters must be set")
+	if !anyValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.Tags, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+		return fmt.Errorf("at least one of password policy, session policy, authentication policy, tag, object properties, object parameters, or session parameters must be set")
 	}
-	if moreThanOneValueSet(opts.SessionPolicy, opts.PasswordPolicy, opts.Tags) {
-		return fmt.Errorf("setting session policy, password policy and tags must be done separately")
+	if moreThanOneValueSet(opts.SessionPolicy, opts.PasswordPolicy, opts.AuthenticationPolicy, opts.Tags) {
+		return fmt.Errorf("setting session policy, password policy, authentication policy and tags must be done separately")
 	}
 	if anyValueSet(opts.ObjectParameters, opts.SessionParameters, opts.ObjectProperties) {
-		if anyValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.Tags) {
-			return fmt.Errorf("cannot set both {object parameters, session parameters,object properties} and password policy, session policy, or tag")
+		if anyValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.Tags) {
+			return fmt.Errorf("cannot set both {object parameters, session parameters,object properties} and password policy, session policy, authentication policy, or tag")
 		}
 	}
 	return nil
 }
 
 type UserUnset struct {
-	PasswordPolicy    *bool                      `ddl:"keyword" sql:"PASSWORD POLICY"`
-	SessionPolicy     *bool                      `ddl:"keyword" sql:"SESSION POLICY"`
-	Tags              *[]string                  `ddl:"keyword" sql:"TAG"`
-	ObjectProperties  *UserObjectPropertiesUnset `ddl:"list"`
-	ObjectParameters  *UserObjectParametersUnset `ddl:"list"`
-	SessionParameters *SessionParametersUnset    `ddl:"list"`
+	PasswordPolicy       *bool                      `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy        *bool                      `ddl:"keyword" sql:"SESSION POLICY"`
+	AuthenticationPolicy *bool                      `ddl:"keyword" sql:"AUTHENTICATION POLICY"`
+	Tags                 *[]string                  `ddl:"keyword" sql:"TAG"`
+	ObjectProperties     *UserObjectPropertiesUnset `ddl:"list"`
+	ObjectParameters     *UserObjectParametersUnset `ddl:"list"`
+	SessionParameters    *SessionParametersUnset    `ddl:"list"`
 }
 
 func (opts *UserUnset) validate() error {
-	if !exactlyOneValueSet(opts.Tags, opts.PasswordPolicy, opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
-		return fmt.Errorf("exactly one of password policy, tag, object properties, object parameters, or session parameters must be set")
+	if !exactlyOneValueSet(opts.Tags, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+		return fmt.Errorf("exactly one of password policy, session policy, authentication policy, tag, object properties, object parameters, or session parameters must be set")
 	}
 	return nil
 }
@@ -419,6 +451,7 @@ func (v *users) Drop(ctx context.Context, id AccountObjectIdentifier) error {
 // UserDetails contains details about a user.
 type UserDetails struct {
 	Name                                *StringProperty
+	Type                                *StringProperty
 	Comment                             *StringProperty
 	DisplayName                         *StringProperty
 	LoginName                           *StringProperty
@@ -456,6 +489,8 @@ func userDetailsFromRows(rows []propertyRow) *UserDetails {
 		switch row.Property {
 		case "NAME":
 			v.Name = row.toStringProperty()
+		case "TYPE":
+			v.Type = row.toStringProperty()
 		case "COMMENT":
 			v.Comment = row.toStringProperty()
 		case "DISPLAY_NAME":
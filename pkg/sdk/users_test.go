@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/internal/random"
@@ -44,6 +45,39 @@ func TestUserCreate(t *testing.T) {
 
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE USER IF NOT EXISTS %s PASSWORD = '%s' LOGIN_NAME = '%s' ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = true AUTOCOMMIT = true WITH TAG ("db"."schema"."tag1" = 'v1')`, id.FullyQualifiedName(), password, loginName)
 	})
+
+	t.Run("with service type", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				Type:         Pointer(UserTypeService),
+				RSAPublicKey: String("key"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s TYPE = SERVICE RSA_PUBLIC_KEY = 'key'`, id.FullyQualifiedName())
+	})
+
+	t.Run("validation: service type with password", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				Type:     Pointer(UserTypeService),
+				Password: String("password"),
+			},
+		}
+		assertOptsInvalid(t, opts, errors.New("users of type SERVICE cannot have a password, must change password, first name, middle name or last name"))
+	})
+
+	t.Run("with legacy service type and password", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				Type:     Pointer(UserTypeLegacyService),
+				Password: String("password"),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s TYPE = LEGACY_SERVICE PASSWORD = 'password'`, id.FullyQualifiedName())
+	})
 }
 
 func TestUserAlter(t *testing.T) {
@@ -69,7 +103,39 @@ func TestUserAlter(t *testing.T) {
 				PasswordPolicy: String(passwordPolicy),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET PASSWORD POLICY = %s", id.FullyQualifiedName(), passwordPolicy)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET PASSWORD POLICY %s", id.FullyQualifiedName(), passwordPolicy)
+	})
+
+	t.Run("with setting an authentication policy", func(t *testing.T) {
+		authenticationPolicy := NewSchemaObjectIdentifier("db", "schema", "authpol")
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				AuthenticationPolicy: String(authenticationPolicy.FullyQualifiedName()),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER USER %s SET AUTHENTICATION POLICY "db"."schema"."authpol"`, id.FullyQualifiedName())
+	})
+
+	t.Run("with setting multiple policies", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				PasswordPolicy:       String("PASSWORD_POLICY1"),
+				AuthenticationPolicy: String("AUTHENTICATION_POLICY1"),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errors.New("setting session policy, password policy, authentication policy and tags must be done separately"))
+	})
+
+	t.Run("with unsetting an authentication policy", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				AuthenticationPolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET AUTHENTICATION POLICY", id.FullyQualifiedName())
 	})
 
 	t.Run("with setting tags", func(t *testing.T) {
@@ -195,6 +261,43 @@ func TestUserAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET PASSWORD", id.FullyQualifiedName())
 	})
 
+	t.Run("with setting type", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					Type: Pointer(UserTypeService),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET TYPE = SERVICE", id.FullyQualifiedName())
+	})
+
+	t.Run("with unsetting RSA public keys", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					RSAPublicKey:  Bool(true),
+					RSAPublicKey2: Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET RSA_PUBLIC_KEY, RSA_PUBLIC_KEY_2", id.FullyQualifiedName())
+	})
+
+	t.Run("with unsetting type", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					Type: Bool(true),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET TYPE", id.FullyQualifiedName())
+	})
+
 	t.Run("with unsetting a policy", func(t *testing.T) {
 		sessionPolicy := "SESSION_POLICY1"
 		opts := &AlterUserOptions{
@@ -203,7 +306,7 @@ func TestUserAlter(t *testing.T) {
 				SessionPolicy: String(sessionPolicy),
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET SESSION POLICY = %s", id.FullyQualifiedName(), sessionPolicy)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET SESSION POLICY %s", id.FullyQualifiedName(), sessionPolicy)
 	})
 
 	t.Run("with removing delegated authorization of role", func(t *testing.T) {
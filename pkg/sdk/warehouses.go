@@ -39,6 +39,37 @@ var (
 	WarehouseTypeSnowparkOptimized WarehouseType = "SNOWPARK-OPTIMIZED"
 )
 
+type WarehouseResourceConstraint string
+
+var (
+	WarehouseResourceConstraintMemory1X     WarehouseResourceConstraint = "MEMORY_1X"
+	WarehouseResourceConstraintMemory1XX86  WarehouseResourceConstraint = "MEMORY_1X_x86"
+	WarehouseResourceConstraintMemory16X    WarehouseResourceConstraint = "MEMORY_16X"
+	WarehouseResourceConstraintMemory16XX86 WarehouseResourceConstraint = "MEMORY_16X_x86"
+	WarehouseResourceConstraintMemory64X    WarehouseResourceConstraint = "MEMORY_64X"
+	WarehouseResourceConstraintMemory64XX86 WarehouseResourceConstraint = "MEMORY_64X_x86"
+	WarehouseResourceConstraintStandardGen1 WarehouseResourceConstraint = "STANDARD_GEN_1"
+	WarehouseResourceConstraintStandardGen2 WarehouseResourceConstraint = "STANDARD_GEN_2"
+)
+
+var AllWarehouseResourceConstraints = []WarehouseResourceConstraint{
+	WarehouseResourceConstraintMemory1X,
+	WarehouseResourceConstraintMemory1XX86,
+	WarehouseResourceConstraintMemory16X,
+	WarehouseResourceConstraintMemory16XX86,
+	WarehouseResourceConstraintMemory64X,
+	WarehouseResourceConstraintMemory64XX86,
+	WarehouseResourceConstraintStandardGen1,
+	WarehouseResourceConstraintStandardGen2,
+}
+
+type WarehouseGeneration string
+
+var (
+	WarehouseGeneration1 WarehouseGeneration = "1"
+	WarehouseGeneration2 WarehouseGeneration = "2"
+)
+
 type WarehouseSize string
 
 var (
@@ -98,18 +129,20 @@ type CreateWarehouseOptions struct {
 	name        AccountObjectIdentifier `ddl:"identifier"`
 
 	// Object properties
-	WarehouseType                   *WarehouseType `ddl:"parameter,single_quotes" sql:"WAREHOUSE_TYPE"`
-	WarehouseSize                   *WarehouseSize `ddl:"parameter,single_quotes" sql:"WAREHOUSE_SIZE"`
-	MaxClusterCount                 *int           `ddl:"parameter" sql:"MAX_CLUSTER_COUNT"`
-	MinClusterCount                 *int           `ddl:"parameter" sql:"MIN_CLUSTER_COUNT"`
-	ScalingPolicy                   *ScalingPolicy `ddl:"parameter,single_quotes" sql:"SCALING_POLICY"`
-	AutoSuspend                     *int           `ddl:"parameter" sql:"AUTO_SUSPEND"`
-	AutoResume                      *bool          `ddl:"parameter" sql:"AUTO_RESUME"`
-	InitiallySuspended              *bool          `ddl:"parameter" sql:"INITIALLY_SUSPENDED"`
-	ResourceMonitor                 *string        `ddl:"parameter,double_quotes" sql:"RESOURCE_MONITOR"`
-	Comment                         *string        `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	EnableQueryAcceleration         *bool          `ddl:"parameter" sql:"ENABLE_QUERY_ACCELERATION"`
-	QueryAccelerationMaxScaleFactor *int           `ddl:"parameter" sql:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
+	WarehouseType                   *WarehouseType               `ddl:"parameter,single_quotes" sql:"WAREHOUSE_TYPE"`
+	WarehouseSize                   *WarehouseSize               `ddl:"parameter,single_quotes" sql:"WAREHOUSE_SIZE"`
+	ResourceConstraint              *WarehouseResourceConstraint `ddl:"parameter,single_quotes" sql:"RESOURCE_CONSTRAINT"`
+	Generation                      *WarehouseGeneration         `ddl:"parameter,single_quotes" sql:"GENERATION"`
+	MaxClusterCount                 *int                         `ddl:"parameter" sql:"MAX_CLUSTER_COUNT"`
+	MinClusterCount                 *int                         `ddl:"parameter" sql:"MIN_CLUSTER_COUNT"`
+	ScalingPolicy                   *ScalingPolicy               `ddl:"parameter,single_quotes" sql:"SCALING_POLICY"`
+	AutoSuspend                     *int                         `ddl:"parameter" sql:"AUTO_SUSPEND"`
+	AutoResume                      *bool                        `ddl:"parameter" sql:"AUTO_RESUME"`
+	InitiallySuspended              *bool                        `ddl:"parameter" sql:"INITIALLY_SUSPENDED"`
+	ResourceMonitor                 *string                      `ddl:"parameter,double_quotes" sql:"RESOURCE_MONITOR"`
+	Comment                         *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	EnableQueryAcceleration         *bool                        `ddl:"parameter" sql:"ENABLE_QUERY_ACCELERATION"`
+	QueryAccelerationMaxScaleFactor *int                         `ddl:"parameter" sql:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
 
 	// Object params
 	MaxConcurrencyLevel             *int             `ddl:"parameter" sql:"MAX_CONCURRENCY_LEVEL"`
@@ -201,18 +234,20 @@ func (opts *AlterWarehouseOptions) validate() error {
 
 type WarehouseSet struct {
 	// Object properties
-	WarehouseType                   *WarehouseType          `ddl:"parameter,single_quotes" sql:"WAREHOUSE_TYPE"`
-	WarehouseSize                   *WarehouseSize          `ddl:"parameter,single_quotes" sql:"WAREHOUSE_SIZE"`
-	WaitForCompletion               *bool                   `ddl:"parameter" sql:"WAIT_FOR_COMPLETION"`
-	MaxClusterCount                 *int                    `ddl:"parameter" sql:"MAX_CLUSTER_COUNT"`
-	MinClusterCount                 *int                    `ddl:"parameter" sql:"MIN_CLUSTER_COUNT"`
-	ScalingPolicy                   *ScalingPolicy          `ddl:"parameter,single_quotes" sql:"SCALING_POLICY"`
-	AutoSuspend                     *int                    `ddl:"parameter" sql:"AUTO_SUSPEND"`
-	AutoResume                      *bool                   `ddl:"parameter" sql:"AUTO_RESUME"`
-	ResourceMonitor                 AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
-	Comment                         *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	EnableQueryAcceleration         *bool                   `ddl:"parameter" sql:"ENABLE_QUERY_ACCELERATION"`
-	QueryAccelerationMaxScaleFactor *int                    `ddl:"parameter" sql:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
+	WarehouseType                   *WarehouseType               `ddl:"parameter,single_quotes" sql:"WAREHOUSE_TYPE"`
+	WarehouseSize                   *WarehouseSize               `ddl:"parameter,single_quotes" sql:"WAREHOUSE_SIZE"`
+	ResourceConstraint              *WarehouseResourceConstraint `ddl:"parameter,single_quotes" sql:"RESOURCE_CONSTRAINT"`
+	Generation                      *WarehouseGeneration         `ddl:"parameter,single_quotes" sql:"GENERATION"`
+	WaitForCompletion               *bool                        `ddl:"parameter" sql:"WAIT_FOR_COMPLETION"`
+	MaxClusterCount                 *int                         `ddl:"parameter" sql:"MAX_CLUSTER_COUNT"`
+	MinClusterCount                 *int                         `ddl:"parameter" sql:"MIN_CLUSTER_COUNT"`
+	ScalingPolicy                   *ScalingPolicy               `ddl:"parameter,single_quotes" sql:"SCALING_POLICY"`
+	AutoSuspend                     *int                         `ddl:"parameter" sql:"AUTO_SUSPEND"`
+	AutoResume                      *bool                        `ddl:"parameter" sql:"AUTO_RESUME"`
+	ResourceMonitor                 AccountObjectIdentifier      `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
+	Comment                         *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	EnableQueryAcceleration         *bool                        `ddl:"parameter" sql:"ENABLE_QUERY_ACCELERATION"`
+	QueryAccelerationMaxScaleFactor *int                         `ddl:"parameter" sql:"QUERY_ACCELERATION_MAX_SCALE_FACTOR"`
 
 	// Object params
 	MaxConcurrencyLevel             *int `ddl:"parameter" sql:"MAX_CONCURRENCY_LEVEL"`
@@ -254,6 +289,8 @@ type WarehouseUnset struct {
 	// Object properties
 	WarehouseType                   *bool `ddl:"keyword" sql:"WAREHOUSE_TYPE"`
 	WarehouseSize                   *bool `ddl:"keyword" sql:"WAREHOUSE_SIZE"`
+	ResourceConstraint              *bool `ddl:"keyword" sql:"RESOURCE_CONSTRAINT"`
+	Generation                      *bool `ddl:"keyword" sql:"GENERATION"`
 	WaitForCompletion               *bool `ddl:"keyword" sql:"WAIT_FOR_COMPLETION"`
 	MaxClusterCount                 *bool `ddl:"keyword" sql:"MAX_CLUSTER_COUNT"`
 	MinClusterCount                 *bool `ddl:"keyword" sql:"MIN_CLUSTER_COUNT"`
@@ -379,40 +416,44 @@ type Warehouse struct {
 	QueryAccelerationMaxScaleFactor int
 	ResourceMonitor                 string
 	ScalingPolicy                   ScalingPolicy
+	ResourceConstraint              WarehouseResourceConstraint
+	Generation                      WarehouseGeneration
 }
 
 type warehouseDBRow struct {
-	Name                            string        `db:"name"`
-	State                           string        `db:"state"`
-	Type                            string        `db:"type"`
-	Size                            string        `db:"size"`
-	MinClusterCount                 int           `db:"min_cluster_count"`
-	MaxClusterCount                 int           `db:"max_cluster_count"`
-	StartedClusters                 int           `db:"started_clusters"`
-	Running                         int           `db:"running"`
-	Queued                          int           `db:"queued"`
-	IsDefault                       string        `db:"is_default"`
-	IsCurrent                       string        `db:"is_current"`
-	AutoSuspend                     sql.NullInt64 `db:"auto_suspend"`
-	AutoResume                      bool          `db:"auto_resume"`
-	Available                       string        `db:"available"`
-	Provisioning                    string        `db:"provisioning"`
-	Quiescing                       string        `db:"quiescing"`
-	Other                           string        `db:"other"`
-	CreatedOn                       time.Time     `db:"created_on"`
-	ResumedOn                       time.Time     `db:"resumed_on"`
-	UpdatedOn                       time.Time     `db:"updated_on"`
-	Owner                           string        `db:"owner"`
-	Comment                         string        `db:"comment"`
-	EnableQueryAcceleration         bool          `db:"enable_query_acceleration"`
-	QueryAccelerationMaxScaleFactor int           `db:"query_acceleration_max_scale_factor"`
-	ResourceMonitor                 string        `db:"resource_monitor"`
-	Actives                         string        `db:"actives"`
-	Pendings                        string        `db:"pendings"`
-	Failed                          string        `db:"failed"`
-	Suspended                       string        `db:"suspended"`
-	UUID                            string        `db:"uuid"`
-	ScalingPolicy                   string        `db:"scaling_policy"`
+	Name                            string         `db:"name"`
+	State                           string         `db:"state"`
+	Type                            string         `db:"type"`
+	Size                            string         `db:"size"`
+	MinClusterCount                 int            `db:"min_cluster_count"`
+	MaxClusterCount                 int            `db:"max_cluster_count"`
+	StartedClusters                 int            `db:"started_clusters"`
+	Running                         int            `db:"running"`
+	Queued                          int            `db:"queued"`
+	IsDefault                       string         `db:"is_default"`
+	IsCurrent                       string         `db:"is_current"`
+	AutoSuspend                     sql.NullInt64  `db:"auto_suspend"`
+	AutoResume                      bool           `db:"auto_resume"`
+	Available                       string         `db:"available"`
+	Provisioning                    string         `db:"provisioning"`
+	Quiescing                       string         `db:"quiescing"`
+	Other                           string         `db:"other"`
+	CreatedOn                       time.Time      `db:"created_on"`
+	ResumedOn                       time.Time      `db:"resumed_on"`
+	UpdatedOn                       time.Time      `db:"updated_on"`
+	Owner                           string         `db:"owner"`
+	Comment                         string         `db:"comment"`
+	EnableQueryAcceleration         bool           `db:"enable_query_acceleration"`
+	QueryAccelerationMaxScaleFactor int            `db:"query_acceleration_max_scale_factor"`
+	ResourceMonitor                 string         `db:"resource_monitor"`
+	Actives                         string         `db:"actives"`
+	Pendings                        string         `db:"pendings"`
+	Failed                          string         `db:"failed"`
+	Suspended                       string         `db:"suspended"`
+	UUID                            string         `db:"uuid"`
+	ScalingPolicy                   string         `db:"scaling_policy"`
+	ResourceConstraint              sql.NullString `db:"resource_constraint"`
+	Generation                      sql.NullString `db:"generation"`
 }
 
 func (row warehouseDBRow) convert() *Warehouse {
@@ -454,6 +495,12 @@ func (row warehouseDBRow) convert() *Warehouse {
 	if row.AutoSuspend.Valid {
 		wh.AutoSuspend = int(row.AutoSuspend.Int64)
 	}
+	if row.ResourceConstraint.Valid {
+		wh.ResourceConstraint = WarehouseResourceConstraint(row.ResourceConstraint.String)
+	}
+	if row.Generation.Valid {
+		wh.Generation = WarehouseGeneration(row.Generation.String)
+	}
 	return wh
 }
 
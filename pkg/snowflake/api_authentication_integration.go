@@ -0,0 +1,42 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// NewAPIAuthenticationIntegrationBuilder returns a pointer to a Builder that abstracts the DDL operations for an api authentication integration.
+//
+// Supported DDL operations are:
+//   - CREATE SECURITY INTEGRATION
+//   - ALTER SECURITY INTEGRATION
+//   - DROP INTEGRATION
+//   - SHOW INTEGRATIONS
+//   - DESCRIBE INTEGRATION
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth)
+func NewAPIAuthenticationIntegrationBuilder(name string) *Builder {
+	return &Builder{
+		entityType: SecurityIntegrationType,
+		name:       name,
+	}
+}
+
+type APIAuthenticationIntegration struct {
+	Name            sql.NullString `db:"name"`
+	Category        sql.NullString `db:"category"`
+	IntegrationType sql.NullString `db:"type"`
+	CreatedOn       sql.NullString `db:"created_on"`
+	Enabled         sql.NullBool   `db:"enabled"`
+	Comment         sql.NullString `db:"comment"`
+}
+
+func ScanAPIAuthenticationIntegration(row *sqlx.Row) (*APIAuthenticationIntegration, error) {
+	r := &APIAuthenticationIntegration{}
+	if err := row.StructScan(r); err != nil {
+		return r, fmt.Errorf("error scanning struct err = %w", err)
+	}
+	return r, nil
+}
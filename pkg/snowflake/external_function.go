@@ -203,19 +203,11 @@ func (fb *ExternalFunctionBuilder) Create() string {
 	q.WriteString(fmt.Sprintf(` API_INTEGRATION = '%v'`, EscapeString(fb.apiIntegration)))
 
 	if len(fb.headers) > 0 {
-		q.WriteString(` HEADERS = (`)
-		headers := []string{}
-		for _, header := range fb.headers {
-			headers = append(headers, fmt.Sprintf(`'%v' = '%v'`, EscapeString(header["name"]), EscapeString(header["value"])))
-		}
-		q.WriteString(strings.Join(headers, ", "))
-		q.WriteString(`)`)
+		q.WriteString(fmt.Sprintf(` HEADERS = %v`, fb.formattedHeaders()))
 	}
 
 	if len(fb.contextHeaders) > 0 {
-		q.WriteString(` CONTEXT_HEADERS = (`)
-		q.WriteString(EscapeString(strings.Join(fb.contextHeaders, ", ")))
-		q.WriteString(`)`)
+		q.WriteString(fmt.Sprintf(` CONTEXT_HEADERS = %v`, fb.formattedContextHeaders()))
 	}
 
 	if fb.maxBatchRows > 0 {
@@ -227,11 +219,11 @@ func (fb *ExternalFunctionBuilder) Create() string {
 	}
 
 	if fb.requestTranslator != "" {
-		q.WriteString(fmt.Sprintf(` REQUEST_TRANSLATOR = '%v'`, EscapeString(fb.requestTranslator)))
+		q.WriteString(fmt.Sprintf(` REQUEST_TRANSLATOR = %v`, fb.requestTranslator))
 	}
 
 	if fb.responseTranslator != "" {
-		q.WriteString(fmt.Sprintf(` RESPONSE_TRANSLATOR = '%v'`, EscapeString(fb.responseTranslator)))
+		q.WriteString(fmt.Sprintf(` RESPONSE_TRANSLATOR = %v`, fb.responseTranslator))
 	}
 
 	q.WriteString(fmt.Sprintf(` AS '%v'`, EscapeString(fb.urlOfProxyAndResource)))
@@ -239,6 +231,78 @@ func (fb *ExternalFunctionBuilder) Create() string {
 	return q.String()
 }
 
+func (fb *ExternalFunctionBuilder) formattedHeaders() string {
+	headers := []string{}
+	for _, header := range fb.headers {
+		headers = append(headers, fmt.Sprintf(`'%v' = '%v'`, EscapeString(header["name"]), EscapeString(header["value"])))
+	}
+	return fmt.Sprintf(`(%v)`, strings.Join(headers, ", "))
+}
+
+func (fb *ExternalFunctionBuilder) formattedContextHeaders() string {
+	return fmt.Sprintf(`(%v)`, EscapeString(strings.Join(fb.contextHeaders, ", ")))
+}
+
+// ChangeAPIIntegration returns the SQL query that will change the API integration of the external function.
+func (fb *ExternalFunctionBuilder) ChangeAPIIntegration(apiIntegration string) string {
+	return fmt.Sprintf(`ALTER FUNCTION %v SET API_INTEGRATION = '%v'`, fb.QualifiedNameWithArgTypes(), EscapeString(apiIntegration))
+}
+
+// ChangeHeaders returns the SQL query that will replace the headers of the external function, or unset them when empty.
+func (fb *ExternalFunctionBuilder) ChangeHeaders(headers []map[string]string) string {
+	if len(headers) == 0 {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET HEADERS`, fb.QualifiedNameWithArgTypes())
+	}
+	fb.headers = headers
+	return fmt.Sprintf(`ALTER FUNCTION %v SET HEADERS = %v`, fb.QualifiedNameWithArgTypes(), fb.formattedHeaders())
+}
+
+// ChangeContextHeaders returns the SQL query that will replace the context headers of the external function, or unset them when empty.
+func (fb *ExternalFunctionBuilder) ChangeContextHeaders(contextHeaders []string) string {
+	if len(contextHeaders) == 0 {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET CONTEXT_HEADERS`, fb.QualifiedNameWithArgTypes())
+	}
+	fb.contextHeaders = contextHeaders
+	return fmt.Sprintf(`ALTER FUNCTION %v SET CONTEXT_HEADERS = %v`, fb.QualifiedNameWithArgTypes(), fb.formattedContextHeaders())
+}
+
+// ChangeMaxBatchRows returns the SQL query that will change the max batch rows of the external function, or unset it when 0.
+func (fb *ExternalFunctionBuilder) ChangeMaxBatchRows(maxBatchRows int) string {
+	if maxBatchRows == 0 {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET MAX_BATCH_ROWS`, fb.QualifiedNameWithArgTypes())
+	}
+	return fmt.Sprintf(`ALTER FUNCTION %v SET MAX_BATCH_ROWS = %d`, fb.QualifiedNameWithArgTypes(), maxBatchRows)
+}
+
+// ChangeCompression returns the SQL query that will change the compression of the external function.
+func (fb *ExternalFunctionBuilder) ChangeCompression(compression string) string {
+	return fmt.Sprintf(`ALTER FUNCTION %v SET COMPRESSION = '%v'`, fb.QualifiedNameWithArgTypes(), EscapeString(compression))
+}
+
+// ChangeRequestTranslator returns the SQL query that will change the request translator of the external function, or unset it when empty.
+func (fb *ExternalFunctionBuilder) ChangeRequestTranslator(requestTranslator string) string {
+	if requestTranslator == "" {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET REQUEST_TRANSLATOR`, fb.QualifiedNameWithArgTypes())
+	}
+	return fmt.Sprintf(`ALTER FUNCTION %v SET REQUEST_TRANSLATOR = %v`, fb.QualifiedNameWithArgTypes(), requestTranslator)
+}
+
+// ChangeResponseTranslator returns the SQL query that will change the response translator of the external function, or unset it when empty.
+func (fb *ExternalFunctionBuilder) ChangeResponseTranslator(responseTranslator string) string {
+	if responseTranslator == "" {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET RESPONSE_TRANSLATOR`, fb.QualifiedNameWithArgTypes())
+	}
+	return fmt.Sprintf(`ALTER FUNCTION %v SET RESPONSE_TRANSLATOR = %v`, fb.QualifiedNameWithArgTypes(), responseTranslator)
+}
+
+// ChangeComment returns the SQL query that will change the comment of the external function, or unset it when empty.
+func (fb *ExternalFunctionBuilder) ChangeComment(comment string) string {
+	if comment == "" {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET COMMENT`, fb.QualifiedNameWithArgTypes())
+	}
+	return fmt.Sprintf(`ALTER FUNCTION %v SET COMMENT = '%v'`, fb.QualifiedNameWithArgTypes(), EscapeString(comment))
+}
+
 // Drop returns the SQL query that will drop an external function.
 func (fb *ExternalFunctionBuilder) Drop() string {
 	return fmt.Sprintf(`DROP FUNCTION %v`, fb.QualifiedNameWithArgTypes())
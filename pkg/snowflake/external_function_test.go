@@ -22,10 +22,30 @@ func TestExternalFunctionCreate(t *testing.T) {
 	r.Equal(`"test_db"."test_schema"."test_function"`, s.QualifiedName())
 	r.Equal(`"test_db"."test_schema"."test_function" (varchar)`, s.QualifiedNameWithArgTypes())
 
-	expected := `CREATE EXTERNAL FUNCTION "test_db"."test_schema"."test_function" (data varchar) RETURNS varchar NULL RETURNS NULL ON NULL INPUT IMMUTABLE API_INTEGRATION = 'test_api_integration_01' REQUEST_TRANSLATOR = 'test_request_translator' RESPONSE_TRANSLATOR = 'test_response_translator' AS 'https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func'`
+	expected := `CREATE EXTERNAL FUNCTION "test_db"."test_schema"."test_function" (data varchar) RETURNS varchar NULL RETURNS NULL ON NULL INPUT IMMUTABLE API_INTEGRATION = 'test_api_integration_01' REQUEST_TRANSLATOR = test_request_translator RESPONSE_TRANSLATOR = test_response_translator AS 'https://123456.execute-api.us-west-2.amazonaws.com/prod/test_func'`
 	r.Equal(expected, s.Create())
 }
 
+func TestExternalFunctionAlter(t *testing.T) {
+	r := require.New(t)
+	s := NewExternalFunctionBuilder("test_function", "test_db", "test_schema").WithArgTypes("varchar")
+
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET API_INTEGRATION = 'test_api_integration_02'`, s.ChangeAPIIntegration("test_api_integration_02"))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET HEADERS = ('x-custom-header' = 'snowflake')`, s.ChangeHeaders([]map[string]string{{"name": "x-custom-header", "value": "snowflake"}}))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) UNSET HEADERS`, s.ChangeHeaders(nil))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET CONTEXT_HEADERS = (current_account, current_timestamp)`, s.ChangeContextHeaders([]string{"current_account", "current_timestamp"}))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) UNSET CONTEXT_HEADERS`, s.ChangeContextHeaders(nil))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET MAX_BATCH_ROWS = 100`, s.ChangeMaxBatchRows(100))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) UNSET MAX_BATCH_ROWS`, s.ChangeMaxBatchRows(0))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET COMPRESSION = 'GZIP'`, s.ChangeCompression("GZIP"))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET REQUEST_TRANSLATOR = "test_db"."test_schema"."request_translator"`, s.ChangeRequestTranslator(`"test_db"."test_schema"."request_translator"`))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) UNSET REQUEST_TRANSLATOR`, s.ChangeRequestTranslator(""))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET RESPONSE_TRANSLATOR = "test_db"."test_schema"."response_translator"`, s.ChangeResponseTranslator(`"test_db"."test_schema"."response_translator"`))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) UNSET RESPONSE_TRANSLATOR`, s.ChangeResponseTranslator(""))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) SET COMMENT = 'new comment'`, s.ChangeComment("new comment"))
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_function" (varchar) UNSET COMMENT`, s.ChangeComment(""))
+}
+
 func TestExternalFunctionDrop(t *testing.T) {
 	r := require.New(t)
 
@@ -68,6 +68,8 @@ type ExternalOauthIntegration3 struct {
 
 	Comment   sql.NullString `pos:"parameter" db:"comment"`
 	CommentOk bool
+
+	CreatedOn sql.NullString `db:"created_on"`
 }
 
 type ExternalOauthIntegration3Manager struct {
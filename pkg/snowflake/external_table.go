@@ -22,6 +22,7 @@ type ExternalTableBuilder struct {
 	autoRefresh     bool
 	pattern         string
 	fileFormat      string
+	tableFormat     string
 	copyGrants      bool
 	awsSNSTopic     string
 	comment         string
@@ -101,6 +102,12 @@ func (tb *ExternalTableBuilder) WithAwsSNSTopic(c string) *ExternalTableBuilder
 	return tb
 }
 
+// WithTableFormat sets the table format (e.g. DELTA) on the ExternalTableBuilder.
+func (tb *ExternalTableBuilder) WithTableFormat(c string) *ExternalTableBuilder {
+	tb.tableFormat = c
+	return tb
+}
+
 // WithTags sets the tags on the ExternalTableBuilder.
 func (tb *ExternalTableBuilder) WithTags(tags []TagValue) *ExternalTableBuilder {
 	tb.tags = tags
@@ -151,6 +158,10 @@ func (tb *ExternalTableBuilder) Create() string {
 
 	q.WriteString(fmt.Sprintf(` FILE_FORMAT = ( %v )`, tb.fileFormat))
 
+	if tb.tableFormat != "" {
+		q.WriteString(fmt.Sprintf(` TABLE_FORMAT = %v`, tb.tableFormat))
+	}
+
 	if tb.awsSNSTopic != "" {
 		q.WriteString(fmt.Sprintf(` AWS_SNS_TOPIC = '%v'`, EscapeString(tb.awsSNSTopic)))
 	}
@@ -182,6 +193,19 @@ func (tb *ExternalTableBuilder) Update() string {
 	return q.String()
 }
 
+// ChangeAutoRefresh returns the SQL statement required to enable or disable the automatic refresh of the externalTable metadata.
+func (tb *ExternalTableBuilder) ChangeAutoRefresh(autoRefresh bool) string {
+	return fmt.Sprintf(`ALTER EXTERNAL TABLE %v SET AUTO_REFRESH = %t`, tb.QualifiedName(), autoRefresh)
+}
+
+// Refresh returns the SQL statement required to manually refresh the externalTable metadata, optionally limited to the relative path.
+func (tb *ExternalTableBuilder) Refresh(path string) string {
+	if path != "" {
+		return fmt.Sprintf(`ALTER EXTERNAL TABLE %v REFRESH '%v'`, tb.QualifiedName(), EscapeString(path))
+	}
+	return fmt.Sprintf(`ALTER EXTERNAL TABLE %v REFRESH`, tb.QualifiedName())
+}
+
 // Drop returns the SQL query that will drop a externalTable.
 func (tb *ExternalTableBuilder) Drop() string {
 	return fmt.Sprintf(`DROP EXTERNAL TABLE %v`, tb.QualifiedName())
@@ -208,12 +232,19 @@ func (tb *ExternalTableBuilder) GetTagValueString() string {
 }
 
 type ExternalTable struct {
-	CreatedOn         sql.NullString `db:"created_on"`
-	ExternalTableName sql.NullString `db:"name"`
-	DatabaseName      sql.NullString `db:"database_name"`
-	SchemaName        sql.NullString `db:"schema_name"`
-	Comment           sql.NullString `db:"comment"`
-	Owner             sql.NullString `db:"owner"`
+	CreatedOn           sql.NullString `db:"created_on"`
+	ExternalTableName   sql.NullString `db:"name"`
+	DatabaseName        sql.NullString `db:"database_name"`
+	SchemaName          sql.NullString `db:"schema_name"`
+	Comment             sql.NullString `db:"comment"`
+	Owner               sql.NullString `db:"owner"`
+	Stage               sql.NullString `db:"stage"`
+	Location            sql.NullString `db:"location"`
+	FileFormatName      sql.NullString `db:"file_format_name"`
+	FileFormatType      sql.NullString `db:"file_format_type"`
+	TableFormat         sql.NullString `db:"table_format"`
+	NotificationChannel sql.NullString `db:"notification_channel"`
+	LastRefreshedOn     sql.NullString `db:"last_refreshed_on"`
 }
 
 func ScanExternalTable(row *sqlx.Row) (*ExternalTable, error) {
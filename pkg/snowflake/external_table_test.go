@@ -21,6 +21,31 @@ func TestExternalTableCreate(t *testing.T) {
 	r.Equal(`CREATE EXTERNAL TABLE "test_db"."test_schema"."test_table" ("column1" OBJECT AS expression1, "column2" VARCHAR AS expression2) WITH LOCATION = location REFRESH_ON_CREATE = false AUTO_REFRESH = false PATTERN = 'pattern' FILE_FORMAT = ( TYPE = CSV FIELD_DELIMITER = '|' ) COMMENT = 'Test Comment'`, s.Create())
 }
 
+func TestExternalTableCreateDeltaLake(t *testing.T) {
+	r := require.New(t)
+	s := NewExternalTableBuilder("test_table", "test_db", "test_schema")
+	s.WithColumns([]map[string]string{{"name": "column1", "type": "VARCHAR", "as": "expression1"}})
+	s.WithPartitionBys([]string{"column1"})
+	s.WithLocation("location")
+	s.WithFileFormat("TYPE = PARQUET")
+	s.WithTableFormat("DELTA")
+
+	r.Equal(`CREATE EXTERNAL TABLE "test_db"."test_schema"."test_table" ("column1" VARCHAR AS expression1) PARTITION BY ( column1 ) WITH LOCATION = location REFRESH_ON_CREATE = false AUTO_REFRESH = false FILE_FORMAT = ( TYPE = PARQUET ) TABLE_FORMAT = DELTA`, s.Create())
+}
+
+func TestExternalTableChangeAutoRefresh(t *testing.T) {
+	r := require.New(t)
+	s := NewExternalTableBuilder("test_table", "test_db", "test_schema")
+	r.Equal(`ALTER EXTERNAL TABLE "test_db"."test_schema"."test_table" SET AUTO_REFRESH = true`, s.ChangeAutoRefresh(true))
+}
+
+func TestExternalTableRefresh(t *testing.T) {
+	r := require.New(t)
+	s := NewExternalTableBuilder("test_table", "test_db", "test_schema")
+	r.Equal(`ALTER EXTERNAL TABLE "test_db"."test_schema"."test_table" REFRESH`, s.Refresh(""))
+	r.Equal(`ALTER EXTERNAL TABLE "test_db"."test_schema"."test_table" REFRESH 'year=2024/'`, s.Refresh("year=2024/"))
+}
+
 func TestExternalTableUpdate(t *testing.T) {
 	r := require.New(t)
 	s := NewExternalTableBuilder("test_table", "test_db", "test_schema")
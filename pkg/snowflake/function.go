@@ -29,6 +29,15 @@ type FunctionBuilder struct {
 	statement         string
 	runtimeVersion    string // for Python runtime version
 	secure            bool
+
+	externalAccessIntegrations []string
+	secrets                    []FunctionSecret
+}
+
+// FunctionSecret binds a secret to the variable name used by the handler code of the function or procedure.
+type FunctionSecret struct {
+	VariableName string
+	SecretID     string
 }
 
 // QualifiedName prepends the db and schema and appends argument types.
@@ -88,7 +97,7 @@ func (pb *FunctionBuilder) WithReturnType(s string) *FunctionBuilder {
 	return pb
 }
 
-// WithLanguage sets the language to SQL, JAVA or JAVASCRIPT.
+// WithLanguage sets the language to SQL, JAVA, SCALA, JAVASCRIPT or PYTHON.
 func (pb *FunctionBuilder) WithLanguage(s string) *FunctionBuilder {
 	pb.language = s
 	return pb
@@ -118,6 +127,18 @@ func (pb *FunctionBuilder) WithTargetPath(s string) *FunctionBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the external access integrations the handler code can use.
+func (pb *FunctionBuilder) WithExternalAccessIntegrations(integrations []string) *FunctionBuilder {
+	pb.externalAccessIntegrations = integrations
+	return pb
+}
+
+// WithSecrets sets the secrets the handler code can read.
+func (pb *FunctionBuilder) WithSecrets(secrets []FunctionSecret) *FunctionBuilder {
+	pb.secrets = secrets
+	return pb
+}
+
 // WithSecure sets the secure boolean to true
 // [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-function)
 func (pb *FunctionBuilder) WithSecure() *FunctionBuilder {
@@ -230,14 +251,78 @@ func (pb *FunctionBuilder) Create() (string, error) {
 		q.WriteString(fmt.Sprintf(" HANDLER = '%v'", pb.handler))
 	}
 
+	if len(pb.externalAccessIntegrations) > 0 {
+		q.WriteString(fmt.Sprintf(" EXTERNAL_ACCESS_INTEGRATIONS = %v", pb.formattedExternalAccessIntegrations()))
+	}
+
+	if len(pb.secrets) > 0 {
+		q.WriteString(fmt.Sprintf(" SECRETS = %v", pb.formattedSecrets()))
+	}
+
 	if pb.targetPath != "" {
 		q.WriteString(fmt.Sprintf(" TARGET_PATH = '%v'", pb.targetPath))
 	}
 
-	q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
+	// Java and Scala functions with the handler in a staged jar have no inline code
+	if pb.statement != "" {
+		q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
+	}
 	return q.String(), nil
 }
 
+func (pb *FunctionBuilder) formattedExternalAccessIntegrations() string {
+	return formatExternalAccessIntegrations(pb.externalAccessIntegrations)
+}
+
+func (pb *FunctionBuilder) formattedSecrets() string {
+	return formatSecrets(pb.secrets)
+}
+
+// formatExternalAccessIntegrations renders the EXTERNAL_ACCESS_INTEGRATIONS list shared by functions and procedures.
+func formatExternalAccessIntegrations(externalAccessIntegrations []string) string {
+	integrations := make([]string, len(externalAccessIntegrations))
+	for i, integration := range externalAccessIntegrations {
+		integrations[i] = fmt.Sprintf(`"%v"`, EscapeString(integration))
+	}
+	return fmt.Sprintf(`(%v)`, strings.Join(integrations, ", "))
+}
+
+// formatSecrets renders the SECRETS list shared by functions and procedures.
+func formatSecrets(functionSecrets []FunctionSecret) string {
+	secrets := make([]string, len(functionSecrets))
+	for i, secret := range functionSecrets {
+		secrets[i] = fmt.Sprintf(`'%v' = %v`, EscapeString(secret.VariableName), secret.SecretID)
+	}
+	return fmt.Sprintf(`(%v)`, strings.Join(secrets, ", "))
+}
+
+// ChangeExternalAccessIntegrations returns the SQL query that will replace the external access integrations of the function,
+// or unset them when the list is empty.
+func (pb *FunctionBuilder) ChangeExternalAccessIntegrations(integrations []string) (string, error) {
+	qn, err := pb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	pb.externalAccessIntegrations = integrations
+	if len(integrations) == 0 {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET EXTERNAL_ACCESS_INTEGRATIONS`, qn), nil
+	}
+	return fmt.Sprintf(`ALTER FUNCTION %v SET EXTERNAL_ACCESS_INTEGRATIONS = %v`, qn, pb.formattedExternalAccessIntegrations()), nil
+}
+
+// ChangeSecrets returns the SQL query that will replace the secrets of the function, or unset them when the list is empty.
+func (pb *FunctionBuilder) ChangeSecrets(secrets []FunctionSecret) (string, error) {
+	qn, err := pb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	pb.secrets = secrets
+	if len(secrets) == 0 {
+		return fmt.Sprintf(`ALTER FUNCTION %v UNSET SECRETS`, qn), nil
+	}
+	return fmt.Sprintf(`ALTER FUNCTION %v SET SECRETS = %v`, qn, pb.formattedSecrets()), nil
+}
+
 // Rename returns the SQL query that will rename the function.
 func (pb *FunctionBuilder) Rename(newName string) (string, error) {
 	oldName, err := pb.QualifiedName()
@@ -383,5 +468,71 @@ func ListUserFunctions(databaseName string, schemaName string, db *sql.DB) ([]Us
 		}
 		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
 	}
-	return dbs, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	return dbs, nil
+}
+
+// CallableDetails is a row of the FUNCTIONS or PROCEDURES view of the INFORMATION_SCHEMA, with the
+// properties that SHOW USER FUNCTIONS and SHOW PROCEDURES do not return.
+type CallableDetails struct {
+	Name              sql.NullString `db:"NAME"`
+	ArgumentSignature sql.NullString `db:"ARGUMENT_SIGNATURE"`
+	Language          sql.NullString `db:"LANGUAGE"`
+	Owner             sql.NullString `db:"OWNER"`
+}
+
+// Signature returns the name and the argument types, e.g. ADD(NUMBER, VARCHAR), in the format of the
+// arguments column of SHOW USER FUNCTIONS and SHOW PROCEDURES.
+func (c CallableDetails) Signature() string {
+	arguments := strings.TrimSuffix(strings.TrimPrefix(c.ArgumentSignature.String, "("), ")")
+	argumentTypes := []string{}
+	for _, argument := range splitArguments(arguments) {
+		// each argument is its name followed by its type
+		if _, argumentType, found := strings.Cut(strings.TrimSpace(argument), " "); found {
+			argumentTypes = append(argumentTypes, strings.TrimSpace(argumentType))
+		}
+	}
+	return fmt.Sprintf("%s(%s)", c.Name.String, strings.Join(argumentTypes, ", "))
+}
+
+// splitArguments splits the arguments on the commas that are not inside of a type, e.g. NUMBER(38, 0).
+func splitArguments(arguments string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range arguments {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, arguments[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if strings.TrimSpace(arguments[start:]) != "" {
+		parts = append(parts, arguments[start:])
+	}
+	return parts
+}
+
+// ListFunctionDetails returns the language and the owner of the user functions of the schema.
+func ListFunctionDetails(databaseName string, schemaName string, db *sql.DB) ([]CallableDetails, error) {
+	stmt := fmt.Sprintf(`SELECT FUNCTION_NAME AS NAME, ARGUMENT_SIGNATURE, FUNCTION_LANGUAGE AS LANGUAGE, FUNCTION_OWNER AS OWNER FROM "%v".INFORMATION_SCHEMA.FUNCTIONS WHERE FUNCTION_SCHEMA = '%v'`, databaseName, schemaName)
+	return listCallableDetails(stmt, db)
+}
+
+func listCallableDetails(stmt string, db *sql.DB) ([]CallableDetails, error) {
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	details := []CallableDetails{}
+	if err := sqlx.StructScan(rows, &details); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return details, nil
 }
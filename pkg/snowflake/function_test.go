@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -226,6 +227,77 @@ func TestFunctionCreateWithPythonFunctionWithTargetPath(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestFunctionCreateWithPythonFunctionWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getPythonFunction(true)
+	s.WithLanguage("PYTHON")
+	s.WithRuntimeVersion("3.10")
+	s.WithHandler("add_py")
+	s.WithExternalAccessIntegrations([]string{"EAI_A", "EAI_B"})
+	s.WithSecrets([]FunctionSecret{{VariableName: "cred", SecretID: `"db"."schema"."secret"`}})
+
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE FUNCTION "test_db"."test_schema"."test_func"` +
+		`(arg INT) RETURNS INT` +
+		` LANGUAGE PYTHON RUNTIME_VERSION = '3.10'` +
+		` HANDLER = 'add_py' EXTERNAL_ACCESS_INTEGRATIONS = ("EAI_A", "EAI_B") SECRETS = ('cred' = "db"."schema"."secret")` +
+		` AS $$` + pythonfunc + `$$`
+	r.Equal(expected, createStmnt)
+}
+
+func TestFunctionChangeExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getPythonFunction(true)
+
+	q, err := s.ChangeExternalAccessIntegrations([]string{"EAI_A"})
+	r.NoError(err)
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_func"(INT) SET EXTERNAL_ACCESS_INTEGRATIONS = ("EAI_A")`, q)
+
+	q, err = s.ChangeExternalAccessIntegrations([]string{})
+	r.NoError(err)
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_func"(INT) UNSET EXTERNAL_ACCESS_INTEGRATIONS`, q)
+
+	q, err = s.ChangeSecrets([]FunctionSecret{{VariableName: "cred", SecretID: `"db"."schema"."secret"`}})
+	r.NoError(err)
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_func"(INT) SET SECRETS = ('cred' = "db"."schema"."secret")`, q)
+
+	q, err = s.ChangeSecrets(nil)
+	r.NoError(err)
+	r.Equal(`ALTER FUNCTION "test_db"."test_schema"."test_func"(INT) UNSET SECRETS`, q)
+}
+
+func TestFunctionCreateWithJavaFunctionFromStagedJar(t *testing.T) {
+	r := require.New(t)
+	s := NewFunctionBuilder("test_db", "test_schema", "test_func", []string{})
+	s.WithArgs([]map[string]string{{"name": "user", "type": "varchar"}})
+	s.WithReturnType("varchar")
+	s.WithLanguage("JAVA")
+	s.WithImports([]string{"@~/stage/handler.jar"})
+	s.WithHandler("CoolFunc.test")
+
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE FUNCTION "test_db"."test_schema"."test_func"` +
+		`(user VARCHAR) RETURNS VARCHAR LANGUAGE JAVA IMPORTS = ('@~/stage/handler.jar') HANDLER = 'CoolFunc.test'`
+	r.Equal(expected, createStmnt)
+}
+
+func TestFunctionCreateWithScalaTableFunction(t *testing.T) {
+	r := require.New(t)
+	s := NewFunctionBuilder("test_db", "test_schema", "test_func", []string{})
+	s.WithArgs([]map[string]string{{"name": "sentence", "type": "varchar"}})
+	s.WithReturnType("table (word varchar, position number)")
+	s.WithLanguage("SCALA")
+	s.WithRuntimeVersion("2.12")
+	s.WithHandler("Splitter")
+	s.WithStatement("class Splitter {}")
+
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE FUNCTION "test_db"."test_schema"."test_func"` +
+		`(sentence VARCHAR) RETURNS TABLE (WORD VARCHAR, POSITION NUMBER) LANGUAGE SCALA RUNTIME_VERSION = '2.12'` +
+		` HANDLER = 'Splitter' AS $$class Splitter {}$$`
+	r.Equal(expected, createStmnt)
+}
+
 func TestFunctionDrop(t *testing.T) {
 	r := require.New(t)
 
@@ -310,3 +382,20 @@ func TestFunctionArgumentsSignature(t *testing.T) {
 	sign, _ = s.ArgumentsSignature()
 	r.Equal("test_func(VARCHAR, DATE) RETURN VARCHAR", sign)
 }
+
+func TestCallableDetailsSignature(t *testing.T) {
+	r := require.New(t)
+
+	for argumentSignature, expected := range map[string]string{
+		"()":                                 "ADD()",
+		"(A NUMBER, B VARCHAR)":              "ADD(NUMBER, VARCHAR)",
+		"(A NUMBER(38, 0), B TIMESTAMP_NTZ)": "ADD(NUMBER(38, 0), TIMESTAMP_NTZ)",
+		"(V VECTOR(FLOAT, 3))":               "ADD(VECTOR(FLOAT, 3))",
+	} {
+		details := CallableDetails{
+			Name:              sql.NullString{String: "ADD", Valid: true},
+			ArgumentSignature: sql.NullString{String: argumentSignature, Valid: true},
+		}
+		r.Equal(expected, details.Signature())
+	}
+}
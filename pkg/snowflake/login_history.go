@@ -0,0 +1,82 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// LoginHistoryFilter limits the rows returned from the SNOWFLAKE.ACCOUNT_USAGE.LOGIN_HISTORY view. The times are
+// timestamps accepted by TO_TIMESTAMP_LTZ, e.g. 2023-01-01T00:00:00Z; the empty fields are not filtered on.
+type LoginHistoryFilter struct {
+	UserName  string
+	StartTime string
+	EndTime   string
+	// FailedOnly returns only the unsuccessful login attempts.
+	FailedOnly bool
+	Limit      int
+}
+
+// LoginHistoryEvent is a single row of the SNOWFLAKE.ACCOUNT_USAGE.LOGIN_HISTORY view.
+type LoginHistoryEvent struct {
+	EventID                    sql.NullString `db:"EVENT_ID"`
+	EventTimestamp             sql.NullString `db:"EVENT_TIMESTAMP"`
+	EventType                  sql.NullString `db:"EVENT_TYPE"`
+	UserName                   sql.NullString `db:"USER_NAME"`
+	ClientIP                   sql.NullString `db:"CLIENT_IP"`
+	ReportedClientType         sql.NullString `db:"REPORTED_CLIENT_TYPE"`
+	ReportedClientVersion      sql.NullString `db:"REPORTED_CLIENT_VERSION"`
+	FirstAuthenticationFactor  sql.NullString `db:"FIRST_AUTHENTICATION_FACTOR"`
+	SecondAuthenticationFactor sql.NullString `db:"SECOND_AUTHENTICATION_FACTOR"`
+	IsSuccess                  sql.NullString `db:"IS_SUCCESS"`
+	ErrorCode                  sql.NullString `db:"ERROR_CODE"`
+	ErrorMessage               sql.NullString `db:"ERROR_MESSAGE"`
+}
+
+// LoginHistoryQuery returns the SQL query selecting the login events matching the filter, the latest first.
+func LoginHistoryQuery(filter LoginHistoryFilter) string {
+	conditions := []string{}
+	if filter.UserName != "" {
+		conditions = append(conditions, fmt.Sprintf(`USER_NAME = '%v'`, EscapeString(filter.UserName)))
+	}
+	if filter.StartTime != "" {
+		conditions = append(conditions, fmt.Sprintf(`EVENT_TIMESTAMP >= TO_TIMESTAMP_LTZ('%v')`, EscapeString(filter.StartTime)))
+	}
+	if filter.EndTime != "" {
+		conditions = append(conditions, fmt.Sprintf(`EVENT_TIMESTAMP < TO_TIMESTAMP_LTZ('%v')`, EscapeString(filter.EndTime)))
+	}
+	if filter.FailedOnly {
+		conditions = append(conditions, `IS_SUCCESS = 'NO'`)
+	}
+
+	q := strings.Builder{}
+	q.WriteString(`SELECT EVENT_ID, EVENT_TIMESTAMP, EVENT_TYPE, USER_NAME, CLIENT_IP, REPORTED_CLIENT_TYPE, REPORTED_CLIENT_VERSION,`)
+	q.WriteString(` FIRST_AUTHENTICATION_FACTOR, SECOND_AUTHENTICATION_FACTOR, IS_SUCCESS, ERROR_CODE, ERROR_MESSAGE`)
+	q.WriteString(` FROM SNOWFLAKE.ACCOUNT_USAGE.LOGIN_HISTORY`)
+	if len(conditions) > 0 {
+		q.WriteString(` WHERE ` + strings.Join(conditions, " AND "))
+	}
+	q.WriteString(` ORDER BY EVENT_TIMESTAMP DESC`)
+	if filter.Limit > 0 {
+		q.WriteString(fmt.Sprintf(` LIMIT %d`, filter.Limit))
+	}
+	return q.String()
+}
+
+// ListLoginHistory returns the login events matching the filter, the latest first.
+func ListLoginHistory(db *sql.DB, filter LoginHistoryFilter) ([]LoginHistoryEvent, error) {
+	stmt := LoginHistoryQuery(filter)
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []LoginHistoryEvent{}
+	if err := sqlx.StructScan(rows, &events); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return events, nil
+}
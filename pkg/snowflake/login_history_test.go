@@ -0,0 +1,24 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginHistoryQuery(t *testing.T) {
+	r := require.New(t)
+	columns := `SELECT EVENT_ID, EVENT_TIMESTAMP, EVENT_TYPE, USER_NAME, CLIENT_IP, REPORTED_CLIENT_TYPE, REPORTED_CLIENT_VERSION, FIRST_AUTHENTICATION_FACTOR, SECOND_AUTHENTICATION_FACTOR, IS_SUCCESS, ERROR_CODE, ERROR_MESSAGE FROM SNOWFLAKE.ACCOUNT_USAGE.LOGIN_HISTORY`
+
+	r.Equal(columns+` ORDER BY EVENT_TIMESTAMP DESC`, snowflake.LoginHistoryQuery(snowflake.LoginHistoryFilter{}))
+
+	q := snowflake.LoginHistoryQuery(snowflake.LoginHistoryFilter{
+		UserName:   "O'BRIEN",
+		StartTime:  "2023-01-01T00:00:00Z",
+		EndTime:    "2023-01-02T00:00:00Z",
+		FailedOnly: true,
+		Limit:      10,
+	})
+	r.Equal(columns+` WHERE USER_NAME = 'O\'BRIEN' AND EVENT_TIMESTAMP >= TO_TIMESTAMP_LTZ('2023-01-01T00:00:00Z') AND EVENT_TIMESTAMP < TO_TIMESTAMP_LTZ('2023-01-02T00:00:00Z') AND IS_SUCCESS = 'NO' ORDER BY EVENT_TIMESTAMP DESC LIMIT 10`, q)
+}
@@ -20,6 +20,7 @@ type MaterializedViewBuilder struct {
 	replace   bool
 	comment   string
 	statement string
+	clusterBy []string
 	tags      []TagValue
 }
 
@@ -87,6 +88,12 @@ func (vb *MaterializedViewBuilder) WithStatement(s string) *MaterializedViewBuil
 	return vb
 }
 
+// WithClustering adds cluster keys/expressions to the MaterializedViewBuilder.
+func (vb *MaterializedViewBuilder) WithClustering(c []string) *MaterializedViewBuilder {
+	vb.clusterBy = c
+	return vb
+}
+
 // WithTags sets the tags on the ExternalTableBuilder.
 func (vb *MaterializedViewBuilder) WithTags(tags []TagValue) *MaterializedViewBuilder {
 	vb.tags = tags
@@ -148,6 +155,10 @@ func (vb *MaterializedViewBuilder) Create() []string {
 		q1.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(vb.comment)))
 	}
 
+	if len(vb.clusterBy) > 0 {
+		q1.WriteString(fmt.Sprintf(" CLUSTER BY (%v)", JoinStringList(vb.clusterBy, ", ")))
+	}
+
 	q1.WriteString(fmt.Sprintf(" AS %v", vb.statement))
 
 	s := make([]string, 2)
@@ -173,6 +184,26 @@ func (vb *MaterializedViewBuilder) Unsecure() string {
 	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %v UNSET SECURE`, vb.QualifiedName())
 }
 
+// ChangeClusterBy returns the SQL query that will change the clustering key of the view.
+func (vb *MaterializedViewBuilder) ChangeClusterBy(cb []string) string {
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %v CLUSTER BY (%v)`, vb.QualifiedName(), JoinStringList(cb, ", "))
+}
+
+// DropClustering returns the SQL query that will remove the clustering key from the view.
+func (vb *MaterializedViewBuilder) DropClustering() string {
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %v DROP CLUSTERING KEY`, vb.QualifiedName())
+}
+
+// SuspendRecluster returns the SQL query that will suspend automatic clustering of the view.
+func (vb *MaterializedViewBuilder) SuspendRecluster() string {
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %v SUSPEND RECLUSTER`, vb.QualifiedName())
+}
+
+// ResumeRecluster returns the SQL query that will resume automatic clustering of the view.
+func (vb *MaterializedViewBuilder) ResumeRecluster() string {
+	return fmt.Sprintf(`ALTER MATERIALIZED VIEW %v RESUME RECLUSTER`, vb.QualifiedName())
+}
+
 // ChangeComment returns the SQL query that will update the comment on the view.
 // Note that comment is the only parameter, if more are released this should be
 // abstracted as per the generic builder.
@@ -201,13 +232,16 @@ func (vb *MaterializedViewBuilder) Drop() string {
 }
 
 type MaterializedView struct {
-	Comment       sql.NullString `db:"comment"`
-	IsSecure      bool           `db:"is_secure"`
-	Name          sql.NullString `db:"name"`
-	SchemaName    sql.NullString `db:"schema_name"`
-	Text          sql.NullString `db:"text"`
-	DatabaseName  sql.NullString `db:"database_name"`
-	WarehouseName sql.NullString `db:"warehouse_name"`
+	Comment             sql.NullString `db:"comment"`
+	IsSecure            bool           `db:"is_secure"`
+	Name                sql.NullString `db:"name"`
+	SchemaName          sql.NullString `db:"schema_name"`
+	Text                sql.NullString `db:"text"`
+	DatabaseName        sql.NullString `db:"database_name"`
+	WarehouseName       sql.NullString `db:"warehouse_name"`
+	ClusterBy           sql.NullString `db:"cluster_by"`
+	AutomaticClustering sql.NullString `db:"automatic_clustering"`
+	Owner               sql.NullString `db:"owner"`
 }
 
 func ScanMaterializedView(row *sqlx.Row) (*MaterializedView, error) {
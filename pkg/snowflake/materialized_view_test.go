@@ -0,0 +1,22 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterializedViewClustering(t *testing.T) {
+	r := require.New(t)
+	vb := NewMaterializedViewBuilder("test").WithDB("some_database").WithSchema("some_schema").WithWarehouse("wh")
+	vb.WithSecure().WithClustering([]string{"ID", "TO_DATE(CREATED_AT)"}).WithStatement("SELECT * FROM DUMMY")
+
+	q := vb.Create()
+	r.Equal(`USE WAREHOUSE wh;`, q[0])
+	r.Equal(`CREATE SECURE MATERIALIZED VIEW "some_database"."some_schema"."test" CLUSTER BY (ID, TO_DATE(CREATED_AT)) AS SELECT * FROM DUMMY`, q[1])
+
+	r.Equal(`ALTER MATERIALIZED VIEW "some_database"."some_schema"."test" CLUSTER BY (ID)`, vb.ChangeClusterBy([]string{"ID"}))
+	r.Equal(`ALTER MATERIALIZED VIEW "some_database"."some_schema"."test" DROP CLUSTERING KEY`, vb.DropClustering())
+	r.Equal(`ALTER MATERIALIZED VIEW "some_database"."some_schema"."test" SUSPEND RECLUSTER`, vb.SuspendRecluster())
+	r.Equal(`ALTER MATERIALIZED VIEW "some_database"."some_schema"."test" RESUME RECLUSTER`, vb.ResumeRecluster())
+}
@@ -40,13 +40,16 @@ func (e *ViewSelectStatementExtractor) Extract() (string, error) {
 	e.consumeToken("if not exists")
 	e.consumeSpace()
 	e.consumeID()
-	// TODO column list
+	e.consumeSpace()
+	e.consumeColumnList()
 	e.consumeSpace()
 	e.consumeToken("copy grants")
 	e.consumeComment()
 	e.consumeSpace()
 	e.consumeComment()
 	e.consumeSpace()
+	e.consumeChangeTracking()
+	e.consumeSpace()
 	e.consumeToken("as")
 	e.consumeSpace()
 
@@ -173,16 +176,74 @@ func (e *ViewSelectStatementExtractor) consumeComment() {
 	}
 }
 
+// consumeColumnList consumes a parenthesized column list, skipping over quoted identifiers and
+// comments so that parentheses inside them are not taken into account.
+func (e *ViewSelectStatementExtractor) consumeColumnList() {
+	if e.pos > len(e.input)-1 || e.input[e.pos] != '(' {
+		return
+	}
+	depth := 0
+	var quote rune
+	escaped := false
+	found := 0
+	for e.pos+found < len(e.input) {
+		r := e.input[e.pos+found]
+		found++
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	e.pos += found
+}
+
+func (e *ViewSelectStatementExtractor) consumeChangeTracking() {
+	if c := e.consumeToken("change_tracking"); !c {
+		return
+	}
+	e.consumeSpace()
+	if c := e.consumeToken("="); !c {
+		return
+	}
+	e.consumeSpace()
+	if !e.consumeToken("true") {
+		e.consumeToken("false")
+	}
+}
+
 func (e *ViewSelectStatementExtractor) consumeClusterBy() {
 	if e.input[e.pos] != '(' {
 		return
 	}
-	found := 1
-	for {
-		if e.pos+found > len(e.input)-1 || e.input[e.pos+found-1] == ')' {
-			break
+	// cluster keys may contain function calls, so track the nesting of parentheses
+	depth := 0
+	found := 0
+	for e.pos+found < len(e.input) {
+		switch e.input[e.pos+found] {
+		case '(':
+			depth++
+		case ')':
+			depth--
 		}
 		found++
+		if depth == 0 {
+			break
+		}
 	}
 	e.pos += found
 }
@@ -29,6 +29,8 @@ from bar;`
 	comment := `create view foo comment='asdf' as select * from bar;`
 	commentEscape := `create view foo comment='asdf\'s are fun' as select * from bar;`
 	identifier := `create view "foo"."bar"."bam" comment='asdf\'s are fun' as select * from bar;`
+	columns := `create view foo ("ID" COMMENT 'the (primary) id', "DATA") copy grants as select * from bar;`
+	changeTracking := `create view foo comment='asdf' change_tracking = true as select * from bar;`
 
 	full := `CREATE SECURE VIEW "rgdxfmnfhh"."PUBLIC"."rgdxfmnfhh" COMMENT = 'Terraform test resource' AS SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES`
 
@@ -54,6 +56,8 @@ from bar;`
 		{"comment", args{comment}, "select * from bar;", false},
 		{"commentEscape", args{commentEscape}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
+		{"columns", args{columns}, "select * from bar;", false},
+		{"changeTracking", args{changeTracking}, "select * from bar;", false},
 		{"full", args{full}, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", false},
 	}
 	for _, tt := range tests {
@@ -94,6 +98,7 @@ from bar;`
 	comment := `create materialized view foo comment='asdf' as select * from bar;`
 	commentEscape := `create materialized view foo comment='asdf\'s are fun' as select * from bar;`
 	clusterBy := "create materialized view foo cluster by (c1, c2) as select * from bar;"
+	clusterByExpression := "create materialized view foo cluster by (substr(c1, 0, 10), to_date(c2)) as select * from bar;"
 	identifier := `create materialized view "foo"."bar"."bam" comment='asdf\'s are fun' as select * from bar;`
 
 	full := `CREATE SECURE MATERIALIZED VIEW "rgdxfmnfhh"."PUBLIC"."rgdxfmnfhh" COMMENT = 'Terraform test resource' CLUSTER BY (C1, C2) AS SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES`
@@ -118,6 +123,7 @@ from bar;`
 		{"comment", args{comment}, "select * from bar;", false},
 		{"commentEscape", args{commentEscape}, "select * from bar;", false},
 		{"clusterBy", args{clusterBy}, "select * from bar;", false},
+		{"clusterByExpression", args{clusterByExpression}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
 		{"full", args{full}, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES", false},
 	}
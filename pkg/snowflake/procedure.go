@@ -27,7 +27,10 @@ type ProcedureBuilder struct {
 	executeAs         string
 	comment           string
 	statement         string
-	runtimeVersion    string // for Python runtime version
+	runtimeVersion    string // for Java / Scala / Python runtime version
+
+	externalAccessIntegrations []string
+	secrets                    []FunctionSecret
 }
 
 // QualifiedName prepends the db and schema and appends argument types.
@@ -117,6 +120,18 @@ func (pb *ProcedureBuilder) WithHandler(s string) *ProcedureBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the external access integrations the handler code can use.
+func (pb *ProcedureBuilder) WithExternalAccessIntegrations(integrations []string) *ProcedureBuilder {
+	pb.externalAccessIntegrations = integrations
+	return pb
+}
+
+// WithSecrets sets the secrets the handler code can read.
+func (pb *ProcedureBuilder) WithSecrets(secrets []FunctionSecret) *ProcedureBuilder {
+	pb.secrets = secrets
+	return pb
+}
+
 // WithComment adds a comment to the ProcedureBuilder.
 func (pb *ProcedureBuilder) WithComment(c string) *ProcedureBuilder {
 	pb.comment = c
@@ -208,11 +223,20 @@ func (pb *ProcedureBuilder) Create() (string, error) {
 	if pb.handler != "" {
 		q.WriteString(fmt.Sprintf(" HANDLER = '%v'", pb.handler))
 	}
+	if len(pb.externalAccessIntegrations) > 0 {
+		q.WriteString(fmt.Sprintf(" EXTERNAL_ACCESS_INTEGRATIONS = %v", formatExternalAccessIntegrations(pb.externalAccessIntegrations)))
+	}
+	if len(pb.secrets) > 0 {
+		q.WriteString(fmt.Sprintf(" SECRETS = %v", formatSecrets(pb.secrets)))
+	}
 	if pb.comment != "" {
 		q.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(pb.comment)))
 	}
 	q.WriteString(fmt.Sprintf(" EXECUTE AS %v", pb.executeAs))
-	q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
+	// Java, Scala and Python procedures with the handler in a staged file have no inline code
+	if pb.statement != "" {
+		q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
+	}
 	return q.String(), nil
 }
 
@@ -259,6 +283,33 @@ func (pb *ProcedureBuilder) ChangeExecuteAs(c string) (string, error) {
 	return fmt.Sprintf(`ALTER PROCEDURE %v EXECUTE AS %v`, qn, c), nil
 }
 
+// ChangeExternalAccessIntegrations returns the SQL query that will replace the external access integrations of the procedure,
+// or unset them when the list is empty.
+func (pb *ProcedureBuilder) ChangeExternalAccessIntegrations(integrations []string) (string, error) {
+	qn, err := pb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	pb.externalAccessIntegrations = integrations
+	if len(integrations) == 0 {
+		return fmt.Sprintf(`ALTER PROCEDURE %v UNSET EXTERNAL_ACCESS_INTEGRATIONS`, qn), nil
+	}
+	return fmt.Sprintf(`ALTER PROCEDURE %v SET EXTERNAL_ACCESS_INTEGRATIONS = %v`, qn, formatExternalAccessIntegrations(pb.externalAccessIntegrations)), nil
+}
+
+// ChangeSecrets returns the SQL query that will replace the secrets of the procedure, or unset them when the list is empty.
+func (pb *ProcedureBuilder) ChangeSecrets(secrets []FunctionSecret) (string, error) {
+	qn, err := pb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	pb.secrets = secrets
+	if len(secrets) == 0 {
+		return fmt.Sprintf(`ALTER PROCEDURE %v UNSET SECRETS`, qn), nil
+	}
+	return fmt.Sprintf(`ALTER PROCEDURE %v SET SECRETS = %v`, qn, formatSecrets(pb.secrets)), nil
+}
+
 // Show returns the SQL query that will show the row representing this procedure.
 // This show statement returns all procedures with the given name (overloaded ones).
 func (pb *ProcedureBuilder) Show() string {
@@ -329,6 +380,12 @@ func ScanProcedures(rows *sqlx.Rows) ([]*Procedure, error) {
 	return pcs, rows.Err()
 }
 
+// ListProcedureDetails returns the language and the owner of the procedures of the schema.
+func ListProcedureDetails(databaseName string, schemaName string, db *sql.DB) ([]CallableDetails, error) {
+	stmt := fmt.Sprintf(`SELECT PROCEDURE_NAME AS NAME, ARGUMENT_SIGNATURE, PROCEDURE_LANGUAGE AS LANGUAGE, PROCEDURE_OWNER AS OWNER FROM "%v".INFORMATION_SCHEMA.PROCEDURES WHERE PROCEDURE_SCHEMA = '%v'`, databaseName, schemaName)
+	return listCallableDetails(stmt, db)
+}
+
 func ListProcedures(databaseName string, schemaName string, db *sql.DB) ([]Procedure, error) {
 	stmt := fmt.Sprintf(`SHOW PROCEDURES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
 	rows, err := Query(db, stmt)
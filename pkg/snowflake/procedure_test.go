@@ -61,6 +61,43 @@ func TestProcedureCreateWithOptionalParams(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestProcedureCreateWithStagedHandlerAndExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := NewProcedureBuilder("test_db", "test_schema", "test_proc", []string{})
+	s.WithReturnType("varchar")
+	s.WithExecuteAs("OWNER")
+	s.WithLanguage("JAVA")
+	s.WithRuntimeVersion("11")
+	s.WithPackages([]string{"com.snowflake:snowpark:latest"})
+	s.WithImports([]string{"@\"test_db\".\"test_schema\".\"test_stage\"/handler.jar"})
+	s.WithHandler("TestHandler.run")
+	s.WithExternalAccessIntegrations([]string{"TEST_INTEGRATION"})
+	s.WithSecrets([]FunctionSecret{{VariableName: "cred", SecretID: `"test_db"."test_schema"."test_secret"`}})
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE PROCEDURE "test_db"."test_schema"."test_proc"() RETURNS VARCHAR LANGUAGE JAVA ` +
+		`RUNTIME_VERSION = '11' PACKAGES = ('com.snowflake:snowpark:latest') ` +
+		`IMPORTS = ('@"test_db"."test_schema"."test_stage"/handler.jar') HANDLER = 'TestHandler.run' ` +
+		`EXTERNAL_ACCESS_INTEGRATIONS = ("TEST_INTEGRATION") SECRETS = ('cred' = "test_db"."test_schema"."test_secret") EXECUTE AS OWNER`
+	r.Equal(expected, createStmnt)
+}
+
+func TestProcedureChangeExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getProcedure(false)
+
+	stmnt, _ := s.ChangeExternalAccessIntegrations([]string{"A", "B"})
+	r.Equal(`ALTER PROCEDURE "test_db"."test_schema"."test_proc"() SET EXTERNAL_ACCESS_INTEGRATIONS = ("A", "B")`, stmnt)
+
+	stmnt, _ = s.ChangeSecrets([]FunctionSecret{{VariableName: "cred", SecretID: `"test_db"."test_schema"."test_secret"`}})
+	r.Equal(`ALTER PROCEDURE "test_db"."test_schema"."test_proc"() SET SECRETS = ('cred' = "test_db"."test_schema"."test_secret")`, stmnt)
+
+	stmnt, _ = s.ChangeExternalAccessIntegrations(nil)
+	r.Equal(`ALTER PROCEDURE "test_db"."test_schema"."test_proc"() UNSET EXTERNAL_ACCESS_INTEGRATIONS`, stmnt)
+
+	stmnt, _ = s.ChangeSecrets(nil)
+	r.Equal(`ALTER PROCEDURE "test_db"."test_schema"."test_proc"() UNSET SECRETS`, stmnt)
+}
+
 func TestProcedureDrop(t *testing.T) {
 	r := require.New(t)
 
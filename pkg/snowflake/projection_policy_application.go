@@ -0,0 +1,31 @@
+package snowflake
+
+import "fmt"
+
+type TableColumnProjectionPolicyApplication struct {
+	Table            *SchemaObjectIdentifier
+	Column           string
+	ProjectionPolicy *SchemaObjectIdentifier
+}
+
+type TableColumnProjectionPolicyApplicationManager struct{}
+
+func NewTableColumnProjectionPolicyApplicationManager() *TableColumnProjectionPolicyApplicationManager {
+	return &TableColumnProjectionPolicyApplicationManager{}
+}
+
+type TableColumnProjectionPolicyApplicationCreateInput struct {
+	TableColumnProjectionPolicyApplication
+}
+
+func (m *TableColumnProjectionPolicyApplicationManager) Create(x *TableColumnProjectionPolicyApplicationCreateInput) string {
+	return fmt.Sprintf(`ALTER TABLE IF EXISTS %s MODIFY COLUMN "%s" SET PROJECTION POLICY %s;`, x.Table.QualifiedName(), x.Column, x.ProjectionPolicy.QualifiedName())
+}
+
+type TableColumnProjectionPolicyApplicationDeleteInput struct {
+	TableColumn
+}
+
+func (m *TableColumnProjectionPolicyApplicationManager) Delete(x *TableColumnProjectionPolicyApplicationDeleteInput) string {
+	return fmt.Sprintf(`ALTER TABLE IF EXISTS %s MODIFY COLUMN "%s" UNSET PROJECTION POLICY;`, x.Table.QualifiedName(), x.Column)
+}
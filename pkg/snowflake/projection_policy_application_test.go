@@ -0,0 +1,51 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableColumnProjectionPolicyApplication(t *testing.T) {
+	r := require.New(t)
+
+	input := &snowflake.TableColumnProjectionPolicyApplicationCreateInput{
+		TableColumnProjectionPolicyApplication: snowflake.TableColumnProjectionPolicyApplication{
+			Table: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "table",
+			},
+			Column: "column",
+			ProjectionPolicy: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "myprojectionpolicy",
+			},
+		},
+	}
+
+	mb := snowflake.NewTableColumnProjectionPolicyApplicationManager()
+	createStmt := mb.Create(input)
+	r.Equal(`ALTER TABLE IF EXISTS "db"."schema"."table" MODIFY COLUMN "column" SET PROJECTION POLICY "db"."schema"."myprojectionpolicy";`, createStmt)
+}
+
+func TestDeleteTableColumnProjectionPolicyApplication(t *testing.T) {
+	r := require.New(t)
+
+	input := &snowflake.TableColumnProjectionPolicyApplicationDeleteInput{
+		TableColumn: snowflake.TableColumn{
+			Table: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "table",
+			},
+			Column: "column",
+		},
+	}
+
+	mb := snowflake.NewTableColumnProjectionPolicyApplicationManager()
+	dropStmt := mb.Delete(input)
+	r.Equal(`ALTER TABLE IF EXISTS "db"."schema"."table" MODIFY COLUMN "column" UNSET PROJECTION POLICY;`, dropStmt)
+}
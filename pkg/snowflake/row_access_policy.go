@@ -165,3 +165,21 @@ func ListRowAccessPolicies(databaseName string, schemaName string, db *sql.DB) (
 	}
 	return dbs, nil
 }
+
+// RowAccessPolicyDescription is a row of DESCRIBE ROW ACCESS POLICY.
+type RowAccessPolicyDescription struct {
+	Name       sql.NullString `db:"name"`
+	Signature  sql.NullString `db:"signature"`
+	ReturnType sql.NullString `db:"return_type"`
+	Body       sql.NullString `db:"body"`
+}
+
+func DescribeRowAccessPolicy(databaseName string, schemaName string, name string, db *sql.DB) (*RowAccessPolicyDescription, error) {
+	stmt := RowAccessPolicy(name, databaseName, schemaName).Describe()
+	row := QueryRow(db, stmt)
+	description := &RowAccessPolicyDescription{}
+	if err := row.StructScan(description); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return description, nil
+}
@@ -0,0 +1,39 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RowAccessPolicyAttachment struct {
+	ObjectType      string
+	Object          *SchemaObjectIdentifier
+	RowAccessPolicy *SchemaObjectIdentifier
+	Columns         []string
+}
+
+type RowAccessPolicyAttachmentManager struct{}
+
+func NewRowAccessPolicyAttachmentManager() *RowAccessPolicyAttachmentManager {
+	return &RowAccessPolicyAttachmentManager{}
+}
+
+type RowAccessPolicyAttachmentCreateInput struct {
+	RowAccessPolicyAttachment
+}
+
+func (m *RowAccessPolicyAttachmentManager) Create(x *RowAccessPolicyAttachmentCreateInput) string {
+	columns := make([]string, len(x.Columns))
+	for i, c := range x.Columns {
+		columns[i] = fmt.Sprintf(`"%s"`, c)
+	}
+	return fmt.Sprintf(`ALTER %s %s ADD ROW ACCESS POLICY %s ON (%s);`, x.ObjectType, x.Object.QualifiedName(), x.RowAccessPolicy.QualifiedName(), strings.Join(columns, ", "))
+}
+
+type RowAccessPolicyAttachmentDeleteInput struct {
+	RowAccessPolicyAttachment
+}
+
+func (m *RowAccessPolicyAttachmentManager) Delete(x *RowAccessPolicyAttachmentDeleteInput) string {
+	return fmt.Sprintf(`ALTER %s %s DROP ROW ACCESS POLICY %s;`, x.ObjectType, x.Object.QualifiedName(), x.RowAccessPolicy.QualifiedName())
+}
@@ -0,0 +1,57 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRowAccessPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+
+	input := &snowflake.RowAccessPolicyAttachmentCreateInput{
+		RowAccessPolicyAttachment: snowflake.RowAccessPolicyAttachment{
+			ObjectType: "TABLE",
+			Object: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "table",
+			},
+			RowAccessPolicy: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "myrowaccesspolicy",
+			},
+			Columns: []string{"region", "department"},
+		},
+	}
+
+	mb := snowflake.NewRowAccessPolicyAttachmentManager()
+	createStmt := mb.Create(input)
+	r.Equal(`ALTER TABLE "db"."schema"."table" ADD ROW ACCESS POLICY "db"."schema"."myrowaccesspolicy" ON ("region", "department");`, createStmt)
+}
+
+func TestDeleteRowAccessPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+
+	input := &snowflake.RowAccessPolicyAttachmentDeleteInput{
+		RowAccessPolicyAttachment: snowflake.RowAccessPolicyAttachment{
+			ObjectType: "VIEW",
+			Object: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "view",
+			},
+			RowAccessPolicy: &snowflake.SchemaObjectIdentifier{
+				Database:   "db",
+				Schema:     "schema",
+				ObjectName: "myrowaccesspolicy",
+			},
+		},
+	}
+
+	mb := snowflake.NewRowAccessPolicyAttachmentManager()
+	dropStmt := mb.Delete(input)
+	r.Equal(`ALTER VIEW "db"."schema"."view" DROP ROW ACCESS POLICY "db"."schema"."myrowaccesspolicy";`, dropStmt)
+}
@@ -30,6 +30,7 @@ type SamlIntegration struct {
 	IntegrationType sql.NullString `db:"type"`
 	CreatedOn       sql.NullString `db:"created_on"`
 	Enabled         sql.NullBool   `db:"enabled"`
+	Comment         sql.NullString `db:"comment"`
 }
 
 func ScanSamlIntegration(row *sqlx.Row) (*SamlIntegration, error) {
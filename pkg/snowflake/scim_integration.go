@@ -29,6 +29,8 @@ type SCIMIntegration struct {
 	Category        sql.NullString `db:"category"`
 	IntegrationType sql.NullString `db:"type"`
 	CreatedOn       sql.NullString `db:"created_on"`
+	Enabled         sql.NullBool   `db:"enabled"`
+	Comment         sql.NullString `db:"comment"`
 }
 
 func ScanScimIntegration(row *sqlx.Row) (*SCIMIntegration, error) {
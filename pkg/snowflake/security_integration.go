@@ -0,0 +1,77 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// NewSecurityIntegrationBuilder returns a pointer to a Builder that abstracts the DDL operations common to all the
+// security integrations, e.g. SCIM, OAuth and SAML2 integrations.
+//
+// Supported DDL operations are:
+//   - DROP INTEGRATION
+//   - SHOW INTEGRATIONS
+//   - DESCRIBE INTEGRATION
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/ddl-user-security.html#security-integrations)
+func NewSecurityIntegrationBuilder(name string) *Builder {
+	return &Builder{
+		entityType: SecurityIntegrationType,
+		name:       name,
+	}
+}
+
+type SecurityIntegration struct {
+	Name            sql.NullString `db:"name"`
+	Category        sql.NullString `db:"category"`
+	IntegrationType sql.NullString `db:"type"`
+	CreatedOn       sql.NullString `db:"created_on"`
+	Enabled         sql.NullBool   `db:"enabled"`
+	Comment         sql.NullString `db:"comment"`
+}
+
+// ListSecurityIntegrations returns the security integrations whose names match the pattern, or all of them when the
+// pattern is empty.
+func ListSecurityIntegrations(db *sql.DB, pattern string) ([]SecurityIntegration, error) {
+	stmt := "SHOW SECURITY INTEGRATIONS"
+	if pattern != "" {
+		stmt += fmt.Sprintf(` LIKE '%v'`, EscapeString(pattern))
+	}
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	integrations := []SecurityIntegration{}
+	if err := sqlx.StructScan(rows, &integrations); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return integrations, nil
+}
+
+// IntegrationProperty is a row of DESCRIBE INTEGRATION.
+type IntegrationProperty struct {
+	Property        sql.NullString `db:"property"`
+	PropertyType    sql.NullString `db:"property_type"`
+	PropertyValue   sql.NullString `db:"property_value"`
+	PropertyDefault sql.NullString `db:"property_default"`
+}
+
+// DescribeIntegration returns the properties of the integration described by the builder.
+func DescribeIntegration(db *sql.DB, builder *Builder) ([]IntegrationProperty, error) {
+	stmt := builder.Describe()
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	properties := []IntegrationProperty{}
+	if err := sqlx.StructScan(rows, &properties); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return properties, nil
+}
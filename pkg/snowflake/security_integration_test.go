@@ -0,0 +1,49 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecurityIntegrations(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	rows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+		AddRow("AAD_PROVISIONING", "SCIM - AZURE", "SECURITY", true, "", "2023-01-01 00:00:00")
+	mock.ExpectQuery(`^SHOW SECURITY INTEGRATIONS LIKE 'AAD%'$`).WillReturnRows(rows)
+
+	integrations, err := snowflake.ListSecurityIntegrations(sqlxDB.DB, "AAD%")
+	r.NoError(err)
+	r.Len(integrations, 1)
+	r.Equal("AAD_PROVISIONING", integrations[0].Name.String)
+	r.Equal("SCIM - AZURE", integrations[0].IntegrationType.String)
+	r.True(integrations[0].Enabled.Bool)
+}
+
+func TestDescribeIntegration(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	rows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+		AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("SCIM_CLIENT", "String", "AZURE", "")
+	mock.ExpectQuery(`^DESCRIBE SECURITY INTEGRATION "AAD_PROVISIONING"$`).WillReturnRows(rows)
+
+	properties, err := snowflake.DescribeIntegration(sqlxDB.DB, snowflake.NewSecurityIntegrationBuilder("AAD_PROVISIONING"))
+	r.NoError(err)
+	r.Len(properties, 2)
+	r.Equal("SCIM_CLIENT", properties[1].Property.String)
+	r.Equal("AZURE", properties[1].PropertyValue.String)
+	r.Equal("false", properties[0].PropertyDefault.String)
+}
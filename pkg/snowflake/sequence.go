@@ -30,6 +30,7 @@ type Sequence struct {
 	CreatedOn  sql.NullString `db:"created_on"`
 	Owner      sql.NullString `db:"owner"`
 	Comment    sql.NullString `db:"comment"`
+	Ordered    sql.NullString `db:"ordered"`
 }
 
 type SequenceBuilder struct {
@@ -39,6 +40,7 @@ type SequenceBuilder struct {
 	increment int
 	comment   string
 	start     int
+	ordering  string
 }
 
 // Drop returns the SQL query that will drop a sequence.
@@ -60,12 +62,34 @@ func (sb *SequenceBuilder) Create() string {
 	if sb.increment != 1 {
 		q.WriteString(fmt.Sprintf(` INCREMENT = %d`, sb.increment))
 	}
+	if sb.ordering != "" {
+		q.WriteString(fmt.Sprintf(` %v`, sb.ordering))
+	}
 	if sb.comment != "" {
 		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(sb.comment)))
 	}
 	return q.String()
 }
 
+// ChangeIncrement returns the SQL query that will change the increment of the sequence in place.
+func (sb *SequenceBuilder) ChangeIncrement(increment int) string {
+	return fmt.Sprintf(`ALTER SEQUENCE %v SET INCREMENT = %d`, sb.QualifiedName(), increment)
+}
+
+// ChangeComment returns the SQL query that will change the comment of the sequence in place.
+func (sb *SequenceBuilder) ChangeComment(comment string) string {
+	if comment == "" {
+		return fmt.Sprintf(`ALTER SEQUENCE %v UNSET COMMENT`, sb.QualifiedName())
+	}
+	return fmt.Sprintf(`ALTER SEQUENCE %v SET COMMENT = '%v'`, sb.QualifiedName(), EscapeString(comment))
+}
+
+// SetNoOrder returns the SQL query that will switch the sequence to NOORDER,
+// which is the only change of the ordering that can be applied in place.
+func (sb *SequenceBuilder) SetNoOrder() string {
+	return fmt.Sprintf(`ALTER SEQUENCE %v SET NOORDER`, sb.QualifiedName())
+}
+
 func (sb *SequenceBuilder) WithComment(comment string) *SequenceBuilder {
 	sb.comment = comment
 	return sb
@@ -76,6 +100,12 @@ func (sb *SequenceBuilder) WithIncrement(increment int) *SequenceBuilder {
 	return sb
 }
 
+// WithOrdering sets the ORDER or NOORDER property of the sequence; empty uses the account default.
+func (sb *SequenceBuilder) WithOrdering(ordering string) *SequenceBuilder {
+	sb.ordering = ordering
+	return sb
+}
+
 func (sb *SequenceBuilder) WithStart(start int) *SequenceBuilder {
 	sb.start = start
 	return sb
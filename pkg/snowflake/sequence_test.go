@@ -33,3 +33,18 @@ func TestSequenceShow(t *testing.T) {
 	s := NewSequenceBuilder("test_sequence", "test_db", "test_schema")
 	r.Equal(`SHOW SEQUENCES LIKE 'test_sequence' IN SCHEMA "test_db"."test_schema"`, s.Show())
 }
+
+func TestSequenceCreateWithOrdering(t *testing.T) {
+	r := require.New(t)
+	s := NewSequenceBuilder("test_sequence", "test_db", "test_schema").WithIncrement(2).WithOrdering("NOORDER").WithComment("Test Comment")
+	r.Equal(`CREATE SEQUENCE "test_db"."test_schema"."test_sequence" INCREMENT = 2 NOORDER COMMENT = 'Test Comment'`, s.Create())
+}
+
+func TestSequenceAlter(t *testing.T) {
+	r := require.New(t)
+	s := NewSequenceBuilder("test_sequence", "test_db", "test_schema")
+	r.Equal(`ALTER SEQUENCE "test_db"."test_schema"."test_sequence" SET INCREMENT = 10`, s.ChangeIncrement(10))
+	r.Equal(`ALTER SEQUENCE "test_db"."test_schema"."test_sequence" SET COMMENT = 'it\'s new'`, s.ChangeComment("it's new"))
+	r.Equal(`ALTER SEQUENCE "test_db"."test_schema"."test_sequence" UNSET COMMENT`, s.ChangeComment(""))
+	r.Equal(`ALTER SEQUENCE "test_db"."test_schema"."test_sequence" SET NOORDER`, s.SetNoOrder())
+}
@@ -193,6 +193,11 @@ func (sb *StageBuilder) ChangeCredentials(c string) string {
 	return fmt.Sprintf(`ALTER STAGE %v SET CREDENTIALS = (%v)`, sb.QualifiedName(), c)
 }
 
+// ChangeURLAndCredentials returns the SQL query that will update the url along with the credentials on the stage.
+func (sb *StageBuilder) ChangeURLAndCredentials(u string, c string) string {
+	return fmt.Sprintf(`ALTER STAGE %v SET URL = '%v' CREDENTIALS = (%v)`, sb.QualifiedName(), u, c)
+}
+
 // ChangeStorageIntegration returns the SQL query that will update the storage integration on the stage.
 func (sb *StageBuilder) ChangeStorageIntegration(s string) string {
 	return fmt.Sprintf(`ALTER STAGE %v SET STORAGE_INTEGRATION = "%v"`, sb.QualifiedName(), s)
@@ -217,6 +222,19 @@ func (sb *StageBuilder) ChangeCopyOptions(c string) string {
 	return fmt.Sprintf(`ALTER STAGE %v SET COPY_OPTIONS = (%v)`, sb.QualifiedName(), c)
 }
 
+// ChangeDirectoryEnable returns the SQL query that will enable or disable the directory table on the stage.
+func (sb *StageBuilder) ChangeDirectoryEnable(enable bool) string {
+	return fmt.Sprintf(`ALTER STAGE %v SET DIRECTORY = (ENABLE = %t)`, sb.QualifiedName(), enable)
+}
+
+// RefreshDirectory returns the SQL query that will refresh the directory table metadata of the stage.
+func (sb *StageBuilder) RefreshDirectory(subpath string) string {
+	if subpath != "" {
+		return fmt.Sprintf(`ALTER STAGE %v REFRESH SUBPATH = '%v'`, sb.QualifiedName(), EscapeString(subpath))
+	}
+	return fmt.Sprintf(`ALTER STAGE %v REFRESH`, sb.QualifiedName())
+}
+
 // Drop returns the SQL query that will drop a stage.
 func (sb *StageBuilder) Drop() string {
 	return fmt.Sprintf(`DROP STAGE %v`, sb.QualifiedName())
@@ -243,6 +261,9 @@ type Stage struct {
 	SchemaName         *string `db:"schema_name"`
 	Comment            *string `db:"comment"`
 	StorageIntegration *string `db:"storage_integration"`
+	URL                *string `db:"url"`
+	Type               *string `db:"type"`
+	Owner              *string `db:"owner"`
 }
 
 func ScanStageShow(row *sqlx.Row) (*Stage, error) {
@@ -252,12 +273,14 @@ func ScanStageShow(row *sqlx.Row) (*Stage, error) {
 }
 
 type DescStageResult struct {
-	URL              string
-	AwsExternalID    string
-	SnowflakeIamUser string
-	FileFormat       string
-	CopyOptions      string
-	Directory        string
+	URL                  string
+	AwsExternalID        string
+	SnowflakeIamUser     string
+	FileFormat           string
+	CopyOptions          string
+	Directory            string
+	DirectoryEnable      bool
+	DirectoryAutoRefresh bool
 }
 
 type descStageRow struct {
@@ -303,6 +326,12 @@ func DescStage(db *sql.DB, query string) (*DescStageResult, error) {
 				co = append(co, fmt.Sprintf("%s = %s", row.Property, row.PropertyValue))
 			}
 		case "DIRECTORY":
+			switch row.Property {
+			case "ENABLE":
+				r.DirectoryEnable = strings.EqualFold(row.PropertyValue, "true")
+			case "AUTO_REFRESH":
+				r.DirectoryAutoRefresh = strings.EqualFold(row.PropertyValue, "true")
+			}
 			if row.PropertyValue != row.PropertyDefault && row.Property != "LAST_REFRESHED_ON" {
 				dir = append(dir, fmt.Sprintf("%s = %s", row.Property, row.PropertyValue))
 			}
@@ -80,6 +80,12 @@ func TestStageChangeCredentials(t *testing.T) {
 	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" SET CREDENTIALS = (aws_role='arn:aws:iam::001234567890:role/mysnowflakerole')`, s.ChangeCredentials("aws_role='arn:aws:iam::001234567890:role/mysnowflakerole'"))
 }
 
+func TestStageChangeURLAndCredentials(t *testing.T) {
+	r := require.New(t)
+	s := NewStageBuilder("test_stage", "test_db", "test_schema")
+	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" SET URL = 's3://load/test' CREDENTIALS = (aws_key_id='1a2b3c' aws_secret_key='4x5y6z')`, s.ChangeURLAndCredentials("s3://load/test", "aws_key_id='1a2b3c' aws_secret_key='4x5y6z'"))
+}
+
 func TestStageChangeStorageIntegration(t *testing.T) {
 	r := require.New(t)
 	s := NewStageBuilder("test_stage", "test_db", "test_schema")
@@ -99,6 +105,20 @@ func TestStageChangeCopyOptions(t *testing.T) {
 	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" SET COPY_OPTIONS = (on_error='skip_file')`, s.ChangeCopyOptions("on_error='skip_file'"))
 }
 
+func TestStageChangeDirectoryEnable(t *testing.T) {
+	r := require.New(t)
+	s := NewStageBuilder("test_stage", "test_db", "test_schema")
+	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" SET DIRECTORY = (ENABLE = true)`, s.ChangeDirectoryEnable(true))
+	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" SET DIRECTORY = (ENABLE = false)`, s.ChangeDirectoryEnable(false))
+}
+
+func TestStageRefreshDirectory(t *testing.T) {
+	r := require.New(t)
+	s := NewStageBuilder("test_stage", "test_db", "test_schema")
+	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" REFRESH`, s.RefreshDirectory(""))
+	r.Equal(`ALTER STAGE "test_db"."test_schema"."test_stage" REFRESH SUBPATH = 'path/to/files'`, s.RefreshDirectory("path/to/files"))
+}
+
 func TestStageDrop(t *testing.T) {
 	r := require.New(t)
 	s := NewStageBuilder("test_stage", "test_db", "test_schema")
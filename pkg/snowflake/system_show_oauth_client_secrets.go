@@ -0,0 +1,51 @@
+package snowflake
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SystemShowOAuthClientSecretsBuilder abstracts calling the SYSTEM$SHOW_OAUTH_CLIENT_SECRETS system function.
+type SystemShowOAuthClientSecretsBuilder struct {
+	integrationName string
+}
+
+// NewSystemShowOAuthClientSecretsBuilder returns a pointer to a builder that abstracts calling the SYSTEM$SHOW_OAUTH_CLIENT_SECRETS system function.
+func NewSystemShowOAuthClientSecretsBuilder(integrationName string) *SystemShowOAuthClientSecretsBuilder {
+	return &SystemShowOAuthClientSecretsBuilder{
+		integrationName: integrationName,
+	}
+}
+
+// Select generates the select statement for obtaining the client id and secrets of a custom oauth client.
+func (pb *SystemShowOAuthClientSecretsBuilder) Select() string {
+	return fmt.Sprintf(`SELECT SYSTEM$SHOW_OAUTH_CLIENT_SECRETS('%v') AS "SECRETS"`, EscapeString(pb.integrationName))
+}
+
+type RawOAuthClientSecrets struct {
+	Secrets string `db:"SECRETS"`
+}
+
+type OAuthClientSecrets struct {
+	ClientID      string `json:"OAUTH_CLIENT_ID"`
+	ClientSecret  string `json:"OAUTH_CLIENT_SECRET"`
+	ClientSecret2 string `json:"OAUTH_CLIENT_SECRET_2"`
+}
+
+// ScanOAuthClientSecrets converts a result into a RawOAuthClientSecrets.
+func ScanOAuthClientSecrets(row *sqlx.Row) (*RawOAuthClientSecrets, error) {
+	s := &RawOAuthClientSecrets{}
+	err := row.StructScan(s)
+	return s, err
+}
+
+// GetStructuredSecrets parses the JSON document returned by SYSTEM$SHOW_OAUTH_CLIENT_SECRETS.
+func (r *RawOAuthClientSecrets) GetStructuredSecrets() (*OAuthClientSecrets, error) {
+	secrets := &OAuthClientSecrets{}
+	if err := json.Unmarshal([]byte(r.Secrets), secrets); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
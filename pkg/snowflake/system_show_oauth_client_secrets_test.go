@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemShowOAuthClientSecretsSelect(t *testing.T) {
+	r := require.New(t)
+	sb := NewSystemShowOAuthClientSecretsBuilder("CUSTOM_CLIENT")
+
+	r.Equal(`SELECT SYSTEM$SHOW_OAUTH_CLIENT_SECRETS('CUSTOM_CLIENT') AS "SECRETS"`, sb.Select())
+}
+
+func TestSystemShowOAuthClientSecretsGetStructuredSecrets(t *testing.T) {
+	r := require.New(t)
+
+	raw := &RawOAuthClientSecrets{
+		Secrets: `{"OAUTH_CLIENT_SECRET_2":"secret2","OAUTH_CLIENT_SECRET":"secret1","OAUTH_CLIENT_ID":"client_id"}`,
+	}
+
+	s, err := raw.GetStructuredSecrets()
+	r.NoError(err)
+	r.Equal("client_id", s.ClientID)
+	r.Equal("secret1", s.ClientSecret)
+	r.Equal("secret2", s.ClientSecret2)
+}
@@ -48,6 +48,7 @@ type ColumnDefault struct {
 type ColumnIdentity struct {
 	startNum int
 	stepNum  int
+	ordering string
 }
 
 func (id *ColumnIdentity) WithStartNum(start int) *ColumnIdentity {
@@ -60,6 +61,12 @@ func (id *ColumnIdentity) WithStep(step int) *ColumnIdentity {
 	return id
 }
 
+// WithOrdering sets the ORDER or NOORDER property of the identity; empty uses the account default.
+func (id *ColumnIdentity) WithOrdering(ordering string) *ColumnIdentity {
+	id.ordering = ordering
+	return id
+}
+
 func NewColumnDefaultWithConstant(constant string) *ColumnDefault {
 	return &ColumnDefault{
 		_type:      columnDefaultTypeConstant,
@@ -118,6 +125,8 @@ type Column struct {
 	identity      *ColumnIdentity
 	comment       string // pointer as value is nullable
 	maskingPolicy string
+	collate       string
+	unique        bool
 }
 
 // WithName set the column name.
@@ -159,6 +168,18 @@ func (c *Column) WithIdentity(id *ColumnIdentity) *Column {
 	return c
 }
 
+// WithCollate sets the collation specification of the column.
+func (c *Column) WithCollate(collate string) *Column {
+	c.collate = collate
+	return c
+}
+
+// WithUnique sets if the column has an inline UNIQUE constraint.
+func (c *Column) WithUnique(unique bool) *Column {
+	c.unique = unique
+	return c
+}
+
 func (c *Column) getColumnDefinition(withInlineConstraints bool, withComment bool) string {
 	if c == nil {
 		return ""
@@ -166,10 +187,17 @@ func (c *Column) getColumnDefinition(withInlineConstraints bool, withComment boo
 	var colDef strings.Builder
 	colDef.WriteString(fmt.Sprintf(`"%v" %v`, EscapeString(c.name), EscapeString(c._type)))
 
+	if c.collate != "" {
+		colDef.WriteString(fmt.Sprintf(` COLLATE '%v'`, EscapeString(c.collate)))
+	}
+
 	if withInlineConstraints {
 		if !c.nullable {
 			colDef.WriteString(` NOT NULL`)
 		}
+		if c.unique {
+			colDef.WriteString(` UNIQUE`)
+		}
 	}
 
 	if c._default != nil {
@@ -178,6 +206,9 @@ func (c *Column) getColumnDefinition(withInlineConstraints bool, withComment boo
 
 	if c.identity != nil {
 		colDef.WriteString(fmt.Sprintf(` IDENTITY(%v, %v)`, c.identity.startNum, c.identity.stepNum))
+		if c.identity.ordering != "" {
+			colDef.WriteString(fmt.Sprintf(` %v`, c.identity.ordering))
+		}
 	}
 
 	if strings.TrimSpace(c.maskingPolicy) != "" {
@@ -239,19 +270,62 @@ func NewColumns(tds []TableDescription) Columns {
 			continue
 		}
 
+		dataType, collate := td.TypeAndCollation()
 		cs = append(cs, Column{
 			name:          td.Name.String,
-			_type:         td.Type.String,
+			_type:         dataType,
 			nullable:      td.IsNullable(),
 			_default:      td.ColumnDefault(),
 			identity:      td.ColumnIdentity(),
 			comment:       td.Comment.String,
 			maskingPolicy: td.MaskingPolicy.String,
+			collate:       collate,
 		})
 	}
 	return Columns(cs)
 }
 
+// HasUniqueKeys returns true if any column of the table description is part of a UNIQUE constraint.
+func HasUniqueKeys(tds []TableDescription) bool {
+	for _, td := range tds {
+		if td.Kind.String == "COLUMN" && td.IsUnique() {
+			return true
+		}
+	}
+	return false
+}
+
+// WithUniqueKeys sets the inline UNIQUE constraints of the columns from the unique keys of the table.
+// DESC TABLE reports every column of any UNIQUE constraint as unique, so only single-column constraints
+// named by Snowflake are read back as inline constraints. Columns of other constraints, e.g. the ones
+// managed by snowflake_table_constraint, keep their configured value.
+func (c Columns) WithUniqueKeys(ukds []UniqueKeyDescription, configured map[string]bool) Columns {
+	constraints := map[string][]string{}
+	for _, ukd := range ukds {
+		constraints[ukd.ConstraintName.String] = append(constraints[ukd.ConstraintName.String], ukd.ColumnName.String)
+	}
+	inline := map[string]bool{}
+	other := map[string]bool{}
+	for name, columns := range constraints {
+		if len(columns) == 1 && strings.HasPrefix(name, "SYS_CONSTRAINT_") {
+			inline[columns[0]] = true
+			continue
+		}
+		for _, column := range columns {
+			other[column] = true
+		}
+	}
+	for i := range c {
+		switch {
+		case inline[c[i].name]:
+			c[i].unique = true
+		case other[c[i].name]:
+			c[i].unique = configured[c[i].name]
+		}
+	}
+	return c
+}
+
 func (c Columns) Flatten() []interface{} {
 	flattened := []interface{}{}
 	for _, col := range c {
@@ -261,6 +335,8 @@ func (c Columns) Flatten() []interface{} {
 		flat["nullable"] = col.nullable
 		flat["comment"] = col.comment
 		flat["masking_policy"] = col.maskingPolicy
+		flat["collate"] = col.collate
+		flat["unique"] = col.unique
 
 		if col._default != nil {
 			def := map[string]interface{}{}
@@ -280,6 +356,7 @@ func (c Columns) Flatten() []interface{} {
 			id := map[string]interface{}{}
 			id["start_num"] = col.identity.startNum
 			id["step_num"] = col.identity.stepNum
+			id["ordering"] = col.identity.ordering
 			flat["identity"] = []interface{}{id}
 		}
 		flattened = append(flattened, flat)
@@ -555,6 +632,19 @@ func (tb *TableBuilder) AddColumn(name string, dataType string, nullable bool, _
 	return fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s`, tb.QualifiedName(), col.getColumnDefinition(true, true))
 }
 
+// AddColumnDefinition returns the SQL query that will add the given column to the table.
+func (tb *TableBuilder) AddColumnDefinition(col Column) string {
+	return fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s`, tb.QualifiedName(), col.getColumnDefinition(true, true))
+}
+
+// ChangeColumnUnique returns the SQL query that will add or drop the UNIQUE constraint on the named column.
+func (tb *TableBuilder) ChangeColumnUnique(name string, unique bool) string {
+	if unique {
+		return fmt.Sprintf(`ALTER TABLE %s ADD UNIQUE ("%v")`, tb.QualifiedName(), EscapeString(name))
+	}
+	return fmt.Sprintf(`ALTER TABLE %s DROP UNIQUE ("%v")`, tb.QualifiedName(), EscapeString(name))
+}
+
 // DropColumn returns the SQL query that will add a new column to the table.
 func (tb *TableBuilder) DropColumn(name string) string {
 	return fmt.Sprintf(`ALTER TABLE %s DROP COLUMN "%s"`, tb.QualifiedName(), name)
@@ -581,6 +671,35 @@ func (tb *TableBuilder) ChangeColumnMaskingPolicy(name string, maskingPolicy str
 	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" SET MASKING POLICY %v`, tb.QualifiedName(), EscapeString(name), EscapeString(maskingPolicy))
 }
 
+// SetColumnTags returns the SQL query that will set the given tags on the named column.
+func (tb *TableBuilder) SetColumnTags(name string, tags []TagValue) string {
+	assignments := make([]string, len(tags))
+	for i, tag := range tags {
+		assignments[i] = fmt.Sprintf(`"%v"."%v"."%v" = '%v'`, tag.Database, tag.Schema, tag.Name, EscapeString(tag.Value))
+	}
+	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" SET TAG %v`, tb.QualifiedName(), EscapeString(name), strings.Join(assignments, ", "))
+}
+
+// UnsetColumnTags returns the SQL query that will unset the given tags from the named column.
+func (tb *TableBuilder) UnsetColumnTags(name string, tags []TagValue) string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = fmt.Sprintf(`"%v"."%v"."%v"`, tag.Database, tag.Schema, tag.Name)
+	}
+	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" UNSET TAG %v`, tb.QualifiedName(), EscapeString(name), strings.Join(names, ", "))
+}
+
+// ShowColumnTags returns the SQL query that will list the tags set directly on the columns of the table.
+func (tb *TableBuilder) ShowColumnTags() string {
+	return fmt.Sprintf(`SELECT COLUMN_NAME, TAG_DATABASE, TAG_SCHEMA, TAG_NAME, TAG_VALUE FROM TABLE("%v".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('%v', 'table')) WHERE LEVEL = 'COLUMN'`, tb.db, EscapeString(tb.QualifiedName()))
+}
+
+// ChangeColumnNoOrder returns the SQL query that will switch the identity of the named column to NOORDER,
+// which is the only change of an identity that can be applied in place.
+func (tb *TableBuilder) ChangeColumnNoOrder(name string) string {
+	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" SET NOORDER`, tb.QualifiedName(), EscapeString(name))
+}
+
 func (tb *TableBuilder) DropColumnDefault(name string) string {
 	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN "%v" DROP DEFAULT`, tb.QualifiedName(), EscapeString(name))
 }
@@ -634,6 +753,10 @@ func (tb *TableBuilder) ShowPrimaryKeys() string {
 	return fmt.Sprintf(`SHOW PRIMARY KEYS IN TABLE %s`, tb.QualifiedName())
 }
 
+func (tb *TableBuilder) ShowUniqueKeys() string {
+	return fmt.Sprintf(`SHOW UNIQUE KEYS IN TABLE %s`, tb.QualifiedName())
+}
+
 func (tb *TableBuilder) Rename(newName string) string {
 	oldName := tb.QualifiedName()
 	tb.name = newName
@@ -648,7 +771,7 @@ type Table struct {
 	Kind                sql.NullString `db:"kind"`
 	Comment             sql.NullString `db:"comment"`
 	ClusterBy           sql.NullString `db:"cluster_by"`
-	Rows                sql.NullString `db:"row"`
+	Rows                sql.NullString `db:"rows"`
 	Bytes               sql.NullString `db:"bytes"`
 	Owner               sql.NullString `db:"owner"`
 	RetentionTime       sql.NullInt32  `db:"retention_time"`
@@ -657,6 +780,30 @@ type Table struct {
 	IsExternal          sql.NullString `db:"is_external"`
 }
 
+// ColumnTagReference is a single column level row of the INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS table function.
+type ColumnTagReference struct {
+	ColumnName  sql.NullString `db:"COLUMN_NAME"`
+	TagDatabase sql.NullString `db:"TAG_DATABASE"`
+	TagSchema   sql.NullString `db:"TAG_SCHEMA"`
+	TagName     sql.NullString `db:"TAG_NAME"`
+	TagValue    sql.NullString `db:"TAG_VALUE"`
+}
+
+func ListColumnTagReferences(tb *TableBuilder, db *sql.DB) ([]ColumnTagReference, error) {
+	stmt := tb.ShowColumnTags()
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []ColumnTagReference{}
+	if err := sqlx.StructScan(rows, &references); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return references, nil
+}
+
 func ScanTable(row *sqlx.Row) (*Table, error) {
 	t := &Table{}
 	e := row.StructScan(t)
@@ -671,12 +818,26 @@ type TableDescription struct {
 	Default       sql.NullString `db:"default"`
 	Comment       sql.NullString `db:"comment"`
 	MaskingPolicy sql.NullString `db:"policy name"`
+	UniqueKey     sql.NullString `db:"unique key"`
 }
 
 func (td *TableDescription) IsNullable() bool {
 	return td.Nullable.String == "Y"
 }
 
+func (td *TableDescription) IsUnique() bool {
+	return td.UniqueKey.String == "Y"
+}
+
+// TypeAndCollation splits the type reported by DESC TABLE, e.g. VARCHAR(16) COLLATE 'en-ci', into the data type and the collation.
+func (td *TableDescription) TypeAndCollation() (string, string) {
+	dataType, collate, found := strings.Cut(td.Type.String, " COLLATE ")
+	if !found {
+		return td.Type.String, ""
+	}
+	return dataType, strings.Trim(strings.TrimSpace(collate), "'")
+}
+
 func (td *TableDescription) ColumnDefault() *ColumnDefault {
 	if !td.Default.Valid {
 		return nil
@@ -706,16 +867,23 @@ func (td *TableDescription) ColumnDefault() *ColumnDefault {
 }
 
 func (td *TableDescription) ColumnIdentity() *ColumnIdentity {
-	// if autoincrement is used this is reflected back IDENTITY START 1 INCREMENT 1
+	// if autoincrement is used this is reflected back IDENTITY START 1 INCREMENT 1 [ORDER|NOORDER]
 	if !td.Default.Valid {
 		return nil
 	}
 	if strings.Contains(td.Default.String, "IDENTITY") {
-		split := strings.Split(td.Default.String, " ")
+		split := strings.Fields(td.Default.String)
+		if len(split) < 5 {
+			return nil
+		}
 		start, _ := strconv.Atoi(split[2])
 		step, _ := strconv.Atoi(split[4])
 
-		return &ColumnIdentity{start, step}
+		identity := &ColumnIdentity{startNum: start, stepNum: step}
+		if len(split) > 5 {
+			identity.ordering = split[5]
+		}
+		return identity
 	}
 	return nil
 }
@@ -726,6 +894,12 @@ type PrimaryKeyDescription struct {
 	ConstraintName sql.NullString `db:"constraint_name"`
 }
 
+type UniqueKeyDescription struct {
+	ColumnName     sql.NullString `db:"column_name"`
+	KeySequence    sql.NullString `db:"key_sequence"`
+	ConstraintName sql.NullString `db:"constraint_name"`
+}
+
 func ScanTableDescription(rows *sqlx.Rows) ([]TableDescription, error) {
 	tds := []TableDescription{}
 	for rows.Next() {
@@ -752,8 +926,25 @@ func ScanPrimaryKeyDescription(rows *sqlx.Rows) ([]PrimaryKeyDescription, error)
 	return pkds, rows.Err()
 }
 
+func ScanUniqueKeyDescription(rows *sqlx.Rows) ([]UniqueKeyDescription, error) {
+	ukds := []UniqueKeyDescription{}
+	for rows.Next() {
+		uk := UniqueKeyDescription{}
+		err := rows.StructScan(&uk)
+		if err != nil {
+			return nil, err
+		}
+		ukds = append(ukds, uk)
+	}
+	return ukds, rows.Err()
+}
+
+// ListTables lists the tables of the schema, or of the whole database when the schema is empty.
 func ListTables(databaseName string, schemaName string, db *sql.DB) ([]Table, error) {
 	stmt := fmt.Sprintf(`SHOW TABLES IN SCHEMA "%s"."%v"`, databaseName, schemaName)
+	if schemaName == "" {
+		stmt = fmt.Sprintf(`SHOW TABLES IN DATABASE "%s"`, databaseName)
+	}
 	rows, err := Query(db, stmt)
 	if err != nil {
 		return nil, err
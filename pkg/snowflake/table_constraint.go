@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -185,6 +187,42 @@ func (b *TableConstraintBuilder) Create() string {
 	return q.String()
 }
 
+// Alter returns the SQL query that will change the enforced, validate and rely properties of the table constraint.
+func (b *TableConstraintBuilder) Alter() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`ALTER TABLE %v ALTER CONSTRAINT %v`, b.tableID, b.name))
+	if b.enforced {
+		q.WriteString(` ENFORCED`)
+	} else {
+		q.WriteString(` NOT ENFORCED`)
+	}
+	if b.validate {
+		q.WriteString(` VALIDATE`)
+	} else {
+		q.WriteString(` NOVALIDATE`)
+	}
+	if b.rely {
+		q.WriteString(` RELY`)
+	} else {
+		q.WriteString(` NORELY`)
+	}
+	return q.String()
+}
+
+// ShowKeys returns the SQL query that will list the keys of the constraint's type on the table;
+// NOT NULL constraints are not listed by Snowflake, so an empty string is returned for them.
+func (b *TableConstraintBuilder) ShowKeys() string {
+	switch b.constraintType {
+	case "PRIMARY KEY":
+		return fmt.Sprintf(`SHOW PRIMARY KEYS IN TABLE %v`, b.tableID)
+	case "UNIQUE":
+		return fmt.Sprintf(`SHOW UNIQUE KEYS IN TABLE %v`, b.tableID)
+	case "FOREIGN KEY":
+		return fmt.Sprintf(`SHOW IMPORTED KEYS IN TABLE %v`, b.tableID)
+	}
+	return ""
+}
+
 // Rename returns the SQL query that will rename the table constraint.
 func (b *TableConstraintBuilder) Rename(newName string) string {
 	return fmt.Sprintf(`ALTER TABLE %v RENAME CONSTRAINT %v TO %v`, b.tableID, b.name, newName)
@@ -239,3 +277,70 @@ func ShowTableConstraint(name, tableDB, tableSchema, tableName string, db *sql.D
 	}
 	return &tableConstraints[0], nil
 }
+
+// TableConstraintKey is a single column of a key returned by SHOW PRIMARY KEYS, SHOW UNIQUE KEYS or SHOW IMPORTED KEYS.
+type TableConstraintKey struct {
+	ConstraintName sql.NullString `db:"constraint_name"`
+	ColumnName     sql.NullString `db:"column_name"`
+	FkName         sql.NullString `db:"fk_name"`
+	FkColumnName   sql.NullString `db:"fk_column_name"`
+	PkDatabaseName sql.NullString `db:"pk_database_name"`
+	PkSchemaName   sql.NullString `db:"pk_schema_name"`
+	PkTableName    sql.NullString `db:"pk_table_name"`
+	PkColumnName   sql.NullString `db:"pk_column_name"`
+	KeySequence    sql.NullString `db:"key_sequence"`
+	UpdateRule     sql.NullString `db:"update_rule"`
+	DeleteRule     sql.NullString `db:"delete_rule"`
+	Rely           sql.NullString `db:"rely"`
+	Comment        sql.NullString `db:"comment"`
+}
+
+// Name returns the name of the constraint the key belongs to.
+func (k *TableConstraintKey) Name() string {
+	if k.FkName.Valid {
+		return k.FkName.String
+	}
+	return k.ConstraintName.String
+}
+
+// Column returns the constrained column of the table the key was listed for.
+func (k *TableConstraintKey) Column() string {
+	if k.FkColumnName.Valid {
+		return k.FkColumnName.String
+	}
+	return k.ColumnName.String
+}
+
+// ListTableConstraintKeys returns the columns of the constraint ordered by their position in the key.
+func ListTableConstraintKeys(b *TableConstraintBuilder, db *sql.DB) ([]TableConstraintKey, error) {
+	stmt := b.ShowKeys()
+	if stmt == "" {
+		return nil, nil
+	}
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []TableConstraintKey{}
+	for rows.Next() {
+		key := TableConstraintKey{}
+		if err := rows.StructScan(&key); err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(key.Name(), b.name) {
+			keys = append(keys, key)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, _ := strconv.Atoi(keys[i].KeySequence.String)
+		b, _ := strconv.Atoi(keys[j].KeySequence.String)
+		return a < b
+	})
+	return keys, nil
+}
@@ -0,0 +1,44 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableConstraintCreateForeignKey(t *testing.T) {
+	r := require.New(t)
+	b := NewTableConstraintBuilder("fk", "FOREIGN KEY", `"db"."schema"."table"`).
+		WithColumns([]string{"a", "b"}).
+		WithReferenceTableID(`"db"."schema"."other"`).
+		WithReferenceColumns([]string{"x", "y"}).
+		WithMatch("SIMPLE").
+		WithUpdate("CASCADE").
+		WithDelete("SET NULL").
+		WithEnforced(true).
+		WithDeferrable(true).
+		WithInitially("DEFERRED").
+		WithEnable(true).
+		WithRely(false)
+
+	r.Equal(`ALTER TABLE "db"."schema"."table" ADD CONSTRAINT fk FOREIGN KEY ("a", "b") REFERENCES "db"."schema"."other" ("x", "y") MATCH SIMPLE ON UPDATE CASCADE ON DELETE SET NULL ENFORCED NORELY`, b.Create())
+}
+
+func TestTableConstraintAlter(t *testing.T) {
+	r := require.New(t)
+	b := NewTableConstraintBuilder("pk", "PRIMARY KEY", `"db"."schema"."table"`)
+
+	r.Equal(`ALTER TABLE "db"."schema"."table" ALTER CONSTRAINT pk NOT ENFORCED NOVALIDATE NORELY`, b.Alter())
+
+	b.WithEnforced(true).WithValidate(true).WithRely(true)
+	r.Equal(`ALTER TABLE "db"."schema"."table" ALTER CONSTRAINT pk ENFORCED VALIDATE RELY`, b.Alter())
+}
+
+func TestTableConstraintShowKeys(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(`SHOW PRIMARY KEYS IN TABLE "db"."schema"."table"`, NewTableConstraintBuilder("c", "PRIMARY KEY", `"db"."schema"."table"`).ShowKeys())
+	r.Equal(`SHOW UNIQUE KEYS IN TABLE "db"."schema"."table"`, NewTableConstraintBuilder("c", "UNIQUE", `"db"."schema"."table"`).ShowKeys())
+	r.Equal(`SHOW IMPORTED KEYS IN TABLE "db"."schema"."table"`, NewTableConstraintBuilder("c", "FOREIGN KEY", `"db"."schema"."table"`).ShowKeys())
+	r.Equal("", NewTableConstraintBuilder("c", "NOT NULL", `"db"."schema"."table"`).ShowKeys())
+}
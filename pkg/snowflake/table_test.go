@@ -0,0 +1,77 @@
+package snowflake
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableDescriptionColumnIdentity(t *testing.T) {
+	r := require.New(t)
+
+	td := TableDescription{Default: sql.NullString{String: "IDENTITY START 2 INCREMENT 4 NOORDER", Valid: true}}
+	r.Equal(&ColumnIdentity{startNum: 2, stepNum: 4, ordering: "NOORDER"}, td.ColumnIdentity())
+	r.Nil(td.ColumnDefault())
+
+	td = TableDescription{Default: sql.NullString{String: "IDENTITY START 1 INCREMENT 1", Valid: true}}
+	r.Equal(&ColumnIdentity{startNum: 1, stepNum: 1}, td.ColumnIdentity())
+
+	td = TableDescription{Default: sql.NullString{String: "CURRENT_TIMESTAMP()", Valid: true}}
+	r.Nil(td.ColumnIdentity())
+}
+
+func TestTableBuilderColumnIdentity(t *testing.T) {
+	r := require.New(t)
+	tb := NewTableBuilder("table", "db", "schema")
+
+	identity := (&ColumnIdentity{}).WithStartNum(1).WithStep(2).WithOrdering("ORDER")
+	r.Equal(`ALTER TABLE "db"."schema"."table" ADD COLUMN "id" NUMBER(38,0) NOT NULL IDENTITY(1, 2) ORDER COMMENT ''`, tb.AddColumn("id", "NUMBER(38,0)", false, nil, identity, "", ""))
+	r.Equal(`ALTER TABLE "db"."schema"."table" MODIFY COLUMN "id" SET NOORDER`, tb.ChangeColumnNoOrder("id"))
+}
+
+func TestTableDescriptionTypeAndCollation(t *testing.T) {
+	r := require.New(t)
+
+	td := TableDescription{Type: sql.NullString{String: "VARCHAR(16777216) COLLATE 'en-ci'", Valid: true}}
+	dataType, collate := td.TypeAndCollation()
+	r.Equal("VARCHAR(16777216)", dataType)
+	r.Equal("en-ci", collate)
+
+	td = TableDescription{Type: sql.NullString{String: "NUMBER(38,0)", Valid: true}}
+	dataType, collate = td.TypeAndCollation()
+	r.Equal("NUMBER(38,0)", dataType)
+	r.Equal("", collate)
+}
+
+func TestTableBuilderColumnCollationAndUnique(t *testing.T) {
+	r := require.New(t)
+	tb := NewTableBuilder("table", "db", "schema")
+
+	col := (&Column{}).WithName("name").WithType("VARCHAR").WithNullable(false).WithCollate("en-ci").WithUnique(true)
+	r.Equal(`ALTER TABLE "db"."schema"."table" ADD COLUMN "name" VARCHAR COLLATE 'en-ci' NOT NULL UNIQUE COMMENT ''`, tb.AddColumnDefinition(*col))
+	r.Equal(`ALTER TABLE "db"."schema"."table" ADD UNIQUE ("name")`, tb.ChangeColumnUnique("name", true))
+	r.Equal(`ALTER TABLE "db"."schema"."table" DROP UNIQUE ("name")`, tb.ChangeColumnUnique("name", false))
+}
+
+func TestColumnsWithUniqueKeys(t *testing.T) {
+	r := require.New(t)
+
+	unique := sql.NullString{String: "Y", Valid: true}
+	tds := []TableDescription{
+		{Name: sql.NullString{String: "id", Valid: true}, Type: sql.NullString{String: "NUMBER(38,0)", Valid: true}, Kind: sql.NullString{String: "COLUMN", Valid: true}, UniqueKey: unique},
+		{Name: sql.NullString{String: "first", Valid: true}, Type: sql.NullString{String: "VARCHAR", Valid: true}, Kind: sql.NullString{String: "COLUMN", Valid: true}, UniqueKey: unique},
+		{Name: sql.NullString{String: "last", Valid: true}, Type: sql.NullString{String: "VARCHAR", Valid: true}, Kind: sql.NullString{String: "COLUMN", Valid: true}, UniqueKey: unique},
+	}
+	r.True(HasUniqueKeys(tds))
+
+	ukds := []UniqueKeyDescription{
+		{ColumnName: sql.NullString{String: "id", Valid: true}, KeySequence: sql.NullString{String: "1", Valid: true}, ConstraintName: sql.NullString{String: "SYS_CONSTRAINT_1f3c", Valid: true}},
+		{ColumnName: sql.NullString{String: "first", Valid: true}, KeySequence: sql.NullString{String: "1", Valid: true}, ConstraintName: sql.NullString{String: "SYS_CONSTRAINT_9a2b", Valid: true}},
+		{ColumnName: sql.NullString{String: "last", Valid: true}, KeySequence: sql.NullString{String: "2", Valid: true}, ConstraintName: sql.NullString{String: "SYS_CONSTRAINT_9a2b", Valid: true}},
+	}
+	flattened := NewColumns(tds).WithUniqueKeys(ukds, map[string]bool{"first": false, "last": true}).Flatten()
+	r.Equal(true, flattened[0].(map[string]interface{})["unique"])
+	r.Equal(false, flattened[1].(map[string]interface{})["unique"])
+	r.Equal(true, flattened[2].(map[string]interface{})["unique"])
+}
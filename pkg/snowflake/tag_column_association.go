@@ -0,0 +1,54 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// TagColumnReference is a single row of the INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS table function.
+type TagColumnReference struct {
+	ColumnName sql.NullString `db:"COLUMN_NAME"`
+	TagValue   sql.NullString `db:"TAG_VALUE"`
+}
+
+func (tb *TagAssociationBuilder) modifyColumns(table string, columns []string, action string) string {
+	modifications := make([]string, len(columns))
+	for i, column := range columns {
+		modifications[i] = fmt.Sprintf(`COLUMN "%v" %v`, column, action)
+	}
+	return fmt.Sprintf(`ALTER TABLE %v MODIFY %v`, table, strings.Join(modifications, ", "))
+}
+
+// CreateForColumns returns a single SQL query that will set the tag on all the given columns of the table.
+func (tb *TagAssociationBuilder) CreateForColumns(table string, columns []string) string {
+	return tb.modifyColumns(table, columns, fmt.Sprintf(`SET TAG "%v"."%v"."%v" = '%v'`, tb.databaseName, tb.schemaName, tb.tagName, EscapeString(tb.tagValue)))
+}
+
+// DropForColumns returns a single SQL query that will remove the tag from all the given columns of the table.
+func (tb *TagAssociationBuilder) DropForColumns(table string, columns []string) string {
+	return tb.modifyColumns(table, columns, fmt.Sprintf(`UNSET TAG "%v"."%v"."%v"`, tb.databaseName, tb.schemaName, tb.tagName))
+}
+
+// ShowForColumns returns the SQL query that will list the columns of the table associated with the tag.
+func (tb *TagAssociationBuilder) ShowForColumns(table *SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`SELECT COLUMN_NAME, TAG_VALUE FROM TABLE("%v".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('%v', 'table')) WHERE TAG_DATABASE = '%v' AND TAG_SCHEMA = '%v' AND TAG_NAME = '%v'`,
+		table.Database, EscapeString(table.QualifiedName()), EscapeString(tb.databaseName), EscapeString(tb.schemaName), EscapeString(tb.tagName))
+}
+
+func ListTagColumnReferences(tb *TagAssociationBuilder, table *SchemaObjectIdentifier, db *sql.DB) ([]TagColumnReference, error) {
+	stmt := tb.ShowForColumns(table)
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []TagColumnReference{}
+	if err := sqlx.StructScan(rows, &references); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return references, nil
+}
@@ -0,0 +1,24 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagColumnAssociation(t *testing.T) {
+	r := require.New(t)
+	builder := NewTagAssociationBuilder("test_db|test_schema|sensitive").WithTagValue("true")
+	table := &SchemaObjectIdentifier{
+		Database:   "test_db",
+		Schema:     "test_schema",
+		ObjectName: "test_table",
+	}
+
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "first" SET TAG "test_db"."test_schema"."sensitive" = 'true', COLUMN "second" SET TAG "test_db"."test_schema"."sensitive" = 'true'`,
+		builder.CreateForColumns(table.QualifiedName(), []string{"first", "second"}))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" MODIFY COLUMN "first" UNSET TAG "test_db"."test_schema"."sensitive", COLUMN "second" UNSET TAG "test_db"."test_schema"."sensitive"`,
+		builder.DropForColumns(table.QualifiedName(), []string{"first", "second"}))
+	r.Equal(`SELECT COLUMN_NAME, TAG_VALUE FROM TABLE("test_db".INFORMATION_SCHEMA.TAG_REFERENCES_ALL_COLUMNS('"test_db"."test_schema"."test_table"', 'table')) WHERE TAG_DATABASE = 'test_db' AND TAG_SCHEMA = 'test_schema' AND TAG_NAME = 'sensitive'`,
+		builder.ShowForColumns(table))
+}
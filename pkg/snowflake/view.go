@@ -13,15 +13,23 @@ import (
 
 // ViewBuilder abstracts the creation of SQL queries for a Snowflake View.
 type ViewBuilder struct {
-	name       string
-	db         string
-	schema     string
-	secure     bool
-	replace    bool
-	copyGrants bool
-	comment    string
-	statement  string
-	tags       []TagValue
+	name           string
+	db             string
+	schema         string
+	secure         bool
+	replace        bool
+	copyGrants     bool
+	changeTracking bool
+	comment        string
+	statement      string
+	columns        []ViewColumn
+	tags           []TagValue
+}
+
+// ViewColumn describes a column in the column list of a view.
+type ViewColumn struct {
+	Name    string
+	Comment string
 }
 
 // QualifiedName prepends the db and schema if set and escapes everything nicely.
@@ -76,6 +84,18 @@ func (vb *ViewBuilder) WithStatement(s string) *ViewBuilder {
 	return vb
 }
 
+// WithChangeTracking enables change tracking on the view.
+func (vb *ViewBuilder) WithChangeTracking() *ViewBuilder {
+	vb.changeTracking = true
+	return vb
+}
+
+// WithColumns sets the column list (names and comments) of the view.
+func (vb *ViewBuilder) WithColumns(c []ViewColumn) *ViewBuilder {
+	vb.columns = c
+	return vb
+}
+
 // WithTags sets the tags on the ViewBuilder.
 func (vb *ViewBuilder) WithTags(tags []TagValue) *ViewBuilder {
 	vb.tags = tags
@@ -137,6 +157,18 @@ func (vb *ViewBuilder) Create() (string, error) {
 
 	q.WriteString(fmt.Sprintf(` VIEW %v`, qn))
 
+	if len(vb.columns) > 0 {
+		columns := make([]string, 0, len(vb.columns))
+		for _, c := range vb.columns {
+			column := fmt.Sprintf(`"%v"`, c.Name)
+			if c.Comment != "" {
+				column += fmt.Sprintf(" COMMENT '%v'", EscapeString(c.Comment))
+			}
+			columns = append(columns, column)
+		}
+		q.WriteString(fmt.Sprintf(" (%v)", strings.Join(columns, ", ")))
+	}
+
 	if vb.copyGrants {
 		q.WriteString(" COPY GRANTS")
 	}
@@ -145,6 +177,10 @@ func (vb *ViewBuilder) Create() (string, error) {
 		q.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(vb.comment)))
 	}
 
+	if vb.changeTracking {
+		q.WriteString(" CHANGE_TRACKING = TRUE")
+	}
+
 	q.WriteString(fmt.Sprintf(" AS %v", vb.statement))
 
 	return q.String(), nil
@@ -206,6 +242,42 @@ func (vb *ViewBuilder) RemoveComment() (string, error) {
 	return fmt.Sprintf(`ALTER VIEW %v UNSET COMMENT`, qn), nil
 }
 
+// ChangeChangeTracking returns the SQL query that will enable or disable change tracking on the view.
+func (vb *ViewBuilder) ChangeChangeTracking(changeTracking bool) (string, error) {
+	qn, err := vb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`ALTER VIEW %v SET CHANGE_TRACKING = %v`, qn, strings.ToUpper(fmt.Sprint(changeTracking))), nil
+}
+
+// ChangeColumnComment returns the SQL query that will update the comment on a column of the view.
+func (vb *ViewBuilder) ChangeColumnComment(column string, c string) (string, error) {
+	qn, err := vb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`ALTER VIEW %v ALTER COLUMN "%v" COMMENT '%v'`, qn, column, EscapeString(c)), nil
+}
+
+// RemoveColumnComment returns the SQL query that will remove the comment on a column of the view.
+func (vb *ViewBuilder) RemoveColumnComment(column string) (string, error) {
+	qn, err := vb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`ALTER VIEW %v ALTER COLUMN "%v" UNSET COMMENT`, qn, column), nil
+}
+
+// Describe returns the SQL query that will describe the columns of the view.
+func (vb *ViewBuilder) Describe() (string, error) {
+	qn, err := vb.QualifiedName()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`DESC VIEW %v`, qn), nil
+}
+
 // Show returns the SQL query that will show the row representing this view.
 func (vb *ViewBuilder) Show() string {
 	return fmt.Sprintf(`SHOW VIEWS LIKE '%v' IN SCHEMA "%v"."%v"`, vb.name, vb.db, vb.schema)
@@ -221,13 +293,15 @@ func (vb *ViewBuilder) Drop() (string, error) {
 }
 
 type View struct {
-	Comment      sql.NullString `db:"comment"`
-	IsSecure     bool           `db:"is_secure"`
-	Name         sql.NullString `db:"name"`
-	SchemaName   sql.NullString `db:"schema_name"`
-	Text         sql.NullString `db:"text"`
-	DatabaseName sql.NullString `db:"database_name"`
-	CreatedOn    time.Time      `db:"created_on"`
+	Comment        sql.NullString `db:"comment"`
+	IsSecure       bool           `db:"is_secure"`
+	Name           sql.NullString `db:"name"`
+	SchemaName     sql.NullString `db:"schema_name"`
+	Text           sql.NullString `db:"text"`
+	DatabaseName   sql.NullString `db:"database_name"`
+	CreatedOn      time.Time      `db:"created_on"`
+	ChangeTracking sql.NullString `db:"change_tracking"`
+	Owner          sql.NullString `db:"owner"`
 }
 
 func ScanView(row *sqlx.Row) (*View, error) {
@@ -256,6 +330,10 @@ func ListViews(databaseName string, schemaName string, db *sql.DB) ([]View, erro
 	return dbs, nil
 }
 
+func (v *View) HasChangeTracking() bool {
+	return strings.EqualFold(v.ChangeTracking.String, "ON")
+}
+
 func (v *View) HasCopyGrants() bool {
 	return strings.Contains(v.Text.String, " COPY GRANTS ")
 }
@@ -106,3 +106,29 @@ func TestRename(t *testing.T) {
 	r.NoError(err)
 	r.Equal(`ALTER VIEW "db"."testSchema"."test4" RENAME TO "db"."testSchema"."test5"`, q)
 }
+
+func TestViewColumnsAndChangeTracking(t *testing.T) {
+	r := require.New(t)
+	vb := NewViewBuilder("test").WithDB("some_database").WithSchema("some_schema").WithReplace().WithCopyGrants()
+	vb.WithColumns([]ViewColumn{{Name: "ID", Comment: "the id's comment"}, {Name: "DATA"}}).WithChangeTracking().WithStatement("SELECT ID, DATA FROM DUMMY")
+
+	q, err := vb.Create()
+	r.NoError(err)
+	r.Equal(`CREATE OR REPLACE VIEW "some_database"."some_schema"."test" ("ID" COMMENT 'the id\'s comment', "DATA") COPY GRANTS CHANGE_TRACKING = TRUE AS SELECT ID, DATA FROM DUMMY`, q)
+
+	q, err = vb.ChangeChangeTracking(false)
+	r.NoError(err)
+	r.Equal(`ALTER VIEW "some_database"."some_schema"."test" SET CHANGE_TRACKING = FALSE`, q)
+
+	q, err = vb.ChangeColumnComment("ID", "new comment")
+	r.NoError(err)
+	r.Equal(`ALTER VIEW "some_database"."some_schema"."test" ALTER COLUMN "ID" COMMENT 'new comment'`, q)
+
+	q, err = vb.RemoveColumnComment("ID")
+	r.NoError(err)
+	r.Equal(`ALTER VIEW "some_database"."some_schema"."test" ALTER COLUMN "ID" UNSET COMMENT`, q)
+
+	q, err = vb.Describe()
+	r.NoError(err)
+	r.Equal(`DESC VIEW "some_database"."some_schema"."test"`, q)
+}
@@ -0,0 +1,50 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// WarehouseMetering is the credit consumption of a warehouse aggregated from the
+// SNOWFLAKE.ACCOUNT_USAGE.WAREHOUSE_METERING_HISTORY view.
+type WarehouseMetering struct {
+	WarehouseName            sql.NullString  `db:"WAREHOUSE_NAME"`
+	CreditsUsed              sql.NullFloat64 `db:"CREDITS_USED"`
+	CreditsUsedCompute       sql.NullFloat64 `db:"CREDITS_USED_COMPUTE"`
+	CreditsUsedCloudServices sql.NullFloat64 `db:"CREDITS_USED_CLOUD_SERVICES"`
+	MeteredHours             sql.NullInt64   `db:"METERED_HOURS"`
+}
+
+// WarehouseMeteringHistoryQuery returns the SQL query summing the credits used by the warehouses in the last days,
+// the most expensive warehouses first. All the warehouses are returned when the warehouse name is empty.
+func WarehouseMeteringHistoryQuery(warehouseName string, days int) string {
+	q := strings.Builder{}
+	q.WriteString(`SELECT WAREHOUSE_NAME, SUM(CREDITS_USED) AS CREDITS_USED, SUM(CREDITS_USED_COMPUTE) AS CREDITS_USED_COMPUTE,`)
+	q.WriteString(` SUM(CREDITS_USED_CLOUD_SERVICES) AS CREDITS_USED_CLOUD_SERVICES, COUNT(*) AS METERED_HOURS`)
+	q.WriteString(` FROM SNOWFLAKE.ACCOUNT_USAGE.WAREHOUSE_METERING_HISTORY`)
+	q.WriteString(fmt.Sprintf(` WHERE START_TIME >= DATEADD(DAY, -%d, CURRENT_TIMESTAMP())`, days))
+	if warehouseName != "" {
+		q.WriteString(fmt.Sprintf(` AND WAREHOUSE_NAME = '%v'`, EscapeString(warehouseName)))
+	}
+	q.WriteString(` GROUP BY WAREHOUSE_NAME ORDER BY CREDITS_USED DESC`)
+	return q.String()
+}
+
+// ListWarehouseMetering returns the credits used by the warehouses in the last days.
+func ListWarehouseMetering(db *sql.DB, warehouseName string, days int) ([]WarehouseMetering, error) {
+	stmt := WarehouseMeteringHistoryQuery(warehouseName, days)
+	rows, err := Query(db, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	meterings := []WarehouseMetering{}
+	if err := sqlx.StructScan(rows, &meterings); err != nil {
+		return nil, fmt.Errorf("unable to scan row for %s err = %w", stmt, err)
+	}
+	return meterings, nil
+}
@@ -0,0 +1,16 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouseMeteringHistoryQuery(t *testing.T) {
+	r := require.New(t)
+	prefix := `SELECT WAREHOUSE_NAME, SUM(CREDITS_USED) AS CREDITS_USED, SUM(CREDITS_USED_COMPUTE) AS CREDITS_USED_COMPUTE, SUM(CREDITS_USED_CLOUD_SERVICES) AS CREDITS_USED_CLOUD_SERVICES, COUNT(*) AS METERED_HOURS FROM SNOWFLAKE.ACCOUNT_USAGE.WAREHOUSE_METERING_HISTORY WHERE START_TIME >= DATEADD(DAY, -7, CURRENT_TIMESTAMP())`
+
+	r.Equal(prefix+` GROUP BY WAREHOUSE_NAME ORDER BY CREDITS_USED DESC`, snowflake.WarehouseMeteringHistoryQuery("", 7))
+	r.Equal(prefix+` AND WAREHOUSE_NAME = 'COMPUTE_WH' GROUP BY WAREHOUSE_NAME ORDER BY CREDITS_USED DESC`, snowflake.WarehouseMeteringHistoryQuery("COMPUTE_WH", 7))
+}